@@ -0,0 +1,143 @@
+// Command novamusic runs the novamusic services and maintains their database.
+//
+// Usage:
+//
+//	novamusic serve [service|all]   run a service, MICROSERVICE env by default
+//	novamusic migrate <command>     run up, up-to, down, down-to or status
+//	novamusic seed                  fill the database with development fixtures
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/app"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/postgres"
+)
+
+const allServices = "all"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		// a second signal terminates the process without waiting for shutdown
+		stop()
+	}()
+
+	var err error
+	switch command, args := os.Args[1], os.Args[2:]; command {
+	case "serve":
+		err = serve(ctx, args)
+	case "migrate":
+		err = migrate(ctx, args)
+	case "seed":
+		err = seed(ctx)
+	default:
+		usage()
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	names := make([]string, 0, len(services))
+	for _, service := range services {
+		names = append(names, service.name)
+	}
+
+	fmt.Fprintf(os.Stderr, "usage:\n"+
+		"  novamusic serve [%s|%s]\n"+
+		"  novamusic migrate up|up-to <version>|down|down-to <version>|status\n"+
+		"  novamusic seed\n",
+		strings.Join(names, "|"), allServices,
+	)
+	os.Exit(2)
+}
+
+func serve(ctx context.Context, args []string) error {
+	name := os.Getenv("MICROSERVICE")
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	setups := make([]setup, 0, len(services))
+	if name == allServices {
+		for _, service := range services {
+			setups = append(setups, service.setup)
+		}
+	} else {
+		setup, ok := findSetup(name)
+		if !ok {
+			return fmt.Errorf("unknown service %q", name)
+		}
+		setups = append(setups, setup)
+	}
+
+	a, err := app.New(ctx, name, name == allServices)
+	if err != nil {
+		return err
+	}
+
+	for _, setup := range setups {
+		if err := setup(a); err != nil {
+			return fmt.Errorf("failed to set up %s: %v", name, err)
+		}
+	}
+
+	a.Logger.Infof("serving %s", name)
+
+	return a.Run()
+}
+
+func migrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		usage()
+	}
+
+	db, err := connect()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return app.Migrate(ctx, db, args[0], args[1:]...)
+}
+
+func seed(ctx context.Context) error {
+	db, err := connect()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return app.Seed(ctx, db)
+}
+
+func connect() (*sql.DB, error) {
+	cfg, err := config.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %v", err)
+	}
+
+	pg, err := postgres.New(&cfg.Postgres)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create postgres client: %v", err)
+	}
+
+	return pg, nil
+}
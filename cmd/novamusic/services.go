@@ -0,0 +1,326 @@
+package main
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/app"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/delivery/grpc/service"
+	albumHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/delivery/http"
+	albumRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/repository"
+	albumUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/usecase"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/delivery/grpc/service"
+	artistHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/delivery/http"
+	artistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/repository"
+	artistUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/usecase"
+	auditHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/delivery/http"
+	csatHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/delivery/http"
+	exportHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/delivery/http"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/delivery/grpc/service"
+	genreHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/delivery/http"
+	genreRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/repository"
+	genreUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/usecase"
+	graphqlHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql/delivery/http"
+	migrationHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/delivery/http"
+	notificationHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/delivery/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/delivery/listener"
+	notificationProducer "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/producer"
+	pagesHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/delivery/http"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/delivery/grpc/service"
+	playlistHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/delivery/http"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	playlistUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/usecase"
+	queueHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/delivery/http"
+	queueListener "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/delivery/listener"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/delivery/grpc/service"
+	trackHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/delivery/http"
+	trackRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/repository"
+	trackUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/usecase"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/delivery/grpc/service"
+	userHttp "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/delivery/http"
+	userRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/repository/postgres"
+	userUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/usecase"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/postgres"
+	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
+	albumClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	genreClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	playlistClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userClient "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+)
+
+// setup binds routes and grpc services of a single service to the application.
+type setup func(a *app.App) error
+
+// services lists setups in the order they are bound in the all mode.
+var services = []struct {
+	name  string
+	setup setup
+}{
+	{"user", setupUser},
+	{"artist", setupArtist},
+	{"album", setupAlbum},
+	{"track", setupTrack},
+	{"genre", setupGenre},
+	{"playlist", setupPlaylist},
+	{"queue", setupQueue},
+	{"pages", setupPages},
+	{"graphql", setupGraphQL},
+	{"csat", setupCSAT},
+	{"audit", setupAudit},
+	{"notification", setupNotification},
+	{"export", setupExport},
+	{"migration", setupMigration},
+}
+
+func findSetup(name string) (setup, bool) {
+	for _, service := range services {
+		if service.name == name {
+			return service.setup, true
+		}
+	}
+	return nil, false
+}
+
+func setupUser(a *app.App) error {
+	a.RequireBuckets("avatars", "playlists", "exports")
+	userHttp.BindRoutes(a.HTTP)
+
+	userPGRepo := userRepo.NewUserPostgresRepository(a.PG, a.Logger)
+	userS3Repo := s3Repo.NewS3Repository(a.S3, a.Logger)
+	recorder := audit.NewPGRecorder(a.PG, a.Logger)
+	userUsecase := userUsecase.NewUserUsecase(&a.CFG.Service.Auth, &a.CFG.Minio, userPGRepo, userS3Repo, recorder, a.Logger)
+	a.RegisterGRPC(userService.RegisterUserService(&a.CFG.Service.Auth, userUsecase, a.Logger))
+
+	return nil
+}
+
+func setupArtist(a *app.App) error {
+	a.RequireBuckets("images")
+	artistHttp.BindRoutes(a.HTTP)
+
+	artistPGRepo := artistRepo.NewArtistPGRepository(a.PG)
+	artistS3Repo := s3Repo.NewS3Repository(a.S3, a.Logger)
+	artistUsecase := artistUsecase.NewArtistUsecase(artistPGRepo, artistS3Repo, a.Logger)
+	a.RegisterGRPC(artistService.RegisterArtistService(artistUsecase, a.Logger))
+
+	return nil
+}
+
+func setupAlbum(a *app.App) error {
+	conn, err := a.Client("artist")
+	if err != nil {
+		return err
+	}
+	artistClient := artistClient.NewArtistServiceClient(conn)
+
+	a.RequireBuckets("images")
+	albumHttp.BindRoutes(a.HTTP, artistClient)
+
+	albumPGRepo := albumRepo.NewAlbumPGRepository(a.PG)
+	albumS3Repo := s3Repo.NewS3Repository(a.S3, a.Logger)
+	notificationProducer := notificationProducer.NewNotificationPGProducer(a.PG)
+	albumUsecase := albumUsecase.NewAlbumUsecase(albumPGRepo, albumS3Repo, artistClient, notificationProducer, a.Logger)
+	a.RegisterGRPC(albumService.RegisterAlbumService(albumUsecase, a.Logger))
+
+	return nil
+}
+
+func setupTrack(a *app.App) error {
+	connArtist, err := a.Client("artist")
+	if err != nil {
+		return err
+	}
+
+	connAlbum, err := a.Client("album")
+	if err != nil {
+		return err
+	}
+
+	connPlaylist, err := a.Client("playlist")
+	if err != nil {
+		return err
+	}
+
+	artistClient := artistClient.NewArtistServiceClient(connArtist)
+	albumClient := albumClient.NewAlbumServiceClient(connAlbum)
+	playlistClient := playlistClient.NewPlaylistServiceClient(connPlaylist)
+
+	trackHttp.BindRoutes(a.HTTP, artistClient, albumClient, playlistClient)
+
+	trackPGRepo := trackRepo.NewTrackPGRepository(a.PG)
+	trackUsecase := trackUsecase.NewTrackUsecase(trackPGRepo, artistClient, albumClient, playlistClient, a.Logger)
+	a.RegisterGRPC(trackService.RegisterTrackService(trackUsecase, a.Logger))
+
+	return nil
+}
+
+func setupGenre(a *app.App) error {
+	genreHttp.BindRoutes(a.HTTP)
+
+	genrePGRepo := genreRepo.NewGenrePGRepository(a.PG)
+	genreUsecase := genreUsecase.NewGenreUsecase(genrePGRepo, a.Logger)
+	a.RegisterGRPC(genreService.RegisterGenreService(genreUsecase, a.Logger))
+
+	return nil
+}
+
+func setupPlaylist(a *app.App) error {
+	conn, err := a.Client("user")
+	if err != nil {
+		return err
+	}
+
+	userClient := userClient.NewUserServiceClient(conn)
+
+	a.RequireBuckets("playlists", "images")
+	playlistHttp.BindRoutes(a.HTTP, userClient)
+
+	playlistPGRepo := playlistRepo.NewPlaylistRepository(a.PG)
+	playlistS3Repo := s3Repo.NewS3Repository(a.S3, a.Logger)
+	notificationProducer := notificationProducer.NewNotificationPGProducer(a.PG)
+	playlistUsecase := playlistUsecase.NewPlaylistUsecase(&a.CFG.Minio, &a.CFG.Service.Trash, playlistPGRepo, playlistS3Repo, userClient, notificationProducer, a.Logger)
+	a.RegisterGRPC(playlistService.RegisterPlaylistService(playlistUsecase, a.Logger))
+
+	return nil
+}
+
+func setupQueue(a *app.App) error {
+	connArtist, err := a.Client("artist")
+	if err != nil {
+		return err
+	}
+
+	connAlbum, err := a.Client("album")
+	if err != nil {
+		return err
+	}
+
+	connPlaylist, err := a.Client("playlist")
+	if err != nil {
+		return err
+	}
+
+	pgListener, err := postgres.NewListener(&a.CFG.Postgres, queueListener.Channel)
+	if err != nil {
+		return err
+	}
+	a.Lifecycle.AddCloser("queue listener", pgListener.Close)
+
+	queueHttp.BindRoutes(
+		a.HTTP,
+		artistClient.NewArtistServiceClient(connArtist),
+		albumClient.NewAlbumServiceClient(connAlbum),
+		playlistClient.NewPlaylistServiceClient(connPlaylist),
+		pgListener,
+	)
+
+	return nil
+}
+
+func setupPages(a *app.App) error {
+	connArtist, err := a.Client("artist")
+	if err != nil {
+		return err
+	}
+
+	connAlbum, err := a.Client("album")
+	if err != nil {
+		return err
+	}
+
+	connTrack, err := a.Client("track")
+	if err != nil {
+		return err
+	}
+
+	connGenre, err := a.Client("genre")
+	if err != nil {
+		return err
+	}
+
+	connPlaylist, err := a.Client("playlist")
+	if err != nil {
+		return err
+	}
+
+	pagesHttp.BindRoutes(
+		a.HTTP,
+		artistClient.NewArtistServiceClient(connArtist),
+		albumClient.NewAlbumServiceClient(connAlbum),
+		trackClient.NewTrackServiceClient(connTrack),
+		genreClient.NewGenreServiceClient(connGenre),
+		playlistClient.NewPlaylistServiceClient(connPlaylist),
+	)
+
+	return nil
+}
+
+func setupGraphQL(a *app.App) error {
+	return graphqlHttp.BindRoutes(a.HTTP)
+}
+
+func setupCSAT(a *app.App) error {
+	csatHttp.BindRoutes(a.HTTP)
+	return nil
+}
+
+func setupAudit(a *app.App) error {
+	auditHttp.BindRoutes(a.HTTP)
+	return nil
+}
+
+func setupNotification(a *app.App) error {
+	pgListener, err := postgres.NewListener(&a.CFG.Postgres, listener.Channel)
+	if err != nil {
+		return err
+	}
+	a.Lifecycle.AddCloser("notification listener", pgListener.Close)
+
+	notificationHttp.BindRoutes(a.HTTP, pgListener)
+
+	return nil
+}
+
+func setupExport(a *app.App) error {
+	connUser, err := a.Client("user")
+	if err != nil {
+		return err
+	}
+
+	connTrack, err := a.Client("track")
+	if err != nil {
+		return err
+	}
+
+	connAlbum, err := a.Client("album")
+	if err != nil {
+		return err
+	}
+
+	connArtist, err := a.Client("artist")
+	if err != nil {
+		return err
+	}
+
+	connPlaylist, err := a.Client("playlist")
+	if err != nil {
+		return err
+	}
+
+	a.RequireBuckets("exports")
+	exportHttp.BindRoutes(
+		a.HTTP,
+		userClient.NewUserServiceClient(connUser),
+		trackClient.NewTrackServiceClient(connTrack),
+		albumClient.NewAlbumServiceClient(connAlbum),
+		artistClient.NewArtistServiceClient(connArtist),
+		playlistClient.NewPlaylistServiceClient(connPlaylist),
+	)
+
+	return nil
+}
+
+func setupMigration(a *app.App) error {
+	migrationHttp.BindRoutes(a.HTTP)
+	return nil
+}
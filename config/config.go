@@ -21,6 +21,14 @@ type ServiceConfig struct {
 	CORS   CORSConfig   `yaml:"cors"`
 	Logger LoggerConfig `yaml:"logger"`
 	Auth   AuthConfig   `yaml:"auth"`
+	Cache  CacheConfig  `yaml:"cache"`
+	// Idempotency configures replaying responses of retried requests.
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+	// Trash configures how long deleted playlists can be restored.
+	Trash TrashConfig `yaml:"trash"`
+	// ShutdownTimeout limits in seconds how long requests and workers
+	// are drained when the service stops.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
 }
 
 type HTTPConfig struct {
@@ -37,6 +45,20 @@ type GRPCConfig struct {
 	Timeout           time.Duration `yaml:"timeout"`
 	MaxConnectionAge  time.Duration `yaml:"maxConnectionAge"`
 	Time              time.Duration `yaml:"time"`
+	// Clients maps a service name to the address of its grpc server.
+	Clients map[string]string `yaml:"clients"`
+	// TLS enables mutual tls between services when its CAPath is set.
+	TLS TLSConfig `yaml:"tls"`
+	// Allow lists services allowed to call each method under mutual tls,
+	// methods not listed are denied.
+	Allow []GRPCAllowConfig `yaml:"allow"`
+}
+
+type GRPCAllowConfig struct {
+	// Method is a full method name, e.g. /userService.UserService/FindByID.
+	Method string `yaml:"method"`
+	// Services are identities of callers, "*" stands for any service.
+	Services []string `yaml:"services"`
 }
 
 type CORSConfig struct {
@@ -50,6 +72,29 @@ type CORSConfig struct {
 type TLSConfig struct {
 	CertPath string `yaml:"certPath"`
 	KeyPath  string `yaml:"keyPath"`
+	// CAPath is the authority certificates of peers are verified against.
+	CAPath string `yaml:"caPath"`
+}
+
+// CacheConfig holds Cache-Control policies routes are served with.
+type CacheConfig struct {
+	// Public is the policy of catalog responses equal for every user,
+	// shared caches may store them.
+	Public string `yaml:"public"`
+	// Private is the policy of responses of a single user.
+	Private string `yaml:"private"`
+}
+
+type IdempotencyConfig struct {
+	// TTL is how long in seconds the response of a request is replayed
+	// for its idempotency key.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+type TrashConfig struct {
+	// Retention is how long in seconds a deleted playlist stays in the
+	// trash before it is purged.
+	Retention time.Duration `yaml:"retention"`
 }
 
 type LoggerConfig struct {
@@ -99,6 +144,8 @@ type MinioConfig struct {
 	User     string `yaml:"user"`
 	Password string `yaml:"password"`
 	SSLMode  bool   `yaml:"sslMode"`
+	// PublicURL is where clients reach the buckets through the proxy.
+	PublicURL string `yaml:"publicURL"`
 }
 
 func New() (*Config, error) {
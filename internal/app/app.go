@@ -0,0 +1,200 @@
+// Package app holds the bootstrapping shared by every novamusic service:
+// configuration, logger, storage clients, metrics, servers and grpc clients.
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/health"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/lifecycle"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/metrics"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/mtls"
+	grpcServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/grpc"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/postgres"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// healthCheckTimeout limits every dependency check of the readiness probe.
+const healthCheckTimeout = 2 * time.Second
+
+type App struct {
+	Name    string
+	CFG     *config.Config
+	Logger  logger.Logger
+	PG      postgres.Client
+	S3      *minio.Client
+	Metrics *metrics.Metrics
+	HTTP    *httpServer.Server
+	// Lifecycle owns servers, workers and resources of the application.
+	Lifecycle *lifecycle.Manager
+	// Health checks the dependencies of the application.
+	Health *health.Checker
+
+	// local makes grpc clients dial the server of this process,
+	// it is set when every service runs in one process.
+	local    bool
+	services []func(server *grpc.Server)
+
+	// credentials of grpc servers and clients, both are set under mutual tls.
+	serverCredentials credentials.TransportCredentials
+	clientCredentials credentials.TransportCredentials
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// New loads the configuration and creates the clients shared by services,
+// name is used as the metrics subsystem. Cancelling ctx stops the application.
+func New(ctx context.Context, name string, local bool) (*App, error) {
+	cfg, err := config.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %v", err)
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+
+	var serverCredentials, clientCredentials credentials.TransportCredentials
+	// traffic never leaves the process in the local mode
+	if mtls.Enabled(&cfg.Service.GRPC.TLS) && !local {
+		if serverCredentials, err = mtls.ServerCredentials(&cfg.Service.GRPC.TLS); err != nil {
+			return nil, err
+		}
+		if clientCredentials, err = mtls.ClientCredentials(&cfg.Service.GRPC.TLS); err != nil {
+			return nil, err
+		}
+	}
+
+	pg, err := postgres.New(&cfg.Postgres)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create postgres client: %v", err)
+	}
+
+	s3, err := s3.New(&cfg.Minio)
+	if err != nil {
+		(*sql.DB)(pg).Close()
+		return nil, fmt.Errorf("failed to create s3 client: %v", err)
+	}
+
+	metrics := metrics.New("backend", name)
+
+	lifecycle := lifecycle.New(ctx, logger, cfg.Service.ShutdownTimeout*time.Second)
+	lifecycle.AddCloser("postgres client", (*sql.DB)(pg).Close)
+	// the keys of every service share a table, the store lets one process
+	// at a time purge it
+	idempotencyStore := idempotency.NewPGStore(pg)
+	lifecycle.Go("idempotency keys purge", func(ctx context.Context) error {
+		return idempotency.RunPurge(ctx, idempotencyStore, logger)
+	})
+
+	checker := health.New(healthCheckTimeout)
+	checker.Add("postgres", health.Postgres(pg))
+	lifecycle.OnShutdown(checker.Shutdown)
+
+	a := &App{
+		Name:      name,
+		CFG:       cfg,
+		Logger:    logger,
+		PG:        pg,
+		S3:        s3,
+		Metrics:   metrics,
+		HTTP:      httpServer.New(cfg, pg, s3, logger, metrics, lifecycle),
+		Lifecycle: lifecycle,
+		Health:    checker,
+		local:     local,
+		conns:     make(map[string]*grpc.ClientConn),
+
+		serverCredentials: serverCredentials,
+		clientCredentials: clientCredentials,
+	}
+	a.HTTP.MUX.HandleFunc("/livez", checker.Livez).Methods("GET")
+	a.HTTP.MUX.HandleFunc("/readyz", checker.Readyz).Methods("GET")
+
+	return a, nil
+}
+
+// Client returns a connection to the grpc server of the service,
+// connections are shared and closed when the application stops.
+func (a *App) Client(service string) (*grpc.ClientConn, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if conn, ok := a.conns[service]; ok {
+		return conn, nil
+	}
+
+	target, ok := a.CFG.Service.GRPC.Clients[service]
+	if a.local {
+		target, ok = "localhost"+a.CFG.Service.GRPC.Port, true
+	}
+	if !ok {
+		return nil, fmt.Errorf("no grpc address configured for %s service", service)
+	}
+
+	creds := a.clientCredentials
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s service: %v", service, err)
+	}
+	a.conns[service] = conn
+	a.Lifecycle.AddCloser(service+" service connection", conn.Close)
+	// services of this process are ready together with it
+	if !a.local {
+		a.Health.AddDownstream(service+" service", health.GRPC(conn))
+	}
+
+	return conn, nil
+}
+
+// RequireBuckets makes the application ready only if the buckets exist.
+func (a *App) RequireBuckets(buckets ...string) {
+	for _, bucket := range buckets {
+		a.Health.Add(bucket+" bucket", health.Bucket(a.S3, bucket))
+	}
+}
+
+// RegisterGRPC adds services to the grpc server,
+// the server is started only if something was registered.
+func (a *App) RegisterGRPC(register func(server *grpc.Server)) {
+	a.services = append(a.services, register)
+}
+
+// Run starts the grpc server, if anything was registered, and then the http
+// server. It blocks until the application is stopped and every resource
+// is released.
+func (a *App) Run() error {
+	if len(a.services) > 0 {
+		services := a.services
+		server := grpcServer.New(a.CFG, a.PG, a.S3, a.Logger, a.Metrics, func(server *grpc.Server) {
+			for _, register := range services {
+				register(server)
+			}
+
+			names := make([]string, 0, len(server.GetServiceInfo()))
+			for name := range server.GetServiceInfo() {
+				names = append(names, name)
+			}
+			a.Health.RegisterGRPC(server, names...)
+		})
+		server.Credentials = a.serverCredentials
+		a.Lifecycle.AddServer("grpc", server)
+	}
+	a.Lifecycle.AddServer("http", a.HTTP)
+
+	return a.Lifecycle.Run()
+}
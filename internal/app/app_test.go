@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	migrations "github.com/go-park-mail-ru/2024_2_NovaCode/internal/db/postgres"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/health"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/lifecycle"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/matching"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func newTestApp(local bool) *App {
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	return &App{
+		Lifecycle: lifecycle.New(context.Background(), logger, time.Second),
+		Health:    health.New(100 * time.Millisecond),
+		CFG: &config.Config{
+			Service: config.ServiceConfig{
+				GRPC: config.GRPCConfig{
+					Port:    ":9000",
+					Clients: map[string]string{"user": "novamusic-user:9000"},
+				},
+			},
+		},
+		local: local,
+		conns: make(map[string]*grpc.ClientConn),
+	}
+}
+
+func TestAppClient(t *testing.T) {
+	a := newTestApp(false)
+
+	conn, err := a.Client("user")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, "novamusic-user:9000", conn.Target())
+
+	same, err := a.Client("user")
+	require.NoError(t, err)
+	require.Same(t, conn, same)
+
+	_, err = a.Client("artist")
+	require.Error(t, err)
+}
+
+func TestAppClient_Local(t *testing.T) {
+	a := newTestApp(true)
+
+	conn, err := a.Client("artist")
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Equal(t, "localhost:9000", conn.Target())
+
+	report := a.Health.Check(context.Background(), true)
+	require.NotContains(t, report.Checks, "artist service")
+}
+
+func TestSeed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "user"`).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO playlist`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, Seed(context.Background(), db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSeed_Rollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "user"`).WillReturnError(errors.New("database error"))
+	mock.ExpectRollback()
+
+	require.Error(t, Seed(context.Background(), db))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationsEmbedded(t *testing.T) {
+	goose.SetBaseFS(migrations.Migrations)
+	defer goose.SetBaseFS(nil)
+
+	collected, err := goose.CollectMigrations("migrations", 0, goose.MaxVersion)
+	require.NoError(t, err)
+	require.NotEmpty(t, collected)
+}
+
+// TestNormalizeName runs the names through normalize_name and
+// matching.Normalize, the imports look the catalog up by comparing the two.
+// It needs a disposable database to migrate.
+func TestNormalizeName(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN isn't set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	require.NoError(t, Migrate(ctx, db, "up"))
+
+	names := []string{
+		"I Just Want To Hide My Face",
+		"Don't Stop Me Now - Remastered 2011",
+		"Song (feat. Someone) [Live]",
+		"Song feat. Someone",
+		"Song - Radio Edit",
+		"Beyoncé",
+		"Sigur Rós",
+		"Motörhead",
+		"Mădălina",
+		"Øyvind & Straße",
+		"Ёлка  и   Мой Друг!",
+		"Мой Йорш",
+		"Rock’n’Roll",
+		"  ",
+	}
+	for _, name := range names {
+		var normalized string
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT normalize_name($1)", name).Scan(&normalized))
+		require.Equal(t, matching.Normalize(name), normalized, name)
+	}
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	migrations "github.com/go-park-mail-ru/2024_2_NovaCode/internal/db/postgres"
+	"github.com/pressly/goose/v3"
+)
+
+// Migrate runs a goose command, e.g. up, up-to, down, down-to or status,
+// against the migrations embedded into the binary.
+func Migrate(ctx context.Context, db *sql.DB, command string, args ...string) error {
+	goose.SetBaseFS(migrations.Migrations)
+	defer goose.SetBaseFS(nil)
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		return err
+	}
+
+	if err := goose.RunContext(ctx, command, db, "migrations", args...); err != nil {
+		return fmt.Errorf("failed to run migrate %s: %v", command, err)
+	}
+
+	return nil
+}
+
+// Seed applies the embedded development fixtures in file name order within
+// a single transaction, fixtures are idempotent and may be applied many times.
+func Seed(ctx context.Context, db *sql.DB) error {
+	files, err := fs.Glob(migrations.Seeds, "seeds/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, file := range files {
+		query, err := fs.ReadFile(migrations.Seeds, file)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, string(query)); err != nil {
+			return fmt.Errorf("failed to apply %s: %v", file, err)
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,92 @@
+// Package audit records administrative and security-relevant actions to an
+// append-only log, telling who did what to which target and when.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+)
+
+const (
+	ActionUserRegister    = "user.register"
+	ActionUserLogin       = "user.login"
+	ActionUserLoginFailed = "user.login_failed"
+	ActionUserUpdate      = "user.update"
+	ActionDeletionRequest = "user.deletion_request"
+	ActionDeletionCancel  = "user.deletion_cancel"
+	ActionUserDelete      = "user.delete"
+
+	ActionCSATStatisticsView = "csat.statistics_view"
+	ActionCSATTrendsView     = "csat.trends_view"
+	ActionSurveyCreate       = "csat.survey_create"
+	ActionSurveyUpdate       = "csat.survey_update"
+	ActionSurveyDelete       = "csat.survey_delete"
+	ActionQuestionCreate     = "csat.question_create"
+	ActionQuestionUpdate     = "csat.question_update"
+	ActionQuestionDelete     = "csat.question_delete"
+	ActionQuestionsReorder   = "csat.questions_reorder"
+)
+
+const (
+	TargetUser         = "user"
+	TargetCSATSurvey   = "csat_survey"
+	TargetCSATQuestion = "csat_question"
+	TargetCSATAnswers  = "csat_answers"
+)
+
+type Recorder interface {
+	// Record appends the event, its actor, request ID and IP are taken from
+	// ctx unless set. A failure to record is logged and never fails the
+	// action itself.
+	Record(ctx context.Context, event *models.AuditEvent)
+}
+
+// Diff returns the fields of before and after that differ, both are
+// encoded as JSON objects first and either may be nil.
+func Diff(before, after any) (json.RawMessage, json.RawMessage) {
+	beforeFields, afterFields := fields(before), fields(after)
+
+	changedBefore := make(map[string]json.RawMessage)
+	changedAfter := make(map[string]json.RawMessage)
+	for name, value := range beforeFields {
+		if !bytes.Equal(value, afterFields[name]) {
+			changedBefore[name] = value
+		}
+	}
+	for name, value := range afterFields {
+		if !bytes.Equal(value, beforeFields[name]) {
+			changedAfter[name] = value
+		}
+	}
+
+	return encode(changedBefore), encode(changedAfter)
+}
+
+func fields(value any) map[string]json.RawMessage {
+	result := make(map[string]json.RawMessage)
+	if value == nil {
+		return result
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return result
+	}
+	_ = json.Unmarshal(raw, &result)
+	return result
+}
+
+func encode(fields map[string]json.RawMessage) json.RawMessage {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return nil
+	}
+	return raw
+}
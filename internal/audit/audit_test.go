@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	type survey struct {
+		Title    string
+		Topic    string
+		IsActive bool
+	}
+
+	before, after := Diff(
+		&survey{Title: "old", Topic: "player", IsActive: true},
+		&survey{Title: "new", Topic: "player", IsActive: false},
+	)
+	require.JSONEq(t, `{"Title":"old","IsActive":true}`, string(before))
+	require.JSONEq(t, `{"Title":"new","IsActive":false}`, string(after))
+
+	before, after = Diff(nil, map[string]any{"username": "user"})
+	require.Nil(t, before)
+	require.JSONEq(t, `{"username":"user"}`, string(after))
+
+	before, after = Diff(map[string]any{"username": "user"}, map[string]any{"username": "user"})
+	require.Nil(t, before)
+	require.Nil(t, after)
+}
+
+func TestPGRecorderRecord(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	recorder := NewPGRecorder(db, logger.New(&config.LoggerConfig{}))
+	actorID := uuid.New()
+	requestID := uuid.New()
+
+	ctx := context.WithValue(context.Background(), utils.UserIDKey{}, actorID)
+	ctx = context.WithValue(ctx, utils.RequestIDKey{}, requestID)
+	ctx = context.WithValue(ctx, utils.ClientIPKey{}, "10.0.0.1")
+
+	event := &models.AuditEvent{
+		Action:     ActionSurveyUpdate,
+		TargetType: TargetCSATSurvey,
+		TargetID:   "1",
+		Before:     json.RawMessage(`{"Title":"old"}`),
+		After:      json.RawMessage(`{"Title":"new"}`),
+	}
+	mock.ExpectExec(insertEventQuery).
+		WithArgs(
+			actorID, ActionSurveyUpdate, TargetCSATSurvey, "1",
+			[]byte(`{"Title":"old"}`), []byte(`{"Title":"new"}`), requestID.String(), "10.0.0.1",
+		).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	recorder.Record(ctx, event)
+
+	mock.ExpectExec(insertEventQuery).
+		WithArgs(nil, ActionUserLoginFailed, TargetUser, "user", nil, nil, "", "").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	recorder.Record(context.Background(), &models.AuditEvent{
+		Action:     ActionUserLoginFailed,
+		TargetType: TargetUser,
+		TargetID:   "user",
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const insertEventQuery = `
+    INSERT INTO audit_event (actor_id, action, target_type, target_id, before, after, request_id, ip)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+type PGRecorder struct {
+	db     *sql.DB
+	logger logger.Logger
+}
+
+func NewPGRecorder(db *sql.DB, logger logger.Logger) *PGRecorder {
+	return &PGRecorder{db: db, logger: logger}
+}
+
+func (r *PGRecorder) Record(ctx context.Context, event *models.AuditEvent) {
+	fromContext(ctx, event)
+
+	var actorID any
+	if event.ActorID != uuid.Nil {
+		actorID = event.ActorID
+	}
+
+	// the event is kept even if the request is cancelled after the action
+	_, err := r.db.ExecContext(
+		context.WithoutCancel(ctx),
+		insertEventQuery,
+		actorID,
+		event.Action,
+		event.TargetType,
+		event.TargetID,
+		nullJSON(event.Before),
+		nullJSON(event.After),
+		event.RequestID,
+		event.IP,
+	)
+	if err != nil {
+		r.logger.Errorf("failed to record audit event %s: %v", event.Action, err)
+	}
+}
+
+func fromContext(ctx context.Context, event *models.AuditEvent) {
+	if event.ActorID == uuid.Nil {
+		if userID, ok := ctx.Value(utils.UserIDKey{}).(uuid.UUID); ok {
+			event.ActorID = userID
+		}
+	}
+	if event.RequestID == "" {
+		if requestID := ctx.Value(utils.RequestIDKey{}); requestID != nil {
+			event.RequestID = fmt.Sprint(requestID)
+		}
+	}
+	if event.IP == "" {
+		if ip, ok := ctx.Value(utils.ClientIPKey{}).(string); ok {
+			event.IP = ip
+		}
+	}
+}
+
+func nullJSON(raw json.RawMessage) any {
+	if raw == nil {
+		return nil
+	}
+	return []byte(raw)
+}
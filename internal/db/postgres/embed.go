@@ -0,0 +1,15 @@
+// Package postgres bundles the schema migrations and development seeds
+// into the binary, so they can be applied without the source tree.
+package postgres
+
+import "embed"
+
+// Migrations holds goose migrations, files are under the migrations directory.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// Seeds holds idempotent development fixtures, files are under the seeds directory.
+//
+//go:embed seeds/*.sql
+var Seeds embed.FS
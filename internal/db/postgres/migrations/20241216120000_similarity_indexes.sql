@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS genre_track_track_idx ON genre_track (track_id);
+CREATE INDEX IF NOT EXISTS genre_artist_artist_idx ON genre_artist (artist_id);
+CREATE INDEX IF NOT EXISTS favorite_track_track_idx ON favorite_track (track_id);
+CREATE INDEX IF NOT EXISTS favorite_artist_artist_idx ON favorite_artist (artist_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS favorite_artist_artist_idx;
+DROP INDEX IF EXISTS favorite_track_track_idx;
+DROP INDEX IF EXISTS genre_artist_artist_idx;
+DROP INDEX IF EXISTS genre_track_track_idx;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "notification" (
+  id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+  user_id UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+  type TEXT NOT NULL,
+    CONSTRAINT notification_type_enum CHECK (type IN ('album_released', 'playlist_favorited')),
+  entity_id INT NOT NULL,
+  message TEXT NOT NULL,
+    CONSTRAINT notification_message_length CHECK (char_length(message) <= 255),
+  is_read BOOL NOT NULL DEFAULT false,
+  created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX notification_user_idx ON notification (user_id, created_at DESC);
+CREATE INDEX notification_unread_idx ON notification (user_id) WHERE NOT is_read;
+
+CREATE OR REPLACE FUNCTION notify_notification_created() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_notify('notification_created', json_build_object('id', NEW.id, 'user_id', NEW.user_id)::text);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notification_created
+  AFTER INSERT ON notification
+  FOR EACH ROW EXECUTE FUNCTION notify_notification_created();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS notification_created ON notification;
+DROP FUNCTION IF EXISTS notify_notification_created;
+DROP TABLE IF EXISTS "notification" CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS play_queue (
+  user_id UUID PRIMARY KEY REFERENCES "user" (id) ON DELETE CASCADE,
+  track_ids BIGINT[] NOT NULL DEFAULT '{}',
+  original_track_ids BIGINT[] NOT NULL DEFAULT '{}',
+  current_index INT NOT NULL DEFAULT 0,
+    CONSTRAINT play_queue_current_index_positive CHECK (current_index >= 0),
+  position_ms BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT play_queue_position_positive CHECK (position_ms >= 0),
+  is_playing BOOL NOT NULL DEFAULT false,
+  shuffle BOOL NOT NULL DEFAULT false,
+  repeat_mode TEXT NOT NULL DEFAULT 'off',
+    CONSTRAINT play_queue_repeat_mode_enum CHECK (repeat_mode IN ('off', 'all', 'one')),
+  source_type TEXT NOT NULL DEFAULT '',
+  source_id INT NOT NULL DEFAULT 0,
+  active_device TEXT NOT NULL DEFAULT '',
+    CONSTRAINT play_queue_active_device_length CHECK (char_length(active_device) <= 64),
+  version BIGINT NOT NULL DEFAULT 1,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS play_queue CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS "track_lyrics" (
+  track_id INT PRIMARY KEY REFERENCES track (id) ON DELETE CASCADE,
+  synced BOOL NOT NULL DEFAULT false,
+  content TEXT NOT NULL,
+    CONSTRAINT track_lyrics_content_length CHECK (char_length(content) <= 20000),
+  plain_text TEXT NOT NULL,
+  fts tsvector GENERATED ALWAYS AS (
+    to_tsvector('english', plain_text) || to_tsvector('russian_hunspell', plain_text)
+  ) STORED,
+  created_at TIMESTAMPTZ DEFAULT NOW(),
+  updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX track_lyrics_fts_idx
+    ON "track_lyrics"
+    USING GIN (fts);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS track_lyrics_fts_idx;
+DROP TABLE IF EXISTS "track_lyrics" CASCADE;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "playlist"
+  ADD COLUMN IF NOT EXISTS has_custom_image BOOL NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "playlist"
+  DROP COLUMN IF EXISTS has_custom_image;
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE track ADD COLUMN IF NOT EXISTS isrc TEXT
+  CONSTRAINT track_isrc_format CHECK (isrc ~ '^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$');
+CREATE INDEX IF NOT EXISTS track_isrc_idx ON track (isrc);
+
+CREATE INDEX IF NOT EXISTS track_name_trgm_idx ON track USING GIN (lower(name) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS artist_name_trgm_idx ON artist USING GIN (lower(name) gin_trgm_ops);
+
+CREATE TABLE IF NOT EXISTS migration_job (
+  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  user_id UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+  status TEXT NOT NULL DEFAULT 'pending',
+    CONSTRAINT migration_job_status_enum CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+  total INT NOT NULL DEFAULT 0,
+  processed INT NOT NULL DEFAULT 0,
+  matched INT NOT NULL DEFAULT 0,
+  ambiguous INT NOT NULL DEFAULT 0,
+  unmatched INT NOT NULL DEFAULT 0,
+  attempts INT NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS migration_job_user_idx ON migration_job (user_id);
+CREATE INDEX IF NOT EXISTS migration_job_queue_idx ON migration_job (created_at)
+  WHERE status IN ('pending', 'running');
+
+CREATE TABLE IF NOT EXISTS migration_item (
+  id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+  job_id UUID NOT NULL REFERENCES migration_job (id) ON DELETE CASCADE,
+  -- playlist is empty for the liked songs of the library
+  playlist TEXT NOT NULL DEFAULT '',
+  position INT NOT NULL,
+  title TEXT NOT NULL,
+  artist TEXT NOT NULL DEFAULT '',
+  album TEXT NOT NULL DEFAULT '',
+  isrc TEXT NOT NULL DEFAULT '',
+  status TEXT NOT NULL DEFAULT 'pending',
+    CONSTRAINT migration_item_status_enum CHECK (status IN ('pending', 'matched', 'ambiguous', 'unmatched', 'resolved', 'skipped')),
+  track_id INT REFERENCES track (id) ON DELETE SET NULL,
+  score REAL NOT NULL DEFAULT 0,
+  candidates INT[] NOT NULL DEFAULT '{}',
+  candidate_scores REAL[] NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX IF NOT EXISTS migration_item_job_idx ON migration_item (job_id, status, id);
+
+CREATE TABLE IF NOT EXISTS migration_playlist (
+  job_id UUID NOT NULL REFERENCES migration_job (id) ON DELETE CASCADE,
+  name TEXT NOT NULL,
+  playlist_id INT NOT NULL REFERENCES playlist (id) ON DELETE CASCADE,
+  PRIMARY KEY (job_id, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS migration_playlist CASCADE;
+DROP TABLE IF EXISTS migration_item CASCADE;
+DROP TABLE IF EXISTS migration_job CASCADE;
+DROP INDEX IF EXISTS artist_name_trgm_idx;
+DROP INDEX IF EXISTS track_name_trgm_idx;
+DROP INDEX IF EXISTS track_isrc_idx;
+ALTER TABLE track DROP COLUMN IF EXISTS isrc;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS data_export (
+  id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+  user_id UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+  status TEXT NOT NULL DEFAULT 'pending',
+    CONSTRAINT data_export_status_enum CHECK (status IN ('pending', 'running', 'completed', 'failed', 'expired')),
+  attempts INT NOT NULL DEFAULT 0,
+  object_key TEXT NOT NULL DEFAULT '',
+  size BIGINT NOT NULL DEFAULT 0,
+  token TEXT UNIQUE,
+  expires_at TIMESTAMPTZ,
+  error TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS data_export_user_idx ON data_export (user_id, created_at DESC);
+-- a user has one export in progress at a time
+CREATE UNIQUE INDEX IF NOT EXISTS data_export_active_idx ON data_export (user_id)
+  WHERE status IN ('pending', 'running');
+CREATE INDEX IF NOT EXISTS data_export_expires_idx ON data_export (expires_at)
+  WHERE status = 'completed';
+
+ALTER TABLE notification DROP CONSTRAINT IF EXISTS notification_type_enum;
+ALTER TABLE notification ADD CONSTRAINT notification_type_enum
+  CHECK (type IN ('album_released', 'playlist_favorited', 'data_export_ready'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM notification WHERE type = 'data_export_ready';
+ALTER TABLE notification DROP CONSTRAINT IF EXISTS notification_type_enum;
+ALTER TABLE notification ADD CONSTRAINT notification_type_enum
+  CHECK (type IN ('album_released', 'playlist_favorited'));
+
+DROP TABLE IF EXISTS data_export CASCADE;
+-- +goose StatementEnd
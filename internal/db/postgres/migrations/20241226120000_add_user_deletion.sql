@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "user"
+  ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS user_deletion_scheduled_idx ON "user" (deletion_scheduled_at)
+  WHERE deletion_scheduled_at IS NOT NULL;
+
+-- survey answers outlive the account without pointing at it
+ALTER TABLE csat_answer ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE csat_answer DROP CONSTRAINT IF EXISTS csat_answer_user_id_fkey;
+ALTER TABLE csat_answer ADD CONSTRAINT csat_answer_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM csat_answer WHERE user_id IS NULL;
+ALTER TABLE csat_answer DROP CONSTRAINT IF EXISTS csat_answer_user_id_fkey;
+ALTER TABLE csat_answer ADD CONSTRAINT csat_answer_user_id_fkey
+  FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE;
+ALTER TABLE csat_answer ALTER COLUMN user_id SET NOT NULL;
+
+DROP INDEX IF EXISTS user_deletion_scheduled_idx;
+ALTER TABLE "user"
+  DROP COLUMN IF EXISTS deletion_scheduled_at;
+-- +goose StatementEnd
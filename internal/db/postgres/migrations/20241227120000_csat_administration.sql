@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE csat
+  ADD COLUMN IF NOT EXISTS active_from TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS active_until TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE csat ADD CONSTRAINT csat_topic_unique UNIQUE (topic);
+ALTER TABLE csat ADD CONSTRAINT csat_active_window
+  CHECK (active_from IS NULL OR active_until IS NULL OR active_from < active_until);
+
+-- csat asks for 1 to 5, nps for 0 to 10 and rating for 1 to 10
+ALTER TABLE csat_question
+  ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'rating',
+  ADD COLUMN IF NOT EXISTS position INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+ALTER TABLE csat_question ADD CONSTRAINT csat_question_type_enum
+  CHECK (type IN ('csat', 'nps', 'rating'));
+
+UPDATE csat_question q
+SET position = ordered.position
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY csat_id ORDER BY id) AS position
+  FROM csat_question
+) ordered
+WHERE q.id = ordered.id;
+
+UPDATE csat_question SET type = 'nps' WHERE title = 'Порекомендуете ли вы NovaMusic друзьям?';
+
+CREATE INDEX IF NOT EXISTS csat_question_position_idx ON csat_question (csat_id, position);
+
+ALTER TABLE csat_answer
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- the latest answer of a user to a question wins
+DELETE FROM csat_answer a
+USING csat_answer newer
+WHERE a.user_id = newer.user_id
+  AND a.csat_question_id = newer.csat_question_id
+  AND a.id < newer.id;
+
+ALTER TABLE csat_answer ADD CONSTRAINT csat_answer_user_question_unique
+  UNIQUE (user_id, csat_question_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE csat_answer DROP CONSTRAINT IF EXISTS csat_answer_user_question_unique;
+ALTER TABLE csat_answer
+  DROP COLUMN IF EXISTS created_at,
+  DROP COLUMN IF EXISTS updated_at;
+
+DROP INDEX IF EXISTS csat_question_position_idx;
+ALTER TABLE csat_question DROP CONSTRAINT IF EXISTS csat_question_type_enum;
+ALTER TABLE csat_question
+  DROP COLUMN IF EXISTS type,
+  DROP COLUMN IF EXISTS position,
+  DROP COLUMN IF EXISTS created_at;
+
+ALTER TABLE csat DROP CONSTRAINT IF EXISTS csat_active_window;
+ALTER TABLE csat DROP CONSTRAINT IF EXISTS csat_topic_unique;
+ALTER TABLE csat
+  DROP COLUMN IF EXISTS active_from,
+  DROP COLUMN IF EXISTS active_until,
+  DROP COLUMN IF EXISTS created_at,
+  DROP COLUMN IF EXISTS updated_at;
+-- +goose StatementEnd
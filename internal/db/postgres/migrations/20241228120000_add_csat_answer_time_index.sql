@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS csat_answer_question_updated_at_idx ON csat_answer (csat_question_id, updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS csat_answer_question_updated_at_idx;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- play_count is the number of tracks the user started playing
+ALTER TABLE play_queue ADD COLUMN IF NOT EXISTS play_count BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE csat
+  ADD COLUMN IF NOT EXISTS min_account_age_days INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS min_plays INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS min_playlists INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS sample_rate INT NOT NULL DEFAULT 100,
+  ADD COLUMN IF NOT EXISTS cooldown_days INT NOT NULL DEFAULT 30;
+ALTER TABLE csat ADD CONSTRAINT csat_targeting_positive
+  CHECK (min_account_age_days >= 0 AND min_plays >= 0 AND min_playlists >= 0 AND cooldown_days >= 0);
+ALTER TABLE csat ADD CONSTRAINT csat_sample_rate_percent
+  CHECK (sample_rate BETWEEN 0 AND 100);
+
+-- csat_prompt records the surveys offered to a user
+CREATE TABLE IF NOT EXISTS csat_prompt (
+  user_id UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+  csat_id INT NOT NULL REFERENCES csat (id) ON DELETE CASCADE,
+  shown_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  dismissed_at TIMESTAMPTZ,
+  PRIMARY KEY (user_id, csat_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS csat_prompt;
+
+ALTER TABLE csat DROP CONSTRAINT IF EXISTS csat_sample_rate_percent;
+ALTER TABLE csat DROP CONSTRAINT IF EXISTS csat_targeting_positive;
+ALTER TABLE csat
+  DROP COLUMN IF EXISTS cooldown_days,
+  DROP COLUMN IF EXISTS sample_rate,
+  DROP COLUMN IF EXISTS min_playlists,
+  DROP COLUMN IF EXISTS min_plays,
+  DROP COLUMN IF EXISTS min_account_age_days;
+
+ALTER TABLE play_queue DROP COLUMN IF EXISTS play_count;
+-- +goose StatementEnd
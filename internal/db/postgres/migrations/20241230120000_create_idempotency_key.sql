@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- idempotency_key keeps the first response of a request per user, key and
+-- route, status_code is NULL while the request is in progress
+CREATE TABLE IF NOT EXISTS idempotency_key (
+  user_id UUID NOT NULL REFERENCES "user" (id) ON DELETE CASCADE,
+  key TEXT NOT NULL,
+  route TEXT NOT NULL,
+  request_hash BYTEA NOT NULL,
+  status_code INT,
+  content_type TEXT NOT NULL DEFAULT '',
+  body BYTEA NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (user_id, key, route)
+);
+
+CREATE INDEX IF NOT EXISTS idempotency_key_expires_idx ON idempotency_key (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_key;
+-- +goose StatementEnd
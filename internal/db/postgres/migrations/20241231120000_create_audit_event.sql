@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- actor_id has no reference to keep the events of deleted users
+CREATE TABLE IF NOT EXISTS audit_event (
+  id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+  actor_id UUID,
+  action TEXT NOT NULL,
+  target_type TEXT NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  before JSONB,
+  after JSONB,
+  request_id TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_actor_idx ON audit_event (actor_id, id DESC);
+CREATE INDEX IF NOT EXISTS audit_event_action_idx ON audit_event (action, id DESC);
+CREATE INDEX IF NOT EXISTS audit_event_target_idx ON audit_event (target_type, target_id, id DESC);
+
+CREATE OR REPLACE FUNCTION audit_event_append_only() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_event is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_event_append_only
+  BEFORE UPDATE OR DELETE ON audit_event
+  FOR EACH ROW EXECUTE FUNCTION audit_event_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_event;
+DROP FUNCTION IF EXISTS audit_event_append_only();
+-- +goose StatementEnd
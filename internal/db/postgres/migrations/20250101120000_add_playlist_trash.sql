@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE playlist
+  ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS playlist_deleted_idx ON playlist (owner_id, deleted_at DESC)
+  WHERE deleted_at IS NOT NULL;
+
+-- a trashed playlist doesn't hold its name, it is checked again on restore
+ALTER TABLE playlist DROP CONSTRAINT IF EXISTS playlist_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS playlist_name_idx ON playlist (name)
+  WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM playlist WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS playlist_name_idx;
+ALTER TABLE playlist ADD CONSTRAINT playlist_name_key UNIQUE (name);
+
+DROP INDEX IF EXISTS playlist_deleted_idx;
+ALTER TABLE playlist
+  DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version counts the changes of the playlist, bumping it locks the playlist
+-- so concurrent changes get distinct versions
+ALTER TABLE playlist
+  ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS playlist_version (
+  playlist_id INT NOT NULL REFERENCES playlist (id) ON DELETE CASCADE,
+  version INT NOT NULL,
+  author_id UUID REFERENCES "user" (id) ON DELETE SET NULL,
+  change TEXT NOT NULL,
+    CONSTRAINT playlist_version_change_enum CHECK (change IN ('create', 'add', 'remove', 'renamed', 'moved', 'revert')),
+  reverted_to INT,
+  name TEXT NOT NULL,
+  track_ids INT[] NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (playlist_id, version)
+);
+
+-- existing playlists start their history from their current state
+UPDATE playlist SET version = 1;
+
+INSERT INTO playlist_version (playlist_id, version, change, name, track_ids)
+SELECT
+  p.id,
+  1,
+  'create',
+  p.name,
+  ARRAY(
+    SELECT pt.track_id
+    FROM playlist_track AS pt
+    WHERE pt.playlist_id = p.id
+    ORDER BY pt.track_order_in_playlist, pt.id
+  )
+FROM playlist AS p;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS playlist_version;
+
+ALTER TABLE playlist
+  DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
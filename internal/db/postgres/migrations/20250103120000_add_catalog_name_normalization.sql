@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- immutable_unaccent names the dictionary, unaccent itself is only stable as
+-- it depends on the search path, so it can't be used in an index
+CREATE OR REPLACE FUNCTION immutable_unaccent(name TEXT) RETURNS TEXT AS $$
+  SELECT public.unaccent('public.unaccent'::regdictionary, name)
+$$ LANGUAGE SQL IMMUTABLE PARALLEL SAFE STRICT;
+
+-- normalize_name mirrors matching.Normalize step by step, so the names it
+-- compares with the catalog are found: lowercase, without decorations and
+-- suffixes, without diacritics but for "й", lowercase letters and digits
+-- separated by single spaces
+CREATE OR REPLACE FUNCTION normalize_name(name TEXT) RETURNS TEXT AS $$
+  SELECT trim(regexp_replace(
+    regexp_replace(
+      replace(
+        immutable_unaccent(replace(replace(normalize(
+          regexp_replace(
+            regexp_replace(lower(name), '\s*[(\[][^)\]]*[)\]]', '', 'g'),
+            '\s+(?:-\s+)?(?:feat\.?|ft\.?|featuring|prod\.?)\s.*$|\s+-\s+.*(?:remaster|version|edit|mix|live).*$', ''
+          ), NFC), 'ё', 'е'), 'й', chr(57344))),
+        chr(57344), 'й'
+      ),
+      '[''’`]', '', 'g'
+    ),
+    '[^[:alnum:]]+', ' ', 'g'
+  ))
+$$ LANGUAGE SQL IMMUTABLE PARALLEL SAFE;
+
+CREATE INDEX IF NOT EXISTS track_normalized_name_idx ON track (normalize_name(name));
+CREATE INDEX IF NOT EXISTS artist_normalized_name_idx ON artist (normalize_name(name));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS artist_normalized_name_idx;
+DROP INDEX IF EXISTS track_normalized_name_idx;
+DROP FUNCTION IF EXISTS normalize_name(TEXT);
+DROP FUNCTION IF EXISTS immutable_unaccent(TEXT);
+-- +goose StatementEnd
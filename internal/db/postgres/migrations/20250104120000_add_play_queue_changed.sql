@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the queue itself may not fit in a notification, instances load the
+-- announced version of the users connected to them
+CREATE OR REPLACE FUNCTION notify_play_queue_changed() RETURNS TRIGGER AS $$
+BEGIN
+  PERFORM pg_notify('play_queue_changed', json_build_object('user_id', NEW.user_id, 'version', NEW.version)::text);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER play_queue_changed
+  AFTER INSERT OR UPDATE ON play_queue
+  FOR EACH ROW EXECUTE FUNCTION notify_play_queue_changed();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS play_queue_changed ON play_queue;
+DROP FUNCTION IF EXISTS notify_play_queue_changed;
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- Development accounts, the password of each is "novamusic".
+INSERT INTO "user"
+    (role, username, email, password_hash)
+VALUES
+    ('admin', 'admin', 'admin@novamusic.local', '$2a$10$Jo4emgjWKGdB4oeEh79r0uJWgqBPCmLFs10mUiQQ3ZXf7MplpBhca'),
+    ('regular', 'demo', 'demo@novamusic.local', '$2a$10$Jo4emgjWKGdB4oeEh79r0uJWgqBPCmLFs10mUiQQ3ZXf7MplpBhca')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,17 @@
+-- A public playlist of the demo account filled with the first album tracks.
+INSERT INTO playlist
+    (name, owner_id, is_private)
+SELECT 'Demo mix', id, false FROM "user" WHERE username = 'demo'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO playlist_user
+    (playlist_id, user_id)
+SELECT p.id, p.owner_id FROM playlist AS p WHERE p.name = 'Demo mix'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO playlist_track
+    (playlist_id, track_id, track_order_in_playlist)
+SELECT p.id, t.id, ROW_NUMBER() OVER (ORDER BY t.id)
+FROM playlist AS p, track AS t
+WHERE p.name = 'Demo mix' AND t.album_id = (SELECT MIN(id) FROM album)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,23 @@
+// Package dto holds the response types shared by the services.
+package dto
+
+import "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+
+// ImageDTO lists the renditions of one size of an avatar or cover.
+//
+//easyjson:json
+type ImageDTO struct {
+	Size int    `json:"size"`
+	JPEG string `json:"jpeg"`
+	WebP string `json:"webp"`
+}
+
+// NewImagesDTO returns the renditions of the image, or nil when the image was
+// not produced by imaging.Process.
+func NewImagesDTO(image string) []*ImageDTO {
+	var imagesDTO []*ImageDTO
+	for _, variant := range imaging.Variants(image) {
+		imagesDTO = append(imagesDTO, &ImageDTO{variant.Size, variant.JPEG, variant.WebP})
+	}
+	return imagesDTO
+}
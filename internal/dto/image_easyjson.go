@@ -0,0 +1,99 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson220accf5DecodeGithubComGoParkMailRu20242NovaCodeInternalDto(in *jlexer.Lexer, out *ImageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "size":
+			out.Size = int(in.Int())
+		case "jpeg":
+			out.JPEG = string(in.String())
+		case "webp":
+			out.WebP = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson220accf5EncodeGithubComGoParkMailRu20242NovaCodeInternalDto(out *jwriter.Writer, in ImageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"jpeg\":"
+		out.RawString(prefix)
+		out.String(string(in.JPEG))
+	}
+	{
+		const prefix string = ",\"webp\":"
+		out.RawString(prefix)
+		out.String(string(in.WebP))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson220accf5EncodeGithubComGoParkMailRu20242NovaCodeInternalDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson220accf5EncodeGithubComGoParkMailRu20242NovaCodeInternalDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson220accf5DecodeGithubComGoParkMailRu20242NovaCodeInternalDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson220accf5DecodeGithubComGoParkMailRu20242NovaCodeInternalDto(l, v)
+}
@@ -0,0 +1,47 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Postgres checks the database answers a ping.
+func Postgres(db *sql.DB) Check {
+	return func(ctx context.Context) error {
+		return db.PingContext(ctx)
+	}
+}
+
+// Bucket checks the bucket exists in the storage.
+func Bucket(client *minio.Client, bucket string) Check {
+	return func(ctx context.Context) error {
+		exists, err := client.BucketExists(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("bucket %s does not exist", bucket)
+		}
+		return nil
+	}
+}
+
+// GRPC checks the server behind the connection reports it is serving.
+func GRPC(conn grpc.ClientConnInterface) Check {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		response, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+		if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("service is %s", response.GetStatus())
+		}
+		return nil
+	}
+}
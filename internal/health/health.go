@@ -0,0 +1,191 @@
+// Package health reports whether a service is alive and ready to serve:
+// it runs the checks registered for the dependencies of the service and
+// exposes the results over http and the standard grpc health protocol.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ShutdownCheck is the name of the check failing once shutdown has begun.
+const ShutdownCheck = "shutdown"
+
+// Check returns an error if the dependency is not usable.
+type Check func(ctx context.Context) error
+
+type check struct {
+	name string
+	run  Check
+	// downstream checks cover other services, they are left out of the grpc
+	// health, so that a failure does not cascade through the callers.
+	downstream bool
+}
+
+type Checker struct {
+	timeout time.Duration
+
+	mu     sync.RWMutex
+	checks []check
+
+	shuttingDown atomic.Bool
+}
+
+// New creates a checker, timeout limits every check run.
+func New(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add registers a check of a dependency owned by the service, e.g. a database,
+// checks are identified by name and added once.
+func (c *Checker) Add(name string, run Check) {
+	c.add(check{name: name, run: run})
+}
+
+// AddDownstream registers a check of another service the service calls.
+func (c *Checker) AddDownstream(name string, run Check) {
+	c.add(check{name: name, run: run, downstream: true})
+}
+
+func (c *Checker) add(check check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, added := range c.checks {
+		if added.name == check.name {
+			return
+		}
+	}
+	c.checks = append(c.checks, check)
+}
+
+// Shutdown makes the service report it is not ready anymore.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check runs checks concurrently, downstream ones only if asked to.
+func (c *Checker) Check(ctx context.Context, downstream bool) *Report {
+	c.mu.RLock()
+	checks := make([]check, 0, len(c.checks))
+	for _, check := range c.checks {
+		if downstream || !check.downstream {
+			checks = append(checks, check)
+		}
+	}
+	c.mu.RUnlock()
+
+	report := &Report{
+		Status: StatusUp,
+		Checks: make(map[string]*CheckResult, len(checks)+1),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := c.run(ctx, check.run)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[check.name] = result
+			if result.Status == StatusDown {
+				report.Status = StatusDown
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.shuttingDown.Load() {
+		report.Status = StatusDown
+		report.Checks[ShutdownCheck] = &CheckResult{Status: StatusDown, Error: "service is shutting down"}
+	}
+
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, check Check) *CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	result := &CheckResult{Status: StatusUp, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// Livez reports the process is able to handle requests,
+// it does not depend on anything outside the process.
+func (c *Checker) Livez(response http.ResponseWriter, request *http.Request) {
+	write(response, &Report{Status: StatusUp})
+}
+
+// Readyz reports every dependency of the service is usable.
+func (c *Checker) Readyz(response http.ResponseWriter, request *http.Request) {
+	write(response, c.Check(request.Context(), true))
+}
+
+func write(response http.ResponseWriter, report *Report) {
+	rawBytes, err := easyjson.Marshal(report)
+	if err != nil {
+		response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.Header().Set("Cache-Control", "no-store")
+	if report.Status == StatusUp {
+		response.WriteHeader(http.StatusOK)
+	} else {
+		response.WriteHeader(http.StatusServiceUnavailable)
+	}
+	response.Write(rawBytes)
+}
+
+// RegisterGRPC adds the grpc.health.v1 service to the server, services
+// listed are known to it and share the status of the whole server.
+func (c *Checker) RegisterGRPC(server *grpc.Server, services ...string) {
+	known := make(map[string]struct{}, len(services)+1)
+	known[""] = struct{}{}
+	for _, service := range services {
+		known[service] = struct{}{}
+	}
+
+	healthpb.RegisterHealthServer(server, &grpcHealth{checker: c, services: known})
+}
+
+type grpcHealth struct {
+	healthpb.UnimplementedHealthServer
+
+	checker  *Checker
+	services map[string]struct{}
+}
+
+func (h *grpcHealth) Check(ctx context.Context, request *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if _, ok := h.services[request.GetService()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", request.GetService())
+	}
+
+	if h.checker.Check(ctx, false).Status != StatusUp {
+		return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_NOT_SERVING}, nil
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
@@ -0,0 +1,118 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func up(ctx context.Context) error {
+	return nil
+}
+
+func down(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+func TestCheckerCheck(t *testing.T) {
+	c := New(time.Second)
+	c.Add("postgres", up)
+	c.Add("postgres", down)
+	c.Add("avatars bucket", up)
+	c.AddDownstream("user service", down)
+
+	report := c.Check(context.Background(), false)
+	require.Equal(t, StatusUp, report.Status)
+	require.Len(t, report.Checks, 2)
+	require.Equal(t, StatusUp, report.Checks["postgres"].Status)
+
+	report = c.Check(context.Background(), true)
+	require.Equal(t, StatusDown, report.Status)
+	require.Equal(t, StatusDown, report.Checks["user service"].Status)
+	require.Equal(t, "connection refused", report.Checks["user service"].Error)
+}
+
+func TestCheckerCheck_Timeout(t *testing.T) {
+	c := New(10 * time.Millisecond)
+	c.Add("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	report := c.Check(context.Background(), true)
+	require.Equal(t, StatusDown, report.Status)
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Checks["postgres"].Error)
+}
+
+func TestCheckerReadyz(t *testing.T) {
+	c := New(time.Second)
+	c.Add("postgres", up)
+
+	response := httptest.NewRecorder()
+	c.Readyz(response, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, response.Code)
+
+	c.Shutdown()
+
+	response = httptest.NewRecorder()
+	c.Readyz(response, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, response.Code)
+
+	var report Report
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&report))
+	require.Equal(t, StatusDown, report.Status)
+	require.Equal(t, StatusUp, report.Checks["postgres"].Status)
+	require.Equal(t, StatusDown, report.Checks[ShutdownCheck].Status)
+
+	response = httptest.NewRecorder()
+	c.Livez(response, httptest.NewRequest(http.MethodGet, "/livez", nil))
+	require.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestCheckerRegisterGRPC(t *testing.T) {
+	c := New(time.Second)
+	c.Add("postgres", up)
+	c.AddDownstream("artist service", down)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	c.RegisterGRPC(server, "user.UserService")
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	check := GRPC(conn)
+	require.NoError(t, check(context.Background()))
+
+	client := healthpb.NewHealthClient(conn)
+	response, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "user.UserService"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, response.GetStatus())
+
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	c.Shutdown()
+	require.Error(t, check(context.Background()))
+}
@@ -0,0 +1,19 @@
+package health
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+//easyjson:json
+type Report struct {
+	Status string                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks,omitempty"`
+}
+
+//easyjson:json
+type CheckResult struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
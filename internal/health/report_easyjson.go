@@ -0,0 +1,216 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package health
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth(in *jlexer.Lexer, out *Report) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "checks":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Checks = make(map[string]*CheckResult)
+				} else {
+					out.Checks = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 *CheckResult
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(CheckResult)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					(out.Checks)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth(out *jwriter.Writer, in Report) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if len(in.Checks) != 0 {
+		const prefix string = ",\"checks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.Checks {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				if v2Value == nil {
+					out.RawString("null")
+				} else {
+					(*v2Value).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte('}')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Report) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Report) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Report) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Report) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth(l, v)
+}
+func easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(in *jlexer.Lexer, out *CheckResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "error":
+			out.Error = string(in.String())
+		case "durationMs":
+			out.DurationMs = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(out *jwriter.Writer, in CheckResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"durationMs\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.DurationMs))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CheckResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CheckResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBd361432EncodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CheckResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CheckResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBd361432DecodeGithubComGoParkMailRu20242NovaCodeInternalHealth1(l, v)
+}
@@ -0,0 +1,42 @@
+// Package idempotency remembers responses of mutating requests by their
+// Idempotency-Key, so retries of a request are answered with the response
+// of the first attempt instead of being executed again.
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Scope identifies a key, the same key may be used by different users and
+// for different routes.
+type Scope struct {
+	UserID uuid.UUID
+	Key    string
+	// Route is the method and the path of the request.
+	Route string
+}
+
+// Record is the request a key was first used with and its response.
+type Record struct {
+	RequestHash []byte
+	// StatusCode is zero while the first request is in progress.
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type Store interface {
+	// Reserve claims the key for a request, it returns nil if the key is
+	// new or expired and the record of the first request otherwise.
+	Reserve(ctx context.Context, scope Scope, requestHash []byte, ttl time.Duration) (*Record, error)
+	// Save stores the response of the request the key is reserved for.
+	Save(ctx context.Context, scope Scope, record *Record) error
+	// Release drops the reservation, so that the request may be retried.
+	Release(ctx context.Context, scope Scope) error
+	// PurgeExpired deletes the keys whose responses are no longer replayed.
+	// Concurrent purges from other processes may be skipped.
+	PurgeExpired(ctx context.Context) (int64, error)
+}
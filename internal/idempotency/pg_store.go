@@ -0,0 +1,104 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// purgeLockID identifies the advisory lock taken by PurgeExpired, every
+// service process runs the purge against the same table.
+const purgeLockID = 0x1de3907e
+
+type PGStore struct {
+	db *sql.DB
+}
+
+func NewPGStore(db *sql.DB) *PGStore {
+	return &PGStore{db: db}
+}
+
+func (s *PGStore) Reserve(ctx context.Context, scope Scope, requestHash []byte, ttl time.Duration) (*Record, error) {
+	var userID []byte
+	err := s.db.QueryRowContext(
+		ctx, reserveQuery, scope.UserID, scope.Key, scope.Route, requestHash, ttl.Seconds(),
+	).Scan(&userID)
+	if err == nil {
+		return nil, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "Reserve.Query")
+	}
+
+	record := &Record{}
+	err = s.db.QueryRowContext(ctx, findQuery, scope.UserID, scope.Key, scope.Route).Scan(
+		&record.RequestHash,
+		&record.StatusCode,
+		&record.ContentType,
+		&record.Body,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		// the first request has just released the key, it is still treated
+		// as in progress, the client retries later
+		return &Record{RequestHash: requestHash}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "Reserve.Find")
+	}
+
+	return record, nil
+}
+
+func (s *PGStore) Save(ctx context.Context, scope Scope, record *Record) error {
+	_, err := s.db.ExecContext(
+		ctx, saveQuery, scope.UserID, scope.Key, scope.Route, record.StatusCode, record.ContentType, record.Body,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Save.Query")
+	}
+
+	return nil
+}
+
+func (s *PGStore) Release(ctx context.Context, scope Scope) error {
+	if _, err := s.db.ExecContext(ctx, releaseQuery, scope.UserID, scope.Key, scope.Route); err != nil {
+		return errors.Wrap(err, "Release.Query")
+	}
+
+	return nil
+}
+
+// PurgeExpired skips the purge while another process runs it.
+func (s *PGStore) PurgeExpired(ctx context.Context) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeExpired.Begin")
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, purgeLockQuery, purgeLockID).Scan(&locked); err != nil {
+		return 0, errors.Wrap(err, "PurgeExpired.Lock")
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	result, err := tx.ExecContext(ctx, purgeExpiredQuery)
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeExpired.Query")
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "PurgeExpired.RowsAffected")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "PurgeExpired.Commit")
+	}
+
+	return purged, nil
+}
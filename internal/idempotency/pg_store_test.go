@@ -0,0 +1,92 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPGStoreReserve(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPGStore(db)
+	scope := Scope{UserID: uuid.New(), Key: "key", Route: "POST /api/v1/playlists"}
+	requestHash := []byte("hash")
+
+	mock.ExpectQuery(reserveQuery).
+		WithArgs(scope.UserID, scope.Key, scope.Route, requestHash, float64(60)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(scope.UserID))
+	record, err := store.Reserve(context.Background(), scope, requestHash, time.Minute)
+	require.NoError(t, err)
+	require.Nil(t, record)
+
+	mock.ExpectQuery(reserveQuery).
+		WithArgs(scope.UserID, scope.Key, scope.Route, requestHash, float64(60)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	mock.ExpectQuery(findQuery).
+		WithArgs(scope.UserID, scope.Key, scope.Route).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"request_hash", "status_code", "content_type", "body"}).
+				AddRow(requestHash, 201, "application/json", []byte(`{"id":1}`)),
+		)
+	record, err = store.Reserve(context.Background(), scope, requestHash, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, &Record{
+		RequestHash: requestHash,
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte(`{"id":1}`),
+	}, record)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPGStoreSaveAndRelease(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	store := NewPGStore(db)
+	scope := Scope{UserID: uuid.New(), Key: "key", Route: "POST /api/v1/playlists"}
+	record := &Record{StatusCode: 200, ContentType: "application/json", Body: []byte("{}")}
+
+	mock.ExpectExec(saveQuery).
+		WithArgs(scope.UserID, scope.Key, scope.Route, record.StatusCode, record.ContentType, record.Body).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.Save(context.Background(), scope, record))
+
+	mock.ExpectExec(releaseQuery).
+		WithArgs(scope.UserID, scope.Key, scope.Route).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, store.Release(context.Background(), scope))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(purgeLockQuery).WithArgs(purgeLockID).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(true))
+	mock.ExpectExec(purgeExpiredQuery).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	purged, err := store.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), purged)
+
+	// another process holds the lock
+	mock.ExpectBegin()
+	mock.ExpectQuery(purgeLockQuery).WithArgs(purgeLockID).
+		WillReturnRows(sqlmock.NewRows([]string{"locked"}).AddRow(false))
+	mock.ExpectRollback()
+	purged, err = store.PurgeExpired(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, purged)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
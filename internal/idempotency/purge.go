@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+// purgeInterval is how often expired keys are deleted, expired keys are
+// reusable before that already.
+const purgeInterval = time.Hour
+
+// RunPurge deletes expired keys until ctx is cancelled.
+func RunPurge(ctx context.Context, store Store, logger logger.Logger) error {
+	for {
+		purged, err := store.PurgeExpired(context.WithoutCancel(ctx))
+		if err != nil {
+			logger.Errorf("failed to purge idempotency keys: %v", err)
+		} else if purged > 0 {
+			logger.Infof("purged %d idempotency keys", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(purgeInterval):
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package idempotency
+
+const (
+	// reserveQuery takes over an expired key as if it was new.
+	reserveQuery = `
+    INSERT INTO idempotency_key (user_id, key, route, request_hash, expires_at)
+    VALUES ($1, $2, $3, $4, NOW() + make_interval(secs => $5))
+    ON CONFLICT (user_id, key, route) DO UPDATE
+    SET request_hash = EXCLUDED.request_hash, status_code = NULL, content_type = '', body = '',
+      created_at = NOW(), expires_at = EXCLUDED.expires_at
+    WHERE idempotency_key.expires_at < NOW()
+    RETURNING user_id`
+
+	findQuery = `
+    SELECT request_hash, COALESCE(status_code, 0), content_type, body
+    FROM idempotency_key
+    WHERE user_id = $1 AND key = $2 AND route = $3`
+
+	saveQuery = `
+    UPDATE idempotency_key
+    SET status_code = $4, content_type = $5, body = $6
+    WHERE user_id = $1 AND key = $2 AND route = $3`
+
+	releaseQuery = `DELETE FROM idempotency_key WHERE user_id = $1 AND key = $2 AND route = $3`
+
+	// purgeLockQuery lets a single process purge at a time, the lock is
+	// released with the transaction
+	purgeLockQuery = `SELECT pg_try_advisory_xact_lock($1)`
+
+	purgeExpiredQuery = `DELETE FROM idempotency_key WHERE expires_at < NOW()`
+)
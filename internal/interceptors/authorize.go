@@ -0,0 +1,49 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/mtls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// allowAny lets every service with a valid certificate call the method.
+const allowAny = "*"
+
+// Authorize denies unary calls of services not allowed to call the method.
+func (im *InterceptorManager) Authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if err := im.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// AuthorizeStream denies streams of services not allowed to call the method.
+func (im *InterceptorManager) AuthorizeStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := im.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+func (im *InterceptorManager) authorize(ctx context.Context, method string) error {
+	caller, ok := mtls.Identity(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "service certificate is required")
+	}
+
+	allowed := im.allow[method]
+	if _, ok := allowed[allowAny]; ok {
+		return nil
+	}
+	if _, ok := allowed[caller]; ok {
+		return nil
+	}
+
+	im.logger.Warnf("Method: %s, Caller: %s, Err: not allowed", method, caller)
+	return status.Errorf(codes.PermissionDenied, "service %s is not allowed to call %s", caller, method)
+}
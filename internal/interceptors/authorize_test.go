@@ -0,0 +1,153 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/mtls"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const serverName = "novamusic-user"
+
+type authority struct {
+	dir  string
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newAuthority(t *testing.T) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "novamusic ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &authority{dir: t.TempDir(), cert: cert, key: key}
+	writePEM(t, filepath.Join(ca.dir, "ca.pem"), "CERTIFICATE", der)
+
+	return ca
+}
+
+// issue creates a certificate of the service and returns tls config using it.
+func (ca *authority) issue(t *testing.T, service string) *config.TLSConfig {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: service},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := &config.TLSConfig{
+		CAPath:   filepath.Join(ca.dir, "ca.pem"),
+		CertPath: filepath.Join(ca.dir, service+".pem"),
+		KeyPath:  filepath.Join(ca.dir, service+".key"),
+	}
+	writePEM(t, cfg.CertPath, "CERTIFICATE", der)
+	writePEM(t, cfg.KeyPath, "EC PRIVATE KEY", rawKey)
+
+	return cfg
+}
+
+func writePEM(t *testing.T, path, kind string, der []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der}), 0o600))
+}
+
+func TestAuthorize(t *testing.T) {
+	ca := newAuthority(t)
+
+	cfg := &config.Config{}
+	cfg.Service.GRPC.Allow = []config.GRPCAllowConfig{
+		{Method: healthpb.Health_Check_FullMethodName, Services: []string{"playlist", "export"}},
+	}
+	im := NewInterceptorManager(logger.New(&config.LoggerConfig{Level: "info", Format: "json"}), cfg)
+
+	serverCredentials, err := mtls.ServerCredentials(ca.issue(t, "user"))
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.Creds(serverCredentials),
+		grpc.ChainUnaryInterceptor(im.Authorize),
+		grpc.ChainStreamInterceptor(im.AuthorizeStream),
+	)
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(listener)
+	defer server.Stop()
+
+	call := func(service string) error {
+		clientCredentials, err := mtls.ClientCredentials(ca.issue(t, service))
+		require.NoError(t, err)
+
+		conn, err := grpc.NewClient("passthrough:///"+serverName,
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(clientCredentials),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	t.Run("allowed service", func(t *testing.T) {
+		require.NoError(t, call("playlist"))
+	})
+
+	t.Run("service not allowed", func(t *testing.T) {
+		require.Equal(t, codes.PermissionDenied, status.Code(call("track")))
+	})
+}
+
+func TestAuthorize_Unauthenticated(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Service.GRPC.Allow = []config.GRPCAllowConfig{
+		{Method: healthpb.Health_Check_FullMethodName, Services: []string{allowAny}},
+	}
+	im := NewInterceptorManager(logger.New(&config.LoggerConfig{Level: "info", Format: "json"}), cfg)
+
+	_, err := im.Authorize(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}, nil)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
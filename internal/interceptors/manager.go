@@ -7,22 +7,36 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/mtls"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 )
 
 type InterceptorManager struct {
 	logger logger.Logger
 	cfg    *config.Config
+	// allow maps a full method name to services allowed to call it.
+	allow map[string]map[string]struct{}
 }
 
 func NewInterceptorManager(logger logger.Logger, cfg *config.Config) *InterceptorManager {
-	return &InterceptorManager{logger: logger, cfg: cfg}
+	allow := make(map[string]map[string]struct{}, len(cfg.Service.GRPC.Allow))
+	for _, rule := range cfg.Service.GRPC.Allow {
+		if _, ok := allow[rule.Method]; !ok {
+			allow[rule.Method] = make(map[string]struct{}, len(rule.Services))
+		}
+		for _, service := range rule.Services {
+			allow[rule.Method][service] = struct{}{}
+		}
+	}
+
+	return &InterceptorManager{logger: logger, cfg: cfg, allow: allow}
 }
 
 func (im *InterceptorManager) Logger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 	md, _ := metadata.FromIncomingContext(ctx)
+	caller, _ := mtls.Identity(ctx)
 	reply, err := handler(ctx, req)
-	im.logger.Infof("Method: %s, Metadata: %v, Err: %v", info.FullMethod, md, err)
+	im.logger.Infof("Method: %s, Caller: %s, Metadata: %v, Err: %v", info.FullMethod, caller, md, err)
 
 	return reply, err
 }
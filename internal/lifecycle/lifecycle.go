@@ -0,0 +1,212 @@
+// Package lifecycle starts the components of a service in order and stops
+// them gracefully: servers and background tasks are drained in parallel
+// within a deadline, then resources are closed in reverse order.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+// Server is a component accepting requests.
+type Server interface {
+	// Listen binds the server address, it fails fast if the address is taken.
+	Listen() error
+	// Serve handles requests until Shutdown is called, it returns nil then.
+	Serve() error
+	// Shutdown stops accepting requests and waits for in-flight ones
+	// until ctx is done.
+	Shutdown(ctx context.Context) error
+}
+
+type server struct {
+	name   string
+	server Server
+}
+
+type task struct {
+	name string
+	run  func(ctx context.Context) error
+	// done receives the task result once it has returned.
+	done chan error
+}
+
+type closer struct {
+	name  string
+	close func() error
+}
+
+type Manager struct {
+	logger  logger.Logger
+	timeout time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	servers []server
+	tasks   []task
+	closers []closer
+	hooks   []func()
+}
+
+// New creates a manager with the root context derived from parent,
+// timeout limits how long servers and tasks are drained on shutdown.
+func New(parent context.Context, logger logger.Logger, timeout time.Duration) *Manager {
+	ctx, cancel := context.WithCancel(parent)
+	return &Manager{
+		logger:  logger,
+		timeout: timeout,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Context returns the root context, it is cancelled when shutdown begins.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// AddServer adds a server, servers start in the order they were added.
+func (m *Manager) AddServer(name string, s Server) {
+	m.servers = append(m.servers, server{name, s})
+}
+
+// Go adds a background task started once every server is listening,
+// the task must return when its context is cancelled.
+func (m *Manager) Go(name string, run func(ctx context.Context) error) {
+	m.tasks = append(m.tasks, task{name, run, make(chan error, 1)})
+}
+
+// AddCloser adds a resource released after servers and tasks are drained,
+// resources are closed in the reverse order they were added.
+func (m *Manager) AddCloser(name string, close func() error) {
+	m.closers = append(m.closers, closer{name, close})
+}
+
+// OnShutdown adds a function called as soon as shutdown begins,
+// before servers and tasks are drained.
+func (m *Manager) OnShutdown(hook func()) {
+	m.hooks = append(m.hooks, hook)
+}
+
+// Run starts every component and blocks until the root context is cancelled
+// or a component fails, then shuts everything down. The returned error
+// joins the failure that stopped the service with shutdown failures.
+func (m *Manager) Run() error {
+	defer m.cancel()
+
+	failures := make(chan error, len(m.servers)+len(m.tasks))
+
+	servers, tasks, failure := m.start(failures)
+	if failure == nil {
+		select {
+		case <-m.ctx.Done():
+			m.logger.Info("shutting down", nil)
+		case failure = <-failures:
+			m.logger.Errorf("shutting down: %v", failure)
+		}
+	}
+
+	for _, hook := range m.hooks {
+		hook()
+	}
+	m.cancel()
+
+	return errors.Join(failure, m.drain(servers, tasks), m.close())
+}
+
+// start listens servers one by one and then runs tasks, it stops at the
+// first server failing to listen and returns the components started so far.
+func (m *Manager) start(failures chan<- error) ([]server, []task, error) {
+	for i, s := range m.servers {
+		if err := s.server.Listen(); err != nil {
+			return m.servers[:i], nil, fmt.Errorf("failed to start %s server: %w", s.name, err)
+		}
+		m.logger.Infof("%s server started", s.name)
+
+		go func() {
+			if err := s.server.Serve(); err != nil {
+				failures <- fmt.Errorf("%s server failed: %w", s.name, err)
+			}
+		}()
+	}
+
+	for _, t := range m.tasks {
+		go func() {
+			err := t.run(m.ctx)
+			// a task failing on its own stops the service
+			if err != nil && m.ctx.Err() == nil {
+				failures <- fmt.Errorf("%s failed: %w", t.name, err)
+				err = nil
+			}
+			t.done <- err
+		}()
+	}
+
+	return m.servers, m.tasks, nil
+}
+
+// drain shuts servers down and waits for tasks in parallel until the deadline.
+func (m *Manager) drain(servers []server, tasks []task) error {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	fail := func(err error) {
+		m.logger.Errorf("%v", err)
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+
+	for _, s := range servers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.server.Shutdown(ctx); err != nil {
+				fail(fmt.Errorf("failed to shut down %s server: %w", s.name, err))
+			}
+		}()
+	}
+
+	for _, t := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case err := <-t.done:
+				if err != nil && !errors.Is(err, context.Canceled) {
+					fail(fmt.Errorf("%s failed: %w", t.name, err))
+				}
+			case <-ctx.Done():
+				fail(fmt.Errorf("%s did not stop: %w", t.name, ctx.Err()))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (m *Manager) close() error {
+	var errs []error
+	for i := len(m.closers) - 1; i >= 0; i-- {
+		c := m.closers[i]
+		if err := c.close(); err != nil {
+			err = fmt.Errorf("failed to close %s: %w", c.name, err)
+			m.logger.Errorf("%v", err)
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
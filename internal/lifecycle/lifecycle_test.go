@@ -0,0 +1,165 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+type events struct {
+	mu   sync.Mutex
+	list []string
+}
+
+func (e *events) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.list = append(e.list, event)
+}
+
+func (e *events) get() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.list...)
+}
+
+type fakeServer struct {
+	name      string
+	events    *events
+	listenErr error
+	serveErr  error
+	stop      chan struct{}
+}
+
+func newFakeServer(name string, events *events) *fakeServer {
+	return &fakeServer{name: name, events: events, stop: make(chan struct{})}
+}
+
+func (s *fakeServer) Listen() error {
+	s.events.add("listen " + s.name)
+	return s.listenErr
+}
+
+func (s *fakeServer) Serve() error {
+	if s.serveErr != nil {
+		return s.serveErr
+	}
+	<-s.stop
+	return nil
+}
+
+func (s *fakeServer) Shutdown(ctx context.Context) error {
+	s.events.add("shutdown " + s.name)
+	close(s.stop)
+	return nil
+}
+
+func newTestManager(ctx context.Context, timeout time.Duration) *Manager {
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	return New(ctx, logger, timeout)
+}
+
+func TestManagerRun(t *testing.T) {
+	events := &events{}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := newTestManager(ctx, time.Second)
+
+	m.AddServer("grpc", newFakeServer("grpc", events))
+	m.AddServer("http", newFakeServer("http", events))
+	started := make(chan struct{})
+	m.Go("worker", func(ctx context.Context) error {
+		events.add("worker started")
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	m.OnShutdown(func() {
+		events.add("shutdown begun")
+	})
+	m.AddCloser("postgres", func() error {
+		events.add("close postgres")
+		return nil
+	})
+	m.AddCloser("client", func() error {
+		events.add("close client")
+		return nil
+	})
+
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	require.NoError(t, m.Run())
+
+	list := events.get()
+	require.Equal(t, []string{"listen grpc", "listen http", "worker started", "shutdown begun"}, list[:4])
+	require.ElementsMatch(t, []string{"shutdown grpc", "shutdown http"}, list[4:6])
+	require.Equal(t, []string{"close client", "close postgres"}, list[6:])
+}
+
+func TestManagerRun_ListenFailure(t *testing.T) {
+	events := &events{}
+	m := newTestManager(context.Background(), time.Second)
+
+	failing := newFakeServer("http", events)
+	failing.listenErr = errors.New("address already in use")
+
+	m.AddServer("grpc", newFakeServer("grpc", events))
+	m.AddServer("http", failing)
+	m.Go("worker", func(ctx context.Context) error {
+		events.add("worker started")
+		return nil
+	})
+	m.AddCloser("postgres", func() error {
+		events.add("close postgres")
+		return nil
+	})
+
+	err := m.Run()
+	require.ErrorIs(t, err, failing.listenErr)
+	require.Equal(t, []string{"listen grpc", "listen http", "shutdown grpc", "close postgres"}, events.get())
+}
+
+func TestManagerRun_ServeFailure(t *testing.T) {
+	events := &events{}
+	m := newTestManager(context.Background(), time.Second)
+
+	failing := newFakeServer("grpc", events)
+	failing.serveErr = errors.New("listener closed")
+
+	m.AddServer("grpc", failing)
+	m.AddServer("http", newFakeServer("http", events))
+
+	err := m.Run()
+	require.ErrorIs(t, err, failing.serveErr)
+	require.ElementsMatch(t, []string{"listen grpc", "listen http", "shutdown grpc", "shutdown http"}, events.get())
+}
+
+func TestManagerRun_DrainDeadline(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	m := newTestManager(ctx, 10*time.Millisecond)
+
+	stuck := make(chan struct{})
+	defer close(stuck)
+	m.Go("worker", func(ctx context.Context) error {
+		<-stuck
+		return nil
+	})
+
+	closeErr := errors.New("connection reset")
+	m.AddCloser("postgres", func() error {
+		return closeErr
+	})
+
+	err := m.Run()
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorIs(t, err, closeErr)
+}
@@ -44,3 +44,33 @@ func AuthMiddleware(cfg *config.AuthConfig, logger logger.Logger, next http.Hand
 		next.ServeHTTP(response, request.WithContext(ctx))
 	})
 }
+
+// OptionalAuthMiddleware puts the user ID into the context when the request
+// carries a valid token and lets anonymous requests through otherwise.
+func OptionalAuthMiddleware(cfg *config.AuthConfig, logger logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		cookie, err := request.Cookie(cfg.Jwt.Cookie.Name)
+		if err != nil {
+			next.ServeHTTP(response, request)
+			return
+		}
+
+		claims, err := utils.VerifyJWT(&cfg.Jwt, cookie.Value)
+		if err != nil {
+			logger.Warnf("invalid jwt token: %v", err)
+			next.ServeHTTP(response, request)
+			return
+		}
+
+		userIDStr, _ := claims["user_id"].(string)
+		userID, err := uuid.Parse(userIDStr)
+		if err != nil {
+			logger.Warnf("invalid user ID format in token: %v", err)
+			next.ServeHTTP(response, request)
+			return
+		}
+
+		ctx := context.WithValue(request.Context(), utils.UserIDKey{}, userID)
+		next.ServeHTTP(response, request.WithContext(ctx))
+	})
+}
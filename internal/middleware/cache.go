@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// CacheMiddleware serves successful responses of the route with the
+// Cache-Control policy, errors are never cached.
+func CacheMiddleware(policy string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		next.ServeHTTP(&cacheWriter{ResponseWriter: response, policy: policy}, request)
+	})
+}
+
+type cacheWriter struct {
+	http.ResponseWriter
+	policy      string
+	wroteHeader bool
+}
+
+func (w *cacheWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if code == http.StatusOK || code == http.StatusNotModified {
+			w.Header().Set("Cache-Control", w.policy)
+		} else {
+			w.Header().Set("Cache-Control", "no-store")
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *cacheWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *cacheWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
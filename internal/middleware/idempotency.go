@@ -0,0 +1,177 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/google/uuid"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	maxIdempotentRequestSize  = 10 << 20
+	idempotentMultipartMemory = 1 << 20
+)
+
+// IdempotencyMiddleware replays the first response of a request to its
+// retries carrying the same Idempotency-Key. Requests without the key are
+// passed as is, it has to be wrapped by AuthMiddleware, as keys are scoped
+// by user.
+func IdempotencyMiddleware(cfg *config.IdempotencyConfig, store idempotency.Store, logger logger.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		key := request.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			next.ServeHTTP(response, request)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			utils.JSONError(response, http.StatusBadRequest, "Idempotency key is too long")
+			return
+		}
+
+		userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+		if !ok {
+			logger.Errorf("user id not found in context")
+			utils.JSONError(response, http.StatusUnauthorized, "user is not authorized")
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(response, request.Body, maxIdempotentRequestSize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				utils.JSONError(response, http.StatusRequestEntityTooLarge, "Request is too large")
+				return
+			}
+			utils.JSONError(response, http.StatusBadRequest, "Failed to read request")
+			return
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+
+		scope := idempotency.Scope{UserID: userID, Key: key, Route: request.Method + " " + request.URL.Path}
+		requestHash := hashRequest(request.Header.Get("Content-Type"), body)
+
+		record, err := store.Reserve(request.Context(), scope, requestHash, cfg.TTL*time.Second)
+		if err != nil {
+			logger.Errorf("failed to reserve idempotency key: %v", err)
+			utils.JSONError(response, http.StatusInternalServerError, "Failed to check idempotency key")
+			return
+		}
+
+		if record != nil {
+			switch {
+			case !bytes.Equal(record.RequestHash, requestHash):
+				utils.JSONError(response, http.StatusUnprocessableEntity, "Idempotency key is already used for another request")
+			case record.StatusCode == 0:
+				utils.JSONError(response, http.StatusConflict, "Request with this idempotency key is in progress")
+			default:
+				if record.ContentType != "" {
+					response.Header().Set("Content-Type", record.ContentType)
+				}
+				response.Header().Set(IdempotentReplayedHeader, "true")
+				response.WriteHeader(record.StatusCode)
+				if _, err := response.Write(record.Body); err != nil {
+					logger.Errorf("failed to write replayed response: %v", err)
+				}
+			}
+			return
+		}
+
+		// the response is kept even if the client is gone, its retry gets it
+		ctx := context.WithoutCancel(request.Context())
+
+		// a panicking handler leaves no response to keep, the key is freed
+		// for a retry instead of answering 409 until it expires
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				if err := store.Release(ctx, scope); err != nil {
+					logger.Errorf("failed to release idempotency key: %v", err)
+				}
+				panic(recovered)
+			}
+		}()
+
+		rec := &recordingWriter{ResponseWriter: response, statusCode: http.StatusOK}
+		next.ServeHTTP(rec, request)
+
+		if rec.statusCode >= http.StatusInternalServerError {
+			if err := store.Release(ctx, scope); err != nil {
+				logger.Errorf("failed to release idempotency key: %v", err)
+			}
+			return
+		}
+
+		err = store.Save(ctx, scope, &idempotency.Record{
+			RequestHash: requestHash,
+			StatusCode:  rec.statusCode,
+			ContentType: rec.Header().Get("Content-Type"),
+			Body:        rec.body.Bytes(),
+		})
+		if err != nil {
+			logger.Errorf("failed to save idempotent response: %v", err)
+		}
+	})
+}
+
+// hashRequest hashes the body, multipart bodies are hashed by their parts,
+// as clients pick a new boundary on every attempt.
+func hashRequest(contentType string, body []byte) []byte {
+	hash := sha256.New()
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") && params["boundary"] != "" {
+		reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err != nil {
+				break
+			}
+			hash.Write([]byte(part.FormName() + "\x00" + part.FileName() + "\x00"))
+			_, _ = io.Copy(hash, part)
+		}
+		return hash.Sum(nil)
+	}
+
+	hash.Write(body)
+	return hash.Sum(nil)
+}
+
+// recordingWriter writes the response and keeps a copy of it.
+type recordingWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.statusCode = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{Service: config.ServiceConfig{Idempotency: config.IdempotencyConfig{TTL: 60}}}
+	logger := logger.New(&cfg.Service.Logger)
+	store := mocks.NewMockStore(ctrl)
+	userID := uuid.New()
+	scope := idempotency.Scope{UserID: userID, Key: "key", Route: "POST /api/v1/playlists"}
+
+	calls := 0
+	status := http.StatusCreated
+	handler := IdempotencyMiddleware(&cfg.Service.Idempotency, store, logger, http.HandlerFunc(
+		func(response http.ResponseWriter, request *http.Request) {
+			calls++
+			response.Header().Set("Content-Type", "application/json")
+			response.WriteHeader(status)
+			_, _ = response.Write([]byte(`{"id":1}`))
+		},
+	))
+
+	newRequest := func(key, body string) *http.Request {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/playlists", bytes.NewBufferString(body))
+		if key != "" {
+			request.Header.Set(IdempotencyKeyHeader, key)
+		}
+		return request.WithContext(context.WithValue(request.Context(), utils.UserIDKey{}, userID))
+	}
+	hash := hashRequest("", []byte(`{"name":"a"}`))
+
+	t.Run("Without key", func(t *testing.T) {
+		calls = 0
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("", `{"name":"a"}`))
+
+		require.Equal(t, http.StatusCreated, response.Code)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("First request", func(t *testing.T) {
+		calls = 0
+		store.EXPECT().Reserve(gomock.Any(), scope, hash, time.Minute).Return(nil, nil)
+		store.EXPECT().Save(gomock.Any(), scope, &idempotency.Record{
+			RequestHash: hash,
+			StatusCode:  http.StatusCreated,
+			ContentType: "application/json",
+			Body:        []byte(`{"id":1}`),
+		}).Return(nil)
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("key", `{"name":"a"}`))
+
+		require.Equal(t, http.StatusCreated, response.Code)
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("Retry is replayed", func(t *testing.T) {
+		calls = 0
+		store.EXPECT().Reserve(gomock.Any(), scope, hash, time.Minute).Return(&idempotency.Record{
+			RequestHash: hash,
+			StatusCode:  http.StatusCreated,
+			ContentType: "application/json",
+			Body:        []byte(`{"id":1}`),
+		}, nil)
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("key", `{"name":"a"}`))
+
+		require.Equal(t, http.StatusCreated, response.Code)
+		require.Equal(t, `{"id":1}`, response.Body.String())
+		require.Equal(t, "true", response.Header().Get(IdempotentReplayedHeader))
+		require.Equal(t, 0, calls)
+	})
+
+	t.Run("Key reused with another body", func(t *testing.T) {
+		store.EXPECT().Reserve(gomock.Any(), scope, gomock.Any(), time.Minute).
+			Return(&idempotency.Record{RequestHash: hash, StatusCode: http.StatusCreated}, nil)
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("key", `{"name":"b"}`))
+
+		require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+	})
+
+	t.Run("First request in progress", func(t *testing.T) {
+		store.EXPECT().Reserve(gomock.Any(), scope, hash, time.Minute).
+			Return(&idempotency.Record{RequestHash: hash}, nil)
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("key", `{"name":"a"}`))
+
+		require.Equal(t, http.StatusConflict, response.Code)
+	})
+
+	t.Run("Failed request releases key", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		defer func() { status = http.StatusCreated }()
+
+		store.EXPECT().Reserve(gomock.Any(), scope, hash, time.Minute).Return(nil, nil)
+		store.EXPECT().Release(gomock.Any(), scope).Return(nil)
+
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, newRequest("key", `{"name":"a"}`))
+
+		require.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+
+	t.Run("Panicking handler releases key", func(t *testing.T) {
+		panicking := IdempotencyMiddleware(&cfg.Service.Idempotency, store, logger, http.HandlerFunc(
+			func(response http.ResponseWriter, request *http.Request) {
+				panic("boom")
+			},
+		))
+
+		store.EXPECT().Reserve(gomock.Any(), scope, hash, time.Minute).Return(nil, nil)
+		store.EXPECT().Release(gomock.Any(), scope).Return(nil)
+
+		require.PanicsWithValue(t, "boom", func() {
+			panicking.ServeHTTP(httptest.NewRecorder(), newRequest("key", `{"name":"a"}`))
+		})
+	})
+}
+
+func TestHashRequestMultipart(t *testing.T) {
+	encode := func(boundary string) (string, []byte) {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		require.NoError(t, writer.SetBoundary(boundary))
+		require.NoError(t, writer.WriteField("name", "imported"))
+		file, err := writer.CreateFormFile("file", "playlist.m3u")
+		require.NoError(t, err)
+		_, err = file.Write([]byte("#EXTM3U"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		return writer.FormDataContentType(), body.Bytes()
+	}
+
+	firstType, firstBody := encode("first-boundary")
+	secondType, secondBody := encode("second-boundary")
+
+	require.NotEqual(t, firstBody, secondBody)
+	require.Equal(t, hashRequest(firstType, firstBody), hashRequest(secondType, secondBody))
+}
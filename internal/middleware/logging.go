@@ -1,9 +1,12 @@
 package middleware
 
 import (
+	"bufio"
 	"context"
+	"net"
 	"net/http"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
@@ -23,6 +26,16 @@ func (w *responseWriter) WriteHeader(statusCode int) {
 	w.ResponseWriter.WriteHeader(statusCode)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Hijack lets websocket upgraders take over the connection.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
 func LoggingMiddleware(cfg *config.ServiceConfig, logger logger.Logger, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
 		// intercepting response status
@@ -30,6 +43,7 @@ func LoggingMiddleware(cfg *config.ServiceConfig, logger logger.Logger, next htt
 
 		requestID := uuid.New()
 		ctx := context.WithValue(request.Context(), utils.RequestIDKey{}, requestID)
+		ctx = context.WithValue(ctx, utils.ClientIPKey{}, clientIP(request))
 
 		defer func() {
 			if err := recover(); err != nil {
@@ -57,3 +71,21 @@ func LoggingMiddleware(cfg *config.ServiceConfig, logger logger.Logger, next htt
 		)
 	})
 }
+
+// clientIP prefers the address the proxy has seen to the address of the
+// proxy itself.
+func clientIP(request *http.Request) string {
+	if realIP := request.Header.Get("X-Real-IP"); realIP != "" {
+		return realIP
+	}
+	if forwarded := request.Header.Get("X-Forwarded-For"); forwarded != "" {
+		ip, _, _ := strings.Cut(forwarded, ",")
+		return strings.TrimSpace(ip)
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+	return host
+}
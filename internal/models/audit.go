@@ -0,0 +1,37 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEvent is an append-only record of an action. ActorID is uuid.Nil
+// when nobody is authenticated, e.g. for a failed login. Before and After
+// hold the changed fields only, they are nil for actions changing nothing.
+type AuditEvent struct {
+	ID         uint64
+	ActorID    uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	RequestID  string
+	IP         string
+	CreatedAt  time.Time
+}
+
+// AuditFilter selects audit events, empty fields match any event. Events
+// are listed newest first, BeforeID continues the list after an event.
+type AuditFilter struct {
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	From       *time.Time
+	To         *time.Time
+	BeforeID   uint64
+	Limit      uint64
+}
@@ -1,11 +1,90 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Question types, each with its own score range.
+const (
+	CSATQuestionCSAT   = "csat"
+	CSATQuestionNPS    = "nps"
+	CSATQuestionRating = "rating"
+)
+
+// CSATScoreRange returns the scores accepted for the question type.
+func CSATScoreRange(questionType string) (min, max uint8, ok bool) {
+	switch questionType {
+	case CSATQuestionCSAT:
+		return 1, 5, true
+	case CSATQuestionNPS:
+		return 0, 10, true
+	case CSATQuestionRating:
+		return 1, 10, true
+	default:
+		return 0, 0, false
+	}
+}
+
+// CSATSurvey is a topic of questions, asked between ActiveFrom and
+// ActiveUntil when they are set.
+type CSATSurvey struct {
+	ID          uint64
+	Topic       string
+	ActiveFrom  *time.Time
+	ActiveUntil *time.Time
+	Targeting   CSATTargeting
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CSATTargeting decides which users are offered the survey. A user qualifies
+// once the account is old and active enough, and only SampleRate percent of
+// the qualified users are offered it. After being offered the survey the
+// user isn't offered another one for CooldownDays.
+type CSATTargeting struct {
+	MinAccountAgeDays int
+	MinPlays          int
+	MinPlaylists      int
+	SampleRate        int
+	CooldownDays      int
+}
+
+// Targeting of the surveys created without one.
+const (
+	CSATDefaultSampleRate   = 100
+	CSATDefaultCooldownDays = 30
+)
+
+// CSATUserActivity is what the survey targeting knows about a user.
+type CSATUserActivity struct {
+	RegisteredAt time.Time
+	Plays        uint64
+	Playlists    uint64
+}
+
+// CSATPrompt is a survey offered to a user.
+type CSATPrompt struct {
+	SurveyID     uint64
+	ShownAt      time.Time
+	DismissedAt  *time.Time
+	CooldownDays int
+}
+
+func (survey *CSATSurvey) IsActive(now time.Time) bool {
+	if survey.ActiveFrom != nil && now.Before(*survey.ActiveFrom) {
+		return false
+	}
+	return survey.ActiveUntil == nil || now.Before(*survey.ActiveUntil)
+}
 
 type CSATQuestion struct {
 	ID       uint64
 	Question string
 	CSATID   uint64
+	Type     string
+	Position int
 }
 
 type CSATAnswer struct {
@@ -15,10 +94,44 @@ type CSATAnswer struct {
 	CSATQuestionID uint64
 }
 
-type CSATStat struct {
-	ID           uint64
-	Topic        string
-	QuestionID   uint64
-	Question     string
-	AverageScore float64
+// Segments the answers can be grouped by.
+const (
+	CSATSegmentRole              = "role"
+	CSATSegmentRegistrationMonth = "registration_month"
+)
+
+// Intervals of the answer trends.
+const (
+	CSATIntervalDay   = "day"
+	CSATIntervalWeek  = "week"
+	CSATIntervalMonth = "month"
+)
+
+// CSATFilter narrows the answers the statistics are built of. From and To
+// bound the time the score was given, To is exclusive.
+type CSATFilter struct {
+	From       *time.Time
+	To         *time.Time
+	QuestionID uint64
+	Segment    string
+}
+
+// CSATScoreCount is the number of times a question was given a score within
+// a segment of users, or within a bucket of time for the trends.
+type CSATScoreCount struct {
+	Topic      string
+	QuestionID uint64
+	Question   string
+	Type       string
+	Segment    string
+	Bucket     time.Time
+	Score      uint8
+	Count      uint64
+}
+
+// CSATUserAnswer is an answer of a user along with its question.
+type CSATUserAnswer struct {
+	Topic    string
+	Question string
+	Score    uint8
 }
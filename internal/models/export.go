@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ExportPending   = "pending"
+	ExportRunning   = "running"
+	ExportCompleted = "completed"
+	ExportFailed    = "failed"
+	ExportExpired   = "expired"
+)
+
+// DataExport is an archive of the personal data of a user. Token and
+// ExpiresAt are set once the archive is ready to be downloaded.
+type DataExport struct {
+	ID        uint64
+	UserID    uuid.UUID
+	Status    string
+	Attempts  uint64
+	ObjectKey string
+	Size      uint64
+	Token     string
+	ExpiresAt time.Time
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
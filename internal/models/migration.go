@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MigrationPending   = "pending"
+	MigrationRunning   = "running"
+	MigrationCompleted = "completed"
+	MigrationFailed    = "failed"
+)
+
+const (
+	MigrationItemPending   = "pending"
+	MigrationItemMatched   = "matched"
+	MigrationItemAmbiguous = "ambiguous"
+	MigrationItemUnmatched = "unmatched"
+	MigrationItemResolved  = "resolved"
+	MigrationItemSkipped   = "skipped"
+)
+
+type MigrationJob struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Status    string
+	Total     uint64
+	Processed uint64
+	Matched   uint64
+	Ambiguous uint64
+	Unmatched uint64
+	Attempts  uint64
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MigrationItem is a track of the imported library. Playlist is empty for
+// the liked songs.
+type MigrationItem struct {
+	ID              uint64
+	JobID           uuid.UUID
+	Playlist        string
+	Position        uint64
+	Title           string
+	Artist          string
+	Album           string
+	ISRC            string
+	Status          string
+	TrackID         uint64
+	Score           float64
+	Candidates      []uint64
+	CandidateScores []float64
+}
+
+// MigrationCandidate is a catalog track an imported item may be.
+type MigrationCandidate struct {
+	TrackID  uint64
+	Name     string
+	Artist   string
+	Album    string
+	Duration uint64
+	Image    string
+}
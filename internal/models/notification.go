@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationAlbumReleased     = "album_released"
+	NotificationPlaylistFavorited = "playlist_favorited"
+	NotificationDataExportReady   = "data_export_ready"
+)
+
+type Notification struct {
+	ID        uint64
+	UserID    uuid.UUID
+	Type      string
+	EntityID  uint64
+	Message   string
+	IsRead    bool
+	CreatedAt time.Time
+}
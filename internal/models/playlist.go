@@ -15,7 +15,43 @@ type Playlist struct {
 	IsPrivate bool
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// DeletedAt is set while the playlist is in the trash.
+	DeletedAt *time.Time
 }
 
 //easyjson:json
 type Playlists []*Playlist
+
+const (
+	PlaylistChangeCreate  = "create"
+	PlaylistChangeAdd     = "add"
+	PlaylistChangeRemove  = "remove"
+	PlaylistChangeRenamed = "renamed"
+	PlaylistChangeMoved   = "moved"
+	PlaylistChangeRevert  = "revert"
+)
+
+// PlaylistVersion is the state of a playlist after one of its changes.
+type PlaylistVersion struct {
+	PlaylistID uint64
+	Version    uint64
+	// AuthorID is uuid.Nil when the author is unknown.
+	AuthorID uuid.UUID
+	Change   string
+	// RevertedTo is the version restored by a revert.
+	RevertedTo uint64
+	Name       string
+	TrackIDs   []uint64
+	CreatedAt  time.Time
+}
+
+// PlaylistEntry is a catalog track with the names of its artist and album, as
+// written to and matched against playlist files.
+type PlaylistEntry struct {
+	TrackID  uint64
+	Name     string
+	Artist   string
+	Album    string
+	Duration uint64
+	FilePath string
+}
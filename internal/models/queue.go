@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	QueueSourceAlbum    = "album"
+	QueueSourcePlaylist = "playlist"
+	QueueSourceRadio    = "radio"
+	QueueSourceTracks   = "tracks"
+)
+
+const (
+	RepeatOff = "off"
+	RepeatAll = "all"
+	RepeatOne = "one"
+)
+
+type PlayQueue struct {
+	UserID           uuid.UUID
+	TrackIDs         []uint64
+	OriginalTrackIDs []uint64
+	CurrentIndex     uint64
+	PositionMs       uint64
+	IsPlaying        bool
+	Shuffle          bool
+	RepeatMode       string
+	SourceType       string
+	SourceID         uint64
+	ActiveDevice     string
+	PlayCount        uint64
+	Version          uint64
+	UpdatedAt        time.Time
+}
@@ -17,3 +17,14 @@ type Track struct {
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// Lyrics of a track. Content is either plain text or an LRC document when
+// Synced is set, PlainText is the content without timestamps.
+type Lyrics struct {
+	TrackID   uint64
+	Synced    bool
+	Content   string
+	PlainText string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -33,3 +33,11 @@ func (user *User) HashPassword() error {
 func (user *User) ComparePasswords(password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 }
+
+// UserObjects are what a user keeps in the object storage: the avatar, the
+// covers of the owned playlists and the data export archives.
+type UserObjects struct {
+	Image          string
+	PlaylistImages []string
+	ExportKeys     []string
+}
@@ -0,0 +1,91 @@
+// Package mtls secures grpc traffic between services with mutual tls:
+// both sides present certificates signed by the same authority, and the
+// common name of a certificate identifies the service that owns it.
+package mtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Enabled reports whether the configuration asks for mutual tls.
+func Enabled(cfg *config.TLSConfig) bool {
+	return cfg.CAPath != ""
+}
+
+// ServerCredentials accepts only clients with a certificate signed by the authority.
+func ServerCredentials(cfg *config.TLSConfig) (credentials.TransportCredentials, error) {
+	cert, pool, err := load(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// ClientCredentials presents the service certificate and accepts only
+// servers with a certificate signed by the authority.
+func ClientCredentials(cfg *config.TLSConfig) (credentials.TransportCredentials, error) {
+	cert, pool, err := load(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+func load(cfg *config.TLSConfig) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load service certificate: %v", err)
+	}
+
+	ca, err := os.ReadFile(cfg.CAPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read certificate authority: %v", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificates found in %s", cfg.CAPath)
+	}
+
+	return cert, pool, nil
+}
+
+// Identity returns the name of the calling service taken from its verified
+// certificate, it is false for callers without one.
+func Identity(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	leaf := info.State.VerifiedChains[0][0]
+	if leaf.Subject.CommonName == "" {
+		return "", false
+	}
+
+	return leaf.Subject.CommonName, true
+}
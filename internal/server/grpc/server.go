@@ -1,11 +1,9 @@
 package grpc
 
 import (
-	"log"
+	"context"
 	"net"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
@@ -15,6 +13,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/minio/minio-go/v7"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
@@ -27,6 +26,12 @@ type Server struct {
 	Metrics *metrics.Metrics
 
 	RegisterServices func(server *grpc.Server)
+	// Credentials secure connections and make calls authorized by the
+	// identity of the caller, the server is insecure when they are nil.
+	Credentials credentials.TransportCredentials
+
+	server   *grpc.Server
+	listener net.Listener
 }
 
 func New(
@@ -47,25 +52,30 @@ func New(
 	}
 }
 
-func (s *Server) Run() error {
+func (s *Server) Listen() error {
 	l, err := net.Listen("tcp", s.CFG.Service.GRPC.Port)
 	if err != nil {
 		return err
 	}
-	defer l.Close()
 
 	im := interceptors.NewInterceptorManager(s.Logger, s.CFG)
-	server := grpc.NewServer(grpc.KeepaliveParams(keepalive.ServerParameters{
+	unary := []grpc.UnaryServerInterceptor{im.Logger, im.PanicRecover}
+	var stream []grpc.StreamServerInterceptor
+
+	options := []grpc.ServerOption{grpc.KeepaliveParams(keepalive.ServerParameters{
 		MaxConnectionIdle: s.CFG.Service.GRPC.MaxConnectionIdle * time.Minute,
 		Timeout:           s.CFG.Service.GRPC.Timeout * time.Second,
 		MaxConnectionAge:  s.CFG.Service.GRPC.MaxConnectionAge * time.Minute,
 		Time:              s.CFG.Service.GRPC.Timeout * time.Minute,
-	}),
-		grpc.ChainUnaryInterceptor(
-			im.Logger,
-			im.PanicRecover,
-		),
-	)
+	})}
+	if s.Credentials != nil {
+		options = append(options, grpc.Creds(s.Credentials))
+		unary = append(unary, im.Authorize)
+		stream = append(stream, im.AuthorizeStream)
+	}
+	options = append(options, grpc.ChainUnaryInterceptor(unary...), grpc.ChainStreamInterceptor(stream...))
+
+	server := grpc.NewServer(options...)
 
 	if os.Getenv("ENV") != "prod" {
 		reflection.Register(server)
@@ -75,17 +85,30 @@ func (s *Server) Run() error {
 		s.RegisterServices(server)
 	}
 
-	go func() {
-		if err := server.Serve(l); err != nil {
-			log.Fatalf("failed to start grpc server: %s", err)
-		}
-	}()
+	s.listener = l
+	s.server = server
+
+	return nil
+}
 
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+func (s *Server) Serve() error {
+	return s.server.Serve(s.listener)
+}
 
-	<-quit
-	server.GracefulStop()
+// Shutdown waits for in-flight calls to finish and cancels them
+// if ctx is done first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
 
-	return nil
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
 }
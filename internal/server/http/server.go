@@ -2,14 +2,14 @@ package http
 
 import (
 	"context"
-	"log"
+	"errors"
+	"net"
 	"net/http"
 	"os"
-	"os/signal"
-	"syscall"
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/lifecycle"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/metrics"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/postgres"
@@ -25,47 +25,73 @@ type Server struct {
 	S3      *minio.Client
 	Logger  logger.Logger
 	Metrics *metrics.Metrics
+	// Lifecycle runs the background workers of services.
+	Lifecycle *lifecycle.Manager
+
+	server   *http.Server
+	listener net.Listener
 }
 
-func New(cfg *config.Config, pg postgres.Client, s3 *minio.Client, logger logger.Logger, metrics *metrics.Metrics) *Server {
-	return &Server{mux.NewRouter(), cfg, pg, s3, logger, metrics}
+func New(
+	cfg *config.Config,
+	pg postgres.Client,
+	s3 *minio.Client,
+	logger logger.Logger,
+	metrics *metrics.Metrics,
+	lifecycle *lifecycle.Manager,
+) *Server {
+	return &Server{
+		MUX:       mux.NewRouter(),
+		CFG:       cfg,
+		PG:        pg,
+		S3:        s3,
+		Logger:    logger,
+		Metrics:   metrics,
+		Lifecycle: lifecycle,
+	}
 }
 
-func (s *Server) Run() error {
+func (s *Server) Listen() error {
 	metricsMux := middleware.MetricsMiddleware(s.Metrics, s.MUX)
 	corsedMux := middleware.CORSMiddleware(&s.CFG.Service.CORS, metricsMux)
 	loggedCorsedMux := middleware.LoggingMiddleware(&s.CFG.Service, s.Logger, corsedMux)
 
-	server := &http.Server{
-		Addr:         s.CFG.Service.HTTP.Port,
+	l, err := net.Listen("tcp", s.CFG.Service.HTTP.Port)
+	if err != nil {
+		return err
+	}
+
+	s.listener = l
+	s.server = &http.Server{
 		Handler:      loggedCorsedMux,
 		ReadTimeout:  s.CFG.Service.HTTP.ReadTimeout * time.Second,
 		WriteTimeout: s.CFG.Service.HTTP.WriteTimeout * time.Second,
 		IdleTimeout:  s.CFG.Service.HTTP.IdleTimeout * time.Second,
 	}
 
-	go func() {
-		env := os.Getenv("ENV")
-		var err error
-
-		if env == "prod" {
-			err = server.ListenAndServeTLS(s.CFG.Service.TLS.CertPath, s.CFG.Service.TLS.KeyPath)
-		} else {
-			err = server.ListenAndServe()
-		}
-
-		if err != nil && err != http.ErrServerClosed {
-			log.Fatalf("failed to start http server: %s", err)
-		}
-	}()
-
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	return nil
+}
 
-	<-quit
+func (s *Server) Serve() error {
+	var err error
+	if os.Getenv("ENV") == "prod" {
+		err = s.server.ServeTLS(s.listener, s.CFG.Service.TLS.CertPath, s.CFG.Service.TLS.KeyPath)
+	} else {
+		err = s.server.Serve(s.listener)
+	}
 
-	ctx, shutdown := context.WithTimeout(context.Background(), s.CFG.Service.HTTP.ContextTimeout*time.Second)
-	defer shutdown()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
 
-	return server.Shutdown(ctx)
+// Shutdown waits for in-flight requests to finish and closes the remaining
+// connections if ctx is done first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.server.Shutdown(ctx)
+	if err != nil && errors.Is(err, ctx.Err()) {
+		s.server.Close()
+	}
+	return err
 }
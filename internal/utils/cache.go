@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Versioned is a resource whose state is identified by its id and the time
+// it was last updated.
+type Versioned interface {
+	Version() (uint64, time.Time)
+}
+
+// NotModified sets ETag and Last-Modified of a response built of the
+// resource and answers 304 Not Modified when the copy of the client is still
+// fresh, in which case the response must not be written further.
+func NotModified[T Versioned](response http.ResponseWriter, request *http.Request, resource T) bool {
+	etag, lastModified := validators([]T{resource})
+	return notModified(response, request, etag, lastModified)
+}
+
+// NotModifiedList is NotModified for a response built of a list of resources.
+// It sets the ETag only: removing an item doesn't make the newest update time
+// of the list any newer, so Last-Modified would keep a stale copy fresh.
+func NotModifiedList[T Versioned](response http.ResponseWriter, request *http.Request, resources []T) bool {
+	etag, _ := validators(resources)
+	return notModified(response, request, etag, time.Time{})
+}
+
+func notModified(response http.ResponseWriter, request *http.Request, etag string, lastModified time.Time) bool {
+	response.Header().Set("ETag", etag)
+	if !lastModified.IsZero() {
+		response.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		return false
+	}
+
+	if !fresh(request, etag, lastModified) {
+		return false
+	}
+
+	response.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// validators hashes ids and update times of the resources in their order,
+// so adding, removing or reordering them changes the tag as well. The tag
+// is weak, as responses also carry fields of related resources.
+func validators[T Versioned](resources []T) (string, time.Time) {
+	hash := fnv.New64a()
+	var lastModified time.Time
+
+	buf := make([]byte, 16)
+	for _, resource := range resources {
+		id, updatedAt := resource.Version()
+		binary.BigEndian.PutUint64(buf[:8], id)
+		binary.BigEndian.PutUint64(buf[8:], uint64(updatedAt.UnixNano()))
+		hash.Write(buf)
+
+		if updatedAt.After(lastModified) {
+			lastModified = updatedAt
+		}
+	}
+
+	return fmt.Sprintf(`W/"%d-%x"`, len(resources), hash.Sum64()), lastModified.Truncate(time.Second)
+}
+
+// fresh evaluates the conditional headers of the request, If-None-Match
+// takes precedence over If-Modified-Since.
+func fresh(request *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := request.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		for _, candidate := range strings.Split(ifNoneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(request.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !lastModified.After(ifModifiedSince)
+}
@@ -3,4 +3,5 @@ package utils
 type (
 	UserIDKey    struct{}
 	RequestIDKey struct{}
+	ClientIPKey  struct{}
 )
@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+func ParseIDList(raw string) ([]uint64, error) {
+	var ids []uint64
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// ParseLimit returns defaultLimit when raw is empty or zero and caps the limit
+// at maxLimit.
+func ParseLimit(raw string, defaultLimit, maxLimit uint64) (uint64, error) {
+	if raw == "" {
+		return defaultLimit, nil
+	}
+
+	limit, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 {
+		return defaultLimit, nil
+	}
+	if limit > maxLimit {
+		return maxLimit, nil
+	}
+
+	return limit, nil
+}
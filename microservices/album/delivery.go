@@ -3,6 +3,7 @@ package album
 import "net/http"
 
 type Handlers interface {
+	CreateAlbum(response http.ResponseWriter, request *http.Request)
 	SearchAlbum(response http.ResponseWriter, request *http.Request)
 	ViewAlbum(response http.ResponseWriter, request *http.Request)
 	GetAll(response http.ResponseWriter, request *http.Request)
@@ -11,4 +12,5 @@ type Handlers interface {
 	DeleteFavoriteAlbum(response http.ResponseWriter, request *http.Request)
 	IsFavoriteAlbum(response http.ResponseWriter, request *http.Request)
 	GetFavoriteAlbums(response http.ResponseWriter, request *http.Request)
+	UploadImage(response http.ResponseWriter, request *http.Request)
 }
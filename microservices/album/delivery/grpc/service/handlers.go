@@ -7,6 +7,7 @@ import (
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
 	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -22,11 +23,71 @@ func (service *albumsService) FindByID(ctx context.Context, request *albumServic
 	return &albumService.FindByIDResponse{Album: service.albumDTOToProto(album)}, nil
 }
 
+func (service *albumsService) ListByArtist(ctx context.Context, request *albumService.ListByArtistRequest) (*albumService.ListResponse, error) {
+	albums, err := service.usecase.GetAllByArtistID(ctx, request.GetArtistID())
+	if err != nil {
+		service.logger.Errorf("cannot list albums by artist: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list albums by artist: %v", err)
+	}
+
+	return &albumService.ListResponse{Albums: service.albumsDTOToProto(albums)}, nil
+}
+
+func (service *albumsService) ListRecent(ctx context.Context, request *albumService.ListRecentRequest) (*albumService.ListResponse, error) {
+	albums, err := service.usecase.GetRecent(ctx, request.GetLimit())
+	if err != nil {
+		service.logger.Errorf("cannot list recent albums: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list recent albums: %v", err)
+	}
+
+	return &albumService.ListResponse{Albums: service.albumsDTOToProto(albums)}, nil
+}
+
+func (service *albumsService) ListFavorites(ctx context.Context, request *albumService.ListFavoritesRequest) (*albumService.ListResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	albums, err := service.usecase.GetFavoriteAlbums(ctx, userID)
+	if err != nil {
+		service.logger.Errorf("cannot list favorite albums: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list favorite albums: %v", err)
+	}
+
+	return &albumService.ListResponse{Albums: service.albumsDTOToProto(albums)}, nil
+}
+
+func (service *albumsService) IsFavorite(ctx context.Context, request *albumService.IsFavoriteRequest) (*albumService.IsFavoriteResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	favorite, err := service.usecase.IsFavoriteAlbum(ctx, userID, request.GetId())
+	if err != nil {
+		service.logger.Errorf("cannot check favorite album: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot check favorite album: %v", err)
+	}
+
+	return &albumService.IsFavoriteResponse{Favorite: favorite}, nil
+}
+
+func (service *albumsService) albumsDTOToProto(albums []*dto.AlbumDTO) []*albumService.Album {
+	protoAlbums := make([]*albumService.Album, 0, len(albums))
+	for _, album := range albums {
+		protoAlbums = append(protoAlbums, service.albumDTOToProto(album))
+	}
+	return protoAlbums
+}
+
 func (service *albumsService) albumDTOToProto(album *dto.AlbumDTO) *albumService.Album {
 	return &albumService.Album{
 		Id:          album.ID,
 		Name:        album.Name,
 		ReleaseDate: timestamppb.New(album.ReleaseDate),
 		Image:       album.Image,
+		ArtistID:    album.ArtistID,
+		ArtistName:  album.ArtistName,
 	}
 }
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
@@ -24,6 +27,54 @@ func NewAlbumHandlers(usecase album.Usecase, logger logger.Logger) album.Handler
 	return &albumHandlers{usecase, logger}
 }
 
+// CreateAlbum godoc
+// @Summary Create album
+// @Description Creates a new album, users who favorited its artist get notified. Admin only.
+// @Param album body dto.AlbumDTO true "Album"
+// @Success 200 {object} dto.AlbumDTO "Created album"
+// @Failure 400 {object} utils.ErrorResponse "Invalid album"
+// @Failure 500 {object} utils.ErrorResponse "Failed to create album"
+// @Router /api/v1/albums [post]
+func (handlers *albumHandlers) CreateAlbum(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	albumDTO := &dto.AlbumDTO{}
+	rawBytes, err := io.ReadAll(request.Body)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to read body: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Can't read body")
+		return
+	}
+
+	if err := easyjson.Unmarshal(rawBytes, albumDTO); err != nil || albumDTO.Name == "" || albumDTO.ArtistID == 0 {
+		handlers.logger.Error(fmt.Sprintf("Invalid album: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Invalid album")
+		return
+	}
+
+	createdAlbum, err := handlers.usecase.Create(request.Context(), albumDTO)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to create album: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Can't create album")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err = easyjson.Marshal(createdAlbum)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode album: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
 // SearchAlbum godoc
 // @Summary Search albums by query
 // @Description Searches for albums based on the provided "query" query parameter.
@@ -96,6 +147,10 @@ func (handlers *albumHandlers) ViewAlbum(response http.ResponseWriter, request *
 		return
 	}
 
+	if utils.NotModified(response, request, foundAlbum) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(foundAlbum)
 	if err != nil {
@@ -133,6 +188,10 @@ func (handlers *albumHandlers) GetAll(response http.ResponseWriter, request *htt
 		return
 	}
 
+	if utils.NotModifiedList(response, request, albums) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.AlbumDTOs(albums))
 	if err != nil {
@@ -180,6 +239,10 @@ func (handlers *albumHandlers) GetAllByArtistID(response http.ResponseWriter, re
 		return
 	}
 
+	if utils.NotModifiedList(response, request, albums) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.AlbumDTOs(albums))
 	if err != nil {
@@ -311,6 +374,10 @@ func (handlers *albumHandlers) GetFavoriteAlbums(response http.ResponseWriter, r
 		return
 	}
 
+	if utils.NotModifiedList(response, request, albums) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.AlbumDTOs(albums))
 	if err != nil {
@@ -327,3 +394,79 @@ func (handlers *albumHandlers) GetFavoriteAlbums(response http.ResponseWriter, r
 		return
 	}
 }
+
+// UploadImage godoc
+// @Summary Upload cover of album
+// @Description Uploads the cover of the album. JPEG, PNG, GIF and WebP pictures of at least 64x64 pixels are accepted, they are cropped to a square and stored as JPEG and WebP renditions of 64, 300 and 640 pixels. Admin only.
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path uint64 true "Album ID"
+// @Param file formData file true "Cover image file"
+// @Success 200 {object} dto.AlbumDTO "Album with the uploaded cover"
+// @Failure 400 {object} utils.ErrorResponse "Invalid file format or missing file"
+// @Failure 404 {object} utils.ErrorResponse "Album not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to upload cover"
+// @Router /api/v1/albums/{id}/image [post]
+func (handlers *albumHandlers) UploadImage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	albumID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	file, _, err := request.FormFile("file")
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to read file: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "failed to read file")
+		return
+	}
+
+	renditions, err := imaging.Process(fileBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("invalid image: %v", err), requestID)
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat), errors.Is(err, imaging.ErrTooSmall), errors.Is(err, imaging.ErrTooLarge):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "failed to process image")
+		}
+		return
+	}
+
+	albumDTO, err := handlers.usecase.UploadImage(request.Context(), albumID, renditions)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to upload cover: %v", err), requestID)
+		if errors.Is(err, album.ErrAlbumNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "failed to upload image")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(albumDTO)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode album: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
@@ -3,10 +3,13 @@ package http
 import (
 	"net/http"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
 	albumRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/repository"
 	albumUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/usecase"
+	notificationProducer "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/producer"
+	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -15,17 +18,58 @@ func BindRoutes(s *httpServer.Server, artistClient artistService.ArtistServiceCl
 	s.MUX.Handle("/metrics", promhttp.Handler())
 
 	albumRepo := albumRepo.NewAlbumPGRepository(s.PG)
-	albumUsecase := albumUsecase.NewAlbumUsecase(albumRepo, artistClient, s.Logger)
+	albumS3Repo := s3Repo.NewS3Repository(s.S3, s.Logger)
+	notificationProducer := notificationProducer.NewNotificationPGProducer(s.PG)
+	albumUsecase := albumUsecase.NewAlbumUsecase(albumRepo, albumS3Repo, artistClient, notificationProducer, s.Logger)
 	albumHandleres := NewAlbumHandlers(albumUsecase, s.Logger)
 
+	idempotencyStore := idempotency.NewPGStore(s.PG)
+
 	s.MUX.HandleFunc("/api/v1/albums/search", albumHandleres.SearchAlbum).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/albums/{id:[0-9]+}", albumHandleres.ViewAlbum).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/albums", albumHandleres.GetAll).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/albums/byArtistId/{artistId:[0-9]+}", albumHandleres.GetAllByArtistID).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/albums/{id:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(albumHandleres.ViewAlbum)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/albums",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(albumHandleres.GetAll)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/albums",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(
+				&s.CFG.Service.Auth, s.Logger,
+				middleware.IdempotencyMiddleware(
+					&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+					http.HandlerFunc(albumHandleres.CreateAlbum),
+				),
+			),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/albums/{id:[0-9]+}/image",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(albumHandleres.UploadImage)),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/albums/byArtistId/{artistId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(albumHandleres.GetAllByArtistID)),
+	).Methods("GET")
 
 	s.MUX.Handle(
 		"/api/v1/albums/favorite/byUser/{userID}",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(albumHandleres.GetFavoriteAlbums)),
+		middleware.CacheMiddleware(
+			s.CFG.Service.Cache.Private,
+			middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(albumHandleres.GetFavoriteAlbums)),
+		),
 	).Methods("GET")
 
 	s.MUX.Handle(
@@ -4,16 +4,19 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 )
 
 //easyjson:json
 type AlbumDTO struct {
-	ID          uint64    `json:"id"`
-	Name        string    `json:"name"`
-	ReleaseDate time.Time `json:"release"`
-	Image       string    `json:"image"`
-	ArtistName  string    `json:"artistName"`
-	ArtistID    uint64    `json:"artistID"`
+	ID          uint64      `json:"id"`
+	Name        string      `json:"name"`
+	ReleaseDate time.Time   `json:"release"`
+	Image       string      `json:"image"`
+	ArtistName  string      `json:"artistName"`
+	ArtistID    uint64      `json:"artistID"`
+	Images      []*ImageDTO `json:"images,omitempty"`
+	UpdatedAt   time.Time   `json:"-"`
 }
 
 func NewAlbumDTO(album *models.Album) *AlbumDTO {
@@ -22,8 +25,38 @@ func NewAlbumDTO(album *models.Album) *AlbumDTO {
 		Name:        album.Name,
 		ReleaseDate: album.ReleaseDate,
 		Image:       album.Image,
+		Images:      NewImagesDTO(album.Image),
+		UpdatedAt:   album.UpdatedAt,
+	}
+}
+
+func (album *AlbumDTO) Version() (uint64, time.Time) {
+	return album.ID, album.UpdatedAt
+}
+
+func NewAlbumFromAlbumDTO(albumDTO *AlbumDTO) *models.Album {
+	return &models.Album{
+		Name:        albumDTO.Name,
+		ReleaseDate: albumDTO.ReleaseDate,
+		Image:       albumDTO.Image,
+		ArtistID:    albumDTO.ArtistID,
 	}
 }
 
 //easyjson:json
 type AlbumDTOs []*AlbumDTO
+
+//easyjson:json
+type ImageDTO struct {
+	Size int    `json:"size"`
+	JPEG string `json:"jpeg"`
+	WebP string `json:"webp"`
+}
+
+func NewImagesDTO(image string) []*ImageDTO {
+	var imagesDTO []*ImageDTO
+	for _, variant := range imaging.Variants(image) {
+		imagesDTO = append(imagesDTO, &ImageDTO{variant.Size, variant.JPEG, variant.WebP})
+	}
+	return imagesDTO
+}
@@ -17,7 +17,87 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(in *jlexer.Lexer, out *AlbumDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(in *jlexer.Lexer, out *ImageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "size":
+			out.Size = int(in.Int())
+		case "jpeg":
+			out.JPEG = string(in.String())
+		case "webp":
+			out.WebP = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(out *jwriter.Writer, in ImageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"jpeg\":"
+		out.RawString(prefix)
+		out.String(string(in.JPEG))
+	}
+	{
+		const prefix string = ",\"webp\":"
+		out.RawString(prefix)
+		out.String(string(in.WebP))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(in *jlexer.Lexer, out *AlbumDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -53,7 +133,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumD
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(out *jwriter.Writer, in AlbumDTOs) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(out *jwriter.Writer, in AlbumDTOs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -75,27 +155,27 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumD
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumDTOs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumDTOs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(in *jlexer.Lexer, out *AlbumDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(in *jlexer.Lexer, out *AlbumDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -128,6 +208,37 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumD
 			out.ArtistName = string(in.String())
 		case "artistID":
 			out.ArtistID = uint64(in.Uint64())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]*ImageDTO, 0, 8)
+					} else {
+						out.Images = []*ImageDTO{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *ImageDTO
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(ImageDTO)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Images = append(out.Images, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -138,7 +249,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumD
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(out *jwriter.Writer, in AlbumDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(out *jwriter.Writer, in AlbumDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -172,29 +283,47 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumD
 		out.RawString(prefix)
 		out.Uint64(uint64(in.ArtistID))
 	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Images {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto1(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAlbumDto2(l, v)
 }
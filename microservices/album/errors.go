@@ -0,0 +1,5 @@
+package album
+
+import "errors"
+
+var ErrAlbumNotFound = errors.New("Album wasn't found")
@@ -56,6 +56,86 @@ func (mr *MockAlbumServiceClientMockRecorder) FindByID(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAlbumServiceClient)(nil).FindByID), varargs...)
 }
 
+// IsFavorite mocks base method.
+func (m *MockAlbumServiceClient) IsFavorite(ctx context.Context, in *albumService.IsFavoriteRequest, opts ...grpc.CallOption) (*albumService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsFavorite", varargs...)
+	ret0, _ := ret[0].(*albumService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockAlbumServiceClientMockRecorder) IsFavorite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockAlbumServiceClient)(nil).IsFavorite), varargs...)
+}
+
+// ListByArtist mocks base method.
+func (m *MockAlbumServiceClient) ListByArtist(ctx context.Context, in *albumService.ListByArtistRequest, opts ...grpc.CallOption) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByArtist", varargs...)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockAlbumServiceClientMockRecorder) ListByArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockAlbumServiceClient)(nil).ListByArtist), varargs...)
+}
+
+// ListFavorites mocks base method.
+func (m *MockAlbumServiceClient) ListFavorites(ctx context.Context, in *albumService.ListFavoritesRequest, opts ...grpc.CallOption) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFavorites", varargs...)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockAlbumServiceClientMockRecorder) ListFavorites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockAlbumServiceClient)(nil).ListFavorites), varargs...)
+}
+
+// ListRecent mocks base method.
+func (m *MockAlbumServiceClient) ListRecent(ctx context.Context, in *albumService.ListRecentRequest, opts ...grpc.CallOption) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRecent", varargs...)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecent indicates an expected call of ListRecent.
+func (mr *MockAlbumServiceClientMockRecorder) ListRecent(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecent", reflect.TypeOf((*MockAlbumServiceClient)(nil).ListRecent), varargs...)
+}
+
 // MockAlbumServiceServer is a mock of AlbumServiceServer interface.
 type MockAlbumServiceServer struct {
 	ctrl     *gomock.Controller
@@ -94,6 +174,66 @@ func (mr *MockAlbumServiceServerMockRecorder) FindByID(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAlbumServiceServer)(nil).FindByID), arg0, arg1)
 }
 
+// IsFavorite mocks base method.
+func (m *MockAlbumServiceServer) IsFavorite(arg0 context.Context, arg1 *albumService.IsFavoriteRequest) (*albumService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*albumService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockAlbumServiceServerMockRecorder) IsFavorite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockAlbumServiceServer)(nil).IsFavorite), arg0, arg1)
+}
+
+// ListByArtist mocks base method.
+func (m *MockAlbumServiceServer) ListByArtist(arg0 context.Context, arg1 *albumService.ListByArtistRequest) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArtist", arg0, arg1)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockAlbumServiceServerMockRecorder) ListByArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockAlbumServiceServer)(nil).ListByArtist), arg0, arg1)
+}
+
+// ListFavorites mocks base method.
+func (m *MockAlbumServiceServer) ListFavorites(arg0 context.Context, arg1 *albumService.ListFavoritesRequest) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", arg0, arg1)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockAlbumServiceServerMockRecorder) ListFavorites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockAlbumServiceServer)(nil).ListFavorites), arg0, arg1)
+}
+
+// ListRecent mocks base method.
+func (m *MockAlbumServiceServer) ListRecent(arg0 context.Context, arg1 *albumService.ListRecentRequest) (*albumService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecent", arg0, arg1)
+	ret0, _ := ret[0].(*albumService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecent indicates an expected call of ListRecent.
+func (mr *MockAlbumServiceServerMockRecorder) ListRecent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecent", reflect.TypeOf((*MockAlbumServiceServer)(nil).ListRecent), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAlbumServiceServer mocks base method.
 func (m *MockAlbumServiceServer) mustEmbedUnimplementedAlbumServiceServer() {
 	m.ctrl.T.Helper()
@@ -50,6 +50,21 @@ func (mr *MockRepoMockRecorder) AddFavoriteAlbum(ctx, userID, albumID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteAlbum", reflect.TypeOf((*MockRepo)(nil).AddFavoriteAlbum), ctx, userID, albumID)
 }
 
+// Count mocks base method.
+func (m *MockRepo) Count(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepoMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepo)(nil).Count), ctx)
+}
+
 // Create mocks base method.
 func (m *MockRepo) Create(ctx context.Context, album *models.Album) (*models.Album, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,21 @@ func (mr *MockRepoMockRecorder) DeleteFavoriteAlbum(ctx, userID, albumID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteAlbum", reflect.TypeOf((*MockRepo)(nil).DeleteFavoriteAlbum), ctx, userID, albumID)
 }
 
+// FindByIDs mocks base method.
+func (m *MockRepo) FindByIDs(ctx context.Context, albumIDs []uint64) ([]*models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, albumIDs)
+	ret0, _ := ret[0].([]*models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockRepoMockRecorder) FindByIDs(ctx, albumIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockRepo)(nil).FindByIDs), ctx, albumIDs)
+}
+
 // FindById mocks base method.
 func (m *MockRepo) FindById(ctx context.Context, albumID uint64) (*models.Album, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +169,21 @@ func (mr *MockRepoMockRecorder) GetAllByArtistID(ctx, artistID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByArtistID", reflect.TypeOf((*MockRepo)(nil).GetAllByArtistID), ctx, artistID)
 }
 
+// GetAllByArtistIDs mocks base method.
+func (m *MockRepo) GetAllByArtistIDs(ctx context.Context, artistIDs []uint64) ([]*models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByArtistIDs", ctx, artistIDs)
+	ret0, _ := ret[0].([]*models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByArtistIDs indicates an expected call of GetAllByArtistIDs.
+func (mr *MockRepoMockRecorder) GetAllByArtistIDs(ctx, artistIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByArtistIDs", reflect.TypeOf((*MockRepo)(nil).GetAllByArtistIDs), ctx, artistIDs)
+}
+
 // GetFavoriteAlbums mocks base method.
 func (m *MockRepo) GetFavoriteAlbums(ctx context.Context, userID uuid.UUID) ([]*models.Album, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +199,36 @@ func (mr *MockRepoMockRecorder) GetFavoriteAlbums(ctx, userID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteAlbums", reflect.TypeOf((*MockRepo)(nil).GetFavoriteAlbums), ctx, userID)
 }
 
+// GetPage mocks base method.
+func (m *MockRepo) GetPage(ctx context.Context, afterID, limit uint64) ([]*models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockRepoMockRecorder) GetPage(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockRepo)(nil).GetPage), ctx, afterID, limit)
+}
+
+// GetRecent mocks base method.
+func (m *MockRepo) GetRecent(ctx context.Context, limit uint64) ([]*models.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecent", ctx, limit)
+	ret0, _ := ret[0].([]*models.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecent indicates an expected call of GetRecent.
+func (mr *MockRepoMockRecorder) GetRecent(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecent", reflect.TypeOf((*MockRepo)(nil).GetRecent), ctx, limit)
+}
+
 // IsFavoriteAlbum mocks base method.
 func (m *MockRepo) IsFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -168,3 +243,18 @@ func (mr *MockRepoMockRecorder) IsFavoriteAlbum(ctx, userID, albumID interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoriteAlbum", reflect.TypeOf((*MockRepo)(nil).IsFavoriteAlbum), ctx, userID, albumID)
 }
+
+// UpdateImage mocks base method.
+func (m *MockRepo) UpdateImage(ctx context.Context, albumID uint64, image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, albumID, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockRepoMockRecorder) UpdateImage(ctx, albumID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockRepo)(nil).UpdateImage), ctx, albumID, image)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	imaging "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -50,6 +51,21 @@ func (mr *MockUsecaseMockRecorder) AddFavoriteAlbum(ctx, userID, albumID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteAlbum", reflect.TypeOf((*MockUsecase)(nil).AddFavoriteAlbum), ctx, userID, albumID)
 }
 
+// Create mocks base method.
+func (m *MockUsecase) Create(ctx context.Context, albumDTO *dto.AlbumDTO) (*dto.AlbumDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, albumDTO)
+	ret0, _ := ret[0].(*dto.AlbumDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUsecaseMockRecorder) Create(ctx, albumDTO interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUsecase)(nil).Create), ctx, albumDTO)
+}
+
 // DeleteFavoriteAlbum mocks base method.
 func (m *MockUsecase) DeleteFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error {
 	m.ctrl.T.Helper()
@@ -109,6 +125,21 @@ func (mr *MockUsecaseMockRecorder) GetFavoriteAlbums(ctx, userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteAlbums", reflect.TypeOf((*MockUsecase)(nil).GetFavoriteAlbums), ctx, userID)
 }
 
+// GetRecent mocks base method.
+func (m *MockUsecase) GetRecent(ctx context.Context, limit uint64) ([]*dto.AlbumDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecent", ctx, limit)
+	ret0, _ := ret[0].([]*dto.AlbumDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecent indicates an expected call of GetRecent.
+func (mr *MockUsecaseMockRecorder) GetRecent(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecent", reflect.TypeOf((*MockUsecase)(nil).GetRecent), ctx, limit)
+}
+
 // IsFavoriteAlbum mocks base method.
 func (m *MockUsecase) IsFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +170,21 @@ func (mr *MockUsecaseMockRecorder) Search(ctx, name interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsecase)(nil).Search), ctx, name)
 }
 
+// UploadImage mocks base method.
+func (m *MockUsecase) UploadImage(ctx context.Context, albumID uint64, renditions []*imaging.Rendition) (*dto.AlbumDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, albumID, renditions)
+	ret0, _ := ret[0].(*dto.AlbumDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockUsecaseMockRecorder) UploadImage(ctx, albumID, renditions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUsecase)(nil).UploadImage), ctx, albumID, renditions)
+}
+
 // View mocks base method.
 func (m *MockUsecase) View(ctx context.Context, albumID uint64) (*dto.AlbumDTO, error) {
 	m.ctrl.T.Helper()
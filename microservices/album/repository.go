@@ -10,11 +10,20 @@ import (
 type Repo interface {
 	Create(ctx context.Context, album *models.Album) (*models.Album, error)
 	FindById(ctx context.Context, albumID uint64) (*models.Album, error)
+	FindByIDs(ctx context.Context, albumIDs []uint64) ([]*models.Album, error)
 	GetAll(ctx context.Context) ([]*models.Album, error)
+	// GetPage returns up to limit albums with ids over afterID, ordered by
+	// id.
+	GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Album, error)
+	Count(ctx context.Context) (uint64, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Album, error)
+	GetAllByArtistIDs(ctx context.Context, artistIDs []uint64) ([]*models.Album, error)
+	GetRecent(ctx context.Context, limit uint64) ([]*models.Album, error)
 	FindByQuery(ctx context.Context, query string) ([]*models.Album, error)
 	AddFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error
 	DeleteFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error
 	IsFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) (bool, error)
 	GetFavoriteAlbums(ctx context.Context, userID uuid.UUID) ([]*models.Album, error)
+	// UpdateImage sets the cover of the album and returns the previous one.
+	UpdateImage(ctx context.Context, albumID uint64, image string) (string, error)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -63,6 +64,40 @@ func (r *AlbumRepository) FindById(ctx context.Context, albumID uint64) (*models
 	return albums, nil
 }
 
+// FindByIDs returns the albums in the order of ids, missing ones are skipped.
+func (r *AlbumRepository) FindByIDs(ctx context.Context, albumIDs []uint64) ([]*models.Album, error) {
+	ids := make([]int64, 0, len(albumIDs))
+	for _, id := range albumIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var albums []*models.Album
+	rows, err := r.db.QueryContext(ctx, findByIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		album := &models.Album{}
+		err := rows.Scan(
+			&album.ID,
+			&album.Name,
+			&album.ReleaseDate,
+			&album.Image,
+			&album.ArtistID,
+			&album.CreatedAt,
+			&album.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindByIDs.Query")
+		}
+		albums = append(albums, album)
+	}
+
+	return albums, nil
+}
+
 func (r *AlbumRepository) FindByQuery(ctx context.Context, query string) ([]*models.Album, error) {
 	tsQuery := utils.MakeSearchQuery(query)
 
@@ -122,6 +157,43 @@ func (r *AlbumRepository) GetAll(ctx context.Context) ([]*models.Album, error) {
 	return albums, nil
 }
 
+func (r *AlbumRepository) GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Album, error) {
+	var albums []*models.Album
+	rows, err := r.db.QueryContext(ctx, getPageQuery, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPage.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		album := &models.Album{}
+		err := rows.Scan(
+			&album.ID,
+			&album.Name,
+			&album.ReleaseDate,
+			&album.Image,
+			&album.ArtistID,
+			&album.CreatedAt,
+			&album.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetPage.Query")
+		}
+		albums = append(albums, album)
+	}
+
+	return albums, nil
+}
+
+func (r *AlbumRepository) Count(ctx context.Context) (uint64, error) {
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "Count.Query")
+	}
+
+	return count, nil
+}
+
 func (r *AlbumRepository) GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Album, error) {
 	var albums []*models.Album
 	rows, err := r.db.QueryContext(ctx, getByArtistIDQuery, artistID)
@@ -150,6 +222,68 @@ func (r *AlbumRepository) GetAllByArtistID(ctx context.Context, artistID uint64)
 	return albums, nil
 }
 
+// GetAllByArtistIDs returns the albums of all the artists, newest first.
+func (r *AlbumRepository) GetAllByArtistIDs(ctx context.Context, artistIDs []uint64) ([]*models.Album, error) {
+	ids := make([]int64, 0, len(artistIDs))
+	for _, id := range artistIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var albums []*models.Album
+	rows, err := r.db.QueryContext(ctx, getByArtistIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAllByArtistIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		album := &models.Album{}
+		err := rows.Scan(
+			&album.ID,
+			&album.Name,
+			&album.ReleaseDate,
+			&album.Image,
+			&album.ArtistID,
+			&album.CreatedAt,
+			&album.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetAllByArtistIDs.Query")
+		}
+		albums = append(albums, album)
+	}
+
+	return albums, nil
+}
+
+func (r *AlbumRepository) GetRecent(ctx context.Context, limit uint64) ([]*models.Album, error) {
+	var albums []*models.Album
+	rows, err := r.db.QueryContext(ctx, getRecentQuery, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetRecent.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		album := &models.Album{}
+		err := rows.Scan(
+			&album.ID,
+			&album.Name,
+			&album.ReleaseDate,
+			&album.Image,
+			&album.ArtistID,
+			&album.CreatedAt,
+			&album.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetRecent.Query")
+		}
+		albums = append(albums, album)
+	}
+
+	return albums, nil
+}
+
 func (r *AlbumRepository) AddFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error {
 	_, err := r.db.ExecContext(ctx, addFavoriteAlbumQuery, userID, albumID)
 	if err != nil {
@@ -205,3 +339,12 @@ func (r *AlbumRepository) GetFavoriteAlbums(ctx context.Context, userID uuid.UUI
 
 	return albums, nil
 }
+
+func (r *AlbumRepository) UpdateImage(ctx context.Context, albumID uint64, image string) (string, error) {
+	var previousImage string
+	if err := r.db.QueryRowContext(ctx, updateImageQuery, albumID, image).Scan(&previousImage); err != nil {
+		return "", errors.Wrap(err, "UpdateImage.Query")
+	}
+
+	return previousImage, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -87,6 +88,30 @@ func TestAlbumRepositoryFindById(t *testing.T) {
 	require.Equal(t, foundAlbum.ID, foundAlbum.ID)
 }
 
+func TestAlbumRepositoryFindByIDs(t *testing.T) {
+	t.Parallel()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	albumPGRepository := NewAlbumPGRepository(db)
+	releaseDate := time.Date(2024, 07, 19, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "name", "release", "image", "artist_id", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(2, "Album for test 2", releaseDate, "/imgs/albums/album_2.jpg", 1, releaseDate, releaseDate).
+		AddRow(1, "Album for test 1", releaseDate, "/imgs/albums/album_1.jpg", 1, releaseDate, releaseDate)
+
+	mock.ExpectQuery(findByIDsQuery).WithArgs(pq.Array([]int64{2, 3, 1})).WillReturnRows(rows)
+
+	foundAlbums, err := albumPGRepository.FindByIDs(context.Background(), []uint64{2, 3, 1})
+	require.NoError(t, err)
+	require.Len(t, foundAlbums, 2)
+	require.Equal(t, uint64(2), foundAlbums[0].ID)
+	require.Equal(t, uint64(1), foundAlbums[1].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestAlbumRepositoryFindByQuery(t *testing.T) {
 	t.Parallel()
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -369,3 +394,31 @@ func TestAlbumRepositoryGetFavoriteAlbums(t *testing.T) {
 	require.NotNil(t, foundAlbums)
 	require.Equal(t, foundAlbums, expectedAlbums)
 }
+
+func TestAlbumRepositoryGetPage(t *testing.T) {
+	t.Parallel()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	albumPGRepository := NewAlbumPGRepository(db)
+	releaseDate := time.Date(2021, 02, 3, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "name", "release", "image", "artist_id", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, "Album for test 1", releaseDate, "/imgs/albums/album_1.jpg", 1, time.Now(), time.Now()).
+		AddRow(2, "Album for test 2", releaseDate, "/imgs/albums/album_2.jpg", 1, time.Now(), time.Now())
+	mock.ExpectQuery(getPageQuery).WithArgs(uint64(0), uint64(3)).WillReturnRows(rows)
+
+	foundAlbums, err := albumPGRepository.GetPage(context.Background(), 0, 3)
+	require.NoError(t, err)
+	require.Len(t, foundAlbums, 2)
+	require.Equal(t, uint64(2), foundAlbums[1].ID)
+
+	mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := albumPGRepository.Count(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,13 +2,19 @@ package repository
 
 const (
 	createAlbumQuery = `INSERT INTO album (name, release_date, image, artist_id) 
-    VALUES ($1, $2, $3, $4, $5, $6)
+    VALUES ($1, $2, $3, $4)
     RETURNING id, name, release_date, image, artist_id, created_at, updated_at`
 
 	findByIDQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album WHERE id = $1`
 
+	findByIDsQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album WHERE id = ANY($1::INT[]) ORDER BY array_position($1::INT[], id)`
+
 	getAllQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album`
 
+	getPageQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album WHERE id > $1 ORDER BY id LIMIT $2`
+
+	countQuery = `SELECT count(*) FROM album`
+
 	findByQuery = `
 	SELECT id, name, release_date, image, artist_id, created_at, updated_at
 	FROM album
@@ -17,6 +23,10 @@ const (
 
 	getByArtistIDQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album WHERE artist_id = $1`
 
+	getByArtistIDsQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album WHERE artist_id = ANY($1::INT[]) ORDER BY release_date DESC, id DESC`
+
+	getRecentQuery = `SELECT id, name, release_date, image, artist_id, created_at, updated_at FROM album ORDER BY release_date DESC, id DESC LIMIT $1`
+
 	addFavoriteAlbumQuery = `
     INSERT INTO favorite_album (user_id, album_id) 
     VALUES ($1, $2)
@@ -37,4 +47,11 @@ const (
       JOIN favorite_album AS fa
       ON a.id = fa.album_id
     WHERE fa.user_id = $1`
+
+	updateImageQuery = `
+    UPDATE album AS t
+    SET image = $2, updated_at = NOW()
+    FROM album AS old
+    WHERE t.id = $1 AND old.id = t.id
+    RETURNING old.image`
 )
@@ -4,16 +4,20 @@ import (
 	"context"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	uuid "github.com/google/uuid"
 )
 
 type Usecase interface {
+	Create(ctx context.Context, albumDTO *dto.AlbumDTO) (*dto.AlbumDTO, error)
 	View(ctx context.Context, albumID uint64) (*dto.AlbumDTO, error)
 	Search(ctx context.Context, name string) ([]*dto.AlbumDTO, error)
 	GetAll(ctx context.Context) ([]*dto.AlbumDTO, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*dto.AlbumDTO, error)
+	GetRecent(ctx context.Context, limit uint64) ([]*dto.AlbumDTO, error)
 	AddFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error
 	DeleteFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error
 	IsFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) (bool, error)
 	GetFavoriteAlbums(ctx context.Context, userID uuid.UUID) ([]*dto.AlbumDTO, error)
+	UploadImage(ctx context.Context, albumID uint64, renditions []*imaging.Rendition) (*dto.AlbumDTO, error)
 }
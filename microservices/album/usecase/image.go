@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	uuid "github.com/google/uuid"
+)
+
+// imagesBucket keeps the albums and artists images, the renditions of the
+// uploaded covers are stored under imagesPrefix.
+const (
+	imagesBucket = "images"
+	imagesPrefix = "albums/"
+)
+
+func (usecase *albumUsecase) UploadImage(ctx context.Context, albumID uint64, renditions []*imaging.Rendition) (*dto.AlbumDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundAlbum, err := usecase.albumRepo.FindById(ctx, albumID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find album %d: %v", albumID, err), requestID)
+		return nil, album.ErrAlbumNotFound
+	}
+
+	base := imagesPrefix + uuid.New().String()
+	keys, err := usecase.uploadRenditions(ctx, base, renditions)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't save cover of album %d: %v", albumID, err), requestID)
+		usecase.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't save cover of album %d", albumID)
+	}
+
+	image := imaging.Largest(base)
+	previousImage, err := usecase.albumRepo.UpdateImage(ctx, albumID, image)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't update cover of album %d: %v", albumID, err), requestID)
+		usecase.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't update cover of album %d", albumID)
+	}
+	// images set before the renditions were introduced are kept, Keys
+	// returns nothing for them
+	usecase.removeKeys(ctx, imaging.Keys(previousImage))
+	usecase.logger.Info(fmt.Sprintf("Updated cover of album %d", albumID), requestID)
+
+	foundAlbum.Image = image
+	return usecase.convertAlbumToDTO(ctx, foundAlbum)
+}
+
+func (usecase *albumUsecase) uploadRenditions(ctx context.Context, base string, renditions []*imaging.Rendition) ([]string, error) {
+	keys := make([]string, 0, len(renditions))
+	for _, rendition := range renditions {
+		upload := s3.Upload{
+			Bucket:      imagesBucket,
+			Key:         rendition.Key(base),
+			File:        bytes.NewReader(rendition.Data),
+			Size:        int64(len(rendition.Data)),
+			ContentType: rendition.Format.ContentType(),
+		}
+		if _, err := usecase.s3Repo.Put(ctx, upload); err != nil {
+			return keys, err
+		}
+		keys = append(keys, upload.Key)
+	}
+
+	return keys, nil
+}
+
+func (usecase *albumUsecase) removeKeys(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := usecase.s3Repo.Remove(ctx, imagesBucket, key); err != nil {
+			usecase.logger.Warnf("Can't remove album cover '%s': %v", key, err)
+		}
+	}
+}
@@ -10,22 +10,51 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
 )
 
 type albumUsecase struct {
-	albumRepo    album.Repo
-	artistClient artistService.ArtistServiceClient
-	logger       logger.Logger
+	albumRepo            album.Repo
+	s3Repo               s3.S3Repo
+	artistClient         artistService.ArtistServiceClient
+	notificationProducer notification.Producer
+	logger               logger.Logger
 }
 
 func NewAlbumUsecase(
 	albumRepo album.Repo,
+	s3Repo s3.S3Repo,
 	artistClient artistService.ArtistServiceClient,
+	notificationProducer notification.Producer,
 	logger logger.Logger,
 ) album.Usecase {
-	return &albumUsecase{albumRepo, artistClient, logger}
+	return &albumUsecase{albumRepo, s3Repo, artistClient, notificationProducer, logger}
+}
+
+func (usecase *albumUsecase) Create(ctx context.Context, albumDTO *dto.AlbumDTO) (*dto.AlbumDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	createdAlbum, err := usecase.albumRepo.Create(ctx, dto.NewAlbumFromAlbumDTO(albumDTO))
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't create album %s: %v", albumDTO.Name, err), requestID)
+		return nil, fmt.Errorf("Can't create album")
+	}
+	usecase.logger.Info("Album created", requestID)
+
+	// the album is already created, a failed notification must not undo it
+	if err := usecase.notificationProducer.AlbumReleased(ctx, createdAlbum.ID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't notify about album %d: %v", createdAlbum.ID, err), requestID)
+	}
+
+	dtoAlbum, err := usecase.convertAlbumToDTO(ctx, createdAlbum)
+	if err != nil {
+		usecase.logger.Error(fmt.Sprintf("Can't create DTO for %s album: %v", createdAlbum.Name, err), requestID)
+		return nil, fmt.Errorf("Can't create DTO")
+	}
+
+	return dtoAlbum, nil
 }
 
 func (usecase *albumUsecase) View(ctx context.Context, albumID uint64) (*dto.AlbumDTO, error) {
@@ -112,6 +141,28 @@ func (usecase *albumUsecase) GetAllByArtistID(ctx context.Context, artistID uint
 	return dtoAlbums, nil
 }
 
+func (usecase *albumUsecase) GetRecent(ctx context.Context, limit uint64) ([]*dto.AlbumDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	albums, err := usecase.albumRepo.GetRecent(ctx, limit)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load recent albums: %v", err), requestID)
+		return nil, fmt.Errorf("Can't load recent albums")
+	}
+	usecase.logger.Infof("Found %d recent albums", len(albums))
+
+	var dtoAlbums []*dto.AlbumDTO
+	for _, album := range albums {
+		dtoAlbum, err := usecase.convertAlbumToDTO(ctx, album)
+		if err != nil {
+			usecase.logger.Errorf("Can't create DTO for %s album: %v", album.Name, err)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoAlbums = append(dtoAlbums, dtoAlbum)
+	}
+
+	return dtoAlbums, nil
+}
+
 func (usecase *albumUsecase) AddFavoriteAlbum(ctx context.Context, userID uuid.UUID, albumID uint64) error {
 	requestID := ctx.Value(utils.RequestIDKey{})
 	if err := usecase.albumRepo.AddFavoriteAlbum(ctx, userID, albumID); err != nil {
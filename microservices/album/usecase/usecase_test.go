@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,14 +13,66 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	albumPkg "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
 	mockAlbum "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/mock"
 	mockArtist "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/mock"
+	mockNotification "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
 	"github.com/golang/mock/gomock"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/require"
 )
 
+func TestUsecase_Create_NotifiesFavorites(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
+	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
+	producerMock := mockNotification.NewMockProducer(ctrl)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, producerMock, logger)
+
+	albumDTO := &dto.AlbumDTO{
+		Name:        "Attempted Lover",
+		ReleaseDate: time.Date(2024, 07, 19, 0, 0, 0, 0, time.UTC),
+		Image:       "/imgs/albums/album_1.jpg",
+		ArtistID:    1,
+	}
+	createdAlbum := dto.NewAlbumFromAlbumDTO(albumDTO)
+	createdAlbum.ID = 5
+
+	findByIDResponseArtist := &artistService.FindByIDResponse{
+		Artist: &artistService.Artist{Id: 1, Name: "quinn"},
+	}
+
+	ctx := context.Background()
+	albumRepoMock.EXPECT().Create(ctx, dto.NewAlbumFromAlbumDTO(albumDTO)).Return(createdAlbum, nil)
+	producerMock.EXPECT().AlbumReleased(ctx, createdAlbum.ID).Return(errors.New("producer error"))
+	artistClientMock.EXPECT().FindByID(ctx, &artistService.FindByIDRequest{Id: albumDTO.ArtistID}).Return(findByIDResponseArtist, nil)
+
+	dtoAlbum, err := albumUsecase.Create(ctx, albumDTO)
+
+	require.NoError(t, err)
+	require.Equal(t, createdAlbum.ID, dtoAlbum.ID)
+	require.Equal(t, "quinn", dtoAlbum.ArtistName)
+}
+
 func TestUsecase_View_FoundAlbum(t *testing.T) {
 	t.Parallel()
 
@@ -38,7 +91,7 @@ func TestUsecase_View_FoundAlbum(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	findByIDResponseArtist := &artistService.FindByIDResponse{
 		Artist: &artistService.Artist{
@@ -90,7 +143,7 @@ func TestUsecase_View_NotFoundAlbums(t *testing.T) {
 
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	albumRepoMock.EXPECT().FindById(ctx, uint64(1)).Return(nil, errors.New("Album wasn't found"))
 	dtoAlbum, err := albumUsecase.View(ctx, uint64(1))
@@ -116,7 +169,7 @@ func TestUsecase_Search_FoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	now := time.Now()
 	findByIDResponseArtists := []*artistService.FindByIDResponse{
@@ -183,7 +236,7 @@ func TestUsecase_Search_NotFoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	ctx := context.Background()
 	albumRepoMock.EXPECT().FindByQuery(ctx, "album").Return(nil, errors.New("Can't find albums"))
@@ -211,7 +264,7 @@ func TestUsecase_GetAll_FoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	now := time.Now()
 	findByIDResponseArtists := []*artistService.FindByIDResponse{
@@ -293,7 +346,7 @@ func TestUsecase_GetAll_NotFoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	ctx := context.Background()
 	albumRepoMock.EXPECT().GetAll(ctx).Return(nil, errors.New("Can't find albums"))
@@ -320,7 +373,7 @@ func TestUsecase_GetAllByArtistID_FoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	now := time.Now()
 	findByIDResponseArtist := &artistService.FindByIDResponse{
@@ -380,7 +433,7 @@ func TestUsecase_GetAllByArtistID_NotFoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	ctx := context.Background()
 	albumRepoMock.EXPECT().GetAllByArtistID(ctx, uint64(1)).Return(nil, errors.New("Can't load albums by artist ID 1"))
@@ -551,7 +604,7 @@ func TestUsecase_GetFavoriteAlbums_FoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	now := time.Now()
 	albums := []*models.Album{
@@ -624,7 +677,7 @@ func TestUsecase_GetFavoriteAlbums_NotFoundAlbums(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
-	albumUsecase := NewAlbumUsecase(albumRepoMock, artistClientMock, logger)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, nil, artistClientMock, nil, logger)
 
 	userID := uuid.New()
 	ctx := context.Background()
@@ -636,3 +689,63 @@ func TestUsecase_GetFavoriteAlbums_NotFoundAlbums(t *testing.T) {
 	require.Nil(t, dtoAlbums)
 	require.EqualError(t, err, fmt.Sprintf("Can't load albums by user ID %v", userID))
 }
+
+func TestUsecase_UploadImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
+	s3RepoMock := mockAlbum.NewMockS3Repo(ctrl)
+	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, s3RepoMock, artistClientMock, nil, logger)
+
+	album := &models.Album{ID: 1, Name: "test", Image: "ekkstacy.webp", ArtistID: 2}
+	renditions := []*imaging.Rendition{
+		{Size: 64, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+		{Size: 64, Format: imaging.FormatWebP, Data: []byte("webp")},
+	}
+
+	ctx := context.Background()
+
+	var keys []string
+	albumRepoMock.EXPECT().FindById(ctx, album.ID).Return(album, nil)
+	s3RepoMock.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+		require.Equal(t, "images", upload.Bucket)
+		keys = append(keys, upload.Key)
+		return &minio.UploadInfo{Key: upload.Key}, nil
+	}).Times(2)
+	// the seeded cover is not a rendition and stays in the bucket
+	albumRepoMock.EXPECT().UpdateImage(ctx, album.ID, gomock.Any()).Return("ekkstacy.webp", nil)
+	artistClientMock.EXPECT().FindByID(ctx, &artistService.FindByIDRequest{Id: album.ArtistID}).Return(
+		&artistService.FindByIDResponse{Artist: &artistService.Artist{Id: album.ArtistID, Name: "ekkstacy"}}, nil,
+	)
+
+	albumDTO, err := albumUsecase.UploadImage(ctx, album.ID, renditions)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Regexp(t, `^albums/.+/64\.jpg$`, keys[0])
+	require.Equal(t, imaging.Largest(strings.TrimSuffix(keys[0], "/64.jpg")), albumDTO.Image)
+	require.Len(t, albumDTO.Images, len(imaging.Sizes))
+	require.Equal(t, "ekkstacy", albumDTO.ArtistName)
+}
+
+func TestUsecase_UploadImage_NotFoundAlbum(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	albumRepoMock := mockAlbum.NewMockRepo(ctrl)
+	s3RepoMock := mockAlbum.NewMockS3Repo(ctrl)
+	albumUsecase := NewAlbumUsecase(albumRepoMock, s3RepoMock, nil, nil, logger)
+
+	ctx := context.Background()
+	albumRepoMock.EXPECT().FindById(ctx, uint64(1)).Return(nil, errors.New("no rows"))
+
+	_, err := albumUsecase.UploadImage(ctx, 1, []*imaging.Rendition{{Size: 64, Format: imaging.FormatJPEG}})
+	require.ErrorIs(t, err, albumPkg.ErrAlbumNotFound)
+}
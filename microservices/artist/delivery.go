@@ -11,4 +11,6 @@ type Handlers interface {
 	IsFavoriteArtist(response http.ResponseWriter, request *http.Request)
 	GetFavoriteArtists(response http.ResponseWriter, request *http.Request)
 	GetPopular(response http.ResponseWriter, request *http.Request)
+	GetSimilar(response http.ResponseWriter, request *http.Request)
+	UploadImage(response http.ResponseWriter, request *http.Request)
 }
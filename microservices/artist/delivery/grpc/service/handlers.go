@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,6 +21,56 @@ func (service *artistsService) FindByID(ctx context.Context, request *artistServ
 	return &artistService.FindByIDResponse{Artist: service.artistDTOToProto(artist)}, nil
 }
 
+func (service *artistsService) ListPopular(ctx context.Context, request *artistService.ListPopularRequest) (*artistService.ListResponse, error) {
+	artists, err := service.usecase.GetPopular(ctx)
+	if err != nil {
+		service.logger.Errorf("cannot list popular artists: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list popular artists: %v", err)
+	}
+
+	protoArtists := make([]*artistService.Artist, 0, len(artists))
+	for _, artist := range artists {
+		protoArtists = append(protoArtists, service.artistDTOToProto(artist))
+	}
+
+	return &artistService.ListResponse{Artists: protoArtists}, nil
+}
+
+func (service *artistsService) ListFavorites(ctx context.Context, request *artistService.ListFavoritesRequest) (*artistService.ListResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	artists, err := service.usecase.GetFavoriteArtists(ctx, userID)
+	if err != nil {
+		service.logger.Errorf("cannot list favorite artists: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list favorite artists: %v", err)
+	}
+
+	protoArtists := make([]*artistService.Artist, 0, len(artists))
+	for _, artist := range artists {
+		protoArtists = append(protoArtists, service.artistDTOToProto(artist))
+	}
+
+	return &artistService.ListResponse{Artists: protoArtists}, nil
+}
+
+func (service *artistsService) IsFavorite(ctx context.Context, request *artistService.IsFavoriteRequest) (*artistService.IsFavoriteResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	favorite, err := service.usecase.IsFavoriteArtist(ctx, userID, request.GetId())
+	if err != nil {
+		service.logger.Errorf("cannot check favorite artist: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot check favorite artist: %v", err)
+	}
+
+	return &artistService.IsFavoriteResponse{Favorite: favorite}, nil
+}
+
 func (service *artistsService) artistDTOToProto(artist *dto.ArtistDTO) *artistService.Artist {
 	return &artistService.Artist{
 		Id:      artist.ID,
@@ -11,6 +11,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,3 +58,29 @@ func TestFindByID(t *testing.T) {
 		assert.Equal(t, status.Errorf(codes.NotFound, "cannot find artist by id: artist not found"), err)
 	})
 }
+
+func TestListFavorites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewArtistsService(mockUsecase, logger)
+	userID := uuid.New()
+
+	t.Run("successful list", func(t *testing.T) {
+		mockUsecase.EXPECT().GetFavoriteArtists(gomock.Any(), userID).Return([]*dto.ArtistDTO{{ID: 1, Name: "Test Artist", Country: "Test country"}}, nil)
+
+		resp, err := service.ListFavorites(context.Background(), &artistService.ListFavoritesRequest{UserUuid: userID.String()})
+		assert.NoError(t, err)
+		assert.Equal(t, &artistService.ListResponse{
+			Artists: []*artistService.Artist{{Id: 1, Name: "Test Artist", Country: "Test country"}},
+		}, resp)
+	})
+
+	t.Run("invalid user uuid", func(t *testing.T) {
+		_, err := service.ListFavorites(context.Background(), &artistService.ListFavoritesRequest{UserUuid: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
@@ -96,6 +99,10 @@ func (handlers *artistHandlers) ViewArtist(response http.ResponseWriter, request
 		return
 	}
 
+	if utils.NotModified(response, request, foundArtist) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(foundArtist)
 	if err != nil {
@@ -132,6 +139,10 @@ func (handlers *artistHandlers) GetAll(response http.ResponseWriter, request *ht
 		return
 	}
 
+	if utils.NotModifiedList(response, request, artists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.ArtistDTOs(artists))
 	if err != nil {
@@ -297,6 +308,10 @@ func (handlers *artistHandlers) GetFavoriteArtists(response http.ResponseWriter,
 		return
 	}
 
+	if utils.NotModifiedList(response, request, artists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.ArtistDTOs(artists))
 	if err != nil {
@@ -326,6 +341,60 @@ func (handlers *artistHandlers) GetPopular(response http.ResponseWriter, request
 		return
 	}
 
+	if utils.NotModifiedList(response, request, artists) {
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(dto.ArtistDTOs(artists))
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode artists: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode artists: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+// GetSimilar godoc
+// @Summary Get similar artists
+// @Description Retrieves artists related to the given one by shared genres and co-favorites.
+// @Param id path uint64 true "Artist ID"
+// @Success 200 {array} dto.ArtistDTO "List of similar artists"
+// @Failure 400 {object} utils.ErrorResponse "Invalid artist ID"
+// @Failure 404 {object} utils.ErrorResponse "No similar artists found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get similar artists"
+// @Router /api/v1/artists/{id}/similar [get]
+func (handlers *artistHandlers) GetSimilar(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	artistID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	artists, err := handlers.usecase.GetSimilar(request.Context(), artistID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get similar artists: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to get similar artists: %v", err))
+		return
+	} else if len(artists) == 0 {
+		utils.JSONError(response, http.StatusNotFound, "No similar artists were found")
+		return
+	}
+
+	if utils.NotModifiedList(response, request, artists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.ArtistDTOs(artists))
 	if err != nil {
@@ -342,3 +411,79 @@ func (handlers *artistHandlers) GetPopular(response http.ResponseWriter, request
 		return
 	}
 }
+
+// UploadImage godoc
+// @Summary Upload image of artist
+// @Description Uploads the image of the artist. JPEG, PNG, GIF and WebP pictures of at least 64x64 pixels are accepted, they are cropped to a square and stored as JPEG and WebP renditions of 64, 300 and 640 pixels. Admin only.
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path uint64 true "Artist ID"
+// @Param file formData file true "Artist image file"
+// @Success 200 {object} dto.ArtistDTO "Artist with the uploaded image"
+// @Failure 400 {object} utils.ErrorResponse "Invalid file format or missing file"
+// @Failure 404 {object} utils.ErrorResponse "Artist not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to upload image"
+// @Router /api/v1/artists/{id}/image [post]
+func (handlers *artistHandlers) UploadImage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	artistID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	file, _, err := request.FormFile("file")
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to read file: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "failed to read file")
+		return
+	}
+
+	renditions, err := imaging.Process(fileBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("invalid image: %v", err), requestID)
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat), errors.Is(err, imaging.ErrTooSmall), errors.Is(err, imaging.ErrTooLarge):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "failed to process image")
+		}
+		return
+	}
+
+	artistDTO, err := handlers.usecase.UploadImage(request.Context(), artistID, renditions)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to upload image: %v", err), requestID)
+		if errors.Is(err, artist.ErrArtistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "failed to upload image")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(artistDTO)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode artist: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
@@ -7,6 +7,7 @@ import (
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
 	artistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/repository"
 	artistUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/usecase"
+	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -14,16 +15,41 @@ func BindRoutes(s *httpServer.Server) {
 	s.MUX.Handle("/metrics", promhttp.Handler())
 
 	artistRepo := artistRepo.NewArtistPGRepository(s.PG)
-	artistUsecase := artistUsecase.NewArtistUsecase(artistRepo, s.Logger)
+	artistS3Repo := s3Repo.NewS3Repository(s.S3, s.Logger)
+	artistUsecase := artistUsecase.NewArtistUsecase(artistRepo, artistS3Repo, s.Logger)
 	artistHandlers := NewArtistHandlers(artistUsecase, s.Logger)
 
 	s.MUX.HandleFunc("/api/v1/artists/search", artistHandlers.SearchArtist).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/artists/{id:[0-9]+}", artistHandlers.ViewArtist).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/artists", artistHandlers.GetAll).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/artists/{id:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(artistHandlers.ViewArtist)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/artists",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(artistHandlers.GetAll)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/artists/{id:[0-9]+}/image",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(artistHandlers.UploadImage)),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/artists/{id:[0-9]+}/similar",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(artistHandlers.GetSimilar)),
+	).Methods("GET")
 
 	s.MUX.Handle(
 		"/api/v1/artists/favorite/byUser/{userID}",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(artistHandlers.GetFavoriteArtists)),
+		middleware.CacheMiddleware(
+			s.CFG.Service.Cache.Private,
+			middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(artistHandlers.GetFavoriteArtists)),
+		),
 	).Methods("GET")
 
 	s.MUX.Handle(
@@ -41,5 +67,8 @@ func BindRoutes(s *httpServer.Server) {
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(artistHandlers.DeleteFavoriteArtist)),
 	).Methods("DELETE")
 
-	s.MUX.HandleFunc("/api/v1/artists/popular", artistHandlers.GetPopular).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/artists/popular",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(artistHandlers.GetPopular)),
+	).Methods("GET")
 }
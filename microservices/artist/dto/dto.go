@@ -1,16 +1,21 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 )
 
 //easyjson:json
 type ArtistDTO struct {
-	ID      uint64 `json:"id"`
-	Name    string `json:"name"`
-	Bio     string `json:"bio"`
-	Country string `json:"country"`
-	Image   string `json:"image"`
+	ID        uint64      `json:"id"`
+	Name      string      `json:"name"`
+	Bio       string      `json:"bio"`
+	Country   string      `json:"country"`
+	Image     string      `json:"image"`
+	Images    []*ImageDTO `json:"images,omitempty"`
+	UpdatedAt time.Time   `json:"-"`
 }
 
 func NewArtistDTO(artist *models.Artist) *ArtistDTO {
@@ -20,8 +25,29 @@ func NewArtistDTO(artist *models.Artist) *ArtistDTO {
 		artist.Bio,
 		artist.Country,
 		artist.Image,
+		NewImagesDTO(artist.Image),
+		artist.UpdatedAt,
 	}
 }
 
+func (artist *ArtistDTO) Version() (uint64, time.Time) {
+	return artist.ID, artist.UpdatedAt
+}
+
 //easyjson:json
 type ArtistDTOs []*ArtistDTO
+
+//easyjson:json
+type ImageDTO struct {
+	Size int    `json:"size"`
+	JPEG string `json:"jpeg"`
+	WebP string `json:"webp"`
+}
+
+func NewImagesDTO(image string) []*ImageDTO {
+	var imagesDTO []*ImageDTO
+	for _, variant := range imaging.Variants(image) {
+		imagesDTO = append(imagesDTO, &ImageDTO{variant.Size, variant.JPEG, variant.WebP})
+	}
+	return imagesDTO
+}
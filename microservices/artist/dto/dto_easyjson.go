@@ -17,7 +17,87 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(in *jlexer.Lexer, out *ArtistDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(in *jlexer.Lexer, out *ImageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "size":
+			out.Size = int(in.Int())
+		case "jpeg":
+			out.JPEG = string(in.String())
+		case "webp":
+			out.WebP = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(out *jwriter.Writer, in ImageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Size))
+	}
+	{
+		const prefix string = ",\"jpeg\":"
+		out.RawString(prefix)
+		out.String(string(in.JPEG))
+	}
+	{
+		const prefix string = ",\"webp\":"
+		out.RawString(prefix)
+		out.String(string(in.WebP))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(in *jlexer.Lexer, out *ArtistDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -53,7 +133,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtist
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(out *jwriter.Writer, in ArtistDTOs) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(out *jwriter.Writer, in ArtistDTOs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
@@ -75,27 +155,27 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtist
 // MarshalJSON supports json.Marshaler interface
 func (v ArtistDTOs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArtistDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArtistDTOs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArtistDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(in *jlexer.Lexer, out *ArtistDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(in *jlexer.Lexer, out *ArtistDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -124,6 +204,37 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtist
 			out.Country = string(in.String())
 		case "image":
 			out.Image = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]*ImageDTO, 0, 8)
+					} else {
+						out.Images = []*ImageDTO{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *ImageDTO
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(ImageDTO)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Images = append(out.Images, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -134,7 +245,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtist
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(out *jwriter.Writer, in ArtistDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(out *jwriter.Writer, in ArtistDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -163,29 +274,47 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtist
 		out.RawString(prefix)
 		out.String(string(in.Image))
 	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Images {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ArtistDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArtistDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArtistDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArtistDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto1(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesArtistDto2(l, v)
 }
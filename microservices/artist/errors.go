@@ -0,0 +1,5 @@
+package artist
+
+import "errors"
+
+var ErrArtistNotFound = errors.New("Artist wasn't found")
@@ -56,6 +56,66 @@ func (mr *MockArtistServiceClientMockRecorder) FindByID(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockArtistServiceClient)(nil).FindByID), varargs...)
 }
 
+// IsFavorite mocks base method.
+func (m *MockArtistServiceClient) IsFavorite(ctx context.Context, in *artistService.IsFavoriteRequest, opts ...grpc.CallOption) (*artistService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsFavorite", varargs...)
+	ret0, _ := ret[0].(*artistService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockArtistServiceClientMockRecorder) IsFavorite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockArtistServiceClient)(nil).IsFavorite), varargs...)
+}
+
+// ListFavorites mocks base method.
+func (m *MockArtistServiceClient) ListFavorites(ctx context.Context, in *artistService.ListFavoritesRequest, opts ...grpc.CallOption) (*artistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFavorites", varargs...)
+	ret0, _ := ret[0].(*artistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockArtistServiceClientMockRecorder) ListFavorites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockArtistServiceClient)(nil).ListFavorites), varargs...)
+}
+
+// ListPopular mocks base method.
+func (m *MockArtistServiceClient) ListPopular(ctx context.Context, in *artistService.ListPopularRequest, opts ...grpc.CallOption) (*artistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPopular", varargs...)
+	ret0, _ := ret[0].(*artistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPopular indicates an expected call of ListPopular.
+func (mr *MockArtistServiceClientMockRecorder) ListPopular(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPopular", reflect.TypeOf((*MockArtistServiceClient)(nil).ListPopular), varargs...)
+}
+
 // MockArtistServiceServer is a mock of ArtistServiceServer interface.
 type MockArtistServiceServer struct {
 	ctrl     *gomock.Controller
@@ -94,6 +154,51 @@ func (mr *MockArtistServiceServerMockRecorder) FindByID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockArtistServiceServer)(nil).FindByID), arg0, arg1)
 }
 
+// IsFavorite mocks base method.
+func (m *MockArtistServiceServer) IsFavorite(arg0 context.Context, arg1 *artistService.IsFavoriteRequest) (*artistService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*artistService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockArtistServiceServerMockRecorder) IsFavorite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockArtistServiceServer)(nil).IsFavorite), arg0, arg1)
+}
+
+// ListFavorites mocks base method.
+func (m *MockArtistServiceServer) ListFavorites(arg0 context.Context, arg1 *artistService.ListFavoritesRequest) (*artistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", arg0, arg1)
+	ret0, _ := ret[0].(*artistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockArtistServiceServerMockRecorder) ListFavorites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockArtistServiceServer)(nil).ListFavorites), arg0, arg1)
+}
+
+// ListPopular mocks base method.
+func (m *MockArtistServiceServer) ListPopular(arg0 context.Context, arg1 *artistService.ListPopularRequest) (*artistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPopular", arg0, arg1)
+	ret0, _ := ret[0].(*artistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPopular indicates an expected call of ListPopular.
+func (mr *MockArtistServiceServerMockRecorder) ListPopular(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPopular", reflect.TypeOf((*MockArtistServiceServer)(nil).ListPopular), arg0, arg1)
+}
+
 // mustEmbedUnimplementedArtistServiceServer mocks base method.
 func (m *MockArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {
 	m.ctrl.T.Helper()
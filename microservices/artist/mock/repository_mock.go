@@ -50,6 +50,21 @@ func (mr *MockRepoMockRecorder) AddFavoriteArtist(ctx, userID, artistID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteArtist", reflect.TypeOf((*MockRepo)(nil).AddFavoriteArtist), ctx, userID, artistID)
 }
 
+// Count mocks base method.
+func (m *MockRepo) Count(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepoMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepo)(nil).Count), ctx)
+}
+
 // Create mocks base method.
 func (m *MockRepo) Create(ctx context.Context, artist *models.Artist) (*models.Artist, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,21 @@ func (mr *MockRepoMockRecorder) DeleteFavoriteArtist(ctx, userID, artistID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteArtist", reflect.TypeOf((*MockRepo)(nil).DeleteFavoriteArtist), ctx, userID, artistID)
 }
 
+// FindByIDs mocks base method.
+func (m *MockRepo) FindByIDs(ctx context.Context, artistIDs []uint64) ([]*models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, artistIDs)
+	ret0, _ := ret[0].([]*models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockRepoMockRecorder) FindByIDs(ctx, artistIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockRepo)(nil).FindByIDs), ctx, artistIDs)
+}
+
 // FindById mocks base method.
 func (m *MockRepo) FindById(ctx context.Context, artistID uint64) (*models.Artist, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +139,21 @@ func (mr *MockRepoMockRecorder) FindByQuery(ctx, query interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByQuery", reflect.TypeOf((*MockRepo)(nil).FindByQuery), ctx, query)
 }
 
+// FindSimilar mocks base method.
+func (m *MockRepo) FindSimilar(ctx context.Context, artistID, limit uint64) ([]*models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSimilar", ctx, artistID, limit)
+	ret0, _ := ret[0].([]*models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSimilar indicates an expected call of FindSimilar.
+func (mr *MockRepoMockRecorder) FindSimilar(ctx, artistID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSimilar", reflect.TypeOf((*MockRepo)(nil).FindSimilar), ctx, artistID, limit)
+}
+
 // GetAll mocks base method.
 func (m *MockRepo) GetAll(ctx context.Context) ([]*models.Artist, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +184,21 @@ func (mr *MockRepoMockRecorder) GetFavoriteArtists(ctx, userID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteArtists", reflect.TypeOf((*MockRepo)(nil).GetFavoriteArtists), ctx, userID)
 }
 
+// GetPage mocks base method.
+func (m *MockRepo) GetPage(ctx context.Context, afterID, limit uint64) ([]*models.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*models.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockRepoMockRecorder) GetPage(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockRepo)(nil).GetPage), ctx, afterID, limit)
+}
+
 // GetPopular mocks base method.
 func (m *MockRepo) GetPopular(ctx context.Context) ([]*models.Artist, error) {
 	m.ctrl.T.Helper()
@@ -168,3 +228,18 @@ func (mr *MockRepoMockRecorder) IsFavoriteArtist(ctx, userID, artistID interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoriteArtist", reflect.TypeOf((*MockRepo)(nil).IsFavoriteArtist), ctx, userID, artistID)
 }
+
+// UpdateImage mocks base method.
+func (m *MockRepo) UpdateImage(ctx context.Context, artistID uint64, image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, artistID, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockRepoMockRecorder) UpdateImage(ctx, artistID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockRepo)(nil).UpdateImage), ctx, artistID, image)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	imaging "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -109,6 +110,21 @@ func (mr *MockUsecaseMockRecorder) GetPopular(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopular", reflect.TypeOf((*MockUsecase)(nil).GetPopular), ctx)
 }
 
+// GetSimilar mocks base method.
+func (m *MockUsecase) GetSimilar(ctx context.Context, artistID uint64) ([]*dto.ArtistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilar", ctx, artistID)
+	ret0, _ := ret[0].([]*dto.ArtistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilar indicates an expected call of GetSimilar.
+func (mr *MockUsecaseMockRecorder) GetSimilar(ctx, artistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilar", reflect.TypeOf((*MockUsecase)(nil).GetSimilar), ctx, artistID)
+}
+
 // IsFavoriteArtist mocks base method.
 func (m *MockUsecase) IsFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +155,21 @@ func (mr *MockUsecaseMockRecorder) Search(ctx, query interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsecase)(nil).Search), ctx, query)
 }
 
+// UploadImage mocks base method.
+func (m *MockUsecase) UploadImage(ctx context.Context, artistID uint64, renditions []*imaging.Rendition) (*dto.ArtistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, artistID, renditions)
+	ret0, _ := ret[0].(*dto.ArtistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockUsecaseMockRecorder) UploadImage(ctx, artistID, renditions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUsecase)(nil).UploadImage), ctx, artistID, renditions)
+}
+
 // View mocks base method.
 func (m *MockUsecase) View(ctx context.Context, artistID uint64) (*dto.ArtistDTO, error) {
 	m.ctrl.T.Helper()
@@ -10,11 +10,19 @@ import (
 type Repo interface {
 	Create(ctx context.Context, artist *models.Artist) (*models.Artist, error)
 	FindById(ctx context.Context, artistID uint64) (*models.Artist, error)
+	FindByIDs(ctx context.Context, artistIDs []uint64) ([]*models.Artist, error)
 	GetAll(ctx context.Context) ([]*models.Artist, error)
+	// GetPage returns up to limit artists with ids over afterID, ordered by
+	// id.
+	GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Artist, error)
+	Count(ctx context.Context) (uint64, error)
 	FindByQuery(ctx context.Context, query string) ([]*models.Artist, error)
 	AddFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) error
 	DeleteFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) error
 	IsFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) (bool, error)
 	GetFavoriteArtists(ctx context.Context, userID uuid.UUID) ([]*models.Artist, error)
 	GetPopular(ctx context.Context) ([]*models.Artist, error)
+	FindSimilar(ctx context.Context, artistID uint64, limit uint64) ([]*models.Artist, error)
+	// UpdateImage sets the image of the artist and returns the previous one.
+	UpdateImage(ctx context.Context, artistID uint64, image string) (string, error)
 }
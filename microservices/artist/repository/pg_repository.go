@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -62,6 +63,40 @@ func (r *ArtistRepository) FindById(ctx context.Context, artistID uint64) (*mode
 	return artist, nil
 }
 
+// FindByIDs returns the artists in the order of ids, missing ones are skipped.
+func (r *ArtistRepository) FindByIDs(ctx context.Context, artistIDs []uint64) ([]*models.Artist, error) {
+	ids := make([]int64, 0, len(artistIDs))
+	for _, id := range artistIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var artists []*models.Artist
+	rows, err := r.db.QueryContext(ctx, findByIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		artist := &models.Artist{}
+		err := rows.Scan(
+			&artist.ID,
+			&artist.Name,
+			&artist.Bio,
+			&artist.Country,
+			&artist.Image,
+			&artist.CreatedAt,
+			&artist.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindByIDs.Query")
+		}
+		artists = append(artists, artist)
+	}
+
+	return artists, nil
+}
+
 func (r *ArtistRepository) FindByQuery(ctx context.Context, query string) ([]*models.Artist, error) {
 	tsQuery := utils.MakeSearchQuery(query)
 
@@ -121,6 +156,43 @@ func (r *ArtistRepository) GetAll(ctx context.Context) ([]*models.Artist, error)
 	return artists, nil
 }
 
+func (r *ArtistRepository) GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Artist, error) {
+	var artists []*models.Artist
+	rows, err := r.db.QueryContext(ctx, getPageQuery, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPage.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		artist := &models.Artist{}
+		err := rows.Scan(
+			&artist.ID,
+			&artist.Name,
+			&artist.Bio,
+			&artist.Country,
+			&artist.Image,
+			&artist.CreatedAt,
+			&artist.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetPage.Query")
+		}
+		artists = append(artists, artist)
+	}
+
+	return artists, nil
+}
+
+func (r *ArtistRepository) Count(ctx context.Context) (uint64, error) {
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "Count.Query")
+	}
+
+	return count, nil
+}
+
 func (r *ArtistRepository) AddFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) error {
 	_, err := r.db.ExecContext(ctx, addFavoriteArtistQuery, userID, artistID)
 	if err != nil {
@@ -204,3 +276,40 @@ func (r *ArtistRepository) GetPopular(ctx context.Context) ([]*models.Artist, er
 
 	return artists, nil
 }
+
+func (r *ArtistRepository) FindSimilar(ctx context.Context, artistID uint64, limit uint64) ([]*models.Artist, error) {
+	var artists []*models.Artist
+	rows, err := r.db.QueryContext(ctx, findSimilarArtistsQuery, artistID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindSimilar.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		artist := &models.Artist{}
+		err := rows.Scan(
+			&artist.ID,
+			&artist.Name,
+			&artist.Bio,
+			&artist.Country,
+			&artist.Image,
+			&artist.CreatedAt,
+			&artist.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindSimilar.Query")
+		}
+		artists = append(artists, artist)
+	}
+
+	return artists, nil
+}
+
+func (r *ArtistRepository) UpdateImage(ctx context.Context, artistID uint64, image string) (string, error) {
+	var previousImage string
+	if err := r.db.QueryRowContext(ctx, updateImageQuery, artistID, image).Scan(&previousImage); err != nil {
+		return "", errors.Wrap(err, "UpdateImage.Query")
+	}
+
+	return previousImage, nil
+}
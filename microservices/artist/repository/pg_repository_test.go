@@ -404,3 +404,73 @@ func TestArtistRepositoryGetPopular_Error(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestArtistRepositoryFindSimilar(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	artistRepository := NewArtistPGRepository(db)
+
+	artists := []models.Artist{
+		{
+			ID:        2,
+			Name:      "Artist 2",
+			Bio:       "Bio of Artist 2",
+			Country:   "Country B",
+			Image:     "/images/artists/artist_2.jpg",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	columns := []string{"id", "name", "bio", "country", "image", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns)
+	for _, artist := range artists {
+		rows.AddRow(
+			artist.ID,
+			artist.Name,
+			artist.Bio,
+			artist.Country,
+			artist.Image,
+			artist.CreatedAt,
+			artist.UpdatedAt,
+		)
+	}
+
+	mock.ExpectQuery(findSimilarArtistsQuery).WithArgs(uint64(1), uint64(20)).WillReturnRows(rows)
+
+	foundArtists, err := artistRepository.FindSimilar(context.Background(), uint64(1), uint64(20))
+	require.NoError(t, err)
+	require.Equal(t, len(artists), len(foundArtists))
+	require.Equal(t, artists[0].Name, foundArtists[0].Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestArtistRepositoryGetPage(t *testing.T) {
+	t.Parallel()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	artistPGRepository := NewArtistPGRepository(db)
+
+	columns := []string{"id", "name", "bio", "country", "image", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(3, "Artist for test 2", "Some random bio", "USA", "/imgs/artists/artist_3.jpg", time.Now(), time.Now())
+	mock.ExpectQuery(getPageQuery).WithArgs(uint64(2), uint64(21)).WillReturnRows(rows)
+
+	foundArtists, err := artistPGRepository.GetPage(context.Background(), 2, 21)
+	require.NoError(t, err)
+	require.Len(t, foundArtists, 1)
+	require.Equal(t, uint64(3), foundArtists[0].ID)
+
+	mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := artistPGRepository.Count(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,8 +7,14 @@ const (
 
 	findByIDQuery = `SELECT id, name, bio, country, image, created_at, updated_at FROM artist WHERE id = $1`
 
+	findByIDsQuery = `SELECT id, name, bio, country, image, created_at, updated_at FROM artist WHERE id = ANY($1::INT[]) ORDER BY array_position($1::INT[], id)`
+
 	getAllQuery = `SELECT id, name, bio, country, image, created_at, updated_at FROM artist`
 
+	getPageQuery = `SELECT id, name, bio, country, image, created_at, updated_at FROM artist WHERE id > $1 ORDER BY id LIMIT $2`
+
+	countQuery = `SELECT count(*) FROM artist`
+
 	findByQuery = `
 	SELECT id, name, bio, country, image, created_at, updated_at
 	FROM artist
@@ -54,4 +60,36 @@ const (
         COUNT(fa.artist_id) DESC
     LIMIT 50;
 `
+
+	findSimilarArtistsQuery = `
+    WITH seed_genres AS (
+        SELECT genre_id FROM genre_artist WHERE artist_id = $1
+    ),
+    seed_fans AS (
+        SELECT user_id FROM favorite_artist WHERE artist_id = $1
+    ),
+    scored AS (
+        SELECT
+            a.id,
+            3 * (SELECT COUNT(*) FROM genre_artist ga
+                WHERE ga.artist_id = a.id AND ga.genre_id IN (SELECT genre_id FROM seed_genres))
+            + 2 * (SELECT COUNT(*) FROM favorite_artist fa
+                WHERE fa.artist_id = a.id AND fa.user_id IN (SELECT user_id FROM seed_fans)) AS score,
+            (SELECT COUNT(*) FROM favorite_artist fa WHERE fa.artist_id = a.id) AS popularity
+        FROM artist a
+        WHERE a.id <> $1
+    )
+    SELECT a.id, a.name, a.bio, a.country, a.image, a.created_at, a.updated_at
+    FROM artist a
+      JOIN scored s ON a.id = s.id
+    WHERE s.score > 0
+    ORDER BY s.score DESC, s.popularity DESC, a.id
+    LIMIT $2`
+
+	updateImageQuery = `
+    UPDATE artist AS t
+    SET image = $2, updated_at = NOW()
+    FROM artist AS old
+    WHERE t.id = $1 AND old.id = t.id
+    RETURNING old.image`
 )
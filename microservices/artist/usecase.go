@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	uuid "github.com/google/uuid"
 )
 
@@ -16,4 +17,6 @@ type Usecase interface {
 	IsFavoriteArtist(ctx context.Context, userID uuid.UUID, artistID uint64) (bool, error)
 	GetFavoriteArtists(ctx context.Context, userID uuid.UUID) ([]*dto.ArtistDTO, error)
 	GetPopular(ctx context.Context) ([]*dto.ArtistDTO, error)
+	GetSimilar(ctx context.Context, artistID uint64) ([]*dto.ArtistDTO, error)
+	UploadImage(ctx context.Context, artistID uint64, renditions []*imaging.Rendition) (*dto.ArtistDTO, error)
 }
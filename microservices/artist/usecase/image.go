@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	uuid "github.com/google/uuid"
+)
+
+// imagesBucket keeps the artists and albums images, the renditions of the
+// uploaded images are stored under imagesPrefix.
+const (
+	imagesBucket = "images"
+	imagesPrefix = "artists/"
+)
+
+func (usecase *artistUsecase) UploadImage(ctx context.Context, artistID uint64, renditions []*imaging.Rendition) (*dto.ArtistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundArtist, err := usecase.artistRepo.FindById(ctx, artistID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find artist %d: %v", artistID, err), requestID)
+		return nil, artist.ErrArtistNotFound
+	}
+
+	base := imagesPrefix + uuid.New().String()
+	keys, err := usecase.uploadRenditions(ctx, base, renditions)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't save image of artist %d: %v", artistID, err), requestID)
+		usecase.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't save image of artist %d", artistID)
+	}
+
+	image := imaging.Largest(base)
+	previousImage, err := usecase.artistRepo.UpdateImage(ctx, artistID, image)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't update image of artist %d: %v", artistID, err), requestID)
+		usecase.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't update image of artist %d", artistID)
+	}
+	// images set before the renditions were introduced are kept, Keys
+	// returns nothing for them
+	usecase.removeKeys(ctx, imaging.Keys(previousImage))
+	usecase.logger.Info(fmt.Sprintf("Updated image of artist %d", artistID), requestID)
+
+	foundArtist.Image = image
+	return usecase.convertArtistToDTO(foundArtist)
+}
+
+func (usecase *artistUsecase) uploadRenditions(ctx context.Context, base string, renditions []*imaging.Rendition) ([]string, error) {
+	keys := make([]string, 0, len(renditions))
+	for _, rendition := range renditions {
+		upload := s3.Upload{
+			Bucket:      imagesBucket,
+			Key:         rendition.Key(base),
+			File:        bytes.NewReader(rendition.Data),
+			Size:        int64(len(rendition.Data)),
+			ContentType: rendition.Format.ContentType(),
+		}
+		if _, err := usecase.s3Repo.Put(ctx, upload); err != nil {
+			return keys, err
+		}
+		keys = append(keys, upload.Key)
+	}
+
+	return keys, nil
+}
+
+func (usecase *artistUsecase) removeKeys(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := usecase.s3Repo.Remove(ctx, imagesBucket, key); err != nil {
+			usecase.logger.Warnf("Can't remove artist image '%s': %v", key, err)
+		}
+	}
+}
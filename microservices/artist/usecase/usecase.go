@@ -8,17 +8,21 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	uuid "github.com/google/uuid"
 )
 
+const similarArtistsLimit = 20
+
 type artistUsecase struct {
 	artistRepo artist.Repo
+	s3Repo     s3.S3Repo
 	logger     logger.Logger
 }
 
-func NewArtistUsecase(artistRepo artist.Repo, logger logger.Logger) artist.Usecase {
-	return &artistUsecase{artistRepo, logger}
+func NewArtistUsecase(artistRepo artist.Repo, s3Repo s3.S3Repo, logger logger.Logger) artist.Usecase {
+	return &artistUsecase{artistRepo, s3Repo, logger}
 }
 
 func (usecase *artistUsecase) View(ctx context.Context, artistID uint64) (*dto.ArtistDTO, error) {
@@ -161,3 +165,25 @@ func (usecase *artistUsecase) GetPopular(ctx context.Context) ([]*dto.ArtistDTO,
 
 	return dtoArtists, nil
 }
+
+func (usecase *artistUsecase) GetSimilar(ctx context.Context, artistID uint64) ([]*dto.ArtistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	artists, err := usecase.artistRepo.FindSimilar(ctx, artistID, similarArtistsLimit)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load similar artists for artist %d: %v", artistID, err), requestID)
+		return nil, fmt.Errorf("Can't load similar artists")
+	}
+	usecase.logger.Infof("Found %d similar artists for artist ID %d", len(artists), artistID)
+
+	var dtoArtists []*dto.ArtistDTO
+	for _, artist := range artists {
+		dtoArtist, err := usecase.convertArtistToDTO(artist)
+		if err != nil {
+			usecase.logger.Error(fmt.Sprintf("Can't create DTO for %s artist: %v", artist.Name, err), requestID)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoArtists = append(dtoArtists, dtoArtist)
+	}
+
+	return dtoArtists, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,8 +15,11 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
 	mockArtist "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/golang/mock/gomock"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,7 +40,7 @@ func TestUsecase_View_FoundArtist(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	artist := &models.Artist{
 		ID:      1,
@@ -75,7 +79,7 @@ func TestUsecase_View_NotFoundArtist(t *testing.T) {
 	ctx := context.Background()
 
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	artistRepoMock.EXPECT().FindById(ctx, uint64(1)).Return(nil, errors.New("Artist wasn't found"))
 	dtoArtist, err := artistUsecase.View(ctx, uint64(1))
@@ -100,7 +104,7 @@ func TestUsecase_Search_FoundArtists(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	now := time.Now()
 	artists := []*models.Artist{
@@ -136,7 +140,7 @@ func TestUsecase_Search_NotFoundArtists(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	ctx := context.Background()
 	artistRepoMock.EXPECT().FindByQuery(ctx, "artist").Return(nil, errors.New("Can't find artist"))
@@ -163,7 +167,7 @@ func TestUsecase_GetAll_FoundArtists(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	now := time.Now()
 	artists := []*models.Artist{
@@ -201,7 +205,7 @@ func TestUsecase_GetAll_NotFoundArtists(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	artistRepoMock := mockArtist.NewMockRepo(ctrl)
-	artistUsecase := NewArtistUsecase(artistRepoMock, logger)
+	artistUsecase := NewArtistUsecase(artistRepoMock, nil, logger)
 
 	ctx := context.Background()
 	artistRepoMock.EXPECT().GetAll(ctx).Return(nil, errors.New("Can't load artists"))
@@ -455,3 +459,126 @@ func TestArtistUsecase_GetPopular(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestArtistUsecase_GetSimilar(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	mockArtistRepo := mockArtist.NewMockRepo(ctrl)
+	logger := logger.New(&cfg.Service.Logger)
+	artistUsecase := NewArtistUsecase(mockArtistRepo, nil, logger)
+
+	ctx := context.Background()
+	artists := []*models.Artist{
+		{ID: 2, Name: "Artist 2", Bio: "Bio 2", Country: "Country B", Image: "/images/artists/artist_2.jpg"},
+		{ID: 3, Name: "Artist 3", Bio: "Bio 3", Country: "Country C", Image: "/images/artists/artist_3.jpg"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockArtistRepo.EXPECT().FindSimilar(ctx, uint64(1), uint64(similarArtistsLimit)).Return(artists, nil)
+
+		result, err := artistUsecase.GetSimilar(ctx, uint64(1))
+
+		require.NoError(t, err)
+		require.Equal(t, len(artists), len(result))
+		for i, artist := range artists {
+			require.Equal(t, artist.ID, result[i].ID)
+			require.Equal(t, artist.Name, result[i].Name)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockArtistRepo.EXPECT().FindSimilar(ctx, uint64(1), uint64(similarArtistsLimit)).Return(nil, fmt.Errorf("repository error"))
+
+		result, err := artistUsecase.GetSimilar(ctx, uint64(1))
+
+		require.Error(t, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestUsecase_UploadImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	artistRepoMock := mockArtist.NewMockRepo(ctrl)
+	s3RepoMock := mockArtist.NewMockS3Repo(ctrl)
+	artistUsecase := NewArtistUsecase(artistRepoMock, s3RepoMock, logger)
+
+	previousImage := imaging.Largest("artists/0b8f7f5e-6a43-4c55-9a8e-3f0c2b1d4e5f")
+	artist := &models.Artist{ID: 1, Name: "quinn", Image: previousImage}
+	renditions := []*imaging.Rendition{
+		{Size: 64, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+		{Size: 64, Format: imaging.FormatWebP, Data: []byte("webp")},
+	}
+
+	ctx := context.Background()
+
+	var keys []string
+	artistRepoMock.EXPECT().FindById(ctx, artist.ID).Return(artist, nil)
+	s3RepoMock.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+		require.Equal(t, "images", upload.Bucket)
+		keys = append(keys, upload.Key)
+		return &minio.UploadInfo{Key: upload.Key}, nil
+	}).Times(2)
+	artistRepoMock.EXPECT().UpdateImage(ctx, artist.ID, gomock.Any()).Return(previousImage, nil)
+	for _, key := range imaging.Keys(previousImage) {
+		s3RepoMock.EXPECT().Remove(ctx, "images", key).Return(nil)
+	}
+
+	artistDTO, err := artistUsecase.UploadImage(ctx, artist.ID, renditions)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Regexp(t, `^artists/.+/64\.jpg$`, keys[0])
+	require.Regexp(t, `^artists/.+/64\.webp$`, keys[1])
+	require.Equal(t, imaging.Largest(strings.TrimSuffix(keys[0], "/64.jpg")), artistDTO.Image)
+	require.Len(t, artistDTO.Images, len(imaging.Sizes))
+}
+
+func TestUsecase_UploadImageFailure(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	artistRepoMock := mockArtist.NewMockRepo(ctrl)
+	s3RepoMock := mockArtist.NewMockS3Repo(ctrl)
+	artistUsecase := NewArtistUsecase(artistRepoMock, s3RepoMock, logger)
+
+	artist := &models.Artist{ID: 1, Name: "quinn", Image: "quinn.webp"}
+	renditions := []*imaging.Rendition{
+		{Size: 64, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+	}
+
+	ctx := context.Background()
+
+	var uploaded string
+	artistRepoMock.EXPECT().FindById(ctx, artist.ID).Return(artist, nil)
+	s3RepoMock.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+		uploaded = upload.Key
+		return &minio.UploadInfo{Key: upload.Key}, nil
+	})
+	artistRepoMock.EXPECT().UpdateImage(ctx, artist.ID, gomock.Any()).Return("", fmt.Errorf("connection reset"))
+	s3RepoMock.EXPECT().Remove(ctx, "images", gomock.Any()).DoAndReturn(func(_ context.Context, _, key string) error {
+		require.Equal(t, uploaded, key)
+		return nil
+	})
+
+	_, err := artistUsecase.UploadImage(ctx, artist.ID, renditions)
+	require.Error(t, err)
+}
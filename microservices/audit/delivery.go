@@ -0,0 +1,7 @@
+package audit
+
+import "net/http"
+
+type Handlers interface {
+	GetEvents(response http.ResponseWriter, request *http.Request)
+}
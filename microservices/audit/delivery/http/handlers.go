@@ -0,0 +1,135 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+const dateLayout = "2006-01-02"
+
+const (
+	eventsDefaultLimit = 50
+	eventsMaxLimit     = 200
+)
+
+type auditHandlers struct {
+	usecase audit.Usecase
+	logger  logger.Logger
+}
+
+func NewAuditHandlers(usecase audit.Usecase, logger logger.Logger) audit.Handlers {
+	return &auditHandlers{usecase, logger}
+}
+
+// GetEvents godoc
+// @Summary Get audit events
+// @Description Returns the audit events matching the filter, newest first. Admin only.
+// @Param actor_id query string false "ID of the user who acted"
+// @Param action query string false "Action, e.g. user.login or csat.survey_update"
+// @Param target_type query string false "Type of the target, e.g. user or csat_survey"
+// @Param target_id query string false "ID of the target"
+// @Param from query string false "Start of the range, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "End of the range, RFC 3339 or YYYY-MM-DD (inclusive day)"
+// @Param cursor query int false "nextCursor of the previous page"
+// @Param limit query int false "Number of events, 50 by default and 200 at most"
+// @Success 200 {object} dto.AuditEventsDTO "Page of events"
+// @Failure 400 {object} utils.ErrorResponse "Invalid filter"
+// @Failure 500 {object} utils.ErrorResponse "Can't get audit events"
+// @Router /api/v1/audit/events [get]
+func (handlers *auditHandlers) GetEvents(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	filter, err := parseFilter(request)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("invalid audit filter: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "invalid query")
+		return
+	}
+
+	page, err := handlers.usecase.GetEvents(request.Context(), filter)
+	if err != nil {
+		if errors.Is(err, audit.ErrInvalidRange) {
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "Can't get audit events")
+		return
+	}
+
+	rawBytes, err := easyjson.Marshal(page)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to encode audit events: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "encode fail")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write(rawBytes); err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to write response: %v", err), requestID)
+	}
+}
+
+// parseFilter reads the filter from the query. A date without a time as the
+// end of the range includes the whole day.
+func parseFilter(request *http.Request) (*models.AuditFilter, error) {
+	query := request.URL.Query()
+	filter := &models.AuditFilter{
+		Action:     query.Get("action"),
+		TargetType: query.Get("target_type"),
+		TargetID:   query.Get("target_id"),
+	}
+
+	var err error
+	if raw := query.Get("actor_id"); raw != "" {
+		actorID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+		filter.ActorID = &actorID
+	}
+	if filter.From, err = parseTime(query.Get("from"), false); err != nil {
+		return nil, err
+	}
+	if filter.To, err = parseTime(query.Get("to"), true); err != nil {
+		return nil, err
+	}
+	if raw := query.Get("cursor"); raw != "" {
+		if filter.BeforeID, err = strconv.ParseUint(raw, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if filter.Limit, err = utils.ParseLimit(query.Get("limit"), eventsDefaultLimit, eventsMaxLimit); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func parseTime(value string, end bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return &parsed, nil
+	}
+
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+	if end {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+	return &parsed, nil
+}
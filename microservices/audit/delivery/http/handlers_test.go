@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditHandlers_GetEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	auditHandlers := NewAuditHandlers(usecaseMock, logger)
+
+	t.Run("Successful get", func(t *testing.T) {
+		actorID := uuid.New()
+		from := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := &models.AuditFilter{
+			ActorID:    &actorID,
+			TargetType: "csat_survey",
+			From:       &from,
+			To:         &to,
+			BeforeID:   10,
+			Limit:      eventsMaxLimit,
+		}
+		usecaseMock.EXPECT().GetEvents(context.Background(), filter).Return(&dto.AuditEventsDTO{
+			Events:     []*dto.AuditEventDTO{{ID: 9, ActorID: &actorID, Action: "csat.survey_update"}},
+			NextCursor: 9,
+		}, nil)
+
+		request, err := http.NewRequest(
+			http.MethodGet,
+			"/api/v1/audit/events?actor_id="+actorID.String()+"&target_type=csat_survey&from=2024-12-01&to=2024-12-31&cursor=10&limit=1000",
+			nil,
+		)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		auditHandlers.GetEvents(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), `"nextCursor":9`)
+	})
+
+	t.Run("Invalid actor", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/audit/events?actor_id=admin", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		auditHandlers.GetEvents(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("Invalid range", func(t *testing.T) {
+		usecaseMock.EXPECT().GetEvents(context.Background(), gomock.Any()).Return(nil, audit.ErrInvalidRange)
+
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/audit/events?from=2025-01-02&to=2025-01-01", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		auditHandlers.GetEvents(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
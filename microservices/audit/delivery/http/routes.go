@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	auditRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/repository"
+	auditUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/usecase"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(s *httpServer.Server) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	auditRepo := auditRepo.NewAuditPGRepository(s.PG)
+	auditUsecase := auditUsecase.NewAuditUsecase(auditRepo, s.Logger)
+	auditHandlers := NewAuditHandlers(auditUsecase, s.Logger)
+
+	s.MUX.Handle(
+		"/api/v1/audit/events",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(
+				&s.CFG.Service.Auth, s.Logger,
+				http.HandlerFunc(auditHandlers.GetEvents),
+			),
+		),
+	).Methods("GET")
+}
@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/google/uuid"
+)
+
+//easyjson:json
+type AuditEventDTO struct {
+	ID         uint64          `json:"id"`
+	ActorID    *uuid.UUID      `json:"actorID,omitempty"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"targetType"`
+	TargetID   string          `json:"targetID"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	RequestID  string          `json:"requestID"`
+	IP         string          `json:"ip"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
+
+func NewAuditEventDTO(event *models.AuditEvent) *AuditEventDTO {
+	eventDTO := &AuditEventDTO{
+		ID:         event.ID,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Before:     event.Before,
+		After:      event.After,
+		RequestID:  event.RequestID,
+		IP:         event.IP,
+		CreatedAt:  event.CreatedAt,
+	}
+	if event.ActorID != uuid.Nil {
+		actorID := event.ActorID
+		eventDTO.ActorID = &actorID
+	}
+	return eventDTO
+}
+
+// AuditEventsDTO is a page of events, NextCursor is passed as the cursor
+// to get the next one and is zero on the last page.
+//
+//easyjson:json
+type AuditEventsDTO struct {
+	Events     []*AuditEventDTO `json:"events"`
+	NextCursor uint64           `json:"nextCursor,omitempty"`
+}
@@ -0,0 +1,282 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(in *jlexer.Lexer, out *AuditEventsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "events":
+			if in.IsNull() {
+				in.Skip()
+				out.Events = nil
+			} else {
+				in.Delim('[')
+				if out.Events == nil {
+					if !in.IsDelim(']') {
+						out.Events = make([]*AuditEventDTO, 0, 8)
+					} else {
+						out.Events = []*AuditEventDTO{}
+					}
+				} else {
+					out.Events = (out.Events)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *AuditEventDTO
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(AuditEventDTO)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Events = append(out.Events, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "nextCursor":
+			out.NextCursor = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(out *jwriter.Writer, in AuditEventsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"events\":"
+		out.RawString(prefix[1:])
+		if in.Events == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Events {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != 0 {
+		const prefix string = ",\"nextCursor\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuditEventsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuditEventsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuditEventsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuditEventsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(in *jlexer.Lexer, out *AuditEventDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "actorID":
+			if in.IsNull() {
+				in.Skip()
+				out.ActorID = nil
+			} else {
+				if out.ActorID == nil {
+					out.ActorID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ActorID).UnmarshalText(data))
+				}
+			}
+		case "action":
+			out.Action = string(in.String())
+		case "targetType":
+			out.TargetType = string(in.String())
+		case "targetID":
+			out.TargetID = string(in.String())
+		case "before":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Before).UnmarshalJSON(data))
+			}
+		case "after":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.After).UnmarshalJSON(data))
+			}
+		case "requestID":
+			out.RequestID = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(out *jwriter.Writer, in AuditEventDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	if in.ActorID != nil {
+		const prefix string = ",\"actorID\":"
+		out.RawString(prefix)
+		out.RawText((*in.ActorID).MarshalText())
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"targetType\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetType))
+	}
+	{
+		const prefix string = ",\"targetID\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetID))
+	}
+	if len(in.Before) != 0 {
+		const prefix string = ",\"before\":"
+		out.RawString(prefix)
+		out.Raw((in.Before).MarshalJSON())
+	}
+	if len(in.After) != 0 {
+		const prefix string = ",\"after\":"
+		out.RawString(prefix)
+		out.Raw((in.After).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"requestID\":"
+		out.RawString(prefix)
+		out.String(string(in.RequestID))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AuditEventDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AuditEventDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AuditEventDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AuditEventDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesAuditDto1(l, v)
+}
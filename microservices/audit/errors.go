@@ -0,0 +1,5 @@
+package audit
+
+import "errors"
+
+var ErrInvalidRange = errors.New("range must start before it ends")
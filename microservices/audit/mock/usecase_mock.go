@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/audit/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetEvents mocks base method.
+func (m *MockUsecase) GetEvents(ctx context.Context, filter *models.AuditFilter) (*dto.AuditEventsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx, filter)
+	ret0, _ := ret[0].(*dto.AuditEventsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockUsecaseMockRecorder) GetEvents(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockUsecase)(nil).GetEvents), ctx, filter)
+}
@@ -0,0 +1,12 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+)
+
+type Repo interface {
+	// FindEvents returns the events matching the filter, newest first.
+	FindEvents(ctx context.Context, filter *models.AuditFilter) ([]*models.AuditEvent, error)
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type AuditRepository struct {
+	db *sql.DB
+}
+
+func NewAuditPGRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) FindEvents(ctx context.Context, filter *models.AuditFilter) ([]*models.AuditEvent, error) {
+	var actorID any
+	if filter.ActorID != nil {
+		actorID = *filter.ActorID
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		findEvents,
+		actorID,
+		filter.Action,
+		filter.TargetType,
+		filter.TargetID,
+		filter.From,
+		filter.To,
+		filter.BeforeID,
+		filter.Limit,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindEvents.Query")
+	}
+	defer rows.Close()
+
+	var events []*models.AuditEvent
+	for rows.Next() {
+		event := &models.AuditEvent{}
+		var actor uuid.NullUUID
+		var before, after []byte
+		if err := rows.Scan(
+			&event.ID,
+			&actor,
+			&event.Action,
+			&event.TargetType,
+			&event.TargetID,
+			&before,
+			&after,
+			&event.RequestID,
+			&event.IP,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "FindEvents.Query")
+		}
+		event.ActorID = actor.UUID
+		event.Before, event.After = before, after
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "FindEvents.Query")
+	}
+	return events, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditRepositoryFindEvents(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewAuditPGRepository(db)
+	actorID := uuid.New()
+	createdAt := time.Now()
+	filter := &models.AuditFilter{ActorID: &actorID, Action: "user.update", BeforeID: 10, Limit: 2}
+
+	mock.ExpectQuery(findEvents).
+		WithArgs(actorID, "user.update", "", "", nil, nil, 10, 2).
+		WillReturnRows(
+			sqlmock.NewRows([]string{
+				"id", "actor_id", "action", "target_type", "target_id", "before", "after", "request_id", "ip", "created_at",
+			}).
+				AddRow(9, actorID, "user.update", "user", actorID.String(), []byte(`{"email":"a"}`), []byte(`{"email":"b"}`), "request", "10.0.0.1", createdAt).
+				AddRow(8, nil, "user.login_failed", "user", "user", nil, nil, "", "", createdAt),
+		)
+
+	events, err := repo.FindEvents(context.Background(), filter)
+	require.NoError(t, err)
+	require.Equal(t, []*models.AuditEvent{
+		{
+			ID:         9,
+			ActorID:    actorID,
+			Action:     "user.update",
+			TargetType: "user",
+			TargetID:   actorID.String(),
+			Before:     json.RawMessage(`{"email":"a"}`),
+			After:      json.RawMessage(`{"email":"b"}`),
+			RequestID:  "request",
+			IP:         "10.0.0.1",
+			CreatedAt:  createdAt,
+		},
+		{
+			ID:         8,
+			Action:     "user.login_failed",
+			TargetType: "user",
+			TargetID:   "user",
+			CreatedAt:  createdAt,
+		},
+	}, events)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,18 @@
+package repository
+
+const (
+	// findEvents matches any value of the empty filters, $7 continues the
+	// list after an event
+	findEvents = `
+	SELECT id, actor_id, action, target_type, target_id, before, after, request_id, ip, created_at
+	FROM audit_event
+	WHERE ($1::UUID IS NULL OR actor_id = $1)
+		AND ($2 = '' OR action = $2)
+		AND ($3 = '' OR target_type = $3)
+		AND ($4 = '' OR target_id = $4)
+		AND ($5::TIMESTAMPTZ IS NULL OR created_at >= $5)
+		AND ($6::TIMESTAMPTZ IS NULL OR created_at < $6)
+		AND ($7 = 0 OR id < $7)
+	ORDER BY id DESC
+	LIMIT $8`
+)
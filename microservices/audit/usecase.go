@@ -0,0 +1,12 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/dto"
+)
+
+type Usecase interface {
+	GetEvents(ctx context.Context, filter *models.AuditFilter) (*dto.AuditEventsDTO, error)
+}
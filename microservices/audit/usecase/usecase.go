@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+type auditUsecase struct {
+	auditRepo audit.Repo
+	logger    logger.Logger
+}
+
+func NewAuditUsecase(auditRepo audit.Repo, logger logger.Logger) audit.Usecase {
+	return &auditUsecase{auditRepo, logger}
+}
+
+func (usecase *auditUsecase) GetEvents(ctx context.Context, filter *models.AuditFilter) (*dto.AuditEventsDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, audit.ErrInvalidRange
+	}
+
+	// one more event tells whether there is a next page
+	limit := filter.Limit
+	paged := *filter
+	paged.Limit = limit + 1
+
+	events, err := usecase.auditRepo.FindEvents(ctx, &paged)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve audit events: %v", err), requestID)
+		return nil, fmt.Errorf("cannot retrieve audit events")
+	}
+
+	page := &dto.AuditEventsDTO{Events: make([]*dto.AuditEventDTO, 0, len(events))}
+	if limit > 0 && uint64(len(events)) > limit {
+		events = events[:limit]
+		page.NextCursor = events[len(events)-1].ID
+	}
+	for _, event := range events {
+		page.Events = append(page.Events, dto.NewAuditEventDTO(event))
+	}
+
+	return page, nil
+}
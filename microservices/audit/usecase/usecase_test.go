@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/audit/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditUsecaseGetEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockRepo(ctrl)
+	auditUsecase := NewAuditUsecase(mockRepo, logger.New(&config.LoggerConfig{Level: "info", Format: "json"}))
+	ctx := context.Background()
+	actorID := uuid.New()
+
+	mockRepo.EXPECT().FindEvents(ctx, &models.AuditFilter{Action: "user.login", Limit: 3}).Return([]*models.AuditEvent{
+		{ID: 9, ActorID: actorID, Action: "user.login"},
+		{ID: 7, Action: "user.login"},
+		{ID: 4, Action: "user.login"},
+	}, nil)
+	page, err := auditUsecase.GetEvents(ctx, &models.AuditFilter{Action: "user.login", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 2)
+	require.Equal(t, &actorID, page.Events[0].ActorID)
+	require.Nil(t, page.Events[1].ActorID)
+	require.Equal(t, uint64(7), page.NextCursor)
+
+	mockRepo.EXPECT().FindEvents(ctx, &models.AuditFilter{BeforeID: 7, Limit: 3}).Return([]*models.AuditEvent{{ID: 4}}, nil)
+	page, err = auditUsecase.GetEvents(ctx, &models.AuditFilter{BeforeID: 7, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Events, 1)
+	require.Zero(t, page.NextCursor)
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+	_, err = auditUsecase.GetEvents(ctx, &models.AuditFilter{From: &from, To: &to, Limit: 2})
+	require.ErrorIs(t, err, audit.ErrInvalidRange)
+}
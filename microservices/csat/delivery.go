@@ -6,4 +6,19 @@ type Handlers interface {
 	SubmitAnswer(response http.ResponseWriter, request *http.Request)
 	GetQuestionsByTopic(response http.ResponseWriter, request *http.Request)
 	GetStatistics(response http.ResponseWriter, request *http.Request)
+	GetTrends(response http.ResponseWriter, request *http.Request)
+	ExportStatistics(response http.ResponseWriter, request *http.Request)
+
+	GetSurveys(response http.ResponseWriter, request *http.Request)
+	GetSurvey(response http.ResponseWriter, request *http.Request)
+	CreateSurvey(response http.ResponseWriter, request *http.Request)
+	UpdateSurvey(response http.ResponseWriter, request *http.Request)
+	DeleteSurvey(response http.ResponseWriter, request *http.Request)
+	CreateQuestion(response http.ResponseWriter, request *http.Request)
+	UpdateQuestion(response http.ResponseWriter, request *http.Request)
+	DeleteQuestion(response http.ResponseWriter, request *http.Request)
+	ReorderQuestions(response http.ResponseWriter, request *http.Request)
+
+	GetEligibleSurvey(response http.ResponseWriter, request *http.Request)
+	DismissSurvey(response http.ResponseWriter, request *http.Request)
 }
@@ -0,0 +1,181 @@
+package http
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+)
+
+const dateLayout = "2006-01-02"
+
+// maxCSVScore is the highest score of every question type, the CSV has a
+// column for the count of each score up to it.
+const maxCSVScore = 10
+
+// GetTrends godoc
+// @Summary Get CSAT trends
+// @Description Returns the statistics of every question answered within the range, bucketed by day, week or month. Admin only.
+// @Param from query string false "Start of the range, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "End of the range, RFC 3339 or YYYY-MM-DD (inclusive day)"
+// @Param question_id query int false "Question ID"
+// @Param interval query string false "Bucket size: day, week (default) or month"
+// @Success 200 {array} dto.CSATTrendDTO "Trends"
+// @Failure 400 {object} utils.ErrorResponse "Invalid filter"
+// @Failure 500 {object} utils.ErrorResponse "Can't get trends"
+// @Router /api/v1/csat/stat/trends [get]
+func (handlers *csatHandlers) GetTrends(response http.ResponseWriter, request *http.Request) {
+	filter, ok := handlers.filter(response, request)
+	if !ok {
+		return
+	}
+
+	interval := request.URL.Query().Get("interval")
+	if interval == "" {
+		interval = models.CSATIntervalWeek
+	}
+
+	trends, err := handlers.usecase.GetTrends(request.Context(), filter, interval)
+	if err != nil {
+		handlers.fail(response, err, "Can't get trends")
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, dto.CSATTrendDTOs(trends))
+}
+
+// ExportStatistics godoc
+// @Summary Export CSAT statistics
+// @Description Returns the statistics as a CSV file, a row per question and, when segmented, per cohort. Admin only.
+// @Produce text/csv
+// @Param from query string false "Start of the range, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "End of the range, RFC 3339 or YYYY-MM-DD (inclusive day)"
+// @Param question_id query int false "Question ID"
+// @Param segment query string false "Cohort of the users: role or registration_month"
+// @Success 200 {file} file "CSV file"
+// @Failure 400 {object} utils.ErrorResponse "Invalid filter"
+// @Failure 500 {object} utils.ErrorResponse "Can't get statistics"
+// @Router /api/v1/csat/stat/export [get]
+func (handlers *csatHandlers) ExportStatistics(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	filter, ok := handlers.filter(response, request)
+	if !ok {
+		return
+	}
+
+	stats, err := handlers.usecase.GetStatistics(request.Context(), filter)
+	if err != nil {
+		handlers.fail(response, err, "Can't get statistics")
+		return
+	}
+
+	filename := fmt.Sprintf("csat-%s.csv", time.Now().Format(dateLayout))
+	response.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	response.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	response.WriteHeader(http.StatusOK)
+
+	if err := writeStatisticsCSV(csv.NewWriter(response), stats); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write csv: %v", err), requestID)
+	}
+}
+
+// filter reads the statistics filter from the query. A date without a time
+// as the end of the range includes the whole day.
+func (handlers *csatHandlers) filter(response http.ResponseWriter, request *http.Request) (*models.CSATFilter, bool) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	query := request.URL.Query()
+	filter := &models.CSATFilter{Segment: query.Get("segment")}
+
+	var err error
+	if filter.From, err = parseTime(query.Get("from"), false); err == nil {
+		filter.To, err = parseTime(query.Get("to"), true)
+	}
+	if err == nil && query.Get("question_id") != "" {
+		filter.QuestionID, err = strconv.ParseUint(query.Get("question_id"), 10, 64)
+	}
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("invalid statistics filter: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "invalid query")
+		return nil, false
+	}
+
+	return filter, true
+}
+
+func parseTime(value string, end bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return &parsed, nil
+	}
+
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return nil, err
+	}
+	if end {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+	return &parsed, nil
+}
+
+func writeStatisticsCSV(writer *csv.Writer, stats []*dto.CSATStatisticsDTO) error {
+	header := []string{"topic", "question_id", "question", "type", "segment", "responses", "average_score", "csat", "nps"}
+	for score := 0; score <= maxCSVScore; score++ {
+		header = append(header, fmt.Sprintf("score_%d", score))
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, stat := range stats {
+		if err := writer.Write(statisticsRecord(stat, "all", &stat.CSATSummaryDTO)); err != nil {
+			return err
+		}
+		for _, segment := range stat.Segments {
+			if err := writer.Write(statisticsRecord(stat, segment.Segment, &segment.CSATSummaryDTO)); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// statisticsRecord leaves the counts of the scores out of the range of the
+// question empty.
+func statisticsRecord(stat *dto.CSATStatisticsDTO, segment string, summary *dto.CSATSummaryDTO) []string {
+	record := []string{
+		stat.Topic,
+		strconv.FormatUint(stat.QuestionID, 10),
+		stat.Question,
+		stat.Type,
+		segment,
+		strconv.FormatUint(summary.Responses, 10),
+		formatFloat(&summary.AverageScore),
+		formatFloat(summary.CSAT),
+		formatFloat(summary.NPS),
+	}
+
+	scores := make([]string, maxCSVScore+1)
+	for _, count := range summary.Distribution {
+		if int(count.Score) <= maxCSVScore {
+			scores[count.Score] = strconv.FormatUint(count.Count, 10)
+		}
+	}
+	return append(record, scores...)
+}
+
+func formatFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
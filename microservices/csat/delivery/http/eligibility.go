@@ -0,0 +1,73 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+)
+
+// GetEligibleSurvey godoc
+// @Summary Get eligible CSAT survey
+// @Description Returns the survey the user should be asked now, if any, with its questions in the order they are asked.
+// @Success 200 {object} dto.CSATSurveyDTO "Survey to ask"
+// @Success 204 "No survey to ask now"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get survey"
+// @Router /api/v1/csat/eligibility [get]
+func (handlers *csatHandlers) GetEligibleSurvey(response http.ResponseWriter, request *http.Request) {
+	userID, ok := handlers.userID(response, request)
+	if !ok {
+		return
+	}
+
+	survey, err := handlers.usecase.GetEligibleSurvey(request.Context(), userID)
+	if err != nil {
+		handlers.fail(response, err, "failed to get survey")
+		return
+	}
+	if survey == nil {
+		response.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, survey)
+}
+
+// DismissSurvey godoc
+// @Summary Dismiss CSAT survey
+// @Description Stops offering the survey to the user.
+// @Param surveyID path int true "Survey ID"
+// @Success 204 "Survey dismissed"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Router /api/v1/csat/surveys/{surveyID}/dismiss [post]
+func (handlers *csatHandlers) DismissSurvey(response http.ResponseWriter, request *http.Request) {
+	userID, ok := handlers.userID(response, request)
+	if !ok {
+		return
+	}
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	if err := handlers.usecase.DismissSurvey(request.Context(), userID, surveyID); err != nil {
+		handlers.fail(response, err, "failed to dismiss survey")
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (handlers *csatHandlers) userID(response http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("user id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "user id not found")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
@@ -57,8 +57,8 @@ func (handlers *csatHandlers) SubmitAnswer(response http.ResponseWriter, request
 	answer := dto.NewAnswerFromCSATAnswerDTO(csatAnswerDTO)
 	answerDTO, err := handlers.usecase.SubmitAnswer(request.Context(), answer)
 	if err != nil {
-		handlers.logger.Error("cannot submit answer for question", requestID)
-		utils.JSONError(response, http.StatusBadRequest, "user has already answered")
+		handlers.logger.Error(fmt.Sprintf("cannot submit answer for question: %v", err), requestID)
+		handlers.fail(response, err, "failed to submit answer")
 		return
 	}
 
@@ -120,28 +120,28 @@ func (handlers *csatHandlers) GetQuestionsByTopic(response http.ResponseWriter,
 	}
 }
 
+// GetStatistics godoc
+// @Summary Get CSAT statistics
+// @Description Returns the score distribution, response count, average and CSAT or NPS of every question answered within the range, optionally segmented by the cohort of the users. Admin only.
+// @Param from query string false "Start of the range, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "End of the range, RFC 3339 or YYYY-MM-DD (inclusive day)"
+// @Param question_id query int false "Question ID"
+// @Param segment query string false "Cohort of the users: role or registration_month"
+// @Success 200 {array} dto.CSATStatisticsDTO "Statistics"
+// @Failure 400 {object} utils.ErrorResponse "Invalid filter"
+// @Failure 500 {object} utils.ErrorResponse "Can't get statistics"
+// @Router /api/v1/csat/stat [get]
 func (handlers *csatHandlers) GetStatistics(response http.ResponseWriter, request *http.Request) {
-	requestID := request.Context().Value(utils.RequestIDKey{})
-	stat, err := handlers.usecase.GetStatistics(request.Context())
-	if err != nil {
-		handlers.logger.Error(fmt.Sprintf("Failed get statistics: %v", err), requestID)
-		utils.JSONError(response, http.StatusInternalServerError, "Can't get statistics")
+	filter, ok := handlers.filter(response, request)
+	if !ok {
 		return
 	}
 
-	response.Header().Set("Content-Type", "application/json")
-	rawBytes, err := easyjson.Marshal(dto.CSATStatisticsDTOs(stat))
+	stats, err := handlers.usecase.GetStatistics(request.Context(), filter)
 	if err != nil {
-		handlers.logger.Error(fmt.Sprintf("Failed to encode statistics: %v", err), requestID)
-		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		handlers.fail(response, err, "Can't get statistics")
 		return
 	}
 
-	response.WriteHeader(http.StatusOK)
-	_, err = response.Write(rawBytes)
-	if err != nil {
-		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
-		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
-		return
-	}
+	handlers.write(response, request, http.StatusOK, dto.CSATStatisticsDTOs(stats))
 }
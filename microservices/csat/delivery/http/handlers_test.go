@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSATHandlers_ExportStatistics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	csatHandlers := NewCSATHandlers(usecaseMock, logger)
+
+	t.Run("Successful export", func(t *testing.T) {
+		csatScore := 50.0
+		stats := []*dto.CSATStatisticsDTO{{
+			Topic:      "App",
+			QuestionID: 1,
+			Question:   "Are you satisfied?",
+			Type:       models.CSATQuestionCSAT,
+			CSATSummaryDTO: dto.CSATSummaryDTO{
+				Responses:    2,
+				AverageScore: 3.5,
+				Distribution: []*dto.CSATScoreCountDTO{{Score: 1}, {Score: 2, Count: 1}, {Score: 3}, {Score: 4}, {Score: 5, Count: 1}},
+				CSAT:         &csatScore,
+			},
+			Segments: []*dto.CSATSegmentDTO{{Segment: "regular"}},
+		}}
+
+		from := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		filter := &models.CSATFilter{From: &from, To: &to, Segment: models.CSATSegmentRole}
+		usecaseMock.EXPECT().GetStatistics(context.Background(), filter).Return(stats, nil)
+
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/csat/stat/export?from=2024-12-01&to=2024-12-31&segment=role", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		csatHandlers.ExportStatistics(response, request)
+		res := response.Result()
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/csv; charset=utf-8", res.Header.Get("Content-Type"))
+
+		records, err := csv.NewReader(res.Body).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, records, 3)
+		assert.Equal(t, []string{"App", "1", "Are you satisfied?", "csat", "all", "2", "3.5", "50", "", "", "0", "1", "0", "0", "1", "", "", "", "", ""}, records[1])
+		assert.Equal(t, "regular", records[2][4])
+	})
+
+	t.Run("Invalid date", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/csat/stat/export?from=yesterday", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		csatHandlers.ExportStatistics(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Result().StatusCode)
+	})
+
+	t.Run("Invalid segment", func(t *testing.T) {
+		usecaseMock.EXPECT().GetStatistics(context.Background(), &models.CSATFilter{Segment: "country"}).Return(nil, csat.ErrInvalidSegment)
+
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/csat/stat/export?segment=country", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		csatHandlers.ExportStatistics(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Result().StatusCode)
+	})
+}
@@ -3,6 +3,8 @@ package http
 import (
 	"net/http"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
 	csatRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/repository"
@@ -14,9 +16,12 @@ func BindRoutes(s *httpServer.Server) {
 	s.MUX.Handle("/metrics", promhttp.Handler())
 
 	csatRepo := csatRepo.NewCSATPGRepository(s.PG)
-	csatUsecase := csatUsecase.NewCSATUsecase(csatRepo, s.Logger)
+	recorder := audit.NewPGRecorder(s.PG, s.Logger)
+	csatUsecase := csatUsecase.NewCSATUsecase(csatRepo, recorder, s.Logger)
 	csatHandlers := NewCSATHandlers(csatUsecase, s.Logger)
 
+	idempotencyStore := idempotency.NewPGStore(s.PG)
+
 	s.MUX.Handle(
 		"/api/v1/csat/stat",
 		middleware.AuthMiddleware(
@@ -43,4 +48,49 @@ func BindRoutes(s *httpServer.Server) {
 			middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, http.HandlerFunc(csatHandlers.SubmitAnswer)),
 		),
 	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/csat/eligibility",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, http.HandlerFunc(csatHandlers.GetEligibleSurvey)),
+		),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/csat/surveys/{surveyID:[0-9]+}/dismiss",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, http.HandlerFunc(csatHandlers.DismissSurvey)),
+		),
+	).Methods("POST")
+
+	// admin wraps the survey administration handlers, changes also need the CSRF token
+	admin := func(handler http.HandlerFunc, csrf bool) http.Handler {
+		var next http.Handler = handler
+		if csrf {
+			next = middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, next)
+		}
+		return middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, next),
+		)
+	}
+
+	// idempotent lets admins retry creating surveys and questions safely
+	idempotent := func(handler http.HandlerFunc) http.HandlerFunc {
+		return middleware.IdempotencyMiddleware(&s.CFG.Service.Idempotency, idempotencyStore, s.Logger, handler).ServeHTTP
+	}
+
+	s.MUX.Handle("/api/v1/csat/stat/trends", admin(csatHandlers.GetTrends, false)).Methods("GET")
+	s.MUX.Handle("/api/v1/csat/stat/export", admin(csatHandlers.ExportStatistics, false)).Methods("GET")
+	s.MUX.Handle("/api/v1/csat/surveys", admin(csatHandlers.GetSurveys, false)).Methods("GET")
+	s.MUX.Handle("/api/v1/csat/surveys", admin(idempotent(csatHandlers.CreateSurvey), true)).Methods("POST")
+	s.MUX.Handle("/api/v1/csat/surveys/{surveyID:[0-9]+}", admin(csatHandlers.GetSurvey, false)).Methods("GET")
+	s.MUX.Handle("/api/v1/csat/surveys/{surveyID:[0-9]+}", admin(csatHandlers.UpdateSurvey, true)).Methods("PUT")
+	s.MUX.Handle("/api/v1/csat/surveys/{surveyID:[0-9]+}", admin(csatHandlers.DeleteSurvey, true)).Methods("DELETE")
+	s.MUX.Handle("/api/v1/csat/surveys/{surveyID:[0-9]+}/questions", admin(idempotent(csatHandlers.CreateQuestion), true)).Methods("POST")
+	s.MUX.Handle("/api/v1/csat/surveys/{surveyID:[0-9]+}/questions/order", admin(csatHandlers.ReorderQuestions, true)).Methods("PUT")
+	s.MUX.Handle("/api/v1/csat/questions/{questionID:[0-9]+}", admin(csatHandlers.UpdateQuestion, true)).Methods("PUT")
+	s.MUX.Handle("/api/v1/csat/questions/{questionID:[0-9]+}", admin(csatHandlers.DeleteQuestion, true)).Methods("DELETE")
 }
@@ -0,0 +1,316 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+)
+
+// GetSurveys godoc
+// @Summary Get CSAT surveys
+// @Description Returns every CSAT survey with its activation window. Admin only.
+// @Success 200 {array} dto.CSATSurveyDTO "List of surveys"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get surveys"
+// @Router /api/v1/csat/surveys [get]
+func (handlers *csatHandlers) GetSurveys(response http.ResponseWriter, request *http.Request) {
+	surveys, err := handlers.usecase.GetSurveys(request.Context())
+	if err != nil {
+		handlers.fail(response, err, "failed to get surveys")
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, dto.CSATSurveyDTOs(surveys))
+}
+
+// GetSurvey godoc
+// @Summary Get CSAT survey
+// @Description Returns the survey with its questions in the order they are asked. Admin only.
+// @Param surveyID path int true "Survey ID"
+// @Success 200 {object} dto.CSATSurveyDTO "Survey"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Router /api/v1/csat/surveys/{surveyID} [get]
+func (handlers *csatHandlers) GetSurvey(response http.ResponseWriter, request *http.Request) {
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	survey, err := handlers.usecase.GetSurvey(request.Context(), surveyID)
+	if err != nil {
+		handlers.fail(response, err, "failed to get survey")
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, survey)
+}
+
+// CreateSurvey godoc
+// @Summary Create CSAT survey
+// @Description Creates a survey for a topic, optionally active only within a window. Admin only.
+// @Param survey body dto.CSATSurveyDTO true "Survey"
+// @Success 201 {object} dto.CSATSurveyDTO "Created survey"
+// @Failure 400 {object} utils.ErrorResponse "Invalid survey"
+// @Failure 409 {object} utils.ErrorResponse "Topic is taken"
+// @Router /api/v1/csat/surveys [post]
+func (handlers *csatHandlers) CreateSurvey(response http.ResponseWriter, request *http.Request) {
+	surveyDTO := &dto.CSATSurveyDTO{}
+	if !handlers.read(response, request, surveyDTO) {
+		return
+	}
+	if surveyDTO.Topic == "" {
+		utils.JSONError(response, http.StatusBadRequest, "topic is required")
+		return
+	}
+
+	survey, err := handlers.usecase.CreateSurvey(request.Context(), dto.NewSurveyFromCSATSurveyDTO(surveyDTO))
+	if err != nil {
+		handlers.fail(response, err, "failed to create survey")
+		return
+	}
+
+	handlers.write(response, request, http.StatusCreated, survey)
+}
+
+// UpdateSurvey godoc
+// @Summary Update CSAT survey
+// @Description Changes the topic and the activation window of the survey. Admin only.
+// @Param surveyID path int true "Survey ID"
+// @Param survey body dto.CSATSurveyDTO true "Survey"
+// @Success 200 {object} dto.CSATSurveyDTO "Updated survey"
+// @Failure 400 {object} utils.ErrorResponse "Invalid survey"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Failure 409 {object} utils.ErrorResponse "Topic is taken"
+// @Router /api/v1/csat/surveys/{surveyID} [put]
+func (handlers *csatHandlers) UpdateSurvey(response http.ResponseWriter, request *http.Request) {
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	surveyDTO := &dto.CSATSurveyDTO{}
+	if !handlers.read(response, request, surveyDTO) {
+		return
+	}
+	if surveyDTO.Topic == "" {
+		utils.JSONError(response, http.StatusBadRequest, "topic is required")
+		return
+	}
+	surveyDTO.ID = surveyID
+
+	survey, err := handlers.usecase.UpdateSurvey(request.Context(), dto.NewSurveyFromCSATSurveyDTO(surveyDTO))
+	if err != nil {
+		handlers.fail(response, err, "failed to update survey")
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, survey)
+}
+
+// DeleteSurvey godoc
+// @Summary Delete CSAT survey
+// @Description Deletes the survey along with its questions and answers. Admin only.
+// @Param surveyID path int true "Survey ID"
+// @Success 204 "Survey deleted"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Router /api/v1/csat/surveys/{surveyID} [delete]
+func (handlers *csatHandlers) DeleteSurvey(response http.ResponseWriter, request *http.Request) {
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	if err := handlers.usecase.DeleteSurvey(request.Context(), surveyID); err != nil {
+		handlers.fail(response, err, "failed to delete survey")
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// CreateQuestion godoc
+// @Summary Add CSAT question
+// @Description Adds a question to the survey, at the end unless a position is given. Admin only.
+// @Param surveyID path int true "Survey ID"
+// @Param question body dto.CSATQuestionDTO true "Question"
+// @Success 201 {object} dto.CSATQuestionDTO "Created question"
+// @Failure 400 {object} utils.ErrorResponse "Invalid question"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Router /api/v1/csat/surveys/{surveyID}/questions [post]
+func (handlers *csatHandlers) CreateQuestion(response http.ResponseWriter, request *http.Request) {
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	questionDTO := &dto.CSATQuestionDTO{}
+	if !handlers.read(response, request, questionDTO) {
+		return
+	}
+	if questionDTO.Question == "" {
+		utils.JSONError(response, http.StatusBadRequest, "question is required")
+		return
+	}
+
+	question := dto.NewQuestionFromCSATQuestionDTO(questionDTO)
+	question.ID = 0
+	question.CSATID = surveyID
+	created, err := handlers.usecase.CreateQuestion(request.Context(), question)
+	if err != nil {
+		handlers.fail(response, err, "failed to create question")
+		return
+	}
+
+	handlers.write(response, request, http.StatusCreated, created)
+}
+
+// UpdateQuestion godoc
+// @Summary Update CSAT question
+// @Description Changes the text, type or position of the question. The type of an answered question can't be changed. Admin only.
+// @Param questionID path int true "Question ID"
+// @Param question body dto.CSATQuestionDTO true "Question"
+// @Success 200 {object} dto.CSATQuestionDTO "Updated question"
+// @Failure 400 {object} utils.ErrorResponse "Invalid question"
+// @Failure 404 {object} utils.ErrorResponse "Question not found"
+// @Failure 409 {object} utils.ErrorResponse "Question is answered"
+// @Router /api/v1/csat/questions/{questionID} [put]
+func (handlers *csatHandlers) UpdateQuestion(response http.ResponseWriter, request *http.Request) {
+	questionID, ok := handlers.pathID(response, request, "questionID")
+	if !ok {
+		return
+	}
+
+	questionDTO := &dto.CSATQuestionDTO{}
+	if !handlers.read(response, request, questionDTO) {
+		return
+	}
+	if questionDTO.Question == "" {
+		utils.JSONError(response, http.StatusBadRequest, "question is required")
+		return
+	}
+	questionDTO.ID = questionID
+
+	updated, err := handlers.usecase.UpdateQuestion(request.Context(), dto.NewQuestionFromCSATQuestionDTO(questionDTO))
+	if err != nil {
+		handlers.fail(response, err, "failed to update question")
+		return
+	}
+
+	handlers.write(response, request, http.StatusOK, updated)
+}
+
+// DeleteQuestion godoc
+// @Summary Delete CSAT question
+// @Description Deletes the question along with its answers. Admin only.
+// @Param questionID path int true "Question ID"
+// @Success 204 "Question deleted"
+// @Failure 404 {object} utils.ErrorResponse "Question not found"
+// @Router /api/v1/csat/questions/{questionID} [delete]
+func (handlers *csatHandlers) DeleteQuestion(response http.ResponseWriter, request *http.Request) {
+	questionID, ok := handlers.pathID(response, request, "questionID")
+	if !ok {
+		return
+	}
+
+	if err := handlers.usecase.DeleteQuestion(request.Context(), questionID); err != nil {
+		handlers.fail(response, err, "failed to delete question")
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// ReorderQuestions godoc
+// @Summary Reorder CSAT questions
+// @Description Sets the order of the questions of the survey. Every question must be listed once. Admin only.
+// @Param surveyID path int true "Survey ID"
+// @Param order body dto.CSATQuestionOrderDTO true "Question IDs in the new order"
+// @Success 204 "Questions reordered"
+// @Failure 400 {object} utils.ErrorResponse "Invalid order"
+// @Failure 404 {object} utils.ErrorResponse "Survey not found"
+// @Router /api/v1/csat/surveys/{surveyID}/questions/order [put]
+func (handlers *csatHandlers) ReorderQuestions(response http.ResponseWriter, request *http.Request) {
+	surveyID, ok := handlers.pathID(response, request, "surveyID")
+	if !ok {
+		return
+	}
+
+	orderDTO := &dto.CSATQuestionOrderDTO{}
+	if !handlers.read(response, request, orderDTO) {
+		return
+	}
+
+	if err := handlers.usecase.ReorderQuestions(request.Context(), surveyID, orderDTO.QuestionIDs); err != nil {
+		handlers.fail(response, err, "failed to reorder questions")
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (handlers *csatHandlers) pathID(response http.ResponseWriter, request *http.Request, name string) (uint64, bool) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	value := mux.Vars(request)[name]
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("get '%s' wrong id: %v", value, err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "wrong id value")
+		return 0, false
+	}
+	return id, true
+}
+
+func (handlers *csatHandlers) read(response http.ResponseWriter, request *http.Request, target easyjson.Unmarshaler) bool {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	rawBytes, err := io.ReadAll(request.Body)
+	if err == nil {
+		err = easyjson.Unmarshal(rawBytes, target)
+	}
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("invalid request body: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "invalid request body")
+		return false
+	}
+	return true
+}
+
+func (handlers *csatHandlers) write(response http.ResponseWriter, request *http.Request, status int, value easyjson.Marshaler) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	rawBytes, err := easyjson.Marshal(value)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to encode response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "encode fail")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(status)
+	if _, err := response.Write(rawBytes); err != nil {
+		handlers.logger.Error(fmt.Sprintf("failed to write response: %v", err), requestID)
+	}
+}
+
+// fail maps the csat errors to their statuses, anything else is internal.
+func (handlers *csatHandlers) fail(response http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, csat.ErrSurveyNotFound), errors.Is(err, csat.ErrQuestionNotFound):
+		utils.JSONError(response, http.StatusNotFound, err.Error())
+	case errors.Is(err, csat.ErrTopicTaken), errors.Is(err, csat.ErrQuestionAnswered), errors.Is(err, csat.ErrSurveyInactive):
+		utils.JSONError(response, http.StatusConflict, err.Error())
+	case errors.Is(err, csat.ErrInvalidWindow), errors.Is(err, csat.ErrInvalidQuestionType),
+		errors.Is(err, csat.ErrInvalidOrder), errors.Is(err, csat.ErrInvalidScore),
+		errors.Is(err, csat.ErrInvalidSegment), errors.Is(err, csat.ErrInvalidInterval), errors.Is(err, csat.ErrInvalidRange),
+		errors.Is(err, csat.ErrInvalidTargeting):
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+	default:
+		utils.JSONError(response, http.StatusInternalServerError, message)
+	}
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/google/uuid"
 )
@@ -9,12 +11,30 @@ import (
 type CSATQuestionDTO struct {
 	ID       uint64 `json:"id"`
 	Question string `json:"question"`
+	Type     string `json:"type,omitempty"`
+	Position int    `json:"position,omitempty"`
+	MinScore uint8  `json:"min_score"`
+	MaxScore uint8  `json:"max_score"`
 }
 
 func NewCSATQuestionDTO(csatQuestion *models.CSATQuestion) *CSATQuestionDTO {
+	minScore, maxScore, _ := models.CSATScoreRange(csatQuestion.Type)
 	return &CSATQuestionDTO{
 		csatQuestion.ID,
 		csatQuestion.Question,
+		csatQuestion.Type,
+		csatQuestion.Position,
+		minScore,
+		maxScore,
+	}
+}
+
+func NewQuestionFromCSATQuestionDTO(questionDTO *CSATQuestionDTO) *models.CSATQuestion {
+	return &models.CSATQuestion{
+		ID:       questionDTO.ID,
+		Question: questionDTO.Question,
+		Type:     questionDTO.Type,
+		Position: questionDTO.Position,
 	}
 }
 
@@ -46,20 +66,137 @@ func NewAnswerFromCSATAnswerDTO(answerDTO *CSATAnswerDTO) *models.CSATAnswer {
 	}
 }
 
+//easyjson:json
+type CSATScoreCountDTO struct {
+	Score uint8  `json:"score"`
+	Count uint64 `json:"count"`
+}
+
+// CSATSummaryDTO describes a set of answers. CSAT is the percentage of
+// satisfied users and is set for csat questions, NPS is the percentage of
+// promoters minus the percentage of detractors and is set for nps questions.
+//
+//easyjson:json
+type CSATSummaryDTO struct {
+	Responses    uint64               `json:"responses"`
+	AverageScore float64              `json:"average_score"`
+	Distribution []*CSATScoreCountDTO `json:"distribution"`
+	CSAT         *float64             `json:"csat,omitempty"`
+	NPS          *float64             `json:"nps,omitempty"`
+}
+
+//easyjson:json
+type CSATSegmentDTO struct {
+	Segment string `json:"segment"`
+	CSATSummaryDTO
+}
+
 //easyjson:json
 type CSATStatisticsDTO struct {
-	Topic        string  `json:"topic"`
-	Question     string  `json:"question"`
-	AverageScore float64 `json:"average_score"`
+	Topic      string `json:"topic"`
+	QuestionID uint64 `json:"question_id"`
+	Question   string `json:"question"`
+	Type       string `json:"type"`
+	CSATSummaryDTO
+	Segments []*CSATSegmentDTO `json:"segments,omitempty"`
+}
+
+//easyjson:json
+type CSATStatisticsDTOs []*CSATStatisticsDTO
+
+//easyjson:json
+type CSATTrendPointDTO struct {
+	Bucket time.Time `json:"bucket"`
+	CSATSummaryDTO
+}
+
+//easyjson:json
+type CSATTrendDTO struct {
+	Topic      string               `json:"topic"`
+	QuestionID uint64               `json:"question_id"`
+	Question   string               `json:"question"`
+	Type       string               `json:"type"`
+	Interval   string               `json:"interval"`
+	Points     []*CSATTrendPointDTO `json:"points"`
+}
+
+//easyjson:json
+type CSATTrendDTOs []*CSATTrendDTO
+
+//easyjson:json
+type CSATSurveyDTO struct {
+	ID          uint64             `json:"id"`
+	Topic       string             `json:"topic"`
+	ActiveFrom  *time.Time         `json:"active_from,omitempty"`
+	ActiveUntil *time.Time         `json:"active_until,omitempty"`
+	Active      bool               `json:"active"`
+	Targeting   *CSATTargetingDTO  `json:"targeting,omitempty"`
+	Questions   []*CSATQuestionDTO `json:"questions,omitempty"`
+}
+
+// CSATTargetingDTO leaves the sample rate and the cooldown to their defaults
+// when they are omitted.
+//
+//easyjson:json
+type CSATTargetingDTO struct {
+	MinAccountAgeDays int  `json:"min_account_age_days"`
+	MinPlays          int  `json:"min_plays"`
+	MinPlaylists      int  `json:"min_playlists"`
+	SampleRate        *int `json:"sample_rate,omitempty"`
+	CooldownDays      *int `json:"cooldown_days,omitempty"`
 }
 
-func NewCSATStatisticsDTO(csatStat *models.CSATStat) *CSATStatisticsDTO {
-	return &CSATStatisticsDTO{
-		Topic:        csatStat.Topic,
-		Question:     csatStat.Question,
-		AverageScore: csatStat.AverageScore,
+func NewCSATSurveyDTO(survey *models.CSATSurvey, questions []*models.CSATQuestion) *CSATSurveyDTO {
+	surveyDTO := &CSATSurveyDTO{
+		ID:          survey.ID,
+		Topic:       survey.Topic,
+		ActiveFrom:  survey.ActiveFrom,
+		ActiveUntil: survey.ActiveUntil,
+		Active:      survey.IsActive(time.Now()),
+		Targeting: &CSATTargetingDTO{
+			MinAccountAgeDays: survey.Targeting.MinAccountAgeDays,
+			MinPlays:          survey.Targeting.MinPlays,
+			MinPlaylists:      survey.Targeting.MinPlaylists,
+			SampleRate:        &survey.Targeting.SampleRate,
+			CooldownDays:      &survey.Targeting.CooldownDays,
+		},
 	}
+	for _, question := range questions {
+		surveyDTO.Questions = append(surveyDTO.Questions, NewCSATQuestionDTO(question))
+	}
+	return surveyDTO
+}
+
+func NewSurveyFromCSATSurveyDTO(surveyDTO *CSATSurveyDTO) *models.CSATSurvey {
+	survey := &models.CSATSurvey{
+		ID:          surveyDTO.ID,
+		Topic:       surveyDTO.Topic,
+		ActiveFrom:  surveyDTO.ActiveFrom,
+		ActiveUntil: surveyDTO.ActiveUntil,
+		Targeting: models.CSATTargeting{
+			SampleRate:   models.CSATDefaultSampleRate,
+			CooldownDays: models.CSATDefaultCooldownDays,
+		},
+	}
+
+	if targeting := surveyDTO.Targeting; targeting != nil {
+		survey.Targeting.MinAccountAgeDays = targeting.MinAccountAgeDays
+		survey.Targeting.MinPlays = targeting.MinPlays
+		survey.Targeting.MinPlaylists = targeting.MinPlaylists
+		if targeting.SampleRate != nil {
+			survey.Targeting.SampleRate = *targeting.SampleRate
+		}
+		if targeting.CooldownDays != nil {
+			survey.Targeting.CooldownDays = *targeting.CooldownDays
+		}
+	}
+	return survey
 }
 
 //easyjson:json
-type CSATStatisticsDTOs []*CSATStatisticsDTO
+type CSATSurveyDTOs []*CSATSurveyDTO
+
+//easyjson:json
+type CSATQuestionOrderDTO struct {
+	QuestionIDs []uint64 `json:"question_ids"`
+}
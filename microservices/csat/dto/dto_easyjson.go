@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -17,7 +18,913 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(in *jlexer.Lexer, out *CSATStatisticsDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(in *jlexer.Lexer, out *CSATTrendPointDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "bucket":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Bucket).UnmarshalJSON(data))
+			}
+		case "responses":
+			out.Responses = uint64(in.Uint64())
+		case "average_score":
+			out.AverageScore = float64(in.Float64())
+		case "distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]*CSATScoreCountDTO, 0, 8)
+					} else {
+						out.Distribution = []*CSATScoreCountDTO{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *CSATScoreCountDTO
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(CSATScoreCountDTO)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.Distribution = append(out.Distribution, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "csat":
+			if in.IsNull() {
+				in.Skip()
+				out.CSAT = nil
+			} else {
+				if out.CSAT == nil {
+					out.CSAT = new(float64)
+				}
+				*out.CSAT = float64(in.Float64())
+			}
+		case "nps":
+			if in.IsNull() {
+				in.Skip()
+				out.NPS = nil
+			} else {
+				if out.NPS == nil {
+					out.NPS = new(float64)
+				}
+				*out.NPS = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(out *jwriter.Writer, in CSATTrendPointDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"bucket\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.Bucket).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"responses\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Responses))
+	}
+	{
+		const prefix string = ",\"average_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageScore))
+	}
+	{
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Distribution {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				if v3 == nil {
+					out.RawString("null")
+				} else {
+					(*v3).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.CSAT != nil {
+		const prefix string = ",\"csat\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.CSAT))
+	}
+	if in.NPS != nil {
+		const prefix string = ",\"nps\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.NPS))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATTrendPointDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATTrendPointDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATTrendPointDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATTrendPointDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(in *jlexer.Lexer, out *CSATTrendDTOs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CSATTrendDTOs, 0, 8)
+			} else {
+				*out = CSATTrendDTOs{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 *CSATTrendDTO
+			if in.IsNull() {
+				in.Skip()
+				v4 = nil
+			} else {
+				if v4 == nil {
+					v4 = new(CSATTrendDTO)
+				}
+				(*v4).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(out *jwriter.Writer, in CSATTrendDTOs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			if v6 == nil {
+				out.RawString("null")
+			} else {
+				(*v6).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATTrendDTOs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATTrendDTOs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATTrendDTOs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATTrendDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(in *jlexer.Lexer, out *CSATTrendDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "topic":
+			out.Topic = string(in.String())
+		case "question_id":
+			out.QuestionID = uint64(in.Uint64())
+		case "question":
+			out.Question = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "interval":
+			out.Interval = string(in.String())
+		case "points":
+			if in.IsNull() {
+				in.Skip()
+				out.Points = nil
+			} else {
+				in.Delim('[')
+				if out.Points == nil {
+					if !in.IsDelim(']') {
+						out.Points = make([]*CSATTrendPointDTO, 0, 8)
+					} else {
+						out.Points = []*CSATTrendPointDTO{}
+					}
+				} else {
+					out.Points = (out.Points)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *CSATTrendPointDTO
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(CSATTrendPointDTO)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Points = append(out.Points, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(out *jwriter.Writer, in CSATTrendDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"interval\":"
+		out.RawString(prefix)
+		out.String(string(in.Interval))
+	}
+	{
+		const prefix string = ",\"points\":"
+		out.RawString(prefix)
+		if in.Points == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Points {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATTrendDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATTrendDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATTrendDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATTrendDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(in *jlexer.Lexer, out *CSATTargetingDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "min_account_age_days":
+			out.MinAccountAgeDays = int(in.Int())
+		case "min_plays":
+			out.MinPlays = int(in.Int())
+		case "min_playlists":
+			out.MinPlaylists = int(in.Int())
+		case "sample_rate":
+			if in.IsNull() {
+				in.Skip()
+				out.SampleRate = nil
+			} else {
+				if out.SampleRate == nil {
+					out.SampleRate = new(int)
+				}
+				*out.SampleRate = int(in.Int())
+			}
+		case "cooldown_days":
+			if in.IsNull() {
+				in.Skip()
+				out.CooldownDays = nil
+			} else {
+				if out.CooldownDays == nil {
+					out.CooldownDays = new(int)
+				}
+				*out.CooldownDays = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(out *jwriter.Writer, in CSATTargetingDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"min_account_age_days\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.MinAccountAgeDays))
+	}
+	{
+		const prefix string = ",\"min_plays\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinPlays))
+	}
+	{
+		const prefix string = ",\"min_playlists\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinPlaylists))
+	}
+	if in.SampleRate != nil {
+		const prefix string = ",\"sample_rate\":"
+		out.RawString(prefix)
+		out.Int(int(*in.SampleRate))
+	}
+	if in.CooldownDays != nil {
+		const prefix string = ",\"cooldown_days\":"
+		out.RawString(prefix)
+		out.Int(int(*in.CooldownDays))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATTargetingDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATTargetingDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATTargetingDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATTargetingDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(in *jlexer.Lexer, out *CSATSurveyDTOs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(CSATSurveyDTOs, 0, 8)
+			} else {
+				*out = CSATSurveyDTOs{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v10 *CSATSurveyDTO
+			if in.IsNull() {
+				in.Skip()
+				v10 = nil
+			} else {
+				if v10 == nil {
+					v10 = new(CSATSurveyDTO)
+				}
+				(*v10).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v10)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(out *jwriter.Writer, in CSATSurveyDTOs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v11, v12 := range in {
+			if v11 > 0 {
+				out.RawByte(',')
+			}
+			if v12 == nil {
+				out.RawString("null")
+			} else {
+				(*v12).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATSurveyDTOs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATSurveyDTOs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATSurveyDTOs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATSurveyDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(in *jlexer.Lexer, out *CSATSurveyDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = uint64(in.Uint64())
+		case "topic":
+			out.Topic = string(in.String())
+		case "active_from":
+			if in.IsNull() {
+				in.Skip()
+				out.ActiveFrom = nil
+			} else {
+				if out.ActiveFrom == nil {
+					out.ActiveFrom = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ActiveFrom).UnmarshalJSON(data))
+				}
+			}
+		case "active_until":
+			if in.IsNull() {
+				in.Skip()
+				out.ActiveUntil = nil
+			} else {
+				if out.ActiveUntil == nil {
+					out.ActiveUntil = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ActiveUntil).UnmarshalJSON(data))
+				}
+			}
+		case "active":
+			out.Active = bool(in.Bool())
+		case "targeting":
+			if in.IsNull() {
+				in.Skip()
+				out.Targeting = nil
+			} else {
+				if out.Targeting == nil {
+					out.Targeting = new(CSATTargetingDTO)
+				}
+				(*out.Targeting).UnmarshalEasyJSON(in)
+			}
+		case "questions":
+			if in.IsNull() {
+				in.Skip()
+				out.Questions = nil
+			} else {
+				in.Delim('[')
+				if out.Questions == nil {
+					if !in.IsDelim(']') {
+						out.Questions = make([]*CSATQuestionDTO, 0, 8)
+					} else {
+						out.Questions = []*CSATQuestionDTO{}
+					}
+				} else {
+					out.Questions = (out.Questions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 *CSATQuestionDTO
+					if in.IsNull() {
+						in.Skip()
+						v13 = nil
+					} else {
+						if v13 == nil {
+							v13 = new(CSATQuestionDTO)
+						}
+						(*v13).UnmarshalEasyJSON(in)
+					}
+					out.Questions = append(out.Questions, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(out *jwriter.Writer, in CSATSurveyDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.ID))
+	}
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix)
+		out.String(string(in.Topic))
+	}
+	if in.ActiveFrom != nil {
+		const prefix string = ",\"active_from\":"
+		out.RawString(prefix)
+		out.Raw((*in.ActiveFrom).MarshalJSON())
+	}
+	if in.ActiveUntil != nil {
+		const prefix string = ",\"active_until\":"
+		out.RawString(prefix)
+		out.Raw((*in.ActiveUntil).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Active))
+	}
+	if in.Targeting != nil {
+		const prefix string = ",\"targeting\":"
+		out.RawString(prefix)
+		(*in.Targeting).MarshalEasyJSON(out)
+	}
+	if len(in.Questions) != 0 {
+		const prefix string = ",\"questions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.Questions {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				if v15 == nil {
+					out.RawString("null")
+				} else {
+					(*v15).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATSurveyDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATSurveyDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATSurveyDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATSurveyDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto5(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(in *jlexer.Lexer, out *CSATSummaryDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "responses":
+			out.Responses = uint64(in.Uint64())
+		case "average_score":
+			out.AverageScore = float64(in.Float64())
+		case "distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]*CSATScoreCountDTO, 0, 8)
+					} else {
+						out.Distribution = []*CSATScoreCountDTO{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 *CSATScoreCountDTO
+					if in.IsNull() {
+						in.Skip()
+						v16 = nil
+					} else {
+						if v16 == nil {
+							v16 = new(CSATScoreCountDTO)
+						}
+						(*v16).UnmarshalEasyJSON(in)
+					}
+					out.Distribution = append(out.Distribution, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "csat":
+			if in.IsNull() {
+				in.Skip()
+				out.CSAT = nil
+			} else {
+				if out.CSAT == nil {
+					out.CSAT = new(float64)
+				}
+				*out.CSAT = float64(in.Float64())
+			}
+		case "nps":
+			if in.IsNull() {
+				in.Skip()
+				out.NPS = nil
+			} else {
+				if out.NPS == nil {
+					out.NPS = new(float64)
+				}
+				*out.NPS = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(out *jwriter.Writer, in CSATSummaryDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"responses\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.Responses))
+	}
+	{
+		const prefix string = ",\"average_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageScore))
+	}
+	{
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Distribution {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				if v18 == nil {
+					out.RawString("null")
+				} else {
+					(*v18).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.CSAT != nil {
+		const prefix string = ",\"csat\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.CSAT))
+	}
+	if in.NPS != nil {
+		const prefix string = ",\"nps\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.NPS))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATSummaryDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATSummaryDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATSummaryDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATSummaryDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto6(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(in *jlexer.Lexer, out *CSATStatisticsDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -31,71 +938,463 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 				*out = CSATStatisticsDTOs{}
 			}
 		} else {
-			*out = (*out)[:0]
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v19 *CSATStatisticsDTO
+			if in.IsNull() {
+				in.Skip()
+				v19 = nil
+			} else {
+				if v19 == nil {
+					v19 = new(CSATStatisticsDTO)
+				}
+				(*v19).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v19)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(out *jwriter.Writer, in CSATStatisticsDTOs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v20, v21 := range in {
+			if v20 > 0 {
+				out.RawByte(',')
+			}
+			if v21 == nil {
+				out.RawString("null")
+			} else {
+				(*v21).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATStatisticsDTOs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATStatisticsDTOs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATStatisticsDTOs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATStatisticsDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto7(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(in *jlexer.Lexer, out *CSATStatisticsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "topic":
+			out.Topic = string(in.String())
+		case "question_id":
+			out.QuestionID = uint64(in.Uint64())
+		case "question":
+			out.Question = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "segments":
+			if in.IsNull() {
+				in.Skip()
+				out.Segments = nil
+			} else {
+				in.Delim('[')
+				if out.Segments == nil {
+					if !in.IsDelim(']') {
+						out.Segments = make([]*CSATSegmentDTO, 0, 8)
+					} else {
+						out.Segments = []*CSATSegmentDTO{}
+					}
+				} else {
+					out.Segments = (out.Segments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 *CSATSegmentDTO
+					if in.IsNull() {
+						in.Skip()
+						v22 = nil
+					} else {
+						if v22 == nil {
+							v22 = new(CSATSegmentDTO)
+						}
+						(*v22).UnmarshalEasyJSON(in)
+					}
+					out.Segments = append(out.Segments, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "responses":
+			out.Responses = uint64(in.Uint64())
+		case "average_score":
+			out.AverageScore = float64(in.Float64())
+		case "distribution":
+			if in.IsNull() {
+				in.Skip()
+				out.Distribution = nil
+			} else {
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]*CSATScoreCountDTO, 0, 8)
+					} else {
+						out.Distribution = []*CSATScoreCountDTO{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 *CSATScoreCountDTO
+					if in.IsNull() {
+						in.Skip()
+						v23 = nil
+					} else {
+						if v23 == nil {
+							v23 = new(CSATScoreCountDTO)
+						}
+						(*v23).UnmarshalEasyJSON(in)
+					}
+					out.Distribution = append(out.Distribution, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "csat":
+			if in.IsNull() {
+				in.Skip()
+				out.CSAT = nil
+			} else {
+				if out.CSAT == nil {
+					out.CSAT = new(float64)
+				}
+				*out.CSAT = float64(in.Float64())
+			}
+		case "nps":
+			if in.IsNull() {
+				in.Skip()
+				out.NPS = nil
+			} else {
+				if out.NPS == nil {
+					out.NPS = new(float64)
+				}
+				*out.NPS = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(out *jwriter.Writer, in CSATStatisticsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"topic\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Topic))
+	}
+	{
+		const prefix string = ",\"question_id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.QuestionID))
+	}
+	{
+		const prefix string = ",\"question\":"
+		out.RawString(prefix)
+		out.String(string(in.Question))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if len(in.Segments) != 0 {
+		const prefix string = ",\"segments\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v24, v25 := range in.Segments {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				if v25 == nil {
+					out.RawString("null")
+				} else {
+					(*v25).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"responses\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Responses))
+	}
+	{
+		const prefix string = ",\"average_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageScore))
+	}
+	{
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Distribution {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				if v27 == nil {
+					out.RawString("null")
+				} else {
+					(*v27).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
-		for !in.IsDelim(']') {
-			var v1 *CSATStatisticsDTO
+	}
+	if in.CSAT != nil {
+		const prefix string = ",\"csat\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.CSAT))
+	}
+	if in.NPS != nil {
+		const prefix string = ",\"nps\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.NPS))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATStatisticsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATStatisticsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATStatisticsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATStatisticsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto8(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(in *jlexer.Lexer, out *CSATSegmentDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "segment":
+			out.Segment = string(in.String())
+		case "responses":
+			out.Responses = uint64(in.Uint64())
+		case "average_score":
+			out.AverageScore = float64(in.Float64())
+		case "distribution":
 			if in.IsNull() {
 				in.Skip()
-				v1 = nil
+				out.Distribution = nil
 			} else {
-				if v1 == nil {
-					v1 = new(CSATStatisticsDTO)
+				in.Delim('[')
+				if out.Distribution == nil {
+					if !in.IsDelim(']') {
+						out.Distribution = make([]*CSATScoreCountDTO, 0, 8)
+					} else {
+						out.Distribution = []*CSATScoreCountDTO{}
+					}
+				} else {
+					out.Distribution = (out.Distribution)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 *CSATScoreCountDTO
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						if v28 == nil {
+							v28 = new(CSATScoreCountDTO)
+						}
+						(*v28).UnmarshalEasyJSON(in)
+					}
+					out.Distribution = append(out.Distribution, v28)
+					in.WantComma()
 				}
-				(*v1).UnmarshalEasyJSON(in)
+				in.Delim(']')
 			}
-			*out = append(*out, v1)
-			in.WantComma()
+		case "csat":
+			if in.IsNull() {
+				in.Skip()
+				out.CSAT = nil
+			} else {
+				if out.CSAT == nil {
+					out.CSAT = new(float64)
+				}
+				*out.CSAT = float64(in.Float64())
+			}
+		case "nps":
+			if in.IsNull() {
+				in.Skip()
+				out.NPS = nil
+			} else {
+				if out.NPS == nil {
+					out.NPS = new(float64)
+				}
+				*out.NPS = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
 		}
-		in.Delim(']')
+		in.WantComma()
 	}
+	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(out *jwriter.Writer, in CSATStatisticsDTOs) {
-	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-		out.RawString("null")
-	} else {
-		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
-				out.RawByte(',')
-			}
-			if v3 == nil {
-				out.RawString("null")
-			} else {
-				(*v3).MarshalEasyJSON(out)
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(out *jwriter.Writer, in CSATSegmentDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"segment\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Segment))
+	}
+	{
+		const prefix string = ",\"responses\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Responses))
+	}
+	{
+		const prefix string = ",\"average_score\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageScore))
+	}
+	{
+		const prefix string = ",\"distribution\":"
+		out.RawString(prefix)
+		if in.Distribution == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Distribution {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
 			}
+			out.RawByte(']')
 		}
-		out.RawByte(']')
 	}
+	if in.CSAT != nil {
+		const prefix string = ",\"csat\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.CSAT))
+	}
+	if in.NPS != nil {
+		const prefix string = ",\"nps\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.NPS))
+	}
+	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATStatisticsDTOs) MarshalJSON() ([]byte, error) {
+func (v CSATSegmentDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATStatisticsDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(w, v)
+func (v CSATSegmentDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATStatisticsDTOs) UnmarshalJSON(data []byte) error {
+func (v *CSATSegmentDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATStatisticsDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto(l, v)
+func (v *CSATSegmentDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto9(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(in *jlexer.Lexer, out *CSATStatisticsDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(in *jlexer.Lexer, out *CSATScoreCountDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -114,12 +1413,10 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 			continue
 		}
 		switch key {
-		case "topic":
-			out.Topic = string(in.String())
-		case "question":
-			out.Question = string(in.String())
-		case "average_score":
-			out.AverageScore = float64(in.Float64())
+		case "score":
+			out.Score = uint8(in.Uint8())
+		case "count":
+			out.Count = uint64(in.Uint64())
 		default:
 			in.SkipRecursive()
 		}
@@ -130,52 +1427,145 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(out *jwriter.Writer, in CSATStatisticsDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(out *jwriter.Writer, in CSATScoreCountDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"topic\":"
+		const prefix string = ",\"score\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Topic))
+		out.Uint8(uint8(in.Score))
 	}
 	{
-		const prefix string = ",\"question\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix)
-		out.String(string(in.Question))
+		out.Uint64(uint64(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CSATScoreCountDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CSATScoreCountDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CSATScoreCountDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CSATScoreCountDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto10(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(in *jlexer.Lexer, out *CSATQuestionOrderDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "question_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.QuestionIDs = nil
+			} else {
+				in.Delim('[')
+				if out.QuestionIDs == nil {
+					if !in.IsDelim(']') {
+						out.QuestionIDs = make([]uint64, 0, 8)
+					} else {
+						out.QuestionIDs = []uint64{}
+					}
+				} else {
+					out.QuestionIDs = (out.QuestionIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 uint64
+					v31 = uint64(in.Uint64())
+					out.QuestionIDs = append(out.QuestionIDs, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(out *jwriter.Writer, in CSATQuestionOrderDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"average_score\":"
-		out.RawString(prefix)
-		out.Float64(float64(in.AverageScore))
+		const prefix string = ",\"question_ids\":"
+		out.RawString(prefix[1:])
+		if in.QuestionIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.QuestionIDs {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v33))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CSATStatisticsDTO) MarshalJSON() ([]byte, error) {
+func (v CSATQuestionOrderDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CSATStatisticsDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(w, v)
+func (v CSATQuestionOrderDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CSATStatisticsDTO) UnmarshalJSON(data []byte) error {
+func (v *CSATQuestionOrderDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CSATStatisticsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto1(l, v)
+func (v *CSATQuestionOrderDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto11(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(in *jlexer.Lexer, out *CSATQuestionDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(in *jlexer.Lexer, out *CSATQuestionDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -192,17 +1582,17 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v4 *CSATQuestionDTO
+			var v34 *CSATQuestionDTO
 			if in.IsNull() {
 				in.Skip()
-				v4 = nil
+				v34 = nil
 			} else {
-				if v4 == nil {
-					v4 = new(CSATQuestionDTO)
+				if v34 == nil {
+					v34 = new(CSATQuestionDTO)
 				}
-				(*v4).UnmarshalEasyJSON(in)
+				(*v34).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v4)
+			*out = append(*out, v34)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -211,19 +1601,19 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(out *jwriter.Writer, in CSATQuestionDTOs) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(out *jwriter.Writer, in CSATQuestionDTOs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v5, v6 := range in {
-			if v5 > 0 {
+		for v35, v36 := range in {
+			if v35 > 0 {
 				out.RawByte(',')
 			}
-			if v6 == nil {
+			if v36 == nil {
 				out.RawString("null")
 			} else {
-				(*v6).MarshalEasyJSON(out)
+				(*v36).MarshalEasyJSON(out)
 			}
 		}
 		out.RawByte(']')
@@ -233,27 +1623,27 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 // MarshalJSON supports json.Marshaler interface
 func (v CSATQuestionDTOs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CSATQuestionDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CSATQuestionDTOs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CSATQuestionDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto2(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto12(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(in *jlexer.Lexer, out *CSATQuestionDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(in *jlexer.Lexer, out *CSATQuestionDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -276,6 +1666,14 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 			out.ID = uint64(in.Uint64())
 		case "question":
 			out.Question = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "position":
+			out.Position = int(in.Int())
+		case "min_score":
+			out.MinScore = uint8(in.Uint8())
+		case "max_score":
+			out.MaxScore = uint8(in.Uint8())
 		default:
 			in.SkipRecursive()
 		}
@@ -286,7 +1684,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(out *jwriter.Writer, in CSATQuestionDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(out *jwriter.Writer, in CSATQuestionDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -300,33 +1698,53 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 		out.RawString(prefix)
 		out.String(string(in.Question))
 	}
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.Position != 0 {
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"min_score\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.MinScore))
+	}
+	{
+		const prefix string = ",\"max_score\":"
+		out.RawString(prefix)
+		out.Uint8(uint8(in.MaxScore))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CSATQuestionDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CSATQuestionDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CSATQuestionDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CSATQuestionDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto3(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto13(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(in *jlexer.Lexer, out *CSATAnswerDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(in *jlexer.Lexer, out *CSATAnswerDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -365,7 +1783,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(out *jwriter.Writer, in CSATAnswerDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(out *jwriter.Writer, in CSATAnswerDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -401,23 +1819,23 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDt
 // MarshalJSON supports json.Marshaler interface
 func (v CSATAnswerDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CSATAnswerDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CSATAnswerDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CSATAnswerDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto4(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesCsatDto14(l, v)
 }
@@ -0,0 +1,19 @@
+package csat
+
+import "errors"
+
+var (
+	ErrSurveyNotFound      = errors.New("survey not found")
+	ErrQuestionNotFound    = errors.New("question not found")
+	ErrTopicTaken          = errors.New("survey with that topic already exists")
+	ErrInvalidWindow       = errors.New("survey activation must start before it ends")
+	ErrInvalidQuestionType = errors.New("question type must be csat, nps or rating")
+	ErrQuestionAnswered    = errors.New("type of an answered question can't be changed")
+	ErrInvalidOrder        = errors.New("order must list every question of the survey once")
+	ErrInvalidScore        = errors.New("score is out of the range of the question")
+	ErrSurveyInactive      = errors.New("survey isn't active")
+	ErrInvalidSegment      = errors.New("segment must be role or registration_month")
+	ErrInvalidInterval     = errors.New("interval must be day, week or month")
+	ErrInvalidRange        = errors.New("range must start before it ends")
+	ErrInvalidTargeting    = errors.New("targeting thresholds can't be negative and sample rate must be a percentage")
+)
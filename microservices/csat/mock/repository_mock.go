@@ -10,6 +10,7 @@ import (
 
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockRepo is a mock of Repo interface.
@@ -35,6 +36,138 @@ func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
 	return m.recorder
 }
 
+// CreateQuestion mocks base method.
+func (m *MockRepo) CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, question)
+	ret0, _ := ret[0].(*models.CSATQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockRepoMockRecorder) CreateQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockRepo)(nil).CreateQuestion), ctx, question)
+}
+
+// CreateSurvey mocks base method.
+func (m *MockRepo) CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSurvey", ctx, survey)
+	ret0, _ := ret[0].(*models.CSATSurvey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSurvey indicates an expected call of CreateSurvey.
+func (mr *MockRepoMockRecorder) CreateSurvey(ctx, survey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurvey", reflect.TypeOf((*MockRepo)(nil).CreateSurvey), ctx, survey)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockRepo) DeleteQuestion(ctx context.Context, questionID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, questionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockRepoMockRecorder) DeleteQuestion(ctx, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockRepo)(nil).DeleteQuestion), ctx, questionID)
+}
+
+// DeleteSurvey mocks base method.
+func (m *MockRepo) DeleteSurvey(ctx context.Context, surveyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSurvey", ctx, surveyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSurvey indicates an expected call of DeleteSurvey.
+func (mr *MockRepoMockRecorder) DeleteSurvey(ctx, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurvey", reflect.TypeOf((*MockRepo)(nil).DeleteSurvey), ctx, surveyID)
+}
+
+// DismissSurvey mocks base method.
+func (m *MockRepo) DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissSurvey", ctx, userID, surveyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DismissSurvey indicates an expected call of DismissSurvey.
+func (mr *MockRepoMockRecorder) DismissSurvey(ctx, userID, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissSurvey", reflect.TypeOf((*MockRepo)(nil).DismissSurvey), ctx, userID, surveyID)
+}
+
+// FindQuestion mocks base method.
+func (m *MockRepo) FindQuestion(ctx context.Context, questionID uint64) (*models.CSATQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQuestion", ctx, questionID)
+	ret0, _ := ret[0].(*models.CSATQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindQuestion indicates an expected call of FindQuestion.
+func (mr *MockRepoMockRecorder) FindQuestion(ctx, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQuestion", reflect.TypeOf((*MockRepo)(nil).FindQuestion), ctx, questionID)
+}
+
+// FindSurvey mocks base method.
+func (m *MockRepo) FindSurvey(ctx context.Context, surveyID uint64) (*models.CSATSurvey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSurvey", ctx, surveyID)
+	ret0, _ := ret[0].(*models.CSATSurvey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSurvey indicates an expected call of FindSurvey.
+func (mr *MockRepoMockRecorder) FindSurvey(ctx, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSurvey", reflect.TypeOf((*MockRepo)(nil).FindSurvey), ctx, surveyID)
+}
+
+// GetActiveSurveys mocks base method.
+func (m *MockRepo) GetActiveSurveys(ctx context.Context) ([]*models.CSATSurvey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveSurveys", ctx)
+	ret0, _ := ret[0].([]*models.CSATSurvey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveSurveys indicates an expected call of GetActiveSurveys.
+func (mr *MockRepoMockRecorder) GetActiveSurveys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveSurveys", reflect.TypeOf((*MockRepo)(nil).GetActiveSurveys), ctx)
+}
+
+// GetAnsweredSurveys mocks base method.
+func (m *MockRepo) GetAnsweredSurveys(ctx context.Context, userID uuid.UUID) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnsweredSurveys", ctx, userID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnsweredSurveys indicates an expected call of GetAnsweredSurveys.
+func (mr *MockRepoMockRecorder) GetAnsweredSurveys(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnsweredSurveys", reflect.TypeOf((*MockRepo)(nil).GetAnsweredSurveys), ctx, userID)
+}
+
 // GetQuestionsByTopic mocks base method.
 func (m *MockRepo) GetQuestionsByTopic(ctx context.Context, topic string) ([]*models.CSATQuestion, error) {
 	m.ctrl.T.Helper()
@@ -50,32 +183,195 @@ func (mr *MockRepoMockRecorder) GetQuestionsByTopic(ctx, topic interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsByTopic", reflect.TypeOf((*MockRepo)(nil).GetQuestionsByTopic), ctx, topic)
 }
 
-// GetStatistics mocks base method.
-func (m *MockRepo) GetStatistics(ctx context.Context) ([]*models.CSATStat, error) {
+// GetScoreCounts mocks base method.
+func (m *MockRepo) GetScoreCounts(ctx context.Context, filter *models.CSATFilter) ([]*models.CSATScoreCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoreCounts", ctx, filter)
+	ret0, _ := ret[0].([]*models.CSATScoreCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoreCounts indicates an expected call of GetScoreCounts.
+func (mr *MockRepoMockRecorder) GetScoreCounts(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreCounts", reflect.TypeOf((*MockRepo)(nil).GetScoreCounts), ctx, filter)
+}
+
+// GetScoreTrend mocks base method.
+func (m *MockRepo) GetScoreTrend(ctx context.Context, filter *models.CSATFilter, interval string) ([]*models.CSATScoreCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoreTrend", ctx, filter, interval)
+	ret0, _ := ret[0].([]*models.CSATScoreCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoreTrend indicates an expected call of GetScoreTrend.
+func (mr *MockRepoMockRecorder) GetScoreTrend(ctx, filter, interval interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreTrend", reflect.TypeOf((*MockRepo)(nil).GetScoreTrend), ctx, filter, interval)
+}
+
+// GetSurveyQuestions mocks base method.
+func (m *MockRepo) GetSurveyQuestions(ctx context.Context, surveyID uint64) ([]*models.CSATQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSurveyQuestions", ctx, surveyID)
+	ret0, _ := ret[0].([]*models.CSATQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSurveyQuestions indicates an expected call of GetSurveyQuestions.
+func (mr *MockRepoMockRecorder) GetSurveyQuestions(ctx, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveyQuestions", reflect.TypeOf((*MockRepo)(nil).GetSurveyQuestions), ctx, surveyID)
+}
+
+// GetSurveys mocks base method.
+func (m *MockRepo) GetSurveys(ctx context.Context) ([]*models.CSATSurvey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSurveys", ctx)
+	ret0, _ := ret[0].([]*models.CSATSurvey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSurveys indicates an expected call of GetSurveys.
+func (mr *MockRepoMockRecorder) GetSurveys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveys", reflect.TypeOf((*MockRepo)(nil).GetSurveys), ctx)
+}
+
+// GetUserActivity mocks base method.
+func (m *MockRepo) GetUserActivity(ctx context.Context, userID uuid.UUID) (*models.CSATUserActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserActivity", ctx, userID)
+	ret0, _ := ret[0].(*models.CSATUserActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserActivity indicates an expected call of GetUserActivity.
+func (mr *MockRepoMockRecorder) GetUserActivity(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserActivity", reflect.TypeOf((*MockRepo)(nil).GetUserActivity), ctx, userID)
+}
+
+// GetUserAnswers mocks base method.
+func (m *MockRepo) GetUserAnswers(ctx context.Context, userID uuid.UUID) ([]*models.CSATUserAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAnswers", ctx, userID)
+	ret0, _ := ret[0].([]*models.CSATUserAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAnswers indicates an expected call of GetUserAnswers.
+func (mr *MockRepoMockRecorder) GetUserAnswers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAnswers", reflect.TypeOf((*MockRepo)(nil).GetUserAnswers), ctx, userID)
+}
+
+// GetUserPrompts mocks base method.
+func (m *MockRepo) GetUserPrompts(ctx context.Context, userID uuid.UUID) ([]*models.CSATPrompt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPrompts", ctx, userID)
+	ret0, _ := ret[0].([]*models.CSATPrompt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPrompts indicates an expected call of GetUserPrompts.
+func (mr *MockRepoMockRecorder) GetUserPrompts(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPrompts", reflect.TypeOf((*MockRepo)(nil).GetUserPrompts), ctx, userID)
+}
+
+// HasAnswers mocks base method.
+func (m *MockRepo) HasAnswers(ctx context.Context, questionID uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAnswers", ctx, questionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAnswers indicates an expected call of HasAnswers.
+func (mr *MockRepoMockRecorder) HasAnswers(ctx, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAnswers", reflect.TypeOf((*MockRepo)(nil).HasAnswers), ctx, questionID)
+}
+
+// ReorderQuestions mocks base method.
+func (m *MockRepo) ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderQuestions", ctx, surveyID, questionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderQuestions indicates an expected call of ReorderQuestions.
+func (mr *MockRepoMockRecorder) ReorderQuestions(ctx, surveyID, questionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderQuestions", reflect.TypeOf((*MockRepo)(nil).ReorderQuestions), ctx, surveyID, questionIDs)
+}
+
+// SavePrompt mocks base method.
+func (m *MockRepo) SavePrompt(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePrompt", ctx, userID, surveyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePrompt indicates an expected call of SavePrompt.
+func (mr *MockRepoMockRecorder) SavePrompt(ctx, userID, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePrompt", reflect.TypeOf((*MockRepo)(nil).SavePrompt), ctx, userID, surveyID)
+}
+
+// UpdateQuestion mocks base method.
+func (m *MockRepo) UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", ctx, question)
+	ret0, _ := ret[0].(*models.CSATQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockRepoMockRecorder) UpdateQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockRepo)(nil).UpdateQuestion), ctx, question)
+}
+
+// UpdateSurvey mocks base method.
+func (m *MockRepo) UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStatistics", ctx)
-	ret0, _ := ret[0].([]*models.CSATStat)
+	ret := m.ctrl.Call(m, "UpdateSurvey", ctx, survey)
+	ret0, _ := ret[0].(*models.CSATSurvey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStatistics indicates an expected call of GetStatistics.
-func (mr *MockRepoMockRecorder) GetStatistics(ctx interface{}) *gomock.Call {
+// UpdateSurvey indicates an expected call of UpdateSurvey.
+func (mr *MockRepoMockRecorder) UpdateSurvey(ctx, survey interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockRepo)(nil).GetStatistics), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSurvey", reflect.TypeOf((*MockRepo)(nil).UpdateSurvey), ctx, survey)
 }
 
-// InsertAnswer mocks base method.
-func (m *MockRepo) InsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error) {
+// UpsertAnswer mocks base method.
+func (m *MockRepo) UpsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InsertAnswer", ctx, answer)
+	ret := m.ctrl.Call(m, "UpsertAnswer", ctx, answer)
 	ret0, _ := ret[0].(*models.CSATAnswer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InsertAnswer indicates an expected call of InsertAnswer.
-func (mr *MockRepoMockRecorder) InsertAnswer(ctx, answer interface{}) *gomock.Call {
+// UpsertAnswer indicates an expected call of UpsertAnswer.
+func (mr *MockRepoMockRecorder) UpsertAnswer(ctx, answer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAnswer", reflect.TypeOf((*MockRepo)(nil).InsertAnswer), ctx, answer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAnswer", reflect.TypeOf((*MockRepo)(nil).UpsertAnswer), ctx, answer)
 }
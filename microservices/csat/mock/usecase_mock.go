@@ -11,6 +11,7 @@ import (
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockUsecase is a mock of Usecase interface.
@@ -36,6 +37,93 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CreateQuestion mocks base method.
+func (m *MockUsecase) CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, question)
+	ret0, _ := ret[0].(*dto.CSATQuestionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockUsecaseMockRecorder) CreateQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockUsecase)(nil).CreateQuestion), ctx, question)
+}
+
+// CreateSurvey mocks base method.
+func (m *MockUsecase) CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSurvey", ctx, survey)
+	ret0, _ := ret[0].(*dto.CSATSurveyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSurvey indicates an expected call of CreateSurvey.
+func (mr *MockUsecaseMockRecorder) CreateSurvey(ctx, survey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSurvey", reflect.TypeOf((*MockUsecase)(nil).CreateSurvey), ctx, survey)
+}
+
+// DeleteQuestion mocks base method.
+func (m *MockUsecase) DeleteQuestion(ctx context.Context, questionID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestion", ctx, questionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestion indicates an expected call of DeleteQuestion.
+func (mr *MockUsecaseMockRecorder) DeleteQuestion(ctx, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestion", reflect.TypeOf((*MockUsecase)(nil).DeleteQuestion), ctx, questionID)
+}
+
+// DeleteSurvey mocks base method.
+func (m *MockUsecase) DeleteSurvey(ctx context.Context, surveyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSurvey", ctx, surveyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSurvey indicates an expected call of DeleteSurvey.
+func (mr *MockUsecaseMockRecorder) DeleteSurvey(ctx, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSurvey", reflect.TypeOf((*MockUsecase)(nil).DeleteSurvey), ctx, surveyID)
+}
+
+// DismissSurvey mocks base method.
+func (m *MockUsecase) DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissSurvey", ctx, userID, surveyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DismissSurvey indicates an expected call of DismissSurvey.
+func (mr *MockUsecaseMockRecorder) DismissSurvey(ctx, userID, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissSurvey", reflect.TypeOf((*MockUsecase)(nil).DismissSurvey), ctx, userID, surveyID)
+}
+
+// GetEligibleSurvey mocks base method.
+func (m *MockUsecase) GetEligibleSurvey(ctx context.Context, userID uuid.UUID) (*dto.CSATSurveyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEligibleSurvey", ctx, userID)
+	ret0, _ := ret[0].(*dto.CSATSurveyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEligibleSurvey indicates an expected call of GetEligibleSurvey.
+func (mr *MockUsecaseMockRecorder) GetEligibleSurvey(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEligibleSurvey", reflect.TypeOf((*MockUsecase)(nil).GetEligibleSurvey), ctx, userID)
+}
+
 // GetQuestionsByTopic mocks base method.
 func (m *MockUsecase) GetQuestionsByTopic(ctx context.Context, topic string) ([]*dto.CSATQuestionDTO, error) {
 	m.ctrl.T.Helper()
@@ -52,18 +140,77 @@ func (mr *MockUsecaseMockRecorder) GetQuestionsByTopic(ctx, topic interface{}) *
 }
 
 // GetStatistics mocks base method.
-func (m *MockUsecase) GetStatistics(ctx context.Context) ([]*dto.CSATStatisticsDTO, error) {
+func (m *MockUsecase) GetStatistics(ctx context.Context, filter *models.CSATFilter) ([]*dto.CSATStatisticsDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStatistics", ctx)
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, filter)
 	ret0, _ := ret[0].([]*dto.CSATStatisticsDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStatistics indicates an expected call of GetStatistics.
-func (mr *MockUsecaseMockRecorder) GetStatistics(ctx interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) GetStatistics(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockUsecase)(nil).GetStatistics), ctx, filter)
+}
+
+// GetSurvey mocks base method.
+func (m *MockUsecase) GetSurvey(ctx context.Context, surveyID uint64) (*dto.CSATSurveyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSurvey", ctx, surveyID)
+	ret0, _ := ret[0].(*dto.CSATSurveyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSurvey indicates an expected call of GetSurvey.
+func (mr *MockUsecaseMockRecorder) GetSurvey(ctx, surveyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurvey", reflect.TypeOf((*MockUsecase)(nil).GetSurvey), ctx, surveyID)
+}
+
+// GetSurveys mocks base method.
+func (m *MockUsecase) GetSurveys(ctx context.Context) ([]*dto.CSATSurveyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSurveys", ctx)
+	ret0, _ := ret[0].([]*dto.CSATSurveyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSurveys indicates an expected call of GetSurveys.
+func (mr *MockUsecaseMockRecorder) GetSurveys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSurveys", reflect.TypeOf((*MockUsecase)(nil).GetSurveys), ctx)
+}
+
+// GetTrends mocks base method.
+func (m *MockUsecase) GetTrends(ctx context.Context, filter *models.CSATFilter, interval string) ([]*dto.CSATTrendDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrends", ctx, filter, interval)
+	ret0, _ := ret[0].([]*dto.CSATTrendDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrends indicates an expected call of GetTrends.
+func (mr *MockUsecaseMockRecorder) GetTrends(ctx, filter, interval interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockUsecase)(nil).GetStatistics), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrends", reflect.TypeOf((*MockUsecase)(nil).GetTrends), ctx, filter, interval)
+}
+
+// ReorderQuestions mocks base method.
+func (m *MockUsecase) ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderQuestions", ctx, surveyID, questionIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderQuestions indicates an expected call of ReorderQuestions.
+func (mr *MockUsecaseMockRecorder) ReorderQuestions(ctx, surveyID, questionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderQuestions", reflect.TypeOf((*MockUsecase)(nil).ReorderQuestions), ctx, surveyID, questionIDs)
 }
 
 // SubmitAnswer mocks base method.
@@ -80,3 +227,33 @@ func (mr *MockUsecaseMockRecorder) SubmitAnswer(ctx, csatAnswer interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAnswer", reflect.TypeOf((*MockUsecase)(nil).SubmitAnswer), ctx, csatAnswer)
 }
+
+// UpdateQuestion mocks base method.
+func (m *MockUsecase) UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", ctx, question)
+	ret0, _ := ret[0].(*dto.CSATQuestionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockUsecaseMockRecorder) UpdateQuestion(ctx, question interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockUsecase)(nil).UpdateQuestion), ctx, question)
+}
+
+// UpdateSurvey mocks base method.
+func (m *MockUsecase) UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSurvey", ctx, survey)
+	ret0, _ := ret[0].(*dto.CSATSurveyDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSurvey indicates an expected call of UpdateSurvey.
+func (mr *MockUsecaseMockRecorder) UpdateSurvey(ctx, survey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSurvey", reflect.TypeOf((*MockUsecase)(nil).UpdateSurvey), ctx, survey)
+}
@@ -4,10 +4,33 @@ import (
 	"context"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
 )
 
 type Repo interface {
-	GetStatistics(ctx context.Context) ([]*models.CSATStat, error)
+	GetScoreCounts(ctx context.Context, filter *models.CSATFilter) ([]*models.CSATScoreCount, error)
+	GetScoreTrend(ctx context.Context, filter *models.CSATFilter, interval string) ([]*models.CSATScoreCount, error)
 	GetQuestionsByTopic(ctx context.Context, topic string) ([]*models.CSATQuestion, error)
-	InsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error)
+	UpsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error)
+	GetUserAnswers(ctx context.Context, userID uuid.UUID) ([]*models.CSATUserAnswer, error)
+
+	GetSurveys(ctx context.Context) ([]*models.CSATSurvey, error)
+	FindSurvey(ctx context.Context, surveyID uint64) (*models.CSATSurvey, error)
+	CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error)
+	UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error)
+	DeleteSurvey(ctx context.Context, surveyID uint64) error
+	GetSurveyQuestions(ctx context.Context, surveyID uint64) ([]*models.CSATQuestion, error)
+	FindQuestion(ctx context.Context, questionID uint64) (*models.CSATQuestion, error)
+	CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error)
+	UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error)
+	DeleteQuestion(ctx context.Context, questionID uint64) error
+	ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error
+	HasAnswers(ctx context.Context, questionID uint64) (bool, error)
+
+	GetActiveSurveys(ctx context.Context) ([]*models.CSATSurvey, error)
+	GetUserActivity(ctx context.Context, userID uuid.UUID) (*models.CSATUserActivity, error)
+	GetUserPrompts(ctx context.Context, userID uuid.UUID) ([]*models.CSATPrompt, error)
+	GetAnsweredSurveys(ctx context.Context, userID uuid.UUID) ([]uint64, error)
+	SavePrompt(ctx context.Context, userID uuid.UUID, surveyID uint64) error
+	DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error
 }
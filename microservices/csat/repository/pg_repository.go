@@ -6,9 +6,14 @@ import (
 	"fmt"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
+const uniqueViolation = "23505"
+
 type CSATRepository struct {
 	db *sql.DB
 }
@@ -17,30 +22,66 @@ func NewCSATPGRepository(db *sql.DB) *CSATRepository {
 	return &CSATRepository{db: db}
 }
 
-func (r *CSATRepository) GetStatistics(ctx context.Context) ([]*models.CSATStat, error) {
-	rows, err := r.db.QueryContext(ctx, getStatistics)
+func (r *CSATRepository) GetScoreCounts(ctx context.Context, filter *models.CSATFilter) ([]*models.CSATScoreCount, error) {
+	rows, err := r.db.QueryContext(ctx, getScoreCounts, filter.From, filter.To, filter.QuestionID, filter.Segment)
 	if err != nil {
-		return nil, errors.Wrap(err, "GetStatistics.Query")
+		return nil, errors.Wrap(err, "GetScoreCounts.Query")
 	}
 	defer rows.Close()
 
-	var stats []*models.CSATStat
+	var counts []*models.CSATScoreCount
 	for rows.Next() {
-		stat := &models.CSATStat{}
-		err := rows.Scan(
-			&stat.ID,
-			&stat.Topic,
-			&stat.QuestionID,
-			&stat.Question,
-			&stat.AverageScore,
-		)
-		if err != nil {
-			return nil, errors.Wrap(err, "GetStatistics.Query")
+		count := &models.CSATScoreCount{}
+		if err := rows.Scan(
+			&count.Topic,
+			&count.QuestionID,
+			&count.Question,
+			&count.Type,
+			&count.Segment,
+			&count.Score,
+			&count.Count,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetScoreCounts.Query")
+		}
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetScoreCounts.Query")
+	}
+
+	return counts, nil
+}
+
+func (r *CSATRepository) GetScoreTrend(ctx context.Context, filter *models.CSATFilter, interval string) ([]*models.CSATScoreCount, error) {
+	rows, err := r.db.QueryContext(ctx, getScoreTrend, filter.From, filter.To, filter.QuestionID, interval)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetScoreTrend.Query")
+	}
+	defer rows.Close()
+
+	var counts []*models.CSATScoreCount
+	for rows.Next() {
+		count := &models.CSATScoreCount{}
+		if err := rows.Scan(
+			&count.Topic,
+			&count.QuestionID,
+			&count.Question,
+			&count.Type,
+			&count.Bucket,
+			&count.Score,
+			&count.Count,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetScoreTrend.Query")
 		}
-		stats = append(stats, stat)
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetScoreTrend.Query")
 	}
 
-	return stats, nil
+	return counts, nil
 }
 
 func (r *CSATRepository) GetQuestionsByTopic(ctx context.Context, topic string) ([]*models.CSATQuestion, error) {
@@ -52,13 +93,9 @@ func (r *CSATRepository) GetQuestionsByTopic(ctx context.Context, topic string)
 	defer rows.Close()
 
 	for rows.Next() {
-		question := &models.CSATQuestion{}
-		err := rows.Scan(
-			&question.ID,
-			&question.Question,
-		)
+		question, err := scanQuestion(rows)
 		if err != nil {
-			return nil, errors.Wrap(err, "GetByArtistID.Query")
+			return nil, errors.Wrap(err, "GetQuestionsByTopic.Query")
 		}
 		questions = append(questions, question)
 	}
@@ -66,22 +103,405 @@ func (r *CSATRepository) GetQuestionsByTopic(ctx context.Context, topic string)
 	return questions, nil
 }
 
-func (r *CSATRepository) InsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error) {
-	var insertedAnswer models.CSATAnswer
+func (r *CSATRepository) UpsertAnswer(ctx context.Context, answer *models.CSATAnswer) (*models.CSATAnswer, error) {
+	var savedAnswer models.CSATAnswer
 
 	if err := r.db.QueryRowContext(
 		ctx,
-		insertAnswer,
+		upsertAnswer,
 		answer.Score,
 		answer.UserID,
 		answer.CSATQuestionID,
 	).Scan(
-		&insertedAnswer.Score,
-		&insertedAnswer.UserID,
-		&insertedAnswer.CSATQuestionID,
+		&savedAnswer.ID,
+		&savedAnswer.Score,
+		&savedAnswer.UserID,
+		&savedAnswer.CSATQuestionID,
+	); err != nil {
+		return nil, fmt.Errorf("failed to save csat answer: %w", err)
+	}
+
+	return &savedAnswer, nil
+}
+
+func (r *CSATRepository) GetUserAnswers(ctx context.Context, userID uuid.UUID) ([]*models.CSATUserAnswer, error) {
+	rows, err := r.db.QueryContext(ctx, getUserAnswers, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserAnswers.Query")
+	}
+	defer rows.Close()
+
+	var answers []*models.CSATUserAnswer
+	for rows.Next() {
+		answer := &models.CSATUserAnswer{}
+		if err := rows.Scan(
+			&answer.Topic,
+			&answer.Question,
+			&answer.Score,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetUserAnswers.Query")
+		}
+		answers = append(answers, answer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetUserAnswers.Query")
+	}
+
+	return answers, nil
+}
+
+func (r *CSATRepository) GetSurveys(ctx context.Context) ([]*models.CSATSurvey, error) {
+	rows, err := r.db.QueryContext(ctx, getSurveys)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetSurveys.Query")
+	}
+	defer rows.Close()
+
+	var surveys []*models.CSATSurvey
+	for rows.Next() {
+		survey, err := scanSurvey(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetSurveys.Query")
+		}
+		surveys = append(surveys, survey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetSurveys.Query")
+	}
+
+	return surveys, nil
+}
+
+func (r *CSATRepository) FindSurvey(ctx context.Context, surveyID uint64) (*models.CSATSurvey, error) {
+	survey, err := scanSurvey(r.db.QueryRowContext(ctx, findSurvey, surveyID))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindSurvey.Query")
+	}
+
+	return survey, nil
+}
+
+func (r *CSATRepository) CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error) {
+	created, err := scanSurvey(r.db.QueryRowContext(
+		ctx,
+		createSurvey,
+		survey.Topic,
+		survey.ActiveFrom,
+		survey.ActiveUntil,
+		survey.Targeting.MinAccountAgeDays,
+		survey.Targeting.MinPlays,
+		survey.Targeting.MinPlaylists,
+		survey.Targeting.SampleRate,
+		survey.Targeting.CooldownDays,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, csat.ErrTopicTaken
+		}
+		return nil, errors.Wrap(err, "CreateSurvey.Query")
+	}
+
+	return created, nil
+}
+
+func (r *CSATRepository) UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*models.CSATSurvey, error) {
+	updated, err := scanSurvey(r.db.QueryRowContext(
+		ctx,
+		updateSurvey,
+		survey.ID,
+		survey.Topic,
+		survey.ActiveFrom,
+		survey.ActiveUntil,
+		survey.Targeting.MinAccountAgeDays,
+		survey.Targeting.MinPlays,
+		survey.Targeting.MinPlaylists,
+		survey.Targeting.SampleRate,
+		survey.Targeting.CooldownDays,
+	))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, csat.ErrTopicTaken
+		}
+		return nil, errors.Wrap(err, "UpdateSurvey.Query")
+	}
+
+	return updated, nil
+}
+
+func (r *CSATRepository) DeleteSurvey(ctx context.Context, surveyID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteSurvey, surveyID)
+	if err != nil {
+		return errors.Wrap(err, "DeleteSurvey.Query")
+	}
+
+	return checkAffected(result, "DeleteSurvey.Query")
+}
+
+func (r *CSATRepository) GetActiveSurveys(ctx context.Context) ([]*models.CSATSurvey, error) {
+	rows, err := r.db.QueryContext(ctx, getActiveSurveys)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetActiveSurveys.Query")
+	}
+	defer rows.Close()
+
+	var surveys []*models.CSATSurvey
+	for rows.Next() {
+		survey, err := scanSurvey(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetActiveSurveys.Query")
+		}
+		surveys = append(surveys, survey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetActiveSurveys.Query")
+	}
+
+	return surveys, nil
+}
+
+func (r *CSATRepository) GetUserActivity(ctx context.Context, userID uuid.UUID) (*models.CSATUserActivity, error) {
+	var activity models.CSATUserActivity
+	if err := r.db.QueryRowContext(ctx, getUserActivity, userID).Scan(
+		&activity.RegisteredAt,
+		&activity.Plays,
+		&activity.Playlists,
+	); err != nil {
+		return nil, errors.Wrap(err, "GetUserActivity.Query")
+	}
+
+	return &activity, nil
+}
+
+func (r *CSATRepository) GetUserPrompts(ctx context.Context, userID uuid.UUID) ([]*models.CSATPrompt, error) {
+	rows, err := r.db.QueryContext(ctx, getUserPrompts, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserPrompts.Query")
+	}
+	defer rows.Close()
+
+	var prompts []*models.CSATPrompt
+	for rows.Next() {
+		var dismissedAt sql.NullTime
+		prompt := &models.CSATPrompt{}
+		if err := rows.Scan(
+			&prompt.SurveyID,
+			&prompt.ShownAt,
+			&dismissedAt,
+			&prompt.CooldownDays,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetUserPrompts.Query")
+		}
+		if dismissedAt.Valid {
+			prompt.DismissedAt = &dismissedAt.Time
+		}
+		prompts = append(prompts, prompt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetUserPrompts.Query")
+	}
+
+	return prompts, nil
+}
+
+func (r *CSATRepository) GetAnsweredSurveys(ctx context.Context, userID uuid.UUID) ([]uint64, error) {
+	rows, err := r.db.QueryContext(ctx, getAnsweredSurveys, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAnsweredSurveys.Query")
+	}
+	defer rows.Close()
+
+	var surveyIDs []uint64
+	for rows.Next() {
+		var surveyID uint64
+		if err := rows.Scan(&surveyID); err != nil {
+			return nil, errors.Wrap(err, "GetAnsweredSurveys.Query")
+		}
+		surveyIDs = append(surveyIDs, surveyID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetAnsweredSurveys.Query")
+	}
+
+	return surveyIDs, nil
+}
+
+func (r *CSATRepository) SavePrompt(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	if _, err := r.db.ExecContext(ctx, savePrompt, userID, surveyID); err != nil {
+		return errors.Wrap(err, "SavePrompt.Query")
+	}
+
+	return nil
+}
+
+func (r *CSATRepository) DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	if _, err := r.db.ExecContext(ctx, dismissSurvey, userID, surveyID); err != nil {
+		return errors.Wrap(err, "DismissSurvey.Query")
+	}
+
+	return nil
+}
+
+func (r *CSATRepository) GetSurveyQuestions(ctx context.Context, surveyID uint64) ([]*models.CSATQuestion, error) {
+	rows, err := r.db.QueryContext(ctx, getSurveyQuestions, surveyID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetSurveyQuestions.Query")
+	}
+	defer rows.Close()
+
+	var questions []*models.CSATQuestion
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetSurveyQuestions.Query")
+		}
+		questions = append(questions, question)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetSurveyQuestions.Query")
+	}
+
+	return questions, nil
+}
+
+func (r *CSATRepository) FindQuestion(ctx context.Context, questionID uint64) (*models.CSATQuestion, error) {
+	question, err := scanQuestion(r.db.QueryRowContext(ctx, findQuestion, questionID))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindQuestion.Query")
+	}
+
+	return question, nil
+}
+
+func (r *CSATRepository) CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error) {
+	created, err := scanQuestion(r.db.QueryRowContext(
+		ctx,
+		createQuestion,
+		question.Question,
+		question.CSATID,
+		question.Type,
+		question.Position,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateQuestion.Query")
+	}
+
+	return created, nil
+}
+
+func (r *CSATRepository) UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*models.CSATQuestion, error) {
+	updated, err := scanQuestion(r.db.QueryRowContext(
+		ctx,
+		updateQuestion,
+		question.ID,
+		question.Question,
+		question.Type,
+		question.Position,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "UpdateQuestion.Query")
+	}
+
+	return updated, nil
+}
+
+func (r *CSATRepository) DeleteQuestion(ctx context.Context, questionID uint64) error {
+	result, err := r.db.ExecContext(ctx, deleteQuestion, questionID)
+	if err != nil {
+		return errors.Wrap(err, "DeleteQuestion.Query")
+	}
+
+	return checkAffected(result, "DeleteQuestion.Query")
+}
+
+func (r *CSATRepository) ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error {
+	ids := make([]int64, 0, len(questionIDs))
+	for _, questionID := range questionIDs {
+		ids = append(ids, int64(questionID))
+	}
+
+	if _, err := r.db.ExecContext(ctx, reorderQuestions, surveyID, pq.Array(ids)); err != nil {
+		return errors.Wrap(err, "ReorderQuestions.Query")
+	}
+
+	return nil
+}
+
+func (r *CSATRepository) HasAnswers(ctx context.Context, questionID uint64) (bool, error) {
+	var answered bool
+	if err := r.db.QueryRowContext(ctx, hasAnswers, questionID).Scan(&answered); err != nil {
+		return false, errors.Wrap(err, "HasAnswers.Query")
+	}
+
+	return answered, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSurvey(row scanner) (*models.CSATSurvey, error) {
+	var activeFrom, activeUntil sql.NullTime
+	survey := &models.CSATSurvey{}
+	if err := row.Scan(
+		&survey.ID,
+		&survey.Topic,
+		&activeFrom,
+		&activeUntil,
+		&survey.Targeting.MinAccountAgeDays,
+		&survey.Targeting.MinPlays,
+		&survey.Targeting.MinPlaylists,
+		&survey.Targeting.SampleRate,
+		&survey.Targeting.CooldownDays,
+		&survey.CreatedAt,
+		&survey.UpdatedAt,
 	); err != nil {
-		return nil, fmt.Errorf("failed to insert csat answer: %w", err)
+		return nil, err
+	}
+
+	if activeFrom.Valid {
+		survey.ActiveFrom = &activeFrom.Time
+	}
+	if activeUntil.Valid {
+		survey.ActiveUntil = &activeUntil.Time
 	}
+	return survey, nil
+}
+
+func scanQuestion(row scanner) (*models.CSATQuestion, error) {
+	question := &models.CSATQuestion{}
+	if err := row.Scan(
+		&question.ID,
+		&question.Question,
+		&question.CSATID,
+		&question.Type,
+		&question.Position,
+	); err != nil {
+		return nil, err
+	}
+
+	return question, nil
+}
+
+// checkAffected reports sql.ErrNoRows when the statement changed nothing.
+func checkAffected(result sql.Result, op string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, op)
+	}
+	if affected == 0 {
+		return errors.Wrap(sql.ErrNoRows, op)
+	}
+
+	return nil
+}
 
-	return &insertedAnswer, nil
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
 }
@@ -3,51 +3,79 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
-func TestCSATRepositoryGetStatistics_Success(t *testing.T) {
+func TestCSATRepositoryGetScoreCounts_Success(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
 	defer db.Close()
 
 	csatRepo := NewCSATPGRepository(db)
+	from := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	filter := &models.CSATFilter{From: &from, Segment: models.CSATSegmentRole}
 
-	columns := []string{"id", "topic", "question_id", "question", "average_score"}
+	columns := []string{"topic", "id", "title", "type", "segment", "score", "count"}
 	mockRows := sqlmock.NewRows(columns).
-		AddRow(1, "UX Design", 101, "How would you rate our UI?", 4.5).
-		AddRow(2, "Backend Performance", 102, "How would you rate API speed?", 3.8)
+		AddRow("UX Design", 101, "How would you rate our UI?", models.CSATQuestionCSAT, "regular", 4, 10).
+		AddRow("UX Design", 101, "How would you rate our UI?", models.CSATQuestionCSAT, "unknown", 2, 1)
 
-	mock.ExpectQuery(getStatistics).WillReturnRows(mockRows)
+	mock.ExpectQuery(getScoreCounts).WithArgs(filter.From, filter.To, filter.QuestionID, filter.Segment).WillReturnRows(mockRows)
 
-	ctx := context.Background()
-	stats, err := csatRepo.GetStatistics(ctx)
+	counts, err := csatRepo.GetScoreCounts(context.Background(), filter)
+	require.NoError(t, err)
+	require.Equal(t, []*models.CSATScoreCount{
+		{Topic: "UX Design", QuestionID: 101, Question: "How would you rate our UI?", Type: models.CSATQuestionCSAT, Segment: "regular", Score: 4, Count: 10},
+		{Topic: "UX Design", QuestionID: 101, Question: "How would you rate our UI?", Type: models.CSATQuestionCSAT, Segment: "unknown", Score: 2, Count: 1},
+	}, counts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
 
+func TestCSATRepositoryGetScoreCounts_ConnDone(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
-	require.Len(t, stats, 2)
-	require.Equal(t, "UX Design", stats[0].Topic)
-	require.Equal(t, "Backend Performance", stats[1].Topic)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	filter := &models.CSATFilter{}
+
+	mock.ExpectQuery(getScoreCounts).WithArgs(filter.From, filter.To, filter.QuestionID, filter.Segment).WillReturnError(sql.ErrConnDone)
+
+	counts, err := csatRepo.GetScoreCounts(context.Background(), filter)
+	require.Error(t, err)
+	require.Nil(t, counts)
 }
 
-func TestCSATRepositoryGetStatistics_ConnDone(t *testing.T) {
+func TestCSATRepositoryGetScoreTrend_Success(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
 	defer db.Close()
 
 	csatRepo := NewCSATPGRepository(db)
+	filter := &models.CSATFilter{QuestionID: 101}
+	week := time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)
 
-	mock.ExpectQuery(getStatistics).WillReturnError(sql.ErrConnDone)
+	columns := []string{"topic", "id", "title", "type", "bucket", "score", "count"}
+	mockRows := sqlmock.NewRows(columns).
+		AddRow("NPS", 101, "Would you recommend us?", models.CSATQuestionNPS, week, 10, 3)
 
-	ctx := context.Background()
-	stats, err := csatRepo.GetStatistics(ctx)
+	mock.ExpectQuery(getScoreTrend).WithArgs(filter.From, filter.To, filter.QuestionID, models.CSATIntervalWeek).WillReturnRows(mockRows)
 
-	require.Error(t, err)
-	require.Nil(t, stats)
+	counts, err := csatRepo.GetScoreTrend(context.Background(), filter, models.CSATIntervalWeek)
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	require.Equal(t, week, counts[0].Bucket)
+	require.Equal(t, uint64(3), counts[0].Count)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCSATRepositoryGetQuestionsByTopic_Success(t *testing.T) {
@@ -58,10 +86,10 @@ func TestCSATRepositoryGetQuestionsByTopic_Success(t *testing.T) {
 	csatRepo := NewCSATPGRepository(db)
 
 	topic := "UX Design"
-	columns := []string{"id", "question"}
+	columns := []string{"id", "title", "csat_id", "type", "position"}
 	mockRows := sqlmock.NewRows(columns).
-		AddRow(101, "How would you rate our UI?").
-		AddRow(102, "How would you rate our navigation?")
+		AddRow(101, "How would you rate our UI?", 1, models.CSATQuestionCSAT, 1).
+		AddRow(102, "How would you rate our navigation?", 1, models.CSATQuestionRating, 2)
 
 	mock.ExpectQuery(getQuestionsByTopic).WithArgs(topic).WillReturnRows(mockRows)
 
@@ -72,6 +100,8 @@ func TestCSATRepositoryGetQuestionsByTopic_Success(t *testing.T) {
 	require.Len(t, questions, 2)
 	require.Equal(t, "How would you rate our UI?", questions[0].Question)
 	require.Equal(t, "How would you rate our navigation?", questions[1].Question)
+	require.Equal(t, models.CSATQuestionCSAT, questions[0].Type)
+	require.Equal(t, 2, questions[1].Position)
 }
 
 func TestCSATRepositoryGetQuestionsByTopic_NoRows(t *testing.T) {
@@ -91,7 +121,7 @@ func TestCSATRepositoryGetQuestionsByTopic_NoRows(t *testing.T) {
 	require.Nil(t, questions)
 }
 
-func TestCSATRepositoryInsertAnswer_Success(t *testing.T) {
+func TestCSATRepositoryUpsertAnswer_Success(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
 	defer db.Close()
@@ -104,26 +134,27 @@ func TestCSATRepositoryInsertAnswer_Success(t *testing.T) {
 		CSATQuestionID: 101,
 	}
 
-	mockRows := sqlmock.NewRows([]string{"score", "user_id", "csat_question_id"}).
-		AddRow(answer.Score, answer.UserID, answer.CSATQuestionID)
+	mockRows := sqlmock.NewRows([]string{"id", "score", "user_id", "csat_question_id"}).
+		AddRow(7, answer.Score, answer.UserID, answer.CSATQuestionID)
 
-	mock.ExpectQuery(insertAnswer).WithArgs(
+	mock.ExpectQuery(upsertAnswer).WithArgs(
 		answer.Score,
 		answer.UserID,
 		answer.CSATQuestionID,
 	).WillReturnRows(mockRows)
 
 	ctx := context.Background()
-	insertedAnswer, err := csatRepo.InsertAnswer(ctx, answer)
+	insertedAnswer, err := csatRepo.UpsertAnswer(ctx, answer)
 
 	require.NoError(t, err)
 	require.NotNil(t, insertedAnswer)
+	require.Equal(t, uint64(7), insertedAnswer.ID)
 	require.Equal(t, answer.Score, insertedAnswer.Score)
 	require.Equal(t, answer.UserID, insertedAnswer.UserID)
 	require.Equal(t, answer.CSATQuestionID, insertedAnswer.CSATQuestionID)
 }
 
-func TestCSATRepositoryInsertAnswer_ConnDone(t *testing.T) {
+func TestCSATRepositoryUpsertAnswer_ConnDone(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err)
 	defer db.Close()
@@ -136,15 +167,170 @@ func TestCSATRepositoryInsertAnswer_ConnDone(t *testing.T) {
 		CSATQuestionID: 101,
 	}
 
-	mock.ExpectQuery(insertAnswer).WithArgs(
+	mock.ExpectQuery(upsertAnswer).WithArgs(
 		answer.Score,
 		answer.UserID,
 		answer.CSATQuestionID,
 	).WillReturnError(sql.ErrConnDone)
 
 	ctx := context.Background()
-	insertedAnswer, err := csatRepo.InsertAnswer(ctx, answer)
+	insertedAnswer, err := csatRepo.UpsertAnswer(ctx, answer)
 
 	require.Error(t, err)
 	require.Nil(t, insertedAnswer)
 }
+
+func TestCSATRepositoryGetUserAnswers_Success(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	userID := uuid.New()
+
+	columns := []string{"topic", "title", "score"}
+	mockRows := sqlmock.NewRows(columns).
+		AddRow("UX Design", "How would you rate our UI?", 5).
+		AddRow("Backend Performance", "How would you rate API speed?", 3)
+
+	mock.ExpectQuery(getUserAnswers).WithArgs(userID).WillReturnRows(mockRows)
+
+	answers, err := csatRepo.GetUserAnswers(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, []*models.CSATUserAnswer{
+		{Topic: "UX Design", Question: "How would you rate our UI?", Score: 5},
+		{Topic: "Backend Performance", Question: "How would you rate API speed?", Score: 3},
+	}, answers)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryCreateSurvey(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	activeUntil := time.Now().Add(time.Hour)
+	survey := &models.CSATSurvey{
+		Topic:       "Search",
+		ActiveUntil: &activeUntil,
+		Targeting:   models.CSATTargeting{MinPlays: 20, SampleRate: 10, CooldownDays: 30},
+	}
+	args := []driver.Value{survey.Topic, survey.ActiveFrom, survey.ActiveUntil, 0, 20, 0, 10, 30}
+	columns := []string{
+		"id", "topic", "active_from", "active_until",
+		"min_account_age_days", "min_plays", "min_playlists", "sample_rate", "cooldown_days", "created_at", "updated_at",
+	}
+
+	mock.ExpectQuery(createSurvey).WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "Search", nil, activeUntil, 0, 20, 0, 10, 30, time.Now(), time.Now()))
+	created, err := csatRepo.CreateSurvey(context.Background(), survey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), created.ID)
+	require.Nil(t, created.ActiveFrom)
+	require.Equal(t, activeUntil, *created.ActiveUntil)
+	require.Equal(t, survey.Targeting, created.Targeting)
+
+	mock.ExpectQuery(createSurvey).WithArgs(args...).WillReturnError(&pq.Error{Code: uniqueViolation})
+	_, err = csatRepo.CreateSurvey(context.Background(), survey)
+	require.ErrorIs(t, err, csat.ErrTopicTaken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryDeleteSurvey_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+
+	mock.ExpectExec(deleteSurvey).WithArgs(uint64(4)).WillReturnResult(sqlmock.NewResult(0, 0))
+	err = csatRepo.DeleteSurvey(context.Background(), 4)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryCreateQuestion(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	question := &models.CSATQuestion{Question: "How likely are you to recommend us?", CSATID: 3, Type: models.CSATQuestionNPS}
+
+	mock.ExpectQuery(createQuestion).WithArgs(question.Question, question.CSATID, question.Type, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "csat_id", "type", "position"}).
+			AddRow(9, question.Question, 3, models.CSATQuestionNPS, 4))
+
+	created, err := csatRepo.CreateQuestion(context.Background(), question)
+	require.NoError(t, err)
+	require.Equal(t, &models.CSATQuestion{ID: 9, Question: question.Question, CSATID: 3, Type: models.CSATQuestionNPS, Position: 4}, created)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryReorderQuestions(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+
+	mock.ExpectExec(reorderQuestions).WithArgs(uint64(3), pq.Array([]int64{2, 1})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	require.NoError(t, csatRepo.ReorderQuestions(context.Background(), 3, []uint64{2, 1}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryGetUserActivity(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	userID := uuid.New()
+	registeredAt := time.Now().AddDate(0, -2, 0)
+
+	mock.ExpectQuery(getUserActivity).WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at", "play_count", "count"}).AddRow(registeredAt, 120, 3))
+
+	activity, err := csatRepo.GetUserActivity(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, &models.CSATUserActivity{RegisteredAt: registeredAt, Plays: 120, Playlists: 3}, activity)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryGetUserPrompts(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	userID := uuid.New()
+	shownAt := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(getUserPrompts).WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"csat_id", "shown_at", "dismissed_at", "cooldown_days"}).
+			AddRow(2, shownAt, shownAt, 14).
+			AddRow(1, shownAt, nil, 30))
+
+	prompts, err := csatRepo.GetUserPrompts(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, []*models.CSATPrompt{
+		{SurveyID: 2, ShownAt: shownAt, DismissedAt: &shownAt, CooldownDays: 14},
+		{SurveyID: 1, ShownAt: shownAt, CooldownDays: 30},
+	}, prompts)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSATRepositoryDismissSurvey(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	csatRepo := NewCSATPGRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(dismissSurvey).WithArgs(userID, uint64(2)).WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, csatRepo.DismissSurvey(context.Background(), userID, 2))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,32 +1,169 @@
 package repository
 
 const (
-	getStatistics = `
-  SELECT 
-    csat.id AS topic_id,
-    csat.topic AS topic_name,
-    csat_question.id AS question_id,
-    csat_question.title AS question_title,
-    AVG(csat_answer.score) AS average_score
-  FROM 
-    csat
-  JOIN 
-    csat_question ON csat.id = csat_question.csat_id
-  JOIN 
-    csat_answer ON csat_question.id = csat_answer.csat_question_id
-  GROUP BY 
-    csat.id, csat.topic, csat_question.id, csat_question.title
-  `
+	// answerFilter bounds the answers by the time the score was given and,
+	// when it isn't zero, by the question
+	answerFilter = `
+	WHERE ($1::TIMESTAMPTZ IS NULL OR a.updated_at >= $1)
+		AND ($2::TIMESTAMPTZ IS NULL OR a.updated_at < $2)
+		AND ($3 = 0 OR q.id = $3)`
 
+	// getScoreCounts groups the answers by the cohort of the user, answers of
+	// deleted users are in the unknown one
+	getScoreCounts = `
+	SELECT c.topic, q.id, q.title, q.type,
+		CASE $4
+			WHEN 'role' THEN COALESCE(u.role, 'unknown')
+			WHEN 'registration_month' THEN COALESCE(TO_CHAR(u.created_at, 'YYYY-MM'), 'unknown')
+			ELSE ''
+		END AS segment,
+		a.score, COUNT(*)
+	FROM csat_answer a
+		JOIN csat_question q ON q.id = a.csat_question_id
+		JOIN csat c ON c.id = q.csat_id
+		LEFT JOIN "user" u ON u.id = a.user_id` + answerFilter + `
+	GROUP BY c.id, c.topic, q.id, q.title, q.type, q.position, segment, a.score
+	ORDER BY c.id, q.position, q.id, segment, a.score`
+
+	getScoreTrend = `
+	SELECT c.topic, q.id, q.title, q.type, DATE_TRUNC($4, a.updated_at) AS bucket, a.score, COUNT(*)
+	FROM csat_answer a
+		JOIN csat_question q ON q.id = a.csat_question_id
+		JOIN csat c ON c.id = q.csat_id` + answerFilter + `
+	GROUP BY c.id, c.topic, q.id, q.title, q.type, q.position, bucket, a.score
+	ORDER BY c.id, q.position, q.id, bucket, a.score`
+
+	// getQuestionsByTopic returns the questions of the survey only while it is active
 	getQuestionsByTopic = `
-	SELECT q.id, q.title
+	SELECT q.id, q.title, q.csat_id, q.type, q.position
 	FROM csat_question q
 		JOIN csat c ON q.csat_id = c.id
-	WHERE c.topic = $1`
+	WHERE c.topic = $1
+		AND (c.active_from IS NULL OR c.active_from <= NOW())
+		AND (c.active_until IS NULL OR c.active_until > NOW())
+	ORDER BY q.position, q.id`
 
-	insertAnswer = `
+	upsertAnswer = `
 	INSERT INTO csat_answer (score, user_id, csat_question_id)
 	VALUES ($1, $2, $3)
-	RETURNING score, user_id, csat_question_id
+	ON CONFLICT (user_id, csat_question_id)
+	DO UPDATE SET score = EXCLUDED.score, updated_at = NOW()
+	RETURNING id, score, user_id, csat_question_id
 	`
+
+	surveyColumns = `id, topic, active_from, active_until,
+	min_account_age_days, min_plays, min_playlists, sample_rate, cooldown_days, created_at, updated_at`
+
+	getSurveys = `
+	SELECT ` + surveyColumns + `
+	FROM csat
+	ORDER BY id`
+
+	findSurvey = `
+	SELECT ` + surveyColumns + `
+	FROM csat
+	WHERE id = $1`
+
+	createSurvey = `
+	INSERT INTO csat (topic, active_from, active_until,
+		min_account_age_days, min_plays, min_playlists, sample_rate, cooldown_days)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING ` + surveyColumns
+
+	updateSurvey = `
+	UPDATE csat
+	SET topic = $2, active_from = $3, active_until = $4,
+		min_account_age_days = $5, min_plays = $6, min_playlists = $7, sample_rate = $8, cooldown_days = $9,
+		updated_at = NOW()
+	WHERE id = $1
+	RETURNING ` + surveyColumns
+
+	deleteSurvey = `
+	DELETE FROM csat
+	WHERE id = $1`
+
+	questionColumns = `id, title, csat_id, type, position`
+
+	getSurveyQuestions = `
+	SELECT ` + questionColumns + `
+	FROM csat_question
+	WHERE csat_id = $1
+	ORDER BY position, id`
+
+	findQuestion = `
+	SELECT ` + questionColumns + `
+	FROM csat_question
+	WHERE id = $1`
+
+	// createQuestion appends the question to the survey when no position is given
+	createQuestion = `
+	INSERT INTO csat_question (title, csat_id, type, position)
+	VALUES ($1, $2, $3, CASE WHEN $4 > 0 THEN $4
+		ELSE (SELECT COALESCE(MAX(position), 0) + 1 FROM csat_question WHERE csat_id = $2) END)
+	RETURNING ` + questionColumns
+
+	updateQuestion = `
+	UPDATE csat_question
+	SET title = $2, type = $3, position = $4
+	WHERE id = $1
+	RETURNING ` + questionColumns
+
+	deleteQuestion = `
+	DELETE FROM csat_question
+	WHERE id = $1`
+
+	reorderQuestions = `
+	UPDATE csat_question
+	SET position = array_position($2::INT[], id)
+	WHERE csat_id = $1 AND id = ANY($2::INT[])`
+
+	hasAnswers = `
+	SELECT EXISTS (SELECT 1 FROM csat_answer WHERE csat_question_id = $1)`
+
+	getUserAnswers = `
+	SELECT c.topic, q.title, a.score
+	FROM csat_answer a
+		JOIN csat_question q ON q.id = a.csat_question_id
+		JOIN csat c ON c.id = q.csat_id
+	WHERE a.user_id = $1
+	ORDER BY a.id`
+
+	// getActiveSurveys returns the surveys that can be offered now
+	getActiveSurveys = `
+	SELECT ` + surveyColumns + `
+	FROM csat c
+	WHERE (c.active_from IS NULL OR c.active_from <= NOW())
+		AND (c.active_until IS NULL OR c.active_until > NOW())
+		AND EXISTS (SELECT 1 FROM csat_question q WHERE q.csat_id = c.id)
+	ORDER BY c.id`
+
+	getUserActivity = `
+	SELECT u.created_at,
+		COALESCE((SELECT pq.play_count FROM play_queue pq WHERE pq.user_id = u.id), 0),
+		(SELECT COUNT(*) FROM playlist p WHERE p.owner_id = u.id AND p.deleted_at IS NULL)
+	FROM "user" u
+	WHERE u.id = $1`
+
+	getUserPrompts = `
+	SELECT p.csat_id, p.shown_at, p.dismissed_at, c.cooldown_days
+	FROM csat_prompt p
+		JOIN csat c ON c.id = p.csat_id
+	WHERE p.user_id = $1
+	ORDER BY p.shown_at DESC`
+
+	getAnsweredSurveys = `
+	SELECT DISTINCT q.csat_id
+	FROM csat_answer a
+		JOIN csat_question q ON q.id = a.csat_question_id
+	WHERE a.user_id = $1`
+
+	savePrompt = `
+	INSERT INTO csat_prompt (user_id, csat_id)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id, csat_id) DO NOTHING`
+
+	dismissSurvey = `
+	INSERT INTO csat_prompt (user_id, csat_id, dismissed_at)
+	VALUES ($1, $2, NOW())
+	ON CONFLICT (user_id, csat_id) DO UPDATE SET dismissed_at = NOW()`
 )
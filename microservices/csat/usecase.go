@@ -5,10 +5,25 @@ import (
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+	"github.com/google/uuid"
 )
 
 type Usecase interface {
-	GetStatistics(ctx context.Context) ([]*dto.CSATStatisticsDTO, error)
+	GetStatistics(ctx context.Context, filter *models.CSATFilter) ([]*dto.CSATStatisticsDTO, error)
+	GetTrends(ctx context.Context, filter *models.CSATFilter, interval string) ([]*dto.CSATTrendDTO, error)
 	GetQuestionsByTopic(ctx context.Context, topic string) ([]*dto.CSATQuestionDTO, error)
 	SubmitAnswer(ctx context.Context, csatAnswer *models.CSATAnswer) (*dto.CSATAnswerDTO, error)
+
+	GetSurveys(ctx context.Context) ([]*dto.CSATSurveyDTO, error)
+	GetSurvey(ctx context.Context, surveyID uint64) (*dto.CSATSurveyDTO, error)
+	CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error)
+	UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error)
+	DeleteSurvey(ctx context.Context, surveyID uint64) error
+	CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error)
+	UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error)
+	DeleteQuestion(ctx context.Context, questionID uint64) error
+	ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error
+
+	GetEligibleSurvey(ctx context.Context, userID uuid.UUID) (*dto.CSATSurveyDTO, error)
+	DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error
 }
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+)
+
+const (
+	// satisfiedScore is the lowest csat score of a satisfied user.
+	satisfiedScore = 4
+	// promoterScore and detractorScore bound the passive nps scores.
+	promoterScore  = 9
+	detractorScore = 6
+)
+
+func (usecase *csatUsecase) GetStatistics(ctx context.Context, filter *models.CSATFilter) ([]*dto.CSATStatisticsDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+
+	counts, err := usecase.csatRepo.GetScoreCounts(ctx, filter)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load statistics: %v", err), requestID)
+		return nil, fmt.Errorf("Can't load statistics")
+	}
+	usecase.record(ctx, audit.ActionCSATStatisticsView, audit.TargetCSATAnswers, "", nil, filter)
+
+	var stats []*dto.CSATStatisticsDTO
+	for _, group := range groupByQuestion(counts) {
+		total := make(map[uint8]uint64)
+		var segments []string
+		bySegment := make(map[string]map[uint8]uint64)
+		for _, count := range group {
+			total[count.Score] += count.Count
+			if filter.Segment == "" {
+				continue
+			}
+			if _, ok := bySegment[count.Segment]; !ok {
+				segments = append(segments, count.Segment)
+				bySegment[count.Segment] = make(map[uint8]uint64)
+			}
+			bySegment[count.Segment][count.Score] += count.Count
+		}
+
+		question := group[0]
+		stat := &dto.CSATStatisticsDTO{
+			Topic:          question.Topic,
+			QuestionID:     question.QuestionID,
+			Question:       question.Question,
+			Type:           question.Type,
+			CSATSummaryDTO: summarize(question.Type, total),
+		}
+		for _, segment := range segments {
+			stat.Segments = append(stat.Segments, &dto.CSATSegmentDTO{
+				Segment:        segment,
+				CSATSummaryDTO: summarize(question.Type, bySegment[segment]),
+			})
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+func (usecase *csatUsecase) GetTrends(ctx context.Context, filter *models.CSATFilter, interval string) ([]*dto.CSATTrendDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := validateFilter(filter); err != nil {
+		return nil, err
+	}
+	switch interval {
+	case models.CSATIntervalDay, models.CSATIntervalWeek, models.CSATIntervalMonth:
+	default:
+		return nil, csat.ErrInvalidInterval
+	}
+
+	counts, err := usecase.csatRepo.GetScoreTrend(ctx, filter, interval)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load csat trends: %v", err), requestID)
+		return nil, fmt.Errorf("Can't load csat trends")
+	}
+	usecase.record(ctx, audit.ActionCSATTrendsView, audit.TargetCSATAnswers, "", nil, map[string]any{
+		"filter":   filter,
+		"interval": interval,
+	})
+
+	var trends []*dto.CSATTrendDTO
+	for _, group := range groupByQuestion(counts) {
+		question := group[0]
+		trend := &dto.CSATTrendDTO{
+			Topic:      question.Topic,
+			QuestionID: question.QuestionID,
+			Question:   question.Question,
+			Type:       question.Type,
+			Interval:   interval,
+		}
+
+		// the counts of a question are ordered by bucket
+		for start := 0; start < len(group); {
+			bucket := group[start].Bucket
+			scores := make(map[uint8]uint64)
+			end := start
+			for ; end < len(group) && group[end].Bucket.Equal(bucket); end++ {
+				scores[group[end].Score] += group[end].Count
+			}
+			trend.Points = append(trend.Points, &dto.CSATTrendPointDTO{
+				Bucket:         bucket,
+				CSATSummaryDTO: summarize(question.Type, scores),
+			})
+			start = end
+		}
+		trends = append(trends, trend)
+	}
+
+	return trends, nil
+}
+
+func validateFilter(filter *models.CSATFilter) error {
+	switch filter.Segment {
+	case "", models.CSATSegmentRole, models.CSATSegmentRegistrationMonth:
+	default:
+		return csat.ErrInvalidSegment
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return csat.ErrInvalidRange
+	}
+	return nil
+}
+
+// groupByQuestion splits the counts, which are ordered by question, into
+// the counts of each question.
+func groupByQuestion(counts []*models.CSATScoreCount) [][]*models.CSATScoreCount {
+	var groups [][]*models.CSATScoreCount
+	for start := 0; start < len(counts); {
+		end := start
+		for end < len(counts) && counts[end].QuestionID == counts[start].QuestionID {
+			end++
+		}
+		groups = append(groups, counts[start:end])
+		start = end
+	}
+	return groups
+}
+
+// summarize describes the scores given to a question of the type. The
+// distribution lists every score of the range, even the ones never given.
+func summarize(questionType string, scores map[uint8]uint64) dto.CSATSummaryDTO {
+	keys := make(map[uint8]bool, len(scores))
+	for score := range scores {
+		keys[score] = true
+	}
+	if minScore, maxScore, ok := models.CSATScoreRange(questionType); ok {
+		for score := int(minScore); score <= int(maxScore); score++ {
+			keys[uint8(score)] = true
+		}
+	}
+
+	ordered := make([]uint8, 0, len(keys))
+	for score := range keys {
+		ordered = append(ordered, score)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i] < ordered[j] })
+
+	var summary dto.CSATSummaryDTO
+	var sum, satisfied, promoters, detractors uint64
+	for _, score := range ordered {
+		count := scores[score]
+		summary.Distribution = append(summary.Distribution, &dto.CSATScoreCountDTO{Score: score, Count: count})
+		summary.Responses += count
+		sum += uint64(score) * count
+		if score >= satisfiedScore {
+			satisfied += count
+		}
+		if score >= promoterScore {
+			promoters += count
+		}
+		if score <= detractorScore {
+			detractors += count
+		}
+	}
+
+	if summary.Responses == 0 {
+		return summary
+	}
+	summary.AverageScore = round(float64(sum) / float64(summary.Responses))
+
+	switch questionType {
+	case models.CSATQuestionCSAT:
+		csatScore := percentage(satisfied, summary.Responses)
+		summary.CSAT = &csatScore
+	case models.CSATQuestionNPS:
+		nps := round((float64(promoters) - float64(detractors)) * 100 / float64(summary.Responses))
+		summary.NPS = &nps
+	}
+	return summary
+}
+
+func percentage(part, total uint64) float64 {
+	return round(float64(part) * 100 / float64(total))
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSATUsecaseGetStatistics_Segments(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	filter := &models.CSATFilter{Segment: models.CSATSegmentRole}
+
+	question := func(segment string, score uint8, count uint64) *models.CSATScoreCount {
+		return &models.CSATScoreCount{Topic: "App", QuestionID: 1, Question: "Are you satisfied?", Type: models.CSATQuestionCSAT, Segment: segment, Score: score, Count: count}
+	}
+	mockRepo.EXPECT().GetScoreCounts(ctx, filter).Return([]*models.CSATScoreCount{
+		question("admin", 5, 1),
+		question("regular", 2, 1),
+		question("regular", 5, 2),
+		{Topic: "App", QuestionID: 2, Question: "Would you recommend us?", Type: models.CSATQuestionNPS, Segment: "regular", Score: 9, Count: 2},
+	}, nil)
+
+	stats, err := csatUsecase.GetStatistics(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	require.Equal(t, uint64(4), stats[0].Responses)
+	require.Equal(t, 4.25, stats[0].AverageScore)
+	require.Equal(t, 75.0, *stats[0].CSAT)
+	require.Nil(t, stats[0].NPS)
+	require.Len(t, stats[0].Distribution, 5)
+	require.Equal(t, &dto.CSATScoreCountDTO{Score: 5, Count: 3}, stats[0].Distribution[4])
+	require.Len(t, stats[0].Segments, 2)
+	require.Equal(t, "regular", stats[0].Segments[1].Segment)
+	require.Equal(t, uint64(3), stats[0].Segments[1].Responses)
+
+	require.Equal(t, 100.0, *stats[1].NPS)
+	require.Len(t, stats[1].Distribution, 11)
+}
+
+func TestCSATUsecaseGetStatistics_Invalid(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	_, err := csatUsecase.GetStatistics(ctx, &models.CSATFilter{Segment: "country"})
+	require.ErrorIs(t, err, csat.ErrInvalidSegment)
+
+	_, err = csatUsecase.GetStatistics(ctx, &models.CSATFilter{From: &from, To: &to})
+	require.ErrorIs(t, err, csat.ErrInvalidRange)
+
+	mockRepo.EXPECT().GetScoreCounts(ctx, &models.CSATFilter{}).Return(nil, errors.New("database error"))
+	stats, err := csatUsecase.GetStatistics(ctx, &models.CSATFilter{})
+	require.Error(t, err)
+	require.Nil(t, stats)
+}
+
+func TestCSATUsecaseGetTrends(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	filter := &models.CSATFilter{}
+	first := time.Date(2024, 12, 2, 0, 0, 0, 0, time.UTC)
+	second := first.AddDate(0, 0, 7)
+
+	nps := func(bucket time.Time, score uint8, count uint64) *models.CSATScoreCount {
+		return &models.CSATScoreCount{Topic: "App", QuestionID: 2, Question: "Would you recommend us?", Type: models.CSATQuestionNPS, Bucket: bucket, Score: score, Count: count}
+	}
+	mockRepo.EXPECT().GetScoreTrend(ctx, filter, models.CSATIntervalWeek).Return([]*models.CSATScoreCount{
+		nps(first, 3, 1),
+		nps(first, 8, 1),
+		nps(first, 10, 1),
+		nps(second, 10, 4),
+	}, nil)
+
+	trends, err := csatUsecase.GetTrends(ctx, filter, models.CSATIntervalWeek)
+	require.NoError(t, err)
+	require.Len(t, trends, 1)
+	require.Len(t, trends[0].Points, 2)
+	require.Equal(t, first, trends[0].Points[0].Bucket)
+	require.Equal(t, 0.0, *trends[0].Points[0].NPS)
+	require.Equal(t, uint64(4), trends[0].Points[1].Responses)
+	require.Equal(t, 100.0, *trends[0].Points[1].NPS)
+
+	_, err = csatUsecase.GetTrends(ctx, filter, "year")
+	require.ErrorIs(t, err, csat.ErrInvalidInterval)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+	"github.com/google/uuid"
+)
+
+const day = 24 * time.Hour
+
+// GetEligibleSurvey returns the survey the user should be asked now, or nil.
+// A survey offered before and neither answered nor dismissed is offered
+// again, otherwise a new one is offered once the cooldown of the last one
+// is over. A survey is never offered twice after it was answered or
+// dismissed.
+func (usecase *csatUsecase) GetEligibleSurvey(ctx context.Context, userID uuid.UUID) (*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	now := time.Now()
+
+	surveys, err := usecase.csatRepo.GetActiveSurveys(ctx)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve active csat surveys: %v", err), requestID)
+		return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+	}
+	if len(surveys) == 0 {
+		return nil, nil
+	}
+
+	prompts, err := usecase.csatRepo.GetUserPrompts(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve csat prompts of user '%s': %v", userID, err), requestID)
+		return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+	}
+	answeredIDs, err := usecase.csatRepo.GetAnsweredSurveys(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve csat surveys answered by user '%s': %v", userID, err), requestID)
+		return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+	}
+
+	answered := make(map[uint64]bool, len(answeredIDs))
+	for _, surveyID := range answeredIDs {
+		answered[surveyID] = true
+	}
+	prompted := make(map[uint64]*models.CSATPrompt, len(prompts))
+	for _, prompt := range prompts {
+		prompted[prompt.SurveyID] = prompt
+	}
+
+	for _, survey := range surveys {
+		if prompt, ok := prompted[survey.ID]; ok && prompt.DismissedAt == nil && !answered[survey.ID] {
+			return usecase.eligibleSurveyDTO(ctx, survey)
+		}
+	}
+	if coolingDown(prompts, now) {
+		return nil, nil
+	}
+
+	var activity *models.CSATUserActivity
+	for _, survey := range surveys {
+		if _, ok := prompted[survey.ID]; ok || answered[survey.ID] {
+			continue
+		}
+		if !inSample(userID, survey.ID, survey.Targeting.SampleRate) {
+			continue
+		}
+
+		if activity == nil {
+			if activity, err = usecase.csatRepo.GetUserActivity(ctx, userID); err != nil {
+				usecase.logger.Warn(fmt.Sprintf("cannot retrieve activity of user '%s': %v", userID, err), requestID)
+				return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+			}
+		}
+		if !qualifies(survey.Targeting, activity, now) {
+			continue
+		}
+
+		if err := usecase.csatRepo.SavePrompt(ctx, userID, survey.ID); err != nil {
+			usecase.logger.Warn(fmt.Sprintf("cannot save csat prompt of user '%s': %v", userID, err), requestID)
+			return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+		}
+		usecase.logger.Info(fmt.Sprintf("offered csat survey '%d' to user '%s'", survey.ID, userID), requestID)
+		return usecase.eligibleSurveyDTO(ctx, survey)
+	}
+
+	return nil, nil
+}
+
+func (usecase *csatUsecase) DismissSurvey(ctx context.Context, userID uuid.UUID, surveyID uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if _, err := usecase.findSurvey(ctx, surveyID); err != nil {
+		return err
+	}
+
+	if err := usecase.csatRepo.DismissSurvey(ctx, userID, surveyID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot dismiss csat survey '%d' for user '%s': %v", surveyID, userID, err), requestID)
+		return fmt.Errorf("cannot dismiss csat survey")
+	}
+
+	return nil
+}
+
+// eligibleSurveyDTO returns the survey with its questions, without the
+// targeting that only concerns the admins.
+func (usecase *csatUsecase) eligibleSurveyDTO(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	questions, err := usecase.csatRepo.GetSurveyQuestions(ctx, survey.ID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve questions of csat survey '%d': %v", survey.ID, err), requestID)
+		return nil, fmt.Errorf("cannot retrieve eligible csat survey")
+	}
+
+	surveyDTO := dto.NewCSATSurveyDTO(survey, questions)
+	surveyDTO.Targeting = nil
+	return surveyDTO, nil
+}
+
+// coolingDown reports whether a survey was offered to the user within its
+// cooldown.
+func coolingDown(prompts []*models.CSATPrompt, now time.Time) bool {
+	for _, prompt := range prompts {
+		if now.Before(prompt.ShownAt.Add(time.Duration(prompt.CooldownDays) * day)) {
+			return true
+		}
+	}
+	return false
+}
+
+func qualifies(targeting models.CSATTargeting, activity *models.CSATUserActivity, now time.Time) bool {
+	registeredFor := now.Sub(activity.RegisteredAt)
+	return registeredFor >= time.Duration(targeting.MinAccountAgeDays)*day &&
+		activity.Plays >= uint64(targeting.MinPlays) &&
+		activity.Playlists >= uint64(targeting.MinPlaylists)
+}
+
+// inSample reports whether the user falls into the sampled percentage of
+// the users. A user stays in or out of the sample of a survey for good, so
+// asking again doesn't reroll the chance.
+func inSample(userID uuid.UUID, surveyID uint64, sampleRate int) bool {
+	if sampleRate >= 100 {
+		return true
+	}
+
+	hash := fnv.New32a()
+	hash.Write(userID[:])
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], surveyID)
+	hash.Write(id[:])
+	return int(hash.Sum32()%100) < sampleRate
+}
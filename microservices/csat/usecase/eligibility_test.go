@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func everyone(minPlays int) models.CSATTargeting {
+	return models.CSATTargeting{MinPlays: minPlays, SampleRate: 100, CooldownDays: 30}
+}
+
+func TestCSATUsecaseGetEligibleSurvey_Offers(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	surveys := []*models.CSATSurvey{
+		{ID: 1, Topic: "Answered", Targeting: everyone(0)},
+		{ID: 2, Topic: "Heavy listeners", Targeting: everyone(100)},
+		{ID: 3, Topic: "Playlists", Targeting: everyone(10)},
+	}
+	questions := []*models.CSATQuestion{{ID: 7, Question: "How easy is it?", CSATID: 3, Type: models.CSATQuestionCSAT}}
+
+	mockRepo.EXPECT().GetActiveSurveys(ctx).Return(surveys, nil)
+	mockRepo.EXPECT().GetUserPrompts(ctx, userID).Return(nil, nil)
+	mockRepo.EXPECT().GetAnsweredSurveys(ctx, userID).Return([]uint64{1}, nil)
+	mockRepo.EXPECT().GetUserActivity(ctx, userID).Return(&models.CSATUserActivity{RegisteredAt: time.Now().AddDate(0, -1, 0), Plays: 50}, nil)
+	mockRepo.EXPECT().SavePrompt(ctx, userID, uint64(3)).Return(nil)
+	mockRepo.EXPECT().GetSurveyQuestions(ctx, uint64(3)).Return(questions, nil)
+
+	survey, err := csatUsecase.GetEligibleSurvey(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), survey.ID)
+	require.Nil(t, survey.Targeting)
+	require.Len(t, survey.Questions, 1)
+}
+
+func TestCSATUsecaseGetEligibleSurvey_Pending(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().GetActiveSurveys(ctx).Return([]*models.CSATSurvey{{ID: 4, Targeting: everyone(0)}}, nil)
+	mockRepo.EXPECT().GetUserPrompts(ctx, userID).Return([]*models.CSATPrompt{{SurveyID: 4, ShownAt: time.Now(), CooldownDays: 30}}, nil)
+	mockRepo.EXPECT().GetAnsweredSurveys(ctx, userID).Return(nil, nil)
+	mockRepo.EXPECT().GetSurveyQuestions(ctx, uint64(4)).Return(nil, nil)
+
+	survey, err := csatUsecase.GetEligibleSurvey(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), survey.ID)
+}
+
+func TestCSATUsecaseGetEligibleSurvey_CoolingDown(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	userID := uuid.New()
+	dismissedAt := time.Now().AddDate(0, 0, -3)
+
+	mockRepo.EXPECT().GetActiveSurveys(ctx).Return([]*models.CSATSurvey{{ID: 4, Targeting: everyone(0)}, {ID: 5, Targeting: everyone(0)}}, nil)
+	mockRepo.EXPECT().GetUserPrompts(ctx, userID).Return([]*models.CSATPrompt{
+		{SurveyID: 4, ShownAt: dismissedAt, DismissedAt: &dismissedAt, CooldownDays: 7},
+	}, nil)
+	mockRepo.EXPECT().GetAnsweredSurveys(ctx, userID).Return(nil, nil)
+
+	survey, err := csatUsecase.GetEligibleSurvey(ctx, userID)
+	require.NoError(t, err)
+	require.Nil(t, survey)
+}
+
+func TestCSATUsecaseDismissSurvey(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(4)).Return(&models.CSATSurvey{ID: 4}, nil)
+	mockRepo.EXPECT().DismissSurvey(ctx, userID, uint64(4)).Return(nil)
+	require.NoError(t, csatUsecase.DismissSurvey(ctx, userID, 4))
+
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(5)).Return(nil, sql.ErrNoRows)
+	require.ErrorIs(t, csatUsecase.DismissSurvey(ctx, userID, 5), csat.ErrSurveyNotFound)
+}
+
+func TestInSample(t *testing.T) {
+	t.Parallel()
+
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		userID := uuid.New()
+		require.False(t, inSample(userID, 1, 0))
+		require.True(t, inSample(userID, 1, 100))
+		require.Equal(t, inSample(userID, 1, 30), inSample(userID, 1, 30))
+		if inSample(userID, 1, 30) {
+			sampled++
+		}
+	}
+	require.InDelta(t, 300, sampled, 80)
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/dto"
+)
+
+func (usecase *csatUsecase) GetSurveys(ctx context.Context) ([]*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	surveys, err := usecase.csatRepo.GetSurveys(ctx)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve csat surveys: %v", err), requestID)
+		return nil, fmt.Errorf("cannot retrieve csat surveys")
+	}
+
+	var surveysDTO []*dto.CSATSurveyDTO
+	for _, survey := range surveys {
+		surveysDTO = append(surveysDTO, dto.NewCSATSurveyDTO(survey, nil))
+	}
+
+	return surveysDTO, nil
+}
+
+func (usecase *csatUsecase) GetSurvey(ctx context.Context, surveyID uint64) (*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	survey, err := usecase.findSurvey(ctx, surveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := usecase.csatRepo.GetSurveyQuestions(ctx, surveyID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve questions of csat survey '%d': %v", surveyID, err), requestID)
+		return nil, fmt.Errorf("cannot retrieve csat survey")
+	}
+
+	return dto.NewCSATSurveyDTO(survey, questions), nil
+}
+
+func (usecase *csatUsecase) CreateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := validateSurvey(survey); err != nil {
+		return nil, err
+	}
+
+	created, err := usecase.csatRepo.CreateSurvey(ctx, survey)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot create csat survey '%s': %v", survey.Topic, err), requestID)
+		if errors.Is(err, csat.ErrTopicTaken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("cannot create csat survey")
+	}
+	usecase.logger.Info(fmt.Sprintf("created csat survey '%s'", created.Topic), requestID)
+	usecase.record(ctx, audit.ActionSurveyCreate, audit.TargetCSATSurvey, fmt.Sprint(created.ID), nil, created)
+
+	return dto.NewCSATSurveyDTO(created, nil), nil
+}
+
+func (usecase *csatUsecase) UpdateSurvey(ctx context.Context, survey *models.CSATSurvey) (*dto.CSATSurveyDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := validateSurvey(survey); err != nil {
+		return nil, err
+	}
+
+	current, err := usecase.findSurvey(ctx, survey.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := usecase.csatRepo.UpdateSurvey(ctx, survey)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot update csat survey '%d': %v", survey.ID, err), requestID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, csat.ErrSurveyNotFound
+		case errors.Is(err, csat.ErrTopicTaken):
+			return nil, err
+		}
+		return nil, fmt.Errorf("cannot update csat survey")
+	}
+	usecase.record(ctx, audit.ActionSurveyUpdate, audit.TargetCSATSurvey, fmt.Sprint(updated.ID), current, updated)
+
+	return usecase.GetSurvey(ctx, updated.ID)
+}
+
+func (usecase *csatUsecase) DeleteSurvey(ctx context.Context, surveyID uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := usecase.csatRepo.DeleteSurvey(ctx, surveyID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot delete csat survey '%d': %v", surveyID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return csat.ErrSurveyNotFound
+		}
+		return fmt.Errorf("cannot delete csat survey")
+	}
+	usecase.logger.Info(fmt.Sprintf("deleted csat survey '%d'", surveyID), requestID)
+	usecase.record(ctx, audit.ActionSurveyDelete, audit.TargetCSATSurvey, fmt.Sprint(surveyID), nil, nil)
+
+	return nil
+}
+
+func (usecase *csatUsecase) CreateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if question.Type == "" {
+		question.Type = models.CSATQuestionRating
+	}
+	if _, _, ok := models.CSATScoreRange(question.Type); !ok {
+		return nil, csat.ErrInvalidQuestionType
+	}
+
+	if _, err := usecase.findSurvey(ctx, question.CSATID); err != nil {
+		return nil, err
+	}
+
+	created, err := usecase.csatRepo.CreateQuestion(ctx, question)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot create question of csat survey '%d': %v", question.CSATID, err), requestID)
+		return nil, fmt.Errorf("cannot create csat question")
+	}
+	usecase.record(ctx, audit.ActionQuestionCreate, audit.TargetCSATQuestion, fmt.Sprint(created.ID), nil, created)
+
+	return dto.NewCSATQuestionDTO(created), nil
+}
+
+func (usecase *csatUsecase) UpdateQuestion(ctx context.Context, question *models.CSATQuestion) (*dto.CSATQuestionDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	current, err := usecase.csatRepo.FindQuestion(ctx, question.ID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot find csat question '%d': %v", question.ID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, csat.ErrQuestionNotFound
+		}
+		return nil, fmt.Errorf("cannot update csat question")
+	}
+
+	if question.Type == "" {
+		question.Type = current.Type
+	}
+	if question.Position == 0 {
+		question.Position = current.Position
+	}
+	if _, _, ok := models.CSATScoreRange(question.Type); !ok {
+		return nil, csat.ErrInvalidQuestionType
+	}
+
+	// scores given on another scale would skew the statistics
+	if question.Type != current.Type {
+		answered, err := usecase.csatRepo.HasAnswers(ctx, question.ID)
+		if err != nil {
+			usecase.logger.Warn(fmt.Sprintf("cannot check answers of csat question '%d': %v", question.ID, err), requestID)
+			return nil, fmt.Errorf("cannot update csat question")
+		}
+		if answered {
+			return nil, csat.ErrQuestionAnswered
+		}
+	}
+
+	updated, err := usecase.csatRepo.UpdateQuestion(ctx, question)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot update csat question '%d': %v", question.ID, err), requestID)
+		return nil, fmt.Errorf("cannot update csat question")
+	}
+	usecase.record(ctx, audit.ActionQuestionUpdate, audit.TargetCSATQuestion, fmt.Sprint(updated.ID), current, updated)
+
+	return dto.NewCSATQuestionDTO(updated), nil
+}
+
+func (usecase *csatUsecase) DeleteQuestion(ctx context.Context, questionID uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := usecase.csatRepo.DeleteQuestion(ctx, questionID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot delete csat question '%d': %v", questionID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return csat.ErrQuestionNotFound
+		}
+		return fmt.Errorf("cannot delete csat question")
+	}
+	usecase.record(ctx, audit.ActionQuestionDelete, audit.TargetCSATQuestion, fmt.Sprint(questionID), nil, nil)
+
+	return nil
+}
+
+func (usecase *csatUsecase) ReorderQuestions(ctx context.Context, surveyID uint64, questionIDs []uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if _, err := usecase.findSurvey(ctx, surveyID); err != nil {
+		return err
+	}
+
+	questions, err := usecase.csatRepo.GetSurveyQuestions(ctx, surveyID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot retrieve questions of csat survey '%d': %v", surveyID, err), requestID)
+		return fmt.Errorf("cannot reorder csat questions")
+	}
+	if !sameQuestions(questions, questionIDs) {
+		return csat.ErrInvalidOrder
+	}
+
+	if err := usecase.csatRepo.ReorderQuestions(ctx, surveyID, questionIDs); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot reorder questions of csat survey '%d': %v", surveyID, err), requestID)
+		return fmt.Errorf("cannot reorder csat questions")
+	}
+	previousIDs := make([]uint64, 0, len(questions))
+	for _, question := range questions {
+		previousIDs = append(previousIDs, question.ID)
+	}
+	usecase.record(
+		ctx, audit.ActionQuestionsReorder, audit.TargetCSATSurvey, fmt.Sprint(surveyID),
+		map[string]any{"questionIDs": previousIDs}, map[string]any{"questionIDs": questionIDs},
+	)
+
+	return nil
+}
+
+func (usecase *csatUsecase) findSurvey(ctx context.Context, surveyID uint64) (*models.CSATSurvey, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	survey, err := usecase.csatRepo.FindSurvey(ctx, surveyID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot find csat survey '%d': %v", surveyID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, csat.ErrSurveyNotFound
+		}
+		return nil, fmt.Errorf("cannot retrieve csat survey")
+	}
+
+	return survey, nil
+}
+
+func validateSurvey(survey *models.CSATSurvey) error {
+	if survey.ActiveFrom != nil && survey.ActiveUntil != nil && !survey.ActiveFrom.Before(*survey.ActiveUntil) {
+		return csat.ErrInvalidWindow
+	}
+
+	targeting := survey.Targeting
+	if targeting.MinAccountAgeDays < 0 || targeting.MinPlays < 0 || targeting.MinPlaylists < 0 ||
+		targeting.CooldownDays < 0 || targeting.SampleRate < 0 || targeting.SampleRate > 100 {
+		return csat.ErrInvalidTargeting
+	}
+	return nil
+}
+
+// sameQuestions reports whether questionIDs lists every question once.
+func sameQuestions(questions []*models.CSATQuestion, questionIDs []uint64) bool {
+	if len(questions) != len(questionIDs) {
+		return false
+	}
+
+	listed := make(map[uint64]bool, len(questionIDs))
+	for _, questionID := range questionIDs {
+		if listed[questionID] {
+			return false
+		}
+		listed[questionID] = true
+	}
+	for _, question := range questions {
+		if !listed[question.ID] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	auditMock "github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newSurveyUsecase(t *testing.T) (*mock.MockRepo, csat.Usecase) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.LoggerConfig{Level: "info", Format: "json"}
+	mockRepo := mock.NewMockRepo(ctrl)
+	return mockRepo, NewCSATUsecase(mockRepo, newRecorderMock(ctrl), logger.New(cfg))
+}
+
+func TestCSATUsecaseSubmitAnswer_Rejected(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	ended := time.Now().Add(-time.Hour)
+
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(1)).Return(nil, sql.ErrNoRows)
+	_, err := csatUsecase.SubmitAnswer(ctx, &models.CSATAnswer{Score: 5, UserID: uuid.New(), CSATQuestionID: 1})
+	require.ErrorIs(t, err, csat.ErrQuestionNotFound)
+
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(2)).Return(&models.CSATQuestion{ID: 2, CSATID: 1, Type: models.CSATQuestionCSAT}, nil)
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(&models.CSATSurvey{ID: 1, ActiveUntil: &ended}, nil)
+	_, err = csatUsecase.SubmitAnswer(ctx, &models.CSATAnswer{Score: 5, UserID: uuid.New(), CSATQuestionID: 2})
+	require.ErrorIs(t, err, csat.ErrSurveyInactive)
+
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(3)).Return(&models.CSATQuestion{ID: 3, CSATID: 2, Type: models.CSATQuestionCSAT}, nil)
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(2)).Return(&models.CSATSurvey{ID: 2}, nil)
+	_, err = csatUsecase.SubmitAnswer(ctx, &models.CSATAnswer{Score: 7, UserID: uuid.New(), CSATQuestionID: 3})
+	require.ErrorIs(t, err, csat.ErrInvalidScore)
+}
+
+func TestCSATUsecaseCreateSurvey_InvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	_, csatUsecase := newSurveyUsecase(t)
+	from := time.Now()
+	until := from.Add(-time.Hour)
+
+	_, err := csatUsecase.CreateSurvey(context.Background(), &models.CSATSurvey{Topic: "Search", ActiveFrom: &from, ActiveUntil: &until})
+	require.ErrorIs(t, err, csat.ErrInvalidWindow)
+}
+
+func TestCSATUsecaseCreateQuestion(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+
+	_, err := csatUsecase.CreateQuestion(ctx, &models.CSATQuestion{Question: "?", CSATID: 1, Type: "stars"})
+	require.ErrorIs(t, err, csat.ErrInvalidQuestionType)
+
+	question := &models.CSATQuestion{Question: "How likely are you to recommend us?", CSATID: 1, Type: models.CSATQuestionNPS}
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(&models.CSATSurvey{ID: 1}, nil)
+	mockRepo.EXPECT().CreateQuestion(ctx, question).Return(&models.CSATQuestion{ID: 5, Question: question.Question, CSATID: 1, Type: models.CSATQuestionNPS, Position: 3}, nil)
+
+	questionDTO, err := csatUsecase.CreateQuestion(ctx, question)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), questionDTO.MinScore)
+	require.Equal(t, uint8(10), questionDTO.MaxScore)
+	require.Equal(t, 3, questionDTO.Position)
+}
+
+func TestCSATUsecaseUpdateQuestion_AnsweredType(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(5)).Return(&models.CSATQuestion{ID: 5, CSATID: 1, Type: models.CSATQuestionCSAT, Position: 1}, nil)
+	mockRepo.EXPECT().HasAnswers(ctx, uint64(5)).Return(true, nil)
+
+	_, err := csatUsecase.UpdateQuestion(ctx, &models.CSATQuestion{ID: 5, Question: "Rate us", Type: models.CSATQuestionNPS})
+	require.ErrorIs(t, err, csat.ErrQuestionAnswered)
+}
+
+func TestCSATUsecaseReorderQuestions(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+	questions := []*models.CSATQuestion{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(&models.CSATSurvey{ID: 1}, nil).Times(3)
+	mockRepo.EXPECT().GetSurveyQuestions(ctx, uint64(1)).Return(questions, nil).Times(3)
+
+	require.ErrorIs(t, csatUsecase.ReorderQuestions(ctx, 1, []uint64{3, 1}), csat.ErrInvalidOrder)
+	require.ErrorIs(t, csatUsecase.ReorderQuestions(ctx, 1, []uint64{3, 1, 1}), csat.ErrInvalidOrder)
+
+	mockRepo.EXPECT().ReorderQuestions(ctx, uint64(1), []uint64{3, 1, 2}).Return(nil)
+	require.NoError(t, csatUsecase.ReorderQuestions(ctx, 1, []uint64{3, 1, 2}))
+}
+
+func TestCSATUsecaseDeleteSurvey_NotFound(t *testing.T) {
+	t.Parallel()
+
+	mockRepo, csatUsecase := newSurveyUsecase(t)
+	ctx := context.Background()
+
+	mockRepo.EXPECT().DeleteSurvey(ctx, uint64(8)).Return(sql.ErrNoRows)
+	require.ErrorIs(t, csatUsecase.DeleteSurvey(ctx, 8), csat.ErrSurveyNotFound)
+}
+
+func TestCSATUsecaseUpdateSurvey_Audited(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockRepo(ctrl)
+	recorderMock := auditMock.NewMockRecorder(ctrl)
+	csatUsecase := NewCSATUsecase(mockRepo, recorderMock, logger.New(&config.LoggerConfig{Level: "info", Format: "json"}))
+	ctx := context.Background()
+
+	current := &models.CSATSurvey{ID: 1, Topic: "Search", Targeting: models.CSATTargeting{SampleRate: 100}}
+	survey := &models.CSATSurvey{ID: 1, Topic: "Search", Targeting: models.CSATTargeting{SampleRate: 10}}
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(current, nil).Times(2)
+	mockRepo.EXPECT().UpdateSurvey(ctx, survey).Return(survey, nil)
+	mockRepo.EXPECT().GetSurveyQuestions(ctx, uint64(1)).Return(nil, nil)
+	recorderMock.EXPECT().Record(ctx, gomock.Any()).Do(func(_ context.Context, event *models.AuditEvent) {
+		require.Equal(t, audit.ActionSurveyUpdate, event.Action)
+		require.Equal(t, "1", event.TargetID)
+		require.JSONEq(t, `{"Targeting":{"MinAccountAgeDays":0,"MinPlays":0,"MinPlaylists":0,"SampleRate":100,"CooldownDays":0}}`, string(event.Before))
+		require.JSONEq(t, `{"Targeting":{"MinAccountAgeDays":0,"MinPlays":0,"MinPlaylists":0,"SampleRate":10,"CooldownDays":0}}`, string(event.After))
+	})
+
+	_, err := csatUsecase.UpdateSurvey(ctx, survey)
+	require.NoError(t, err)
+}
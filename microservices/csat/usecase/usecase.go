@@ -2,8 +2,12 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
@@ -13,36 +17,12 @@ import (
 
 type csatUsecase struct {
 	csatRepo csat.Repo
+	recorder audit.Recorder
 	logger   logger.Logger
 }
 
-func NewCSATUsecase(csatRepo csat.Repo, logger logger.Logger) csat.Usecase {
-	return &csatUsecase{csatRepo, logger}
-}
-
-func (usecase *csatUsecase) GetStatistics(ctx context.Context) ([]*dto.CSATStatisticsDTO, error) {
-	requestID := ctx.Value(utils.RequestIDKey{})
-	stats, err := usecase.csatRepo.GetStatistics(ctx)
-	if err != nil {
-		usecase.logger.Warn(fmt.Sprintf("Can't load statistics: %v", err), requestID)
-		return nil, fmt.Errorf("Can't load statistics")
-	}
-
-	var dtoStats []*dto.CSATStatisticsDTO
-	for _, stat := range stats {
-		dtoStat, err := usecase.convertStatisticsToDTO(stat)
-		if err != nil {
-			usecase.logger.Error(fmt.Sprintf("Can't create DTO for statistics: %v", err), requestID)
-			return nil, fmt.Errorf("Can't create DTO for statistics")
-		}
-		dtoStats = append(dtoStats, dtoStat)
-	}
-
-	return dtoStats, nil
-}
-
-func (usecase *csatUsecase) convertStatisticsToDTO(stat *models.CSATStat) (*dto.CSATStatisticsDTO, error) {
-	return dto.NewCSATStatisticsDTO(stat), nil
+func NewCSATUsecase(csatRepo csat.Repo, recorder audit.Recorder, logger logger.Logger) csat.Usecase {
+	return &csatUsecase{csatRepo, recorder, logger}
 }
 
 func (usecase *csatUsecase) GetQuestionsByTopic(ctx context.Context, topic string) ([]*dto.CSATQuestionDTO, error) {
@@ -67,12 +47,48 @@ func (usecase *csatUsecase) GetQuestionsByTopic(ctx context.Context, topic strin
 func (usecase *csatUsecase) SubmitAnswer(ctx context.Context, csatAnswer *models.CSATAnswer) (*dto.CSATAnswerDTO, error) {
 	requestID := ctx.Value(utils.RequestIDKey{})
 
-	answer, err := usecase.csatRepo.InsertAnswer(ctx, csatAnswer)
+	question, err := usecase.csatRepo.FindQuestion(ctx, csatAnswer.CSATQuestionID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot find csat question '%d': %v", csatAnswer.CSATQuestionID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, csat.ErrQuestionNotFound
+		}
+		return nil, fmt.Errorf("cannot save csat answer")
+	}
+
+	survey, err := usecase.csatRepo.FindSurvey(ctx, question.CSATID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("cannot find csat survey '%d': %v", question.CSATID, err), requestID)
+		return nil, fmt.Errorf("cannot save csat answer")
+	}
+	if !survey.IsActive(time.Now()) {
+		return nil, csat.ErrSurveyInactive
+	}
+
+	minScore, maxScore, _ := models.CSATScoreRange(question.Type)
+	if csatAnswer.Score < minScore || csatAnswer.Score > maxScore {
+		return nil, csat.ErrInvalidScore
+	}
+
+	// a repeated answer replaces the previous one of the user
+	answer, err := usecase.csatRepo.UpsertAnswer(ctx, csatAnswer)
 	if err != nil {
-		usecase.logger.Warn(fmt.Sprintf("user have already answered on this question: %v", err), requestID)
-		return nil, fmt.Errorf("user have already answered on this question")
+		usecase.logger.Warn(fmt.Sprintf("cannot save csat answer: %v", err), requestID)
+		return nil, fmt.Errorf("cannot save csat answer")
 	}
 
 	answerDTO := dto.NewCSATAnswerDTO(answer)
 	return answerDTO, nil
 }
+
+// record appends an audit event about an administrative action of the
+// user in ctx.
+func (usecase *csatUsecase) record(ctx context.Context, action, targetType, targetID string, before, after any) {
+	event := &models.AuditEvent{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+	}
+	event.Before, event.After = audit.Diff(before, after)
+	usecase.recorder.Record(ctx, event)
+}
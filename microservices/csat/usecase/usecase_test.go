@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	auditMock "github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit/mock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/mock"
@@ -15,64 +16,10 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestCSATUsecaseGetStatistics_Success(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	cfg := &config.Config{
-		Service: config.ServiceConfig{
-			Logger: config.LoggerConfig{
-				Level:  "info",
-				Format: "json",
-			},
-		},
-	}
-
-	ctx := context.WithValue(context.Background(), utils.RequestIDKey{}, "test-request-id")
-	mockRepo := mock.NewMockRepo(ctrl)
-	mockLogger := logger.New(&cfg.Service.Logger)
-
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
-
-	mockStats := []*models.CSATStat{
-		{ID: 1, Topic: "UX", QuestionID: 101, Question: "How is the design?", AverageScore: 4.5},
-		{ID: 2, Topic: "Performance", QuestionID: 102, Question: "Is the app fast?", AverageScore: 4.0},
-	}
-	mockRepo.EXPECT().GetStatistics(ctx).Return(mockStats, nil)
-
-	statsDTO, err := csatUsecase.GetStatistics(ctx)
-
-	require.NoError(t, err)
-	require.Len(t, statsDTO, 2)
-	require.Equal(t, "UX", statsDTO[0].Topic)
-	require.Equal(t, "Performance", statsDTO[1].Topic)
-}
-
-func TestCSATUsecaseGetStatistics_DBError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	cfg := &config.Config{
-		Service: config.ServiceConfig{
-			Logger: config.LoggerConfig{
-				Level:  "info",
-				Format: "json",
-			},
-		},
-	}
-
-	ctx := context.WithValue(context.Background(), utils.RequestIDKey{}, "test-request-id")
-	mockRepo := mock.NewMockRepo(ctrl)
-	mockLogger := logger.New(&cfg.Service.Logger)
-
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
-
-	mockRepo.EXPECT().GetStatistics(ctx).Return(nil, errors.New("database error"))
-
-	statsDTO, err := csatUsecase.GetStatistics(ctx)
-
-	require.Error(t, err)
-	require.Nil(t, statsDTO)
+func newRecorderMock(ctrl *gomock.Controller) *auditMock.MockRecorder {
+	recorderMock := auditMock.NewMockRecorder(ctrl)
+	recorderMock.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+	return recorderMock
 }
 
 func TestCSATUsecaseGetQuestionsByTopic_Success(t *testing.T) {
@@ -92,7 +39,7 @@ func TestCSATUsecaseGetQuestionsByTopic_Success(t *testing.T) {
 	mockRepo := mock.NewMockRepo(ctrl)
 	mockLogger := logger.New(&cfg.Service.Logger)
 
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
+	csatUsecase := NewCSATUsecase(mockRepo, newRecorderMock(ctrl), mockLogger)
 
 	topic := "UX"
 	mockQuestions := []*models.CSATQuestion{
@@ -126,7 +73,7 @@ func TestCSATUsecaseGetQuestionsByTopic_DBError(t *testing.T) {
 	mockRepo := mock.NewMockRepo(ctrl)
 	mockLogger := logger.New(&cfg.Service.Logger)
 
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
+	csatUsecase := NewCSATUsecase(mockRepo, newRecorderMock(ctrl), mockLogger)
 
 	topic := "UX"
 	mockRepo.EXPECT().GetQuestionsByTopic(ctx, topic).Return(nil, errors.New("database error"))
@@ -154,14 +101,16 @@ func TestCSATUsecaseSubmitAnswer_Success(t *testing.T) {
 	mockRepo := mock.NewMockRepo(ctrl)
 	mockLogger := logger.New(&cfg.Service.Logger)
 
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
+	csatUsecase := NewCSATUsecase(mockRepo, newRecorderMock(ctrl), mockLogger)
 
 	mockAnswer := &models.CSATAnswer{
 		Score:          5,
 		UserID:         uuid.New(),
 		CSATQuestionID: 101,
 	}
-	mockRepo.EXPECT().InsertAnswer(ctx, mockAnswer).Return(mockAnswer, nil)
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(101)).Return(&models.CSATQuestion{ID: 101, CSATID: 1, Type: models.CSATQuestionCSAT}, nil)
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(&models.CSATSurvey{ID: 1}, nil)
+	mockRepo.EXPECT().UpsertAnswer(ctx, mockAnswer).Return(mockAnswer, nil)
 
 	answerDTO, err := csatUsecase.SubmitAnswer(ctx, mockAnswer)
 
@@ -187,14 +136,16 @@ func TestCSATUsecaseSubmitAnswer_DBError(t *testing.T) {
 	mockRepo := mock.NewMockRepo(ctrl)
 	mockLogger := logger.New(&cfg.Service.Logger)
 
-	csatUsecase := NewCSATUsecase(mockRepo, mockLogger)
+	csatUsecase := NewCSATUsecase(mockRepo, newRecorderMock(ctrl), mockLogger)
 
 	mockAnswer := &models.CSATAnswer{
 		Score:          5,
 		UserID:         uuid.New(),
 		CSATQuestionID: 101,
 	}
-	mockRepo.EXPECT().InsertAnswer(ctx, mockAnswer).Return(nil, errors.New("database error"))
+	mockRepo.EXPECT().FindQuestion(ctx, uint64(101)).Return(&models.CSATQuestion{ID: 101, CSATID: 1, Type: models.CSATQuestionCSAT}, nil)
+	mockRepo.EXPECT().FindSurvey(ctx, uint64(1)).Return(&models.CSATSurvey{ID: 1}, nil)
+	mockRepo.EXPECT().UpsertAnswer(ctx, mockAnswer).Return(nil, errors.New("database error"))
 
 	answerDTO, err := csatUsecase.SubmitAnswer(ctx, mockAnswer)
 
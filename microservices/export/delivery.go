@@ -0,0 +1,10 @@
+package export
+
+import "net/http"
+
+type Handlers interface {
+	RequestExport(response http.ResponseWriter, request *http.Request)
+	GetExport(response http.ResponseWriter, request *http.Request)
+	GetUserExports(response http.ResponseWriter, request *http.Request)
+	Download(response http.ResponseWriter, request *http.Request)
+}
@@ -0,0 +1,175 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+type exportHandlers struct {
+	usecase export.Usecase
+	logger  logger.Logger
+}
+
+func NewExportHandlers(usecase export.Usecase, logger logger.Logger) export.Handlers {
+	return &exportHandlers{usecase, logger}
+}
+
+// RequestExport godoc
+// @Summary Request personal data export
+// @Description Starts gathering the personal data of the user: profile, favorites, playlists, survey answers and avatar. The archive is built in the background and a notification with a download link is sent when it is ready.
+// @Success 202 {object} dto.ExportDTO "Requested export"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 409 {object} utils.ErrorResponse "Export is already in progress"
+// @Failure 500 {object} utils.ErrorResponse "Can't create data export"
+// @Router /api/v1/exports [post]
+func (handlers *exportHandlers) RequestExport(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := handlers.userID(response, request)
+	if !ok {
+		return
+	}
+
+	exportDTO, err := handlers.usecase.RequestExport(request.Context(), userID)
+	if err != nil {
+		handlers.fail(response, requestID, err)
+		return
+	}
+
+	handlers.write(response, requestID, http.StatusAccepted, exportDTO)
+}
+
+// GetExport godoc
+// @Summary Get personal data export
+// @Description Returns the status of a data export, with the download link once it is completed.
+// @Param exportID path int true "Export ID"
+// @Success 200 {object} dto.ExportDTO "Export"
+// @Failure 400 {object} utils.ErrorResponse "Invalid export ID"
+// @Failure 404 {object} utils.ErrorResponse "Export wasn't found"
+// @Router /api/v1/exports/{exportID} [get]
+func (handlers *exportHandlers) GetExport(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := handlers.userID(response, request)
+	if !ok {
+		return
+	}
+
+	exportID, err := strconv.ParseUint(mux.Vars(request)["exportID"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid export ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, fmt.Sprintf("Invalid export ID: %v", err))
+		return
+	}
+
+	exportDTO, err := handlers.usecase.GetExport(request.Context(), userID, exportID)
+	if err != nil {
+		handlers.fail(response, requestID, err)
+		return
+	}
+
+	handlers.write(response, requestID, http.StatusOK, exportDTO)
+}
+
+// GetUserExports godoc
+// @Summary Get personal data exports
+// @Description Returns the latest data exports of the user.
+// @Success 200 {array} dto.ExportDTO "Exports"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Can't load data exports"
+// @Router /api/v1/exports [get]
+func (handlers *exportHandlers) GetUserExports(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := handlers.userID(response, request)
+	if !ok {
+		return
+	}
+
+	exportDTOs, err := handlers.usecase.GetUserExports(request.Context(), userID)
+	if err != nil {
+		handlers.fail(response, requestID, err)
+		return
+	}
+
+	handlers.write(response, requestID, http.StatusOK, dto.ExportDTOs(exportDTOs))
+}
+
+// Download godoc
+// @Summary Download personal data export
+// @Description Downloads the ZIP archive of a data export. The link is sent in the notification and stops working when it expires.
+// @Produce application/zip
+// @Param token path string true "Download token"
+// @Success 200 {file} file "Archive"
+// @Failure 404 {object} utils.ErrorResponse "Export wasn't found"
+// @Failure 410 {object} utils.ErrorResponse "Download link has expired"
+// @Router /api/v1/exports/download/{token} [get]
+func (handlers *exportHandlers) Download(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	archive, err := handlers.usecase.Download(request.Context(), mux.Vars(request)["token"])
+	if err != nil {
+		handlers.fail(response, requestID, err)
+		return
+	}
+	defer archive.Content.Close()
+
+	response.Header().Set("Content-Type", "application/zip")
+	response.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", archive.Filename))
+	response.Header().Set("Content-Length", strconv.FormatInt(archive.Size, 10))
+	response.Header().Set("Cache-Control", "no-store")
+	response.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(response, archive.Content); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write archive: %v", err), requestID)
+	}
+}
+
+func (handlers *exportHandlers) userID(response http.ResponseWriter, request *http.Request) (uuid.UUID, bool) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", request.Context().Value(utils.RequestIDKey{}))
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+func (handlers *exportHandlers) fail(response http.ResponseWriter, requestID interface{}, err error) {
+	handlers.logger.Error(fmt.Sprintf("Export request failed: %v", err), requestID)
+	switch {
+	case errors.Is(err, export.ErrExportNotFound):
+		utils.JSONError(response, http.StatusNotFound, err.Error())
+	case errors.Is(err, export.ErrExportInProgress):
+		utils.JSONError(response, http.StatusConflict, err.Error())
+	case errors.Is(err, export.ErrExportExpired):
+		utils.JSONError(response, http.StatusGone, err.Error())
+	default:
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func (handlers *exportHandlers) write(response http.ResponseWriter, requestID interface{}, status int, value easyjson.Marshaler) {
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(value)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode export: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode export: %v", err))
+		return
+	}
+
+	response.WriteHeader(status)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
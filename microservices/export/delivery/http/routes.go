@@ -0,0 +1,79 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	csatRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/repository"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/delivery/worker"
+	exportRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/repository"
+	exportUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/usecase"
+	notificationProducer "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/producer"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(
+	s *httpServer.Server,
+	userClient userService.UserServiceClient,
+	trackClient trackService.TrackServiceClient,
+	albumClient albumService.AlbumServiceClient,
+	artistClient artistService.ArtistServiceClient,
+	playlistClient playlistService.PlaylistServiceClient,
+) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	exportRepo := exportRepo.NewExportPGRepository(s.PG)
+	sources := &exportUsecase.Sources{
+		UserClient:     userClient,
+		TrackClient:    trackClient,
+		AlbumClient:    albumClient,
+		ArtistClient:   artistClient,
+		PlaylistClient: playlistClient,
+		PlaylistRepo:   playlistRepo.NewPlaylistRepository(s.PG),
+		CSATRepo:       csatRepo.NewCSATPGRepository(s.PG),
+		S3Repo:         s3Repo.NewS3Repository(s.S3, s.Logger),
+	}
+	notificationProducer := notificationProducer.NewNotificationPGProducer(s.PG)
+	exportUsecase := exportUsecase.NewExportUsecase(exportRepo, sources, notificationProducer, s.Logger)
+	exportHandlers := NewExportHandlers(exportUsecase, s.Logger)
+
+	idempotencyStore := idempotency.NewPGStore(s.PG)
+
+	s.Lifecycle.Go("export worker", func(ctx context.Context) error {
+		return worker.Run(ctx, exportUsecase, s.Logger)
+	})
+
+	s.MUX.Handle(
+		"/api/v1/exports",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.IdempotencyMiddleware(
+				&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+				http.HandlerFunc(exportHandlers.RequestExport),
+			),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/exports",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(exportHandlers.GetUserExports)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/exports/{exportID:[0-9]+}",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(exportHandlers.GetExport)),
+	).Methods("GET")
+
+	// the token of the link authorizes the download, so it works from an email
+	s.MUX.HandleFunc("/api/v1/exports/download/{token:[0-9a-f]+}", exportHandlers.Download).Methods("GET")
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+const (
+	// pollInterval is how often new exports are looked for when there is
+	// nothing to do.
+	pollInterval = 5 * time.Second
+	// cleanupInterval is how often the expired archives are removed.
+	cleanupInterval = time.Hour
+)
+
+// Run processes exports until ctx is cancelled, an export in progress
+// is finished rather than interrupted.
+func Run(ctx context.Context, usecase export.Usecase, logger logger.Logger) error {
+	jobCtx := context.WithoutCancel(ctx)
+
+	var cleanedAt time.Time
+	for {
+		if time.Since(cleanedAt) >= cleanupInterval {
+			if err := usecase.RemoveExpired(jobCtx); err != nil {
+				logger.Errorf("failed to remove expired exports: %v", err)
+			}
+			cleanedAt = time.Now()
+		}
+
+		processed, err := usecase.ProcessNext(jobCtx)
+		if err != nil {
+			logger.Errorf("failed to process export: %v", err)
+		}
+
+		wait := time.Duration(0)
+		if !processed || err != nil {
+			wait = pollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+)
+
+// The types below are the JSON files of a data export archive.
+
+type ProfileFile struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	Image     string    `json:"image"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewProfileFile leaves out the password hash of the user.
+func NewProfileFile(user *userService.User) *ProfileFile {
+	return &ProfileFile{
+		ID:        user.GetUuid(),
+		Username:  user.GetUsername(),
+		Email:     user.GetEmail(),
+		Role:      user.GetRole(),
+		Image:     user.GetImage(),
+		CreatedAt: user.GetCreatedAt().AsTime(),
+		UpdatedAt: user.GetUpdatedAt().AsTime(),
+	}
+}
+
+type TrackFile struct {
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Duration uint64 `json:"duration"`
+	ArtistID uint64 `json:"artistID"`
+	AlbumID  uint64 `json:"albumID"`
+}
+
+func NewTrackFiles(tracks []*trackService.Track) []*TrackFile {
+	files := make([]*TrackFile, 0, len(tracks))
+	for _, track := range tracks {
+		files = append(files, &TrackFile{
+			ID:       track.GetId(),
+			Name:     track.GetName(),
+			Duration: track.GetDuration(),
+			ArtistID: track.GetArtistID(),
+			AlbumID:  track.GetAlbumID(),
+		})
+	}
+	return files
+}
+
+type AlbumFile struct {
+	ID          uint64    `json:"id"`
+	Name        string    `json:"name"`
+	ArtistID    uint64    `json:"artistID"`
+	ReleaseDate time.Time `json:"releaseDate"`
+}
+
+func NewAlbumFiles(albums []*albumService.Album) []*AlbumFile {
+	files := make([]*AlbumFile, 0, len(albums))
+	for _, album := range albums {
+		files = append(files, &AlbumFile{
+			ID:          album.GetId(),
+			Name:        album.GetName(),
+			ArtistID:    album.GetArtistID(),
+			ReleaseDate: album.GetReleaseDate().AsTime(),
+		})
+	}
+	return files
+}
+
+type ArtistFile struct {
+	ID      uint64 `json:"id"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+}
+
+func NewArtistFiles(artists []*artistService.Artist) []*ArtistFile {
+	files := make([]*ArtistFile, 0, len(artists))
+	for _, artist := range artists {
+		files = append(files, &ArtistFile{
+			ID:      artist.GetId(),
+			Name:    artist.GetName(),
+			Country: artist.GetCountry(),
+		})
+	}
+	return files
+}
+
+type FavoritePlaylistFile struct {
+	ID    uint64 `json:"id"`
+	Name  string `json:"name"`
+	Owner string `json:"owner"`
+}
+
+func NewFavoritePlaylistFiles(playlists []*playlistService.Playlist) []*FavoritePlaylistFile {
+	files := make([]*FavoritePlaylistFile, 0, len(playlists))
+	for _, playlist := range playlists {
+		files = append(files, &FavoritePlaylistFile{
+			ID:    playlist.GetId(),
+			Name:  playlist.GetName(),
+			Owner: playlist.GetOwnerName(),
+		})
+	}
+	return files
+}
+
+type PlaylistFile struct {
+	ID        uint64               `json:"id"`
+	Name      string               `json:"name"`
+	IsPrivate bool                 `json:"isPrivate"`
+	CreatedAt time.Time            `json:"createdAt"`
+	Tracks    []*PlaylistTrackFile `json:"tracks,omitempty"`
+}
+
+func NewPlaylistFile(playlist *models.Playlist) *PlaylistFile {
+	return &PlaylistFile{
+		ID:        playlist.ID,
+		Name:      playlist.Name,
+		IsPrivate: playlist.IsPrivate,
+		CreatedAt: playlist.CreatedAt,
+	}
+}
+
+type PlaylistTrackFile struct {
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Artist   string `json:"artist"`
+	Album    string `json:"album"`
+	Duration uint64 `json:"duration"`
+}
+
+func NewPlaylistTrackFiles(entries []*models.PlaylistEntry) []*PlaylistTrackFile {
+	files := make([]*PlaylistTrackFile, 0, len(entries))
+	for _, entry := range entries {
+		files = append(files, &PlaylistTrackFile{
+			ID:       entry.TrackID,
+			Name:     entry.Name,
+			Artist:   entry.Artist,
+			Album:    entry.Album,
+			Duration: entry.Duration,
+		})
+	}
+	return files
+}
+
+type CSATAnswerFile struct {
+	Topic    string `json:"topic"`
+	Question string `json:"question"`
+	Score    uint8  `json:"score"`
+}
+
+func NewCSATAnswerFiles(answers []*models.CSATUserAnswer) []*CSATAnswerFile {
+	files := make([]*CSATAnswerFile, 0, len(answers))
+	for _, answer := range answers {
+		files = append(files, &CSATAnswerFile{
+			Topic:    answer.Topic,
+			Question: answer.Question,
+			Score:    answer.Score,
+		})
+	}
+	return files
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"io"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+)
+
+// downloadPath is where archives are downloaded from, followed by the token.
+const downloadPath = "/api/v1/exports/download/"
+
+func DownloadURL(token string) string {
+	return downloadPath + token
+}
+
+//easyjson:json
+type ExportDTO struct {
+	ID          uint64     `json:"id"`
+	Status      string     `json:"status"`
+	DownloadURL string     `json:"downloadURL,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Size        uint64     `json:"size,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func NewExportDTO(dataExport *models.DataExport) *ExportDTO {
+	exportDTO := &ExportDTO{
+		ID:        dataExport.ID,
+		Status:    dataExport.Status,
+		Error:     dataExport.Error,
+		CreatedAt: dataExport.CreatedAt,
+	}
+	if dataExport.Status == models.ExportCompleted {
+		expiresAt := dataExport.ExpiresAt
+		exportDTO.DownloadURL = DownloadURL(dataExport.Token)
+		exportDTO.ExpiresAt = &expiresAt
+		exportDTO.Size = dataExport.Size
+	}
+
+	return exportDTO
+}
+
+//easyjson:json
+type ExportDTOs []*ExportDTO
+
+// ArchiveDTO is an archive being downloaded.
+type ArchiveDTO struct {
+	Filename string
+	Size     int64
+	Content  io.ReadCloser
+}
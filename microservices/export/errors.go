@@ -0,0 +1,9 @@
+package export
+
+import "errors"
+
+var (
+	ErrExportNotFound   = errors.New("Data export wasn't found")
+	ErrExportInProgress = errors.New("Data export is already in progress")
+	ErrExportExpired    = errors.New("Data export link has expired")
+)
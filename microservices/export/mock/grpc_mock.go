@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/user/user_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockUserServiceClient is a mock of UserServiceClient interface.
+type MockUserServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceClientMockRecorder
+}
+
+// MockUserServiceClientMockRecorder is the mock recorder for MockUserServiceClient.
+type MockUserServiceClientMockRecorder struct {
+	mock *MockUserServiceClient
+}
+
+// NewMockUserServiceClient creates a new mock instance.
+func NewMockUserServiceClient(ctrl *gomock.Controller) *MockUserServiceClient {
+	mock := &MockUserServiceClient{ctrl: ctrl}
+	mock.recorder = &MockUserServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserServiceClient) EXPECT() *MockUserServiceClientMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockUserServiceClient) FindByID(ctx context.Context, in *userService.FindByIDRequest, opts ...grpc.CallOption) (*userService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByID", varargs...)
+	ret0, _ := ret[0].(*userService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockUserServiceClientMockRecorder) FindByID(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserServiceClient)(nil).FindByID), varargs...)
+}
+
+// MockUserServiceServer is a mock of UserServiceServer interface.
+type MockUserServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceServerMockRecorder
+}
+
+// MockUserServiceServerMockRecorder is the mock recorder for MockUserServiceServer.
+type MockUserServiceServerMockRecorder struct {
+	mock *MockUserServiceServer
+}
+
+// NewMockUserServiceServer creates a new mock instance.
+func NewMockUserServiceServer(ctrl *gomock.Controller) *MockUserServiceServer {
+	mock := &MockUserServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUserServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserServiceServer) EXPECT() *MockUserServiceServerMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockUserServiceServer) FindByID(arg0 context.Context, arg1 *userService.FindByIDRequest) (*userService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*userService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockUserServiceServerMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserServiceServer)(nil).FindByID), arg0, arg1)
+}
+
+// mustEmbedUnimplementedUserServiceServer mocks base method.
+func (m *MockUserServiceServer) mustEmbedUnimplementedUserServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedUserServiceServer")
+}
+
+// mustEmbedUnimplementedUserServiceServer indicates an expected call of mustEmbedUnimplementedUserServiceServer.
+func (mr *MockUserServiceServerMockRecorder) mustEmbedUnimplementedUserServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedUserServiceServer", reflect.TypeOf((*MockUserServiceServer)(nil).mustEmbedUnimplementedUserServiceServer))
+}
+
+// MockUnsafeUserServiceServer is a mock of UnsafeUserServiceServer interface.
+type MockUnsafeUserServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeUserServiceServerMockRecorder
+}
+
+// MockUnsafeUserServiceServerMockRecorder is the mock recorder for MockUnsafeUserServiceServer.
+type MockUnsafeUserServiceServerMockRecorder struct {
+	mock *MockUnsafeUserServiceServer
+}
+
+// NewMockUnsafeUserServiceServer creates a new mock instance.
+func NewMockUnsafeUserServiceServer(ctrl *gomock.Controller) *MockUnsafeUserServiceServer {
+	mock := &MockUnsafeUserServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeUserServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeUserServiceServer) EXPECT() *MockUnsafeUserServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedUserServiceServer mocks base method.
+func (m *MockUnsafeUserServiceServer) mustEmbedUnimplementedUserServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedUserServiceServer")
+}
+
+// mustEmbedUnimplementedUserServiceServer indicates an expected call of mustEmbedUnimplementedUserServiceServer.
+func (mr *MockUnsafeUserServiceServerMockRecorder) mustEmbedUnimplementedUserServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedUserServiceServer", reflect.TypeOf((*MockUnsafeUserServiceServer)(nil).mustEmbedUnimplementedUserServiceServer))
+}
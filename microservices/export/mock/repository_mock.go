@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/export/repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepo is a mock of Repo interface.
+type MockRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepoMockRecorder
+}
+
+// MockRepoMockRecorder is the mock recorder for MockRepo.
+type MockRepoMockRecorder struct {
+	mock *MockRepo
+}
+
+// NewMockRepo creates a new mock instance.
+func NewMockRepo(ctrl *gomock.Controller) *MockRepo {
+	mock := &MockRepo{ctrl: ctrl}
+	mock.recorder = &MockRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimExport mocks base method.
+func (m *MockRepo) ClaimExport(ctx context.Context, staleAfter time.Duration) (*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimExport", ctx, staleAfter)
+	ret0, _ := ret[0].(*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimExport indicates an expected call of ClaimExport.
+func (mr *MockRepoMockRecorder) ClaimExport(ctx, staleAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimExport", reflect.TypeOf((*MockRepo)(nil).ClaimExport), ctx, staleAfter)
+}
+
+// CompleteExport mocks base method.
+func (m *MockRepo) CompleteExport(ctx context.Context, dataExport *models.DataExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteExport", ctx, dataExport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteExport indicates an expected call of CompleteExport.
+func (mr *MockRepoMockRecorder) CompleteExport(ctx, dataExport interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteExport", reflect.TypeOf((*MockRepo)(nil).CompleteExport), ctx, dataExport)
+}
+
+// CreateExport mocks base method.
+func (m *MockRepo) CreateExport(ctx context.Context, userID uuid.UUID) (*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExport", ctx, userID)
+	ret0, _ := ret[0].(*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExport indicates an expected call of CreateExport.
+func (mr *MockRepoMockRecorder) CreateExport(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExport", reflect.TypeOf((*MockRepo)(nil).CreateExport), ctx, userID)
+}
+
+// ExpireExports mocks base method.
+func (m *MockRepo) ExpireExports(ctx context.Context, limit int) ([]*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireExports", ctx, limit)
+	ret0, _ := ret[0].([]*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireExports indicates an expected call of ExpireExports.
+func (mr *MockRepoMockRecorder) ExpireExports(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireExports", reflect.TypeOf((*MockRepo)(nil).ExpireExports), ctx, limit)
+}
+
+// FailExport mocks base method.
+func (m *MockRepo) FailExport(ctx context.Context, exportID uint64, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailExport", ctx, exportID, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailExport indicates an expected call of FailExport.
+func (mr *MockRepoMockRecorder) FailExport(ctx, exportID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailExport", reflect.TypeOf((*MockRepo)(nil).FailExport), ctx, exportID, message)
+}
+
+// FindByToken mocks base method.
+func (m *MockRepo) FindByToken(ctx context.Context, token string) (*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByToken", ctx, token)
+	ret0, _ := ret[0].(*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByToken indicates an expected call of FindByToken.
+func (mr *MockRepoMockRecorder) FindByToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByToken", reflect.TypeOf((*MockRepo)(nil).FindByToken), ctx, token)
+}
+
+// FindExport mocks base method.
+func (m *MockRepo) FindExport(ctx context.Context, exportID uint64) (*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExport", ctx, exportID)
+	ret0, _ := ret[0].(*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExport indicates an expected call of FindExport.
+func (mr *MockRepoMockRecorder) FindExport(ctx, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExport", reflect.TypeOf((*MockRepo)(nil).FindExport), ctx, exportID)
+}
+
+// GetUserExports mocks base method.
+func (m *MockRepo) GetUserExports(ctx context.Context, userID uuid.UUID, limit int) ([]*models.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExports", ctx, userID, limit)
+	ret0, _ := ret[0].([]*models.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserExports indicates an expected call of GetUserExports.
+func (mr *MockRepoMockRecorder) GetUserExports(ctx, userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExports", reflect.TypeOf((*MockRepo)(nil).GetUserExports), ctx, userID, limit)
+}
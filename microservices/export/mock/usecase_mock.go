@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/export/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockUsecase) Download(ctx context.Context, token string) (*dto.ArchiveDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, token)
+	ret0, _ := ret[0].(*dto.ArchiveDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockUsecaseMockRecorder) Download(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockUsecase)(nil).Download), ctx, token)
+}
+
+// GetExport mocks base method.
+func (m *MockUsecase) GetExport(ctx context.Context, userID uuid.UUID, exportID uint64) (*dto.ExportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExport", ctx, userID, exportID)
+	ret0, _ := ret[0].(*dto.ExportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExport indicates an expected call of GetExport.
+func (mr *MockUsecaseMockRecorder) GetExport(ctx, userID, exportID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExport", reflect.TypeOf((*MockUsecase)(nil).GetExport), ctx, userID, exportID)
+}
+
+// GetUserExports mocks base method.
+func (m *MockUsecase) GetUserExports(ctx context.Context, userID uuid.UUID) ([]*dto.ExportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExports", ctx, userID)
+	ret0, _ := ret[0].([]*dto.ExportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserExports indicates an expected call of GetUserExports.
+func (mr *MockUsecaseMockRecorder) GetUserExports(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExports", reflect.TypeOf((*MockUsecase)(nil).GetUserExports), ctx, userID)
+}
+
+// ProcessNext mocks base method.
+func (m *MockUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessNext", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessNext indicates an expected call of ProcessNext.
+func (mr *MockUsecaseMockRecorder) ProcessNext(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessNext", reflect.TypeOf((*MockUsecase)(nil).ProcessNext), ctx)
+}
+
+// RemoveExpired mocks base method.
+func (m *MockUsecase) RemoveExpired(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExpired", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExpired indicates an expected call of RemoveExpired.
+func (mr *MockUsecaseMockRecorder) RemoveExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExpired", reflect.TypeOf((*MockUsecase)(nil).RemoveExpired), ctx)
+}
+
+// RequestExport mocks base method.
+func (m *MockUsecase) RequestExport(ctx context.Context, userID uuid.UUID) (*dto.ExportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", ctx, userID)
+	ret0, _ := ret[0].(*dto.ExportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockUsecaseMockRecorder) RequestExport(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockUsecase)(nil).RequestExport), ctx, userID)
+}
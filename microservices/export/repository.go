@@ -0,0 +1,27 @@
+package export
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+type Repo interface {
+	// CreateExport returns ErrExportInProgress when the user already waits
+	// for an export.
+	CreateExport(ctx context.Context, userID uuid.UUID) (*models.DataExport, error)
+	FindExport(ctx context.Context, exportID uint64) (*models.DataExport, error)
+	FindByToken(ctx context.Context, token string) (*models.DataExport, error)
+	GetUserExports(ctx context.Context, userID uuid.UUID, limit int) ([]*models.DataExport, error)
+	// ClaimExport marks the oldest pending export, or a running one that
+	// wasn't updated for staleAfter, as running. It returns sql.ErrNoRows
+	// when there is nothing to do.
+	ClaimExport(ctx context.Context, staleAfter time.Duration) (*models.DataExport, error)
+	CompleteExport(ctx context.Context, dataExport *models.DataExport) error
+	FailExport(ctx context.Context, exportID uint64, message string) error
+	// ExpireExports marks the completed exports past their expiration as
+	// expired and returns them, so their archives can be removed.
+	ExpireExports(ctx context.Context, limit int) ([]*models.DataExport, error)
+}
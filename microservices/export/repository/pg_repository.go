@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const uniqueViolation = "23505"
+
+type ExportRepository struct {
+	db *sql.DB
+}
+
+func NewExportPGRepository(db *sql.DB) *ExportRepository {
+	return &ExportRepository{db: db}
+}
+
+func (r *ExportRepository) CreateExport(ctx context.Context, userID uuid.UUID) (*models.DataExport, error) {
+	dataExport, err := scanExport(r.db.QueryRowContext(ctx, createExportQuery, userID))
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, export.ErrExportInProgress
+		}
+		return nil, errors.Wrap(err, "CreateExport.Query")
+	}
+
+	return dataExport, nil
+}
+
+func (r *ExportRepository) FindExport(ctx context.Context, exportID uint64) (*models.DataExport, error) {
+	dataExport, err := scanExport(r.db.QueryRowContext(ctx, findExportQuery, exportID))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindExport.Query")
+	}
+
+	return dataExport, nil
+}
+
+func (r *ExportRepository) FindByToken(ctx context.Context, token string) (*models.DataExport, error) {
+	dataExport, err := scanExport(r.db.QueryRowContext(ctx, findByTokenQuery, token))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByToken.Query")
+	}
+
+	return dataExport, nil
+}
+
+func (r *ExportRepository) GetUserExports(ctx context.Context, userID uuid.UUID, limit int) ([]*models.DataExport, error) {
+	rows, err := r.db.QueryContext(ctx, getUserExportsQuery, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserExports.Query")
+	}
+
+	exports, err := scanExports(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserExports.Query")
+	}
+	return exports, nil
+}
+
+func (r *ExportRepository) ClaimExport(ctx context.Context, staleAfter time.Duration) (*models.DataExport, error) {
+	dataExport, err := scanExport(r.db.QueryRowContext(ctx, claimExportQuery, staleAfter.Seconds()))
+	if err != nil {
+		return nil, errors.Wrap(err, "ClaimExport.Query")
+	}
+
+	return dataExport, nil
+}
+
+func (r *ExportRepository) CompleteExport(ctx context.Context, dataExport *models.DataExport) error {
+	if _, err := r.db.ExecContext(
+		ctx,
+		completeExportQuery,
+		dataExport.ID,
+		dataExport.ObjectKey,
+		dataExport.Size,
+		dataExport.Token,
+		dataExport.ExpiresAt,
+	); err != nil {
+		return errors.Wrap(err, "CompleteExport.Query")
+	}
+
+	return nil
+}
+
+func (r *ExportRepository) FailExport(ctx context.Context, exportID uint64, message string) error {
+	if _, err := r.db.ExecContext(ctx, failExportQuery, exportID, message); err != nil {
+		return errors.Wrap(err, "FailExport.Query")
+	}
+
+	return nil
+}
+
+func (r *ExportRepository) ExpireExports(ctx context.Context, limit int) ([]*models.DataExport, error) {
+	rows, err := r.db.QueryContext(ctx, expireExportsQuery, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "ExpireExports.Query")
+	}
+
+	exports, err := scanExports(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "ExpireExports.Query")
+	}
+	return exports, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanExport(row scanner) (*models.DataExport, error) {
+	var expiresAt sql.NullTime
+	dataExport := &models.DataExport{}
+	if err := row.Scan(
+		&dataExport.ID,
+		&dataExport.UserID,
+		&dataExport.Status,
+		&dataExport.Attempts,
+		&dataExport.ObjectKey,
+		&dataExport.Size,
+		&dataExport.Token,
+		&expiresAt,
+		&dataExport.Error,
+		&dataExport.CreatedAt,
+		&dataExport.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	dataExport.ExpiresAt = expiresAt.Time
+	return dataExport, nil
+}
+
+func scanExports(rows *sql.Rows) ([]*models.DataExport, error) {
+	defer rows.Close()
+
+	var exports []*models.DataExport
+	for rows.Next() {
+		dataExport, err := scanExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, dataExport)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return exports, nil
+}
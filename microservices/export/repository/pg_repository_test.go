@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var exportColumnNames = []string{
+	"id", "user_id", "status", "attempts", "object_key", "size", "token", "expires_at", "error", "created_at", "updated_at",
+}
+
+func exportRows(dataExports ...*models.DataExport) *sqlmock.Rows {
+	rows := sqlmock.NewRows(exportColumnNames)
+	for _, dataExport := range dataExports {
+		var expiresAt any
+		if !dataExport.ExpiresAt.IsZero() {
+			expiresAt = dataExport.ExpiresAt
+		}
+		rows.AddRow(
+			dataExport.ID,
+			dataExport.UserID,
+			dataExport.Status,
+			dataExport.Attempts,
+			dataExport.ObjectKey,
+			dataExport.Size,
+			dataExport.Token,
+			expiresAt,
+			dataExport.Error,
+			dataExport.CreatedAt,
+			dataExport.UpdatedAt,
+		)
+	}
+	return rows
+}
+
+func TestExportRepositoryCreateExport(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	exportPGRepository := NewExportPGRepository(db)
+	dataExport := &models.DataExport{ID: 1, UserID: uuid.New(), Status: models.ExportPending, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	mock.ExpectQuery(createExportQuery).WithArgs(dataExport.UserID).WillReturnRows(exportRows(dataExport))
+	created, err := exportPGRepository.CreateExport(context.Background(), dataExport.UserID)
+	require.NoError(t, err)
+	require.Equal(t, dataExport, created)
+
+	mock.ExpectQuery(createExportQuery).WithArgs(dataExport.UserID).WillReturnError(&pq.Error{Code: uniqueViolation})
+	_, err = exportPGRepository.CreateExport(context.Background(), dataExport.UserID)
+	require.ErrorIs(t, err, export.ErrExportInProgress)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportRepositoryFindByToken(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	exportPGRepository := NewExportPGRepository(db)
+	dataExport := &models.DataExport{
+		ID:        2,
+		UserID:    uuid.New(),
+		Status:    models.ExportCompleted,
+		ObjectKey: "user/2.zip",
+		Size:      1024,
+		Token:     "abc",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	mock.ExpectQuery(findByTokenQuery).WithArgs("abc").WillReturnRows(exportRows(dataExport))
+	found, err := exportPGRepository.FindByToken(context.Background(), "abc")
+	require.NoError(t, err)
+	require.Equal(t, dataExport, found)
+
+	mock.ExpectQuery(findByTokenQuery).WithArgs("def").WillReturnRows(sqlmock.NewRows(exportColumnNames))
+	_, err = exportPGRepository.FindByToken(context.Background(), "def")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportRepositoryClaimExport(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	exportPGRepository := NewExportPGRepository(db)
+	dataExport := &models.DataExport{ID: 3, UserID: uuid.New(), Status: models.ExportRunning, Attempts: 1}
+
+	mock.ExpectQuery(claimExportQuery).WithArgs(600.0).WillReturnRows(exportRows(dataExport))
+	claimed, err := exportPGRepository.ClaimExport(context.Background(), 10*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, dataExport, claimed)
+
+	mock.ExpectQuery(claimExportQuery).WithArgs(600.0).WillReturnError(sql.ErrNoRows)
+	_, err = exportPGRepository.ClaimExport(context.Background(), 10*time.Minute)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportRepositoryCompleteExport(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	exportPGRepository := NewExportPGRepository(db)
+	dataExport := &models.DataExport{ID: 3, ObjectKey: "user/3.zip", Size: 2048, Token: "abc", ExpiresAt: time.Now()}
+
+	mock.ExpectExec(completeExportQuery).
+		WithArgs(dataExport.ID, dataExport.ObjectKey, dataExport.Size, dataExport.Token, dataExport.ExpiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, exportPGRepository.CompleteExport(context.Background(), dataExport))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportRepositoryExpireExports(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	exportPGRepository := NewExportPGRepository(db)
+	expired := []*models.DataExport{
+		{ID: 4, UserID: uuid.New(), Status: models.ExportExpired, ObjectKey: "a/4.zip"},
+		{ID: 5, UserID: uuid.New(), Status: models.ExportExpired, ObjectKey: "b/5.zip"},
+	}
+
+	mock.ExpectQuery(expireExportsQuery).WithArgs(100).WillReturnRows(exportRows(expired...))
+	exports, err := exportPGRepository.ExpireExports(context.Background(), 100)
+	require.NoError(t, err)
+	require.Equal(t, expired, exports)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,66 @@
+package repository
+
+const (
+	exportColumns = `id, user_id, status, attempts, object_key, size, COALESCE(token, ''), expires_at, error, created_at, updated_at`
+
+	createExportQuery = `
+    INSERT INTO data_export (user_id)
+    VALUES ($1)
+    RETURNING ` + exportColumns
+
+	findExportQuery = `
+    SELECT ` + exportColumns + `
+    FROM data_export
+    WHERE id = $1`
+
+	findByTokenQuery = `
+    SELECT ` + exportColumns + `
+    FROM data_export
+    WHERE token = $1`
+
+	getUserExportsQuery = `
+    SELECT ` + exportColumns + `
+    FROM data_export
+    WHERE user_id = $1
+    ORDER BY created_at DESC
+    LIMIT $2`
+
+	// claimExport skips the exports locked by other workers, so every
+	// export is built by one of them at a time.
+	claimExportQuery = `
+    UPDATE data_export
+    SET status = 'running', attempts = attempts + 1, updated_at = NOW()
+    WHERE id = (
+      SELECT id
+      FROM data_export
+      WHERE status = 'pending'
+        OR status = 'running' AND updated_at < NOW() - make_interval(secs => $1)
+      ORDER BY created_at
+      FOR UPDATE SKIP LOCKED
+      LIMIT 1
+    )
+    RETURNING ` + exportColumns
+
+	completeExportQuery = `
+    UPDATE data_export
+    SET status = 'completed', object_key = $2, size = $3, token = $4, expires_at = $5, updated_at = NOW()
+    WHERE id = $1`
+
+	failExportQuery = `
+    UPDATE data_export
+    SET status = 'failed', error = $2, updated_at = NOW()
+    WHERE id = $1`
+
+	expireExportsQuery = `
+    UPDATE data_export
+    SET status = 'expired', token = NULL, updated_at = NOW()
+    WHERE id IN (
+      SELECT id
+      FROM data_export
+      WHERE status = 'completed' AND expires_at < NOW()
+      ORDER BY expires_at
+      FOR UPDATE SKIP LOCKED
+      LIMIT $1
+    )
+    RETURNING ` + exportColumns
+)
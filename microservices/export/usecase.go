@@ -0,0 +1,20 @@
+package export
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	uuid "github.com/google/uuid"
+)
+
+type Usecase interface {
+	RequestExport(ctx context.Context, userID uuid.UUID) (*dto.ExportDTO, error)
+	GetExport(ctx context.Context, userID uuid.UUID, exportID uint64) (*dto.ExportDTO, error)
+	GetUserExports(ctx context.Context, userID uuid.UUID) ([]*dto.ExportDTO, error)
+	// Download opens the archive behind a download link, the caller closes it.
+	Download(ctx context.Context, token string) (*dto.ArchiveDTO, error)
+	// ProcessNext builds the next archive waiting for the worker and reports
+	// whether there was one.
+	ProcessNext(ctx context.Context) (bool, error)
+	RemoveExpired(ctx context.Context) error
+}
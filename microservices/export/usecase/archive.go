@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	uuid "github.com/google/uuid"
+)
+
+const (
+	avatarsBucket = "avatars"
+	defaultAvatar = "default.webp"
+)
+
+// buildArchive gathers the personal data of the user into a ZIP of JSON
+// files, together with the uploaded avatar.
+func (usecase *exportUsecase) buildArchive(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	sources := usecase.sources
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+
+	user, err := sources.UserClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("can't load profile: %w", err)
+	}
+	if err := writeJSON(archive, "profile.json", dto.NewProfileFile(user.GetUser())); err != nil {
+		return nil, err
+	}
+
+	tracks, err := sources.TrackClient.ListFavorites(ctx, &trackService.ListFavoritesRequest{UserUuid: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("can't load favorite tracks: %w", err)
+	}
+	if err := writeJSON(archive, "favorites/tracks.json", dto.NewTrackFiles(tracks.GetTracks())); err != nil {
+		return nil, err
+	}
+
+	albums, err := sources.AlbumClient.ListFavorites(ctx, &albumService.ListFavoritesRequest{UserUuid: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("can't load favorite albums: %w", err)
+	}
+	if err := writeJSON(archive, "favorites/albums.json", dto.NewAlbumFiles(albums.GetAlbums())); err != nil {
+		return nil, err
+	}
+
+	artists, err := sources.ArtistClient.ListFavorites(ctx, &artistService.ListFavoritesRequest{UserUuid: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("can't load favorite artists: %w", err)
+	}
+	if err := writeJSON(archive, "favorites/artists.json", dto.NewArtistFiles(artists.GetArtists())); err != nil {
+		return nil, err
+	}
+
+	favoritePlaylists, err := sources.PlaylistClient.ListFavorites(ctx, &playlistService.ListFavoritesRequest{UserUuid: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("can't load favorite playlists: %w", err)
+	}
+	if err := writeJSON(archive, "favorites/playlists.json", dto.NewFavoritePlaylistFiles(favoritePlaylists.GetPlaylists())); err != nil {
+		return nil, err
+	}
+
+	playlists, err := sources.PlaylistRepo.GetUserPlaylists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't load playlists: %w", err)
+	}
+	playlistFiles := make([]*dto.PlaylistFile, 0, len(playlists))
+	for _, playlist := range playlists {
+		entries, err := sources.PlaylistRepo.GetPlaylistEntries(ctx, playlist.ID)
+		if err != nil {
+			return nil, fmt.Errorf("can't load tracks of playlist %d: %w", playlist.ID, err)
+		}
+		playlistFile := dto.NewPlaylistFile(playlist)
+		playlistFile.Tracks = dto.NewPlaylistTrackFiles(entries)
+		playlistFiles = append(playlistFiles, playlistFile)
+	}
+	if err := writeJSON(archive, "playlists.json", playlistFiles); err != nil {
+		return nil, err
+	}
+
+	answers, err := sources.CSATRepo.GetUserAnswers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can't load survey answers: %w", err)
+	}
+	if err := writeJSON(archive, "csat_answers.json", dto.NewCSATAnswerFiles(answers)); err != nil {
+		return nil, err
+	}
+
+	usecase.writeAvatar(ctx, archive, user.GetUser().GetImage())
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("can't close archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeAvatar adds the uploaded avatar of the user. The archive is still
+// useful without it, so a missing image is only logged.
+func (usecase *exportUsecase) writeAvatar(ctx context.Context, archive *zip.Writer, image string) {
+	if image == "" || image == defaultAvatar {
+		return
+	}
+
+	object, err := usecase.sources.S3Repo.Get(ctx, avatarsBucket, image)
+	if err != nil {
+		usecase.logger.Warnf("Can't open avatar %s: %v", image, err)
+		return
+	}
+	defer object.Close()
+
+	file, err := archive.Create("avatar" + path.Ext(image))
+	if err != nil {
+		usecase.logger.Warnf("Can't add avatar %s: %v", image, err)
+		return
+	}
+	if _, err := io.Copy(file, object); err != nil {
+		usecase.logger.Warnf("Can't copy avatar %s: %v", image, err)
+	}
+}
+
+func writeJSON(archive *zip.Writer, name string, value any) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't encode %s: %w", name, err)
+	}
+
+	file, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("can't add %s: %w", name, err)
+	}
+	if _, err := file.Write(data); err != nil {
+		return fmt.Errorf("can't write %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	uuid "github.com/google/uuid"
+)
+
+const (
+	exportsBucket = "exports"
+	// exportTTL is how long the download link of an archive works.
+	exportTTL = 7 * 24 * time.Hour
+	// staleAfter is how long a running export may go without progress
+	// before another worker takes it over.
+	staleAfter = 10 * time.Minute
+	// maxAttempts bounds the runs of an export that keeps failing.
+	maxAttempts = 3
+	// maxUserExports bounds the exports listed to a user.
+	maxUserExports  = 10
+	expireBatchSize = 100
+)
+
+// Sources are where the data of an export is gathered from.
+type Sources struct {
+	UserClient     userService.UserServiceClient
+	TrackClient    trackService.TrackServiceClient
+	AlbumClient    albumService.AlbumServiceClient
+	ArtistClient   artistService.ArtistServiceClient
+	PlaylistClient playlistService.PlaylistServiceClient
+	PlaylistRepo   playlist.Repository
+	CSATRepo       csat.Repo
+	S3Repo         s3.S3Repo
+}
+
+type exportUsecase struct {
+	exportRepo           export.Repo
+	sources              *Sources
+	notificationProducer notification.Producer
+	logger               logger.Logger
+}
+
+func NewExportUsecase(exportRepo export.Repo, sources *Sources, notificationProducer notification.Producer, logger logger.Logger) export.Usecase {
+	return &exportUsecase{exportRepo, sources, notificationProducer, logger}
+}
+
+func (usecase *exportUsecase) RequestExport(ctx context.Context, userID uuid.UUID) (*dto.ExportDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	dataExport, err := usecase.exportRepo.CreateExport(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't create data export of user %v: %v", userID, err), requestID)
+		if errors.Is(err, export.ErrExportInProgress) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Can't create data export")
+	}
+	usecase.logger.Infof("User %v requested data export %d", userID, dataExport.ID)
+
+	return dto.NewExportDTO(dataExport), nil
+}
+
+func (usecase *exportUsecase) GetExport(ctx context.Context, userID uuid.UUID, exportID uint64) (*dto.ExportDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	dataExport, err := usecase.exportRepo.FindExport(ctx, exportID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find data export %d: %v", exportID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, export.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("Can't load data export")
+	}
+	if dataExport.UserID != userID {
+		usecase.logger.Warn(fmt.Sprintf("User %v can't access data export %d", userID, exportID), requestID)
+		return nil, export.ErrExportNotFound
+	}
+
+	return dto.NewExportDTO(dataExport), nil
+}
+
+func (usecase *exportUsecase) GetUserExports(ctx context.Context, userID uuid.UUID) ([]*dto.ExportDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	exports, err := usecase.exportRepo.GetUserExports(ctx, userID, maxUserExports)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load data exports of user %v: %v", userID, err), requestID)
+		return nil, fmt.Errorf("Can't load data exports")
+	}
+
+	exportDTOs := make([]*dto.ExportDTO, 0, len(exports))
+	for _, dataExport := range exports {
+		exportDTOs = append(exportDTOs, dto.NewExportDTO(dataExport))
+	}
+	return exportDTOs, nil
+}
+
+func (usecase *exportUsecase) Download(ctx context.Context, token string) (*dto.ArchiveDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	dataExport, err := usecase.exportRepo.FindByToken(ctx, token)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find data export by token: %v", err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, export.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("Can't load data export")
+	}
+	if dataExport.Status != models.ExportCompleted || time.Now().After(dataExport.ExpiresAt) {
+		return nil, export.ErrExportExpired
+	}
+
+	object, err := usecase.sources.S3Repo.Get(ctx, exportsBucket, dataExport.ObjectKey)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't open archive of data export %d: %v", dataExport.ID, err), requestID)
+		return nil, fmt.Errorf("Can't open data export archive")
+	}
+
+	return &dto.ArchiveDTO{
+		Filename: fmt.Sprintf("novamusic-data-%s.zip", dataExport.CreatedAt.Format("2006-01-02")),
+		Size:     int64(dataExport.Size),
+		Content:  object,
+	}, nil
+}
+
+func (usecase *exportUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	dataExport, err := usecase.exportRepo.ClaimExport(ctx, staleAfter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("can't claim data export: %w", err)
+	}
+
+	if dataExport.Attempts > maxAttempts {
+		usecase.logger.Warnf("Giving up data export %d after %d attempts", dataExport.ID, maxAttempts)
+		if err := usecase.exportRepo.FailExport(ctx, dataExport.ID, "Export failed, try again later"); err != nil {
+			return true, fmt.Errorf("can't fail data export %d: %w", dataExport.ID, err)
+		}
+		return true, nil
+	}
+
+	// a failed run leaves the export running, it is retried once stale
+	archive, err := usecase.buildArchive(ctx, dataExport.UserID)
+	if err != nil {
+		return true, fmt.Errorf("can't build data export %d: %w", dataExport.ID, err)
+	}
+
+	dataExport.ObjectKey = fmt.Sprintf("%s/%d.zip", dataExport.UserID, dataExport.ID)
+	upload := s3.Upload{
+		Bucket:      exportsBucket,
+		Key:         dataExport.ObjectKey,
+		File:        bytes.NewReader(archive),
+		Size:        int64(len(archive)),
+		ContentType: "application/zip",
+	}
+	if _, err := usecase.sources.S3Repo.Put(ctx, upload); err != nil {
+		return true, fmt.Errorf("can't upload data export %d: %w", dataExport.ID, err)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return true, fmt.Errorf("can't generate token of data export %d: %w", dataExport.ID, err)
+	}
+	dataExport.Token = token
+	dataExport.Size = uint64(len(archive))
+	dataExport.ExpiresAt = time.Now().Add(exportTTL)
+	if err := usecase.exportRepo.CompleteExport(ctx, dataExport); err != nil {
+		return true, fmt.Errorf("can't complete data export %d: %w", dataExport.ID, err)
+	}
+	usecase.logger.Infof("Completed data export %d of %d bytes", dataExport.ID, dataExport.Size)
+
+	if err := usecase.notificationProducer.DataExportReady(ctx, dataExport.UserID, dataExport.ID, dto.DownloadURL(token), dataExport.ExpiresAt); err != nil {
+		usecase.logger.Warnf("Can't notify user %v about data export %d: %v", dataExport.UserID, dataExport.ID, err)
+	}
+
+	return true, nil
+}
+
+func (usecase *exportUsecase) RemoveExpired(ctx context.Context) error {
+	for {
+		exports, err := usecase.exportRepo.ExpireExports(ctx, expireBatchSize)
+		if err != nil {
+			return fmt.Errorf("can't expire data exports: %w", err)
+		}
+
+		for _, dataExport := range exports {
+			if err := usecase.sources.S3Repo.Remove(ctx, exportsBucket, dataExport.ObjectKey); err != nil {
+				usecase.logger.Warnf("Can't remove archive of data export %d: %v", dataExport.ID, err)
+			}
+		}
+		if len(exports) < expireBatchSize {
+			return nil
+		}
+	}
+}
+
+// newToken returns a random token for a download link.
+func newToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	mockCSAT "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/csat/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/dto"
+	mockExport "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/export/mock"
+	mockNotification "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/mock"
+	mockPlaylist "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeps struct {
+	repo                 *mockExport.MockRepo
+	userClient           *mockExport.MockUserServiceClient
+	trackClient          *mockExport.MockTrackServiceClient
+	albumClient          *mockExport.MockAlbumServiceClient
+	artistClient         *mockExport.MockArtistServiceClient
+	playlistClient       *mockExport.MockPlaylistServiceClient
+	playlistRepo         *mockPlaylist.MockRepository
+	csatRepo             *mockCSAT.MockRepo
+	s3Repo               *mockExport.MockS3Repo
+	notificationProducer *mockNotification.MockProducer
+	usecase              *exportUsecase
+}
+
+func newTestUsecase(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	deps := &testDeps{
+		repo:                 mockExport.NewMockRepo(ctrl),
+		userClient:           mockExport.NewMockUserServiceClient(ctrl),
+		trackClient:          mockExport.NewMockTrackServiceClient(ctrl),
+		albumClient:          mockExport.NewMockAlbumServiceClient(ctrl),
+		artistClient:         mockExport.NewMockArtistServiceClient(ctrl),
+		playlistClient:       mockExport.NewMockPlaylistServiceClient(ctrl),
+		playlistRepo:         mockPlaylist.NewMockRepository(ctrl),
+		csatRepo:             mockCSAT.NewMockRepo(ctrl),
+		s3Repo:               mockExport.NewMockS3Repo(ctrl),
+		notificationProducer: mockNotification.NewMockProducer(ctrl),
+	}
+	sources := &Sources{
+		UserClient:     deps.userClient,
+		TrackClient:    deps.trackClient,
+		AlbumClient:    deps.albumClient,
+		ArtistClient:   deps.artistClient,
+		PlaylistClient: deps.playlistClient,
+		PlaylistRepo:   deps.playlistRepo,
+		CSATRepo:       deps.csatRepo,
+		S3Repo:         deps.s3Repo,
+	}
+	deps.usecase = NewExportUsecase(deps.repo, sources, deps.notificationProducer, logger.New(&cfg.Service.Logger)).(*exportUsecase)
+
+	return deps
+}
+
+func TestUsecase_RequestExport(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	dataExport := &models.DataExport{ID: 1, UserID: userID, Status: models.ExportPending}
+
+	deps.repo.EXPECT().CreateExport(gomock.Any(), userID).Return(dataExport, nil)
+	exportDTO, err := deps.usecase.RequestExport(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, &dto.ExportDTO{ID: 1, Status: models.ExportPending}, exportDTO)
+
+	deps.repo.EXPECT().CreateExport(gomock.Any(), userID).Return(nil, export.ErrExportInProgress)
+	_, err = deps.usecase.RequestExport(context.Background(), userID)
+	require.ErrorIs(t, err, export.ErrExportInProgress)
+}
+
+func TestUsecase_GetExport(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+	dataExport := &models.DataExport{ID: 2, UserID: userID, Status: models.ExportCompleted, Token: "abc", Size: 10, ExpiresAt: expiresAt}
+
+	deps.repo.EXPECT().FindExport(gomock.Any(), uint64(2)).Return(dataExport, nil).Times(2)
+	exportDTO, err := deps.usecase.GetExport(context.Background(), userID, 2)
+	require.NoError(t, err)
+	require.Equal(t, "/api/v1/exports/download/abc", exportDTO.DownloadURL)
+	require.Equal(t, &expiresAt, exportDTO.ExpiresAt)
+
+	_, err = deps.usecase.GetExport(context.Background(), uuid.New(), 2)
+	require.ErrorIs(t, err, export.ErrExportNotFound)
+
+	deps.repo.EXPECT().FindExport(gomock.Any(), uint64(3)).Return(nil, sql.ErrNoRows)
+	_, err = deps.usecase.GetExport(context.Background(), userID, 3)
+	require.ErrorIs(t, err, export.ErrExportNotFound)
+}
+
+func TestUsecase_Download_Expired(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	expired := &models.DataExport{ID: 2, Status: models.ExportCompleted, Token: "abc", ExpiresAt: time.Now().Add(-time.Minute)}
+
+	deps.repo.EXPECT().FindByToken(gomock.Any(), "abc").Return(expired, nil)
+	_, err := deps.usecase.Download(context.Background(), "abc")
+	require.ErrorIs(t, err, export.ErrExportExpired)
+
+	deps.repo.EXPECT().FindByToken(gomock.Any(), "def").Return(nil, sql.ErrNoRows)
+	_, err = deps.usecase.Download(context.Background(), "def")
+	require.ErrorIs(t, err, export.ErrExportNotFound)
+}
+
+func TestUsecase_ProcessNext(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	dataExport := &models.DataExport{ID: 4, UserID: userID, Status: models.ExportRunning, Attempts: 1}
+	created := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	deps.repo.EXPECT().ClaimExport(gomock.Any(), staleAfter).Return(dataExport, nil)
+	deps.userClient.EXPECT().FindByID(gomock.Any(), &userService.FindByIDRequest{Uuid: userID.String()}).
+		Return(&userService.FindByIDResponse{User: &userService.User{
+			Uuid:      userID.String(),
+			Username:  "nova",
+			Email:     "nova@example.com",
+			Image:     "avatar.webp",
+			CreatedAt: timestamppb.New(created),
+			UpdatedAt: timestamppb.New(created),
+		}}, nil)
+	favorites := userID.String()
+	deps.trackClient.EXPECT().ListFavorites(gomock.Any(), &trackService.ListFavoritesRequest{UserUuid: favorites}).
+		Return(&trackService.ListResponse{Tracks: []*trackService.Track{{Id: 1, Name: "Кукушка"}}}, nil)
+	deps.albumClient.EXPECT().ListFavorites(gomock.Any(), &albumService.ListFavoritesRequest{UserUuid: favorites}).
+		Return(&albumService.ListResponse{}, nil)
+	deps.artistClient.EXPECT().ListFavorites(gomock.Any(), &artistService.ListFavoritesRequest{UserUuid: favorites}).
+		Return(&artistService.ListResponse{Artists: []*artistService.Artist{{Id: 1, Name: "Кино"}}}, nil)
+	deps.playlistClient.EXPECT().ListFavorites(gomock.Any(), &playlistService.ListFavoritesRequest{UserUuid: favorites}).
+		Return(&playlistService.ListResponse{Playlists: []*playlistService.Playlist{{Id: 9, Name: "Mix", OwnerName: "kino"}}}, nil)
+	deps.playlistRepo.EXPECT().GetUserPlaylists(gomock.Any(), userID).Return([]*models.Playlist{{ID: 7, Name: "Road trip"}}, nil)
+	deps.playlistRepo.EXPECT().GetPlaylistEntries(gomock.Any(), uint64(7)).
+		Return([]*models.PlaylistEntry{{TrackID: 1, Name: "Кукушка", Artist: "Кино"}}, nil)
+	deps.csatRepo.EXPECT().GetUserAnswers(gomock.Any(), userID).
+		Return([]*models.CSATUserAnswer{{Topic: "Search", Question: "How easy is it?", Score: 5}}, nil)
+	deps.s3Repo.EXPECT().Get(gomock.Any(), avatarsBucket, "avatar.webp").Return(nil, errors.New("no such key"))
+
+	var archive []byte
+	deps.s3Repo.EXPECT().Put(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+		require.Equal(t, exportsBucket, upload.Bucket)
+		require.Equal(t, userID.String()+"/4.zip", upload.Key)
+		data, err := io.ReadAll(upload.File)
+		require.NoError(t, err)
+		archive = data
+		return &minio.UploadInfo{}, nil
+	})
+	deps.repo.EXPECT().CompleteExport(gomock.Any(), dataExport).Return(nil)
+	deps.notificationProducer.EXPECT().DataExportReady(gomock.Any(), userID, uint64(4), gomock.Any(), gomock.Any()).Return(nil)
+
+	processed, err := deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Len(t, dataExport.Token, 64)
+	require.Equal(t, uint64(len(archive)), dataExport.Size)
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	require.NoError(t, err)
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		content, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(content)
+		require.NoError(t, err)
+		content.Close()
+	}
+	require.Len(t, files, 7)
+	require.NotContains(t, string(files["profile.json"]), "hash")
+
+	var playlists []*dto.PlaylistFile
+	require.NoError(t, json.Unmarshal(files["playlists.json"], &playlists))
+	require.Len(t, playlists, 1)
+	require.Equal(t, "Кукушка", playlists[0].Tracks[0].Name)
+
+	var favoritePlaylists []*dto.FavoritePlaylistFile
+	require.NoError(t, json.Unmarshal(files["favorites/playlists.json"], &favoritePlaylists))
+	require.Equal(t, []*dto.FavoritePlaylistFile{{ID: 9, Name: "Mix", Owner: "kino"}}, favoritePlaylists)
+}
+
+func TestUsecase_ProcessNext_GivesUp(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	dataExport := &models.DataExport{ID: 5, UserID: uuid.New(), Status: models.ExportRunning, Attempts: maxAttempts + 1}
+
+	deps.repo.EXPECT().ClaimExport(gomock.Any(), staleAfter).Return(dataExport, nil)
+	deps.repo.EXPECT().FailExport(gomock.Any(), uint64(5), gomock.Any()).Return(nil)
+
+	processed, err := deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+
+	deps.repo.EXPECT().ClaimExport(gomock.Any(), staleAfter).Return(nil, sql.ErrNoRows)
+	processed, err = deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.False(t, processed)
+}
+
+func TestUsecase_RemoveExpired(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	expired := []*models.DataExport{{ID: 6, ObjectKey: "a/6.zip"}, {ID: 7, ObjectKey: "b/7.zip"}}
+
+	deps.repo.EXPECT().ExpireExports(gomock.Any(), expireBatchSize).Return(expired, nil)
+	deps.s3Repo.EXPECT().Remove(gomock.Any(), exportsBucket, "a/6.zip").Return(nil)
+	deps.s3Repo.EXPECT().Remove(gomock.Any(), exportsBucket, "b/7.zip").Return(errors.New("unavailable"))
+
+	require.NoError(t, deps.usecase.RemoveExpired(context.Background()))
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/dto"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (service *genresService) FindByID(ctx context.Context, request *genreService.FindByIDRequest) (*genreService.FindByIDResponse, error) {
+	genreID := request.GetId()
+	genre, err := service.usecase.View(ctx, genreID)
+	if err != nil {
+		service.logger.Errorf("cannot find genre by id: %v", err)
+		return nil, status.Errorf(codes.NotFound, "cannot find genre by id: %v", err)
+	}
+
+	return &genreService.FindByIDResponse{Genre: service.genreDTOToProto(genre)}, nil
+}
+
+func (service *genresService) FindByIDs(ctx context.Context, request *genreService.FindByIDsRequest) (*genreService.FindByIDsResponse, error) {
+	genres, err := service.usecase.GetByIDs(ctx, request.GetIds())
+	if err != nil {
+		service.logger.Errorf("cannot find genres by ids: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot find genres by ids: %v", err)
+	}
+
+	return &genreService.FindByIDsResponse{Genres: service.genresDTOToProto(genres)}, nil
+}
+
+func (service *genresService) ListByArtist(ctx context.Context, request *genreService.ListByArtistRequest) (*genreService.ListResponse, error) {
+	genres, err := service.usecase.GetAllByArtistID(ctx, request.GetArtistID())
+	if err != nil {
+		service.logger.Errorf("cannot list genres by artist: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list genres by artist: %v", err)
+	}
+
+	return &genreService.ListResponse{Genres: service.genresDTOToProto(genres)}, nil
+}
+
+func (service *genresService) ListByTrack(ctx context.Context, request *genreService.ListByTrackRequest) (*genreService.ListResponse, error) {
+	genres, err := service.usecase.GetAllByTrackID(ctx, request.GetTrackID())
+	if err != nil {
+		service.logger.Errorf("cannot list genres by track: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list genres by track: %v", err)
+	}
+
+	return &genreService.ListResponse{Genres: service.genresDTOToProto(genres)}, nil
+}
+
+func (service *genresService) genresDTOToProto(genres []*dto.GenreDTO) []*genreService.Genre {
+	protoGenres := make([]*genreService.Genre, 0, len(genres))
+	for _, genre := range genres {
+		protoGenres = append(protoGenres, service.genreDTOToProto(genre))
+	}
+	return protoGenres
+}
+
+func (service *genresService) genreDTOToProto(genre *dto.GenreDTO) *genreService.Genre {
+	return &genreService.Genre{
+		Id:      genre.ID,
+		Name:    genre.Name,
+		RusName: genre.RusName,
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewGenresService(mockUsecase, logger)
+
+	t.Run("successful find", func(t *testing.T) {
+		mockUsecase.EXPECT().View(gomock.Any(), uint64(1)).Return(&dto.GenreDTO{ID: 1, Name: "rock", RusName: "рок"}, nil)
+
+		resp, err := service.FindByID(context.Background(), &genreService.FindByIDRequest{Id: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, &genreService.FindByIDResponse{
+			Genre: &genreService.Genre{Id: 1, Name: "rock", RusName: "рок"},
+		}, resp)
+	})
+
+	t.Run("not found error", func(t *testing.T) {
+		mockUsecase.EXPECT().View(gomock.Any(), uint64(1)).Return(nil, errors.New("genre not found"))
+
+		_, err := service.FindByID(context.Background(), &genreService.FindByIDRequest{Id: 1})
+		assert.Error(t, err)
+		assert.Equal(t, status.Errorf(codes.NotFound, "cannot find genre by id: genre not found"), err)
+	})
+}
+
+func TestListByTrack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewGenresService(mockUsecase, logger)
+
+	t.Run("successful list", func(t *testing.T) {
+		mockUsecase.EXPECT().GetAllByTrackID(gomock.Any(), uint64(1)).
+			Return([]*dto.GenreDTO{{ID: 1, Name: "rock"}, {ID: 2, Name: "pop"}}, nil)
+
+		resp, err := service.ListByTrack(context.Background(), &genreService.ListByTrackRequest{TrackID: 1})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetGenres(), 2)
+	})
+
+	t.Run("usecase error", func(t *testing.T) {
+		mockUsecase.EXPECT().GetAllByTrackID(gomock.Any(), uint64(1)).Return(nil, errors.New("connection refused"))
+
+		_, err := service.ListByTrack(context.Background(), &genreService.ListByTrackRequest{TrackID: 1})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	"google.golang.org/grpc"
+)
+
+func RegisterGenreService(usecase genre.Usecase, logger logger.Logger) func(server *grpc.Server) {
+	return func(server *grpc.Server) {
+		genresServer := NewGenresService(usecase, logger)
+		genreService.RegisterGenreServiceServer(server, genresServer)
+	}
+}
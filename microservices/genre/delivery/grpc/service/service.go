@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+)
+
+type genresService struct {
+	usecase genre.Usecase
+	logger  logger.Logger
+
+	genreService.UnimplementedGenreServiceServer
+}
+
+func NewGenresService(usecase genre.Usecase, logger logger.Logger) *genresService {
+	return &genresService{usecase, logger, genreService.UnimplementedGenreServiceServer{}}
+}
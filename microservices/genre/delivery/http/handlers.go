@@ -42,6 +42,10 @@ func (handlers *genreHandlers) GetAll(response http.ResponseWriter, request *htt
 		return
 	}
 
+	if utils.NotModifiedList(response, request, genres) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.GenreDTOs(genres))
 	if err != nil {
@@ -89,6 +93,10 @@ func (handlers *genreHandlers) GetAllByArtistID(response http.ResponseWriter, re
 		return
 	}
 
+	if utils.NotModifiedList(response, request, genres) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.GenreDTOs(genres))
 	if err != nil {
@@ -136,6 +144,10 @@ func (handlers *genreHandlers) GetAllByTrackID(response http.ResponseWriter, req
 		return
 	}
 
+	if utils.NotModifiedList(response, request, genres) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.GenreDTOs(genres))
 	if err != nil {
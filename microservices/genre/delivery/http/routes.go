@@ -1,6 +1,9 @@
 package http
 
 import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
 	genreRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/repository"
 	genreUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/usecase"
@@ -14,7 +17,18 @@ func BindRoutes(s *httpServer.Server) {
 	genreUsecase := genreUsecase.NewGenreUsecase(genreRepo, s.Logger)
 	genreHandleres := NewGenreHandlers(genreUsecase, s.Logger)
 
-	s.MUX.HandleFunc("/api/v1/genres", genreHandleres.GetAll).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/genres/byArtistId/{artistId:[0-9]+}", genreHandleres.GetAllByArtistID).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/genres/byTrackId/{trackId:[0-9]+}", genreHandleres.GetAllByTrackID).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/genres",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(genreHandleres.GetAll)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/genres/byArtistId/{artistId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(genreHandleres.GetAllByArtistID)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/genres/byTrackId/{trackId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(genreHandleres.GetAllByTrackID)),
+	).Methods("GET")
 }
@@ -1,14 +1,17 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 )
 
 //easyjson:json
 type GenreDTO struct {
-	ID      uint64 `json:"id"`
-	Name    string `json:"name"`
-	RusName string `json:"rusName"`
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	RusName   string    `json:"rusName"`
+	UpdatedAt time.Time `json:"-"`
 }
 
 func NewGenreDTO(genre *models.Genre) *GenreDTO {
@@ -16,8 +19,13 @@ func NewGenreDTO(genre *models.Genre) *GenreDTO {
 		genre.ID,
 		genre.Name,
 		genre.RusName,
+		genre.UpdatedAt,
 	}
 }
 
+func (genre *GenreDTO) Version() (uint64, time.Time) {
+	return genre.ID, genre.UpdatedAt
+}
+
 //easyjson:json
 type GenreDTOs []*GenreDTO
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/genre/repository.go
+// Source: microservices/genre/repository.go
 
 // Package mock is a generated GoMock package.
 package mock
@@ -50,6 +50,36 @@ func (mr *MockRepoMockRecorder) Create(ctx, genre interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepo)(nil).Create), ctx, genre)
 }
 
+// FindByIDs mocks base method.
+func (m *MockRepo) FindByIDs(ctx context.Context, genreIDs []uint64) ([]*models.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, genreIDs)
+	ret0, _ := ret[0].([]*models.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockRepoMockRecorder) FindByIDs(ctx, genreIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockRepo)(nil).FindByIDs), ctx, genreIDs)
+}
+
+// FindById mocks base method.
+func (m *MockRepo) FindById(ctx context.Context, genreID uint64) (*models.Genre, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, genreID)
+	ret0, _ := ret[0].(*models.Genre)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRepoMockRecorder) FindById(ctx, genreID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepo)(nil).FindById), ctx, genreID)
+}
+
 // GetAll mocks base method.
 func (m *MockRepo) GetAll(ctx context.Context) ([]*models.Genre, error) {
 	m.ctrl.T.Helper()
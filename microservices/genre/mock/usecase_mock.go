@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/genre/usecase.go
+// Source: microservices/genre/usecase.go
 
 // Package mock is a generated GoMock package.
 package mock
@@ -79,3 +79,33 @@ func (mr *MockUsecaseMockRecorder) GetAllByTrackID(ctx, trackID interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByTrackID", reflect.TypeOf((*MockUsecase)(nil).GetAllByTrackID), ctx, trackID)
 }
+
+// GetByIDs mocks base method.
+func (m *MockUsecase) GetByIDs(ctx context.Context, genreIDs []uint64) ([]*dto.GenreDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, genreIDs)
+	ret0, _ := ret[0].([]*dto.GenreDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockUsecaseMockRecorder) GetByIDs(ctx, genreIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUsecase)(nil).GetByIDs), ctx, genreIDs)
+}
+
+// View mocks base method.
+func (m *MockUsecase) View(ctx context.Context, genreID uint64) (*dto.GenreDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "View", ctx, genreID)
+	ret0, _ := ret[0].(*dto.GenreDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// View indicates an expected call of View.
+func (mr *MockUsecaseMockRecorder) View(ctx, genreID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "View", reflect.TypeOf((*MockUsecase)(nil).View), ctx, genreID)
+}
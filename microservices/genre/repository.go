@@ -8,6 +8,8 @@ import (
 
 type Repo interface {
 	Create(ctx context.Context, genre *models.Genre) (*models.Genre, error)
+	FindById(ctx context.Context, genreID uint64) (*models.Genre, error)
+	FindByIDs(ctx context.Context, genreIDs []uint64) ([]*models.Genre, error)
 	GetAll(ctx context.Context) ([]*models.Genre, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Genre, error)
 	GetAllByTrackID(ctx context.Context, trackID uint64) ([]*models.Genre, error)
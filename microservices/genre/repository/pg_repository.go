@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -55,6 +56,38 @@ func (r *GenreRepository) FindById(ctx context.Context, genreID uint64) (*models
 	return genre, nil
 }
 
+// FindByIDs returns the genres in the order of ids, missing ones are skipped.
+func (r *GenreRepository) FindByIDs(ctx context.Context, genreIDs []uint64) ([]*models.Genre, error) {
+	ids := make([]int64, 0, len(genreIDs))
+	for _, id := range genreIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var genres []*models.Genre
+	rows, err := r.db.QueryContext(ctx, findByIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		genre := &models.Genre{}
+		err := rows.Scan(
+			&genre.ID,
+			&genre.Name,
+			&genre.RusName,
+			&genre.CreatedAt,
+			&genre.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindByIDs.Scan")
+		}
+		genres = append(genres, genre)
+	}
+
+	return genres, nil
+}
+
 func (r *GenreRepository) GetAll(ctx context.Context) ([]*models.Genre, error) {
 	var genres []*models.Genre
 	rows, err := r.db.QueryContext(ctx, getAllQuery)
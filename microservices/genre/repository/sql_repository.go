@@ -5,7 +5,9 @@ const (
 	VALUES ($1, $2, $3, $4)
 	RETURNING id, name, rus_name, created_at, updated_at`
 
-	findByIDQuery = `SELECT id, name, rus_name, created_at, updated_at FROM album WHERE id = $1`
+	findByIDQuery = `SELECT id, name, rus_name, created_at, updated_at FROM genre WHERE id = $1`
+
+	findByIDsQuery = `SELECT id, name, rus_name, created_at, updated_at FROM genre WHERE id = ANY($1::INT[]) ORDER BY array_position($1::INT[], id)`
 
 	getAllQuery = `SELECT id, name, rus_name, created_at, updated_at FROM genre`
 
@@ -7,6 +7,8 @@ import (
 )
 
 type Usecase interface {
+	View(ctx context.Context, genreID uint64) (*dto.GenreDTO, error)
+	GetByIDs(ctx context.Context, genreIDs []uint64) ([]*dto.GenreDTO, error)
 	GetAll(ctx context.Context) ([]*dto.GenreDTO, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*dto.GenreDTO, error)
 	GetAllByTrackID(ctx context.Context, trackID uint64) ([]*dto.GenreDTO, error)
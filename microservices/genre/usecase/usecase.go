@@ -20,6 +20,40 @@ func NewGenreUsecase(genreRepo genre.Repo, logger logger.Logger) genre.Usecase {
 	return &genreUsecase{genreRepo, logger}
 }
 
+func (usecase *genreUsecase) View(ctx context.Context, genreID uint64) (*dto.GenreDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	genre, err := usecase.genreRepo.FindById(ctx, genreID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Genre wasn't found: %v", err), requestID)
+		return nil, fmt.Errorf("Genre wasn't found")
+	}
+	usecase.logger.Info("Genre found", requestID)
+
+	return usecase.convertGenreToDTO(ctx, genre)
+}
+
+func (usecase *genreUsecase) GetByIDs(ctx context.Context, genreIDs []uint64) ([]*dto.GenreDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	genres, err := usecase.genreRepo.FindByIDs(ctx, genreIDs)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load genres %v: %v", genreIDs, err), requestID)
+		return nil, fmt.Errorf("Can't load genres")
+	}
+	usecase.logger.Infof("Found %d of %d genres", len(genres), len(genreIDs))
+
+	var dtoGenres []*dto.GenreDTO
+	for _, genre := range genres {
+		dtoGenre, err := usecase.convertGenreToDTO(ctx, genre)
+		if err != nil {
+			usecase.logger.Error(fmt.Sprintf("Can't create DTO for genre: %v", err), requestID)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoGenres = append(dtoGenres, dtoGenre)
+	}
+
+	return dtoGenres, nil
+}
+
 func (usecase *genreUsecase) GetAll(ctx context.Context) ([]*dto.GenreDTO, error) {
 	requestID := ctx.Value(utils.RequestIDKey{})
 	genres, err := usecase.genreRepo.GetAll(ctx)
@@ -0,0 +1,7 @@
+package graphql
+
+import "net/http"
+
+type Handlers interface {
+	Query(response http.ResponseWriter, request *http.Request)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	gql "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql/resolver"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/mailru/easyjson"
+)
+
+const maxQuerySize = 64 << 10
+
+type graphqlHandlers struct {
+	schema   *graphql.Schema
+	resolver *resolver.Resolver
+	logger   logger.Logger
+}
+
+func NewGraphQLHandlers(schema *graphql.Schema, resolver *resolver.Resolver, logger logger.Logger) gql.Handlers {
+	return &graphqlHandlers{schema, resolver, logger}
+}
+
+// Query godoc
+// @Summary Execute graphql query
+// @Description Executes a query over tracks, albums, artists, genres, playlists and the library of the signed in user. Errors of the query are returned in the errors field with status 200.
+// @Accept json
+// @Produce json
+// @Param query body dto.QueryDTO true "GraphQL query"
+// @Success 200 {object} map[string]interface{} "Query result"
+// @Failure 400 {object} utils.ErrorResponse "Invalid query"
+// @Router /api/v1/graphql [post]
+func (handlers *graphqlHandlers) Query(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+
+	request.Body = http.MaxBytesReader(response, request.Body, maxQuerySize)
+	rawBytes, err := io.ReadAll(request.Body)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to read query: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Query is too large")
+		return
+	}
+
+	queryDTO := &dto.QueryDTO{}
+	if err := easyjson.Unmarshal(rawBytes, queryDTO); err != nil || queryDTO.Query == "" {
+		handlers.logger.Error(fmt.Sprintf("Invalid query: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Invalid query")
+		return
+	}
+
+	ctx := handlers.resolver.RequestContext(request.Context())
+	result := handlers.schema.Exec(ctx, queryDTO.Query, queryDTO.OperationName, queryDTO.Variables)
+	for _, queryErr := range result.Errors {
+		handlers.logger.Warn(fmt.Sprintf("Query error: %v", queryErr), requestID)
+	}
+
+	rawBytes, err = json.Marshal(result)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode result: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write(rawBytes); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+	}
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	albumMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/mock"
+	artistMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/mock"
+	genreMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql/resolver"
+	playlistMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	trackMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
+	userMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQLHandlers_Query(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	genreRepoMock := genreMock.NewMockRepo(ctrl)
+	graphqlResolver := resolver.New(
+		trackMock.NewMockRepo(ctrl),
+		albumMock.NewMockRepo(ctrl),
+		artistMock.NewMockRepo(ctrl),
+		genreRepoMock,
+		playlistMock.NewMockRepository(ctrl),
+		userMock.NewMockPostgresRepo(ctrl),
+		logger,
+	)
+	schema, err := resolver.NewSchema(graphqlResolver)
+	assert.NoError(t, err)
+	graphqlHandlers := NewGraphQLHandlers(schema, graphqlResolver, logger)
+
+	t.Run("Successful query", func(t *testing.T) {
+		genreRepoMock.EXPECT().GetAll(gomock.Any()).Return([]*models.Genre{{ID: 1, Name: "Rock", RusName: "Рок"}}, nil)
+
+		body := `{"query": "query Genres { genres { id name } }", "operationName": "Genres"}`
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", bytes.NewBufferString(body))
+		response := httptest.NewRecorder()
+
+		graphqlHandlers.Query(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		var result struct {
+			Data struct {
+				Genres []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"genres"`
+			} `json:"data"`
+			Errors []interface{} `json:"errors"`
+		}
+		assert.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, "1", result.Data.Genres[0].ID)
+		assert.Equal(t, "Rock", result.Data.Genres[0].Name)
+	})
+
+	t.Run("Query errors", func(t *testing.T) {
+		body := `{"query": "{ genre(id: \"abc\") { name } }"}`
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", bytes.NewBufferString(body))
+		response := httptest.NewRecorder()
+
+		graphqlHandlers.Query(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.Contains(t, response.Body.String(), "Invalid ID")
+	})
+
+	t.Run("Invalid body", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", bytes.NewBufferString(`{"variables": {}}`))
+		response := httptest.NewRecorder()
+
+		graphqlHandlers.Query(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	albumRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/repository"
+	artistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/repository"
+	genreRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/repository"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql/resolver"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	trackRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/repository"
+	userRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/repository/postgres"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(s *httpServer.Server) error {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	graphqlResolver := resolver.New(
+		trackRepo.NewTrackPGRepository(s.PG),
+		albumRepo.NewAlbumPGRepository(s.PG),
+		artistRepo.NewArtistPGRepository(s.PG),
+		genreRepo.NewGenrePGRepository(s.PG),
+		playlistRepo.NewPlaylistRepository(s.PG),
+		userRepo.NewUserPostgresRepository(s.PG, s.Logger),
+		s.Logger,
+	)
+	schema, err := resolver.NewSchema(graphqlResolver)
+	if err != nil {
+		return err
+	}
+	graphqlHandlers := NewGraphQLHandlers(schema, graphqlResolver, s.Logger)
+
+	s.MUX.Handle(
+		"/api/v1/graphql",
+		middleware.OptionalAuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(graphqlHandlers.Query)),
+	).Methods("POST")
+
+	return nil
+}
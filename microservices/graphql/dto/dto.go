@@ -0,0 +1,10 @@
+package dto
+
+// QueryDTO is a graphql request as sent by the common clients.
+//
+//easyjson:json
+type QueryDTO struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
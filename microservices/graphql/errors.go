@@ -0,0 +1,11 @@
+package graphql
+
+import "errors"
+
+var (
+	ErrUnauthorized    = errors.New("Authorization is required")
+	ErrInvalidID       = errors.New("Invalid ID")
+	ErrInvalidCursor   = errors.New("Invalid cursor")
+	ErrInvalidPageSize = errors.New("Page size is out of range")
+	ErrQueryTooComplex = errors.New("Query is too complex")
+)
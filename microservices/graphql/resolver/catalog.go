@@ -0,0 +1,175 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type trackResolver struct {
+	track *models.Track
+}
+
+func (r *trackResolver) ID() graphql.ID {
+	return formatID(r.track.ID)
+}
+
+func (r *trackResolver) Name() string {
+	return r.track.Name
+}
+
+func (r *trackResolver) Duration() int32 {
+	return int32(r.track.Duration)
+}
+
+func (r *trackResolver) File() string {
+	return r.track.FilePath
+}
+
+func (r *trackResolver) Image() string {
+	return r.track.Image
+}
+
+func (r *trackResolver) ReleaseDate() graphql.Time {
+	return graphql.Time{Time: r.track.ReleaseDate}
+}
+
+func (r *trackResolver) Artist(ctx context.Context) (*artistResolver, error) {
+	artist, err := loadersFrom(ctx).artist.Load(ctx, r.track.ArtistID)()
+	if err != nil || artist == nil {
+		return nil, err
+	}
+	return &artistResolver{artist}, nil
+}
+
+func (r *trackResolver) Album(ctx context.Context) (*albumResolver, error) {
+	album, err := loadersFrom(ctx).album.Load(ctx, r.track.AlbumID)()
+	if err != nil || album == nil {
+		return nil, err
+	}
+	return &albumResolver{album}, nil
+}
+
+type albumResolver struct {
+	album *models.Album
+}
+
+func (r *albumResolver) ID() graphql.ID {
+	return formatID(r.album.ID)
+}
+
+func (r *albumResolver) Name() string {
+	return r.album.Name
+}
+
+func (r *albumResolver) Image() string {
+	return r.album.Image
+}
+
+func (r *albumResolver) ReleaseDate() graphql.Time {
+	return graphql.Time{Time: r.album.ReleaseDate}
+}
+
+func (r *albumResolver) Artist(ctx context.Context) (*artistResolver, error) {
+	artist, err := loadersFrom(ctx).artist.Load(ctx, r.album.ArtistID)()
+	if err != nil || artist == nil {
+		return nil, err
+	}
+	return &artistResolver{artist}, nil
+}
+
+func (r *albumResolver) Tracks(ctx context.Context, args connectionArgs) (*connection[*trackResolver], error) {
+	tracks, err := loadersFrom(ctx).albumTracks.Load(ctx, r.album.ID)()
+	if err != nil {
+		return nil, err
+	}
+	return newTrackConnection(ctx, args, tracks)
+}
+
+type artistResolver struct {
+	artist *models.Artist
+}
+
+func (r *artistResolver) ID() graphql.ID {
+	return formatID(r.artist.ID)
+}
+
+func (r *artistResolver) Name() string {
+	return r.artist.Name
+}
+
+func (r *artistResolver) Bio() string {
+	return r.artist.Bio
+}
+
+func (r *artistResolver) Country() string {
+	return r.artist.Country
+}
+
+func (r *artistResolver) Image() string {
+	return r.artist.Image
+}
+
+func (r *artistResolver) Albums(ctx context.Context, args connectionArgs) (*connection[*albumResolver], error) {
+	albums, err := loadersFrom(ctx).artistAlbums.Load(ctx, r.artist.ID)()
+	if err != nil {
+		return nil, err
+	}
+	return newAlbumConnection(ctx, args, albums)
+}
+
+type genreResolver struct {
+	genre *models.Genre
+}
+
+func (r *genreResolver) ID() graphql.ID {
+	return formatID(r.genre.ID)
+}
+
+func (r *genreResolver) Name() string {
+	return r.genre.Name
+}
+
+func (r *genreResolver) RusName() string {
+	return r.genre.RusName
+}
+
+func newTrackConnection(ctx context.Context, args connectionArgs, tracks []*models.Track) (*connection[*trackResolver], error) {
+	start, end, err := bounds(ctx, args, len(tracks))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*trackResolver, 0, end-start)
+	for _, track := range tracks[start:end] {
+		nodes = append(nodes, &trackResolver{track})
+	}
+	return newConnection(nodes, start, len(tracks)), nil
+}
+
+func newAlbumConnection(ctx context.Context, args connectionArgs, albums []*models.Album) (*connection[*albumResolver], error) {
+	start, end, err := bounds(ctx, args, len(albums))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*albumResolver, 0, end-start)
+	for _, album := range albums[start:end] {
+		nodes = append(nodes, &albumResolver{album})
+	}
+	return newConnection(nodes, start, len(albums)), nil
+}
+
+func newArtistConnection(ctx context.Context, args connectionArgs, artists []*models.Artist) (*connection[*artistResolver], error) {
+	start, end, err := bounds(ctx, args, len(artists))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*artistResolver, 0, end-start)
+	for _, artist := range artists[start:end] {
+		nodes = append(nodes, &artistResolver{artist})
+	}
+	return newConnection(nodes, start, len(artists)), nil
+}
@@ -0,0 +1,226 @@
+package resolver
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	gql "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql"
+)
+
+const (
+	maxPageSize = 100
+	// maxComplexity is the number of nodes a single query may request,
+	// nested connections multiply their page sizes.
+	maxComplexity = 2000
+
+	cursorPrefix = "cursor:"
+	// idCursorPrefix marks the cursors of the catalog lists paged by id.
+	idCursorPrefix = "id:"
+)
+
+type connectionArgs struct {
+	First int32
+	After *string
+}
+
+// connection is a page of nodes of a list. Cursors are opaque: offsets in
+// the lists loaded whole, ids of the nodes in the catalog lists paged in the
+// database.
+type connection[T any] struct {
+	nodes   []T
+	cursors []string
+	hasNext bool
+	total   int
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+type pageInfo struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func newConnection[T any](nodes []T, offset, total int) *connection[T] {
+	cursors := make([]string, 0, len(nodes))
+	for i := range nodes {
+		cursors = append(cursors, encodeCursor(offset+i))
+	}
+	return &connection[T]{nodes, cursors, offset+len(nodes) < total, total}
+}
+
+// keysetPage loads the requested page of a catalog list paged by id. One
+// item more than the page is loaded to tell whether there is a next page.
+func keysetPage[M, T any](
+	ctx context.Context,
+	args connectionArgs,
+	load func(ctx context.Context, afterID uint64, limit uint64) ([]M, error),
+	count func(ctx context.Context) (uint64, error),
+	id func(M) uint64,
+	node func(M) T,
+) (*connection[T], error) {
+	first, err := pageSize(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	var afterID uint64
+	if args.After != nil {
+		if afterID, err = decodeIDCursor(*args.After); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := load(ctx, afterID, uint64(first+1))
+	if err != nil {
+		return nil, err
+	}
+	total, err := count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNext := len(items) > first
+	items = items[:min(len(items), first)]
+
+	nodes := make([]T, 0, len(items))
+	cursors := make([]string, 0, len(items))
+	for _, item := range items {
+		nodes = append(nodes, node(item))
+		cursors = append(cursors, encodeIDCursor(id(item)))
+	}
+	return &connection[T]{nodes, cursors, hasNext, int(total)}, nil
+}
+
+func (c *connection[T]) Edges() []*edge[T] {
+	edges := make([]*edge[T], 0, len(c.nodes))
+	for i, node := range c.nodes {
+		edges = append(edges, &edge[T]{c.cursors[i], node})
+	}
+	return edges
+}
+
+func (c *connection[T]) PageInfo() *pageInfo {
+	info := &pageInfo{hasNextPage: c.hasNext}
+	if len(c.cursors) > 0 {
+		info.endCursor = &c.cursors[len(c.cursors)-1]
+	}
+	return info
+}
+
+func (c *connection[T]) TotalCount() int32 {
+	return int32(c.total)
+}
+
+func (e *edge[T]) Cursor() string {
+	return e.cursor
+}
+
+func (e *edge[T]) Node() T {
+	return e.node
+}
+
+func (p *pageInfo) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p *pageInfo) EndCursor() *string {
+	return p.endCursor
+}
+
+// bounds returns the range of the requested page of a list of total items
+// and charges the query for its size.
+func bounds(ctx context.Context, args connectionArgs, total int) (int, int, error) {
+	start := 0
+	if args.After != nil {
+		offset, err := decodeCursor(*args.After)
+		if err != nil {
+			return 0, 0, err
+		}
+		start = offset + 1
+	}
+	first, err := pageSize(ctx, args)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = min(start, total)
+	return start, min(start+first, total), nil
+}
+
+// pageSize returns the size of the requested page and charges the query
+// for it.
+func pageSize(ctx context.Context, args connectionArgs) (int, error) {
+	first := int(args.First)
+	if first < 0 || first > maxPageSize {
+		return 0, gql.ErrInvalidPageSize
+	}
+	if err := charge(ctx, first); err != nil {
+		return 0, err
+	}
+	return first, nil
+}
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(raw), cursorPrefix) {
+		return 0, gql.ErrInvalidCursor
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(raw), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, gql.ErrInvalidCursor
+	}
+	return offset, nil
+}
+
+func encodeIDCursor(id uint64) string {
+	return base64.StdEncoding.EncodeToString([]byte(idCursorPrefix + strconv.FormatUint(id, 10)))
+}
+
+func decodeIDCursor(cursor string) (uint64, error) {
+	raw, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(raw), idCursorPrefix) {
+		return 0, gql.ErrInvalidCursor
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(string(raw), idCursorPrefix), 10, 64)
+	if err != nil {
+		return 0, gql.ErrInvalidCursor
+	}
+	return id, nil
+}
+
+type budgetKey struct{}
+
+// budget is the complexity left to a query, it is spent by every list
+// resolved in the query, so the cost of nested lists multiplies.
+type budget struct {
+	left atomic.Int64
+}
+
+func newBudget(limit int64) *budget {
+	b := &budget{}
+	b.left.Store(limit)
+	return b
+}
+
+func charge(ctx context.Context, cost int) error {
+	b, ok := ctx.Value(budgetKey{}).(*budget)
+	if !ok {
+		return nil
+	}
+	if b.left.Add(-int64(cost)) < 0 {
+		return gql.ErrQueryTooComplex
+	}
+	return nil
+}
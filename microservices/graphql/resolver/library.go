@@ -0,0 +1,176 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type playlistResolver struct {
+	playlist *models.Playlist
+}
+
+func (r *playlistResolver) ID() graphql.ID {
+	return formatID(r.playlist.ID)
+}
+
+func (r *playlistResolver) Name() string {
+	return r.playlist.Name
+}
+
+func (r *playlistResolver) Image() string {
+	return r.playlist.Image
+}
+
+func (r *playlistResolver) IsPrivate() bool {
+	return r.playlist.IsPrivate
+}
+
+func (r *playlistResolver) Owner(ctx context.Context) (*userResolver, error) {
+	user, err := loadersFrom(ctx).user.Load(ctx, r.playlist.OwnerID)()
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return &userResolver{user}, nil
+}
+
+// Tracks loads only the tracks of the requested page.
+func (r *playlistResolver) Tracks(ctx context.Context, args connectionArgs) (*connection[*trackResolver], error) {
+	loaders := loadersFrom(ctx)
+	playlistTracks, err := loaders.playlistTracks.Load(ctx, r.playlist.ID)()
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := bounds(ctx, args, len(playlistTracks))
+	if err != nil {
+		return nil, err
+	}
+
+	trackIDs := make([]uint64, 0, end-start)
+	for _, playlistTrack := range playlistTracks[start:end] {
+		trackIDs = append(trackIDs, playlistTrack.TrackID)
+	}
+
+	tracks, errs := loaders.track.LoadMany(ctx, trackIDs)()
+	nodes := make([]*trackResolver, 0, len(tracks))
+	for i, track := range tracks {
+		if len(errs) > i && errs[i] != nil {
+			return nil, errs[i]
+		}
+		if track == nil {
+			continue
+		}
+		nodes = append(nodes, &trackResolver{track})
+	}
+	return newConnection(nodes, start, len(playlistTracks)), nil
+}
+
+type userResolver struct {
+	user *models.User
+}
+
+func (r *userResolver) ID() graphql.ID {
+	return graphql.ID(r.user.UserID.String())
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) Image() string {
+	return r.user.Image
+}
+
+// viewerResolver is the signed in user, the only one whose email and
+// library are exposed.
+type viewerResolver struct {
+	root *Resolver
+	user *models.User
+}
+
+func (r *viewerResolver) ID() graphql.ID {
+	return graphql.ID(r.user.UserID.String())
+}
+
+func (r *viewerResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *viewerResolver) Email() string {
+	return r.user.Email
+}
+
+func (r *viewerResolver) Image() string {
+	return r.user.Image
+}
+
+func (r *viewerResolver) Favorites() *favoritesResolver {
+	return &favoritesResolver{r.root, r.user}
+}
+
+func (r *viewerResolver) Playlists(ctx context.Context, args connectionArgs) (*connection[*playlistResolver], error) {
+	playlists, err := r.root.playlistRepo.GetUserPlaylists(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return newPlaylistConnection(ctx, args, playlists)
+}
+
+type favoritesResolver struct {
+	root *Resolver
+	user *models.User
+}
+
+func (r *favoritesResolver) Tracks(ctx context.Context, args connectionArgs) (*connection[*trackResolver], error) {
+	tracks, err := r.root.trackRepo.GetFavoriteTracks(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return newTrackConnection(ctx, args, tracks)
+}
+
+func (r *favoritesResolver) Albums(ctx context.Context, args connectionArgs) (*connection[*albumResolver], error) {
+	albums, err := r.root.albumRepo.GetFavoriteAlbums(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return newAlbumConnection(ctx, args, albums)
+}
+
+func (r *favoritesResolver) Artists(ctx context.Context, args connectionArgs) (*connection[*artistResolver], error) {
+	artists, err := r.root.artistRepo.GetFavoriteArtists(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return newArtistConnection(ctx, args, artists)
+}
+
+func (r *favoritesResolver) Playlists(ctx context.Context, args connectionArgs) (*connection[*playlistResolver], error) {
+	playlists, err := r.root.playlistRepo.GetFavoritePlaylists(ctx, r.user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	visiblePlaylists := make([]*models.Playlist, 0, len(playlists))
+	for _, playlist := range playlists {
+		if visible(ctx, playlist) {
+			visiblePlaylists = append(visiblePlaylists, playlist)
+		}
+	}
+	return newPlaylistConnection(ctx, args, visiblePlaylists)
+}
+
+func newPlaylistConnection(ctx context.Context, args connectionArgs, playlists []*models.Playlist) (*connection[*playlistResolver], error) {
+	start, end, err := bounds(ctx, args, len(playlists))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*playlistResolver, 0, end-start)
+	for _, playlist := range playlists[start:end] {
+		nodes = append(nodes, &playlistResolver{playlist})
+	}
+	return newConnection(nodes, start, len(playlists)), nil
+}
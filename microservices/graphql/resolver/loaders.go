@@ -0,0 +1,116 @@
+package resolver
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// loaderWait is how long a loader collects keys before querying them at once.
+const loaderWait = 5 * time.Millisecond
+
+type loadersKey struct{}
+
+// loaders turn the lookups of sibling nodes into a single repository call
+// per relation, so a page of tracks loads all its artists by one query.
+type loaders struct {
+	track          *dataloader.Loader[uint64, *models.Track]
+	album          *dataloader.Loader[uint64, *models.Album]
+	artist         *dataloader.Loader[uint64, *models.Artist]
+	user           *dataloader.Loader[uuid.UUID, *models.User]
+	albumTracks    *dataloader.Loader[uint64, []*models.Track]
+	artistAlbums   *dataloader.Loader[uint64, []*models.Album]
+	playlistTracks *dataloader.Loader[uint64, []*models.PlaylistTrack]
+}
+
+func (r *Resolver) newLoaders() *loaders {
+	return &loaders{
+		track: dataloader.NewBatchedLoader(
+			byKey(r.trackRepo.FindByIDs, func(track *models.Track) uint64 { return track.ID }),
+			dataloader.WithWait[uint64, *models.Track](loaderWait),
+		),
+		album: dataloader.NewBatchedLoader(
+			byKey(r.albumRepo.FindByIDs, func(album *models.Album) uint64 { return album.ID }),
+			dataloader.WithWait[uint64, *models.Album](loaderWait),
+		),
+		artist: dataloader.NewBatchedLoader(
+			byKey(r.artistRepo.FindByIDs, func(artist *models.Artist) uint64 { return artist.ID }),
+			dataloader.WithWait[uint64, *models.Artist](loaderWait),
+		),
+		user: dataloader.NewBatchedLoader(
+			byKey(r.userRepo.FindByIDs, func(user *models.User) uuid.UUID { return user.UserID }),
+			dataloader.WithWait[uuid.UUID, *models.User](loaderWait),
+		),
+		albumTracks: dataloader.NewBatchedLoader(
+			groupByKey(r.trackRepo.GetAllByAlbumIDs, func(track *models.Track) uint64 { return track.AlbumID }),
+			dataloader.WithWait[uint64, []*models.Track](loaderWait),
+		),
+		artistAlbums: dataloader.NewBatchedLoader(
+			groupByKey(r.albumRepo.GetAllByArtistIDs, func(album *models.Album) uint64 { return album.ArtistID }),
+			dataloader.WithWait[uint64, []*models.Album](loaderWait),
+		),
+		playlistTracks: dataloader.NewBatchedLoader(
+			groupByKey(r.playlistRepo.GetPlaylistsTracks, func(track *models.PlaylistTrack) uint64 { return track.PlaylistID }),
+			dataloader.WithWait[uint64, []*models.PlaylistTrack](loaderWait),
+		),
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// byKey makes a batch function of a repository lookup returning the found
+// values in any order, keys that were not found load nil.
+func byKey[K comparable, V any](find func(context.Context, []K) ([]V, error), key func(V) K) dataloader.BatchFunc[K, V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[V] {
+		values, err := find(ctx, keys)
+		if err != nil {
+			return failed[K, V](keys, err)
+		}
+
+		found := make(map[K]V, len(values))
+		for _, value := range values {
+			found[key(value)] = value
+		}
+
+		results := make([]*dataloader.Result[V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[V]{Data: found[k]}
+		}
+		return results
+	}
+}
+
+// groupByKey is byKey for one to many relations, the values of every key
+// keep the order returned by the repository.
+func groupByKey[K comparable, V any](find func(context.Context, []K) ([]V, error), key func(V) K) dataloader.BatchFunc[K, []V] {
+	return func(ctx context.Context, keys []K) []*dataloader.Result[[]V] {
+		values, err := find(ctx, keys)
+		if err != nil {
+			return failed[K, []V](keys, err)
+		}
+
+		groups := make(map[K][]V, len(keys))
+		for _, value := range values {
+			groups[key(value)] = append(groups[key(value)], value)
+		}
+
+		results := make([]*dataloader.Result[[]V], len(keys))
+		for i, k := range keys {
+			results[i] = &dataloader.Result[[]V]{Data: groups[k]}
+		}
+		return results
+	}
+}
+
+func failed[K comparable, V any](keys []K, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], len(keys))
+	for i := range keys {
+		results[i] = &dataloader.Result[V]{Error: err}
+	}
+	return results
+}
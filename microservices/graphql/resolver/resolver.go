@@ -0,0 +1,201 @@
+package resolver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre"
+	gql "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Resolver is the root of the schema, it resolves the catalog and the
+// library of the signed in user on top of the service repositories.
+type Resolver struct {
+	trackRepo    track.Repo
+	albumRepo    album.Repo
+	artistRepo   artist.Repo
+	genreRepo    genre.Repo
+	playlistRepo playlist.Repository
+	userRepo     user.PostgresRepo
+	logger       logger.Logger
+}
+
+func New(
+	trackRepo track.Repo,
+	albumRepo album.Repo,
+	artistRepo artist.Repo,
+	genreRepo genre.Repo,
+	playlistRepo playlist.Repository,
+	userRepo user.PostgresRepo,
+	logger logger.Logger,
+) *Resolver {
+	return &Resolver{trackRepo, albumRepo, artistRepo, genreRepo, playlistRepo, userRepo, logger}
+}
+
+// RequestContext prepares ctx for executing a single query, loaders batch
+// and cache the repository calls of the query only.
+func (r *Resolver) RequestContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, loadersKey{}, r.newLoaders())
+	return context.WithValue(ctx, budgetKey{}, newBudget(maxComplexity))
+}
+
+type idArgs struct {
+	ID graphql.ID
+}
+
+func parseID(id graphql.ID) (uint64, error) {
+	parsed, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, gql.ErrInvalidID
+	}
+	return parsed, nil
+}
+
+func formatID(id uint64) graphql.ID {
+	return graphql.ID(strconv.FormatUint(id, 10))
+}
+
+func viewerID(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(utils.UserIDKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+// visible reports whether the playlist can be shown to the viewer.
+func visible(ctx context.Context, playlist *models.Playlist) bool {
+	if !playlist.IsPrivate {
+		return true
+	}
+	userID, ok := viewerID(ctx)
+	return ok && userID == playlist.OwnerID
+}
+
+func (r *Resolver) Track(ctx context.Context, args idArgs) (*trackResolver, error) {
+	trackID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := loadersFrom(ctx).track.Load(ctx, trackID)()
+	if err != nil || track == nil {
+		return nil, err
+	}
+
+	return &trackResolver{track}, nil
+}
+
+func (r *Resolver) Tracks(ctx context.Context, args connectionArgs) (*connection[*trackResolver], error) {
+	return keysetPage(ctx, args, r.trackRepo.GetPage, r.trackRepo.Count,
+		func(track *models.Track) uint64 { return track.ID },
+		func(track *models.Track) *trackResolver { return &trackResolver{track} },
+	)
+}
+
+func (r *Resolver) Album(ctx context.Context, args idArgs) (*albumResolver, error) {
+	albumID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	album, err := loadersFrom(ctx).album.Load(ctx, albumID)()
+	if err != nil || album == nil {
+		return nil, err
+	}
+
+	return &albumResolver{album}, nil
+}
+
+func (r *Resolver) Albums(ctx context.Context, args connectionArgs) (*connection[*albumResolver], error) {
+	return keysetPage(ctx, args, r.albumRepo.GetPage, r.albumRepo.Count,
+		func(album *models.Album) uint64 { return album.ID },
+		func(album *models.Album) *albumResolver { return &albumResolver{album} },
+	)
+}
+
+func (r *Resolver) Artist(ctx context.Context, args idArgs) (*artistResolver, error) {
+	artistID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	artist, err := loadersFrom(ctx).artist.Load(ctx, artistID)()
+	if err != nil || artist == nil {
+		return nil, err
+	}
+
+	return &artistResolver{artist}, nil
+}
+
+func (r *Resolver) Artists(ctx context.Context, args connectionArgs) (*connection[*artistResolver], error) {
+	return keysetPage(ctx, args, r.artistRepo.GetPage, r.artistRepo.Count,
+		func(artist *models.Artist) uint64 { return artist.ID },
+		func(artist *models.Artist) *artistResolver { return &artistResolver{artist} },
+	)
+}
+
+func (r *Resolver) Genre(ctx context.Context, args idArgs) (*genreResolver, error) {
+	genreID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	genres, err := r.genreRepo.FindByIDs(ctx, []uint64{genreID})
+	if err != nil || len(genres) == 0 {
+		return nil, err
+	}
+
+	return &genreResolver{genres[0]}, nil
+}
+
+func (r *Resolver) Genres(ctx context.Context) ([]*genreResolver, error) {
+	genres, err := r.genreRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := charge(ctx, len(genres)); err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*genreResolver, 0, len(genres))
+	for _, genre := range genres {
+		resolvers = append(resolvers, &genreResolver{genre})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) Playlist(ctx context.Context, args idArgs) (*playlistResolver, error) {
+	playlistID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	playlists, err := r.playlistRepo.GetPlaylistsByIDs(ctx, []uint64{playlistID})
+	if err != nil || len(playlists) == 0 || !visible(ctx, playlists[0]) {
+		return nil, err
+	}
+
+	return &playlistResolver{playlists[0]}, nil
+}
+
+func (r *Resolver) Me(ctx context.Context) (*viewerResolver, error) {
+	userID, ok := viewerID(ctx)
+	if !ok {
+		return nil, gql.ErrUnauthorized
+	}
+
+	user, err := loadersFrom(ctx).user.Load(ctx, userID)()
+	if err != nil || user == nil {
+		return nil, err
+	}
+
+	return &viewerResolver{r, user}, nil
+}
@@ -0,0 +1,239 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	albumMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/mock"
+	artistMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/mock"
+	genreMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/mock"
+	gql "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/graphql"
+	playlistMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	trackMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
+	userMock "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/require"
+)
+
+type repos struct {
+	track    *trackMock.MockRepo
+	album    *albumMock.MockRepo
+	artist   *artistMock.MockRepo
+	genre    *genreMock.MockRepo
+	playlist *playlistMock.MockRepository
+	user     *userMock.MockPostgresRepo
+}
+
+func newTestSchema(t *testing.T) (*Resolver, *graphql.Schema, *repos) {
+	ctrl := gomock.NewController(t)
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	r := &repos{
+		track:    trackMock.NewMockRepo(ctrl),
+		album:    albumMock.NewMockRepo(ctrl),
+		artist:   artistMock.NewMockRepo(ctrl),
+		genre:    genreMock.NewMockRepo(ctrl),
+		playlist: playlistMock.NewMockRepository(ctrl),
+		user:     userMock.NewMockPostgresRepo(ctrl),
+	}
+	resolver := New(r.track, r.album, r.artist, r.genre, r.playlist, r.user, logger.New(&cfg.Service.Logger))
+	schema, err := NewSchema(resolver)
+	require.NoError(t, err)
+
+	return resolver, schema, r
+}
+
+func exec(ctx context.Context, resolver *Resolver, schema *graphql.Schema, query string, result interface{}) []error {
+	response := schema.Exec(resolver.RequestContext(ctx), query, "", nil)
+
+	var errs []error
+	for _, err := range response.Errors {
+		errs = append(errs, err)
+	}
+	if len(response.Data) > 0 && result != nil {
+		if err := json.Unmarshal(response.Data, result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+func TestResolver_TracksBatchesArtists(t *testing.T) {
+	resolver, schema, repos := newTestSchema(t)
+
+	tracks := []*models.Track{
+		{ID: 1, Name: "first", ArtistID: 10},
+		{ID: 2, Name: "second", ArtistID: 20},
+		{ID: 3, Name: "third", ArtistID: 10},
+	}
+	repos.track.EXPECT().GetPage(gomock.Any(), uint64(0), uint64(3)).Return(tracks, nil)
+	repos.track.EXPECT().Count(gomock.Any()).Return(uint64(3), nil)
+	repos.artist.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids []uint64) ([]*models.Artist, error) {
+			require.ElementsMatch(t, []uint64{10, 20}, ids)
+			return []*models.Artist{{ID: 20, Name: "b"}, {ID: 10, Name: "a"}}, nil
+		})
+
+	var result struct {
+		Tracks struct {
+			Edges []struct {
+				Cursor string
+				Node   struct {
+					Name   string
+					Artist struct{ Name string }
+				}
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   string
+			}
+			TotalCount int
+		}
+	}
+	errs := exec(context.Background(), resolver, schema, `{
+		tracks(first: 2) {
+			edges { cursor node { name artist { name } } }
+			pageInfo { hasNextPage endCursor }
+			totalCount
+		}
+	}`, &result)
+	require.Empty(t, errs)
+
+	require.Len(t, result.Tracks.Edges, 2)
+	require.Equal(t, "a", result.Tracks.Edges[0].Node.Artist.Name)
+	require.Equal(t, "b", result.Tracks.Edges[1].Node.Artist.Name)
+	require.True(t, result.Tracks.PageInfo.HasNextPage)
+	require.Equal(t, result.Tracks.Edges[1].Cursor, result.Tracks.PageInfo.EndCursor)
+	require.Equal(t, encodeIDCursor(2), result.Tracks.PageInfo.EndCursor)
+	require.Equal(t, 3, result.Tracks.TotalCount)
+}
+
+func TestResolver_TracksAfterCursor(t *testing.T) {
+	resolver, schema, repos := newTestSchema(t)
+
+	repos.track.EXPECT().GetPage(gomock.Any(), uint64(1), uint64(6)).Return([]*models.Track{{ID: 2}, {ID: 3}}, nil)
+	repos.track.EXPECT().Count(gomock.Any()).Return(uint64(3), nil)
+
+	var result struct {
+		Tracks struct {
+			Edges    []struct{ Node struct{ ID string } }
+			PageInfo struct{ HasNextPage bool }
+		}
+	}
+	errs := exec(context.Background(), resolver, schema, `{
+		tracks(first: 5, after: "`+encodeIDCursor(1)+`") { edges { node { id } } pageInfo { hasNextPage } }
+	}`, &result)
+	require.Empty(t, errs)
+
+	require.Len(t, result.Tracks.Edges, 2)
+	require.Equal(t, "2", result.Tracks.Edges[0].Node.ID)
+	require.False(t, result.Tracks.PageInfo.HasNextPage)
+
+	errs = exec(context.Background(), resolver, schema, `{
+		tracks(after: "`+encodeCursor(0)+`") { totalCount }
+	}`, nil)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), gql.ErrInvalidCursor.Error())
+}
+
+func TestResolver_MeRequiresAuthorization(t *testing.T) {
+	resolver, schema, _ := newTestSchema(t)
+
+	errs := exec(context.Background(), resolver, schema, `{ me { username } }`, nil)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0].Error(), gql.ErrUnauthorized.Error())
+}
+
+func TestResolver_MeFavorites(t *testing.T) {
+	resolver, schema, repos := newTestSchema(t)
+	userID := uuid.New()
+	ownerID := uuid.New()
+
+	repos.user.EXPECT().FindByIDs(gomock.Any(), []uuid.UUID{userID}).
+		Return([]*models.User{{UserID: userID, Username: "user", Email: "user@example.com"}}, nil)
+	repos.playlist.EXPECT().GetFavoritePlaylists(gomock.Any(), userID).Return([]*models.Playlist{
+		{ID: 1, Name: "public", OwnerID: ownerID},
+		{ID: 2, Name: "private", OwnerID: ownerID, IsPrivate: true},
+	}, nil)
+	repos.playlist.EXPECT().GetPlaylistsTracks(gomock.Any(), []uint64{1}).
+		Return([]*models.PlaylistTrack{{PlaylistID: 1, TrackID: 7}}, nil)
+	repos.track.EXPECT().FindByIDs(gomock.Any(), []uint64{7}).Return([]*models.Track{{ID: 7, Name: "track"}}, nil)
+
+	var result struct {
+		Me struct {
+			Email     string
+			Favorites struct {
+				Playlists struct {
+					Edges []struct {
+						Node struct {
+							Name   string
+							Tracks struct {
+								Edges []struct{ Node struct{ Name string } }
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	ctx := context.WithValue(context.Background(), utils.UserIDKey{}, userID)
+	errs := exec(ctx, resolver, schema, `{
+		me { email favorites { playlists { edges { node { name tracks { edges { node { name } } } } } } } }
+	}`, &result)
+	require.Empty(t, errs)
+
+	require.Equal(t, "user@example.com", result.Me.Email)
+	playlists := result.Me.Favorites.Playlists.Edges
+	require.Len(t, playlists, 1)
+	require.Equal(t, "public", playlists[0].Node.Name)
+	require.Equal(t, "track", playlists[0].Node.Tracks.Edges[0].Node.Name)
+}
+
+func TestResolver_Limits(t *testing.T) {
+	resolver, schema, repos := newTestSchema(t)
+
+	t.Run("page size", func(t *testing.T) {
+		errs := exec(context.Background(), resolver, schema, `{ tracks(first: 1000) { totalCount } }`, nil)
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0].Error(), gql.ErrInvalidPageSize.Error())
+	})
+
+	t.Run("complexity", func(t *testing.T) {
+		albums := make([]*models.Album, maxPageSize)
+		for i := range albums {
+			albums[i] = &models.Album{ID: uint64(i + 1)}
+		}
+		repos.album.EXPECT().GetPage(gomock.Any(), uint64(0), uint64(maxPageSize+1)).Return(albums, nil)
+		repos.album.EXPECT().Count(gomock.Any()).Return(uint64(maxPageSize), nil)
+		repos.track.EXPECT().GetAllByAlbumIDs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+		errs := exec(context.Background(), resolver, schema, `{
+			albums(first: 100) { edges { node { tracks(first: 100) { totalCount } } } }
+		}`, nil)
+		require.NotEmpty(t, errs)
+		require.Contains(t, errs[0].Error(), gql.ErrQueryTooComplex.Error())
+	})
+
+	t.Run("depth", func(t *testing.T) {
+		errs := exec(context.Background(), resolver, schema, `{
+			albums { edges { node { artist { albums { edges { node { artist { albums { edges { node { artist { name } } } } } } } } } } } }
+		}`, nil)
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0].Error(), "exceeds max depth")
+	})
+}
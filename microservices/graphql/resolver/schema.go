@@ -0,0 +1,28 @@
+package resolver
+
+import (
+	_ "embed"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// maxDepth is enough for me.favorites.albums.edges.node.tracks.edges.node.artist.
+	maxDepth = 12
+	// maxParallelism limits resolvers of a single query run at once, loaders
+	// batch no more keys than that, so it fits a whole page.
+	maxParallelism = maxPageSize
+)
+
+//go:embed schema.graphql
+var schema string
+
+// NewSchema parses the schema with the resolver as its root.
+func NewSchema(resolver *Resolver) (*graphql.Schema, error) {
+	return graphql.ParseSchema(
+		schema,
+		resolver,
+		graphql.MaxDepth(maxDepth),
+		graphql.MaxParallelism(maxParallelism),
+	)
+}
@@ -0,0 +1,10 @@
+package migration
+
+import "net/http"
+
+type Handlers interface {
+	StartMigration(response http.ResponseWriter, request *http.Request)
+	GetJob(response http.ResponseWriter, request *http.Request)
+	GetReview(response http.ResponseWriter, request *http.Request)
+	ResolveItem(response http.ResponseWriter, request *http.Request)
+}
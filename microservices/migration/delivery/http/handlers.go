@@ -0,0 +1,212 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/libraryexport"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+// maxLibrarySize limits the size of an uploaded library export.
+const maxLibrarySize = 10 << 20
+
+type migrationHandlers struct {
+	usecase migration.Usecase
+	logger  logger.Logger
+}
+
+func NewMigrationHandlers(usecase migration.Usecase, logger logger.Logger) migration.Handlers {
+	return &migrationHandlers{usecase, logger}
+}
+
+// StartMigration godoc
+// @Summary Start library migration
+// @Description Uploads a library exported from another streaming service, as CSV (Exportify, Soundiiz) or the JSON of the Spotify data export. The tracks are matched against the catalog in the background: confident matches are added to favorites and to new playlists, ambiguous ones are left for review.
+// @Accept multipart/form-data
+// @Param file formData file true "Library export"
+// @Success 202 {object} dto.JobDTO "Started migration"
+// @Failure 400 {object} utils.ErrorResponse "Unsupported library file"
+// @Failure 500 {object} utils.ErrorResponse "Can't create migration"
+// @Router /api/v1/migrations [post]
+func (handlers *migrationHandlers) StartMigration(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	request.Body = http.MaxBytesReader(response, request.Body, maxLibrarySize)
+	file, header, err := request.FormFile("file")
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	entries, err := libraryexport.Parse(header.Filename, data)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job, err := handlers.usecase.StartMigration(request.Context(), userID, entries)
+	handlers.respond(response, requestID, http.StatusAccepted, job, err)
+}
+
+// GetJob godoc
+// @Summary Get library migration
+// @Description Returns the status and the progress of a library migration.
+// @Param jobID path string true "Migration ID"
+// @Success 200 {object} dto.JobDTO "Migration"
+// @Failure 400 {object} utils.ErrorResponse "Invalid migration ID"
+// @Failure 404 {object} utils.ErrorResponse "Migration wasn't found"
+// @Router /api/v1/migrations/{jobID} [get]
+func (handlers *migrationHandlers) GetJob(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, jobID, ok := handlers.jobRequest(response, request)
+	if !ok {
+		return
+	}
+
+	job, err := handlers.usecase.GetJob(request.Context(), userID, jobID)
+	handlers.respond(response, requestID, http.StatusOK, job, err)
+}
+
+// GetReview godoc
+// @Summary Get ambiguous matches
+// @Description Returns the tracks of a library migration that match several catalog tracks, with the candidates to choose from.
+// @Param jobID path string true "Migration ID"
+// @Success 200 {array} dto.ReviewItemDTO "Tracks to review"
+// @Failure 400 {object} utils.ErrorResponse "Invalid migration ID"
+// @Failure 404 {object} utils.ErrorResponse "Migration wasn't found"
+// @Failure 500 {object} utils.ErrorResponse "Can't load migration review"
+// @Router /api/v1/migrations/{jobID}/review [get]
+func (handlers *migrationHandlers) GetReview(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, jobID, ok := handlers.jobRequest(response, request)
+	if !ok {
+		return
+	}
+
+	review, err := handlers.usecase.GetReview(request.Context(), userID, jobID)
+	if err != nil {
+		handlers.respond(response, requestID, http.StatusOK, nil, err)
+		return
+	}
+
+	handlers.write(response, requestID, http.StatusOK, dto.ReviewItemDTOs(review))
+}
+
+// ResolveItem godoc
+// @Summary Resolve ambiguous match
+// @Description Picks the catalog track of an ambiguous track once the migration is completed, trackID 0 skips it. The chosen track is added to favorites or to the migrated playlist.
+// @Param jobID path string true "Migration ID"
+// @Param itemID path int true "Item ID"
+// @Param choice body dto.ResolveItemDTO true "Chosen track"
+// @Success 200 {object} dto.JobDTO "Migration"
+// @Failure 400 {object} utils.ErrorResponse "Track isn't a candidate of the item"
+// @Failure 404 {object} utils.ErrorResponse "Migration item wasn't found"
+// @Failure 409 {object} utils.ErrorResponse "Migration item was already reviewed"
+// @Router /api/v1/migrations/{jobID}/items/{itemID} [post]
+func (handlers *migrationHandlers) ResolveItem(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, jobID, ok := handlers.jobRequest(response, request)
+	if !ok {
+		return
+	}
+
+	itemID, err := strconv.ParseUint(mux.Vars(request)["itemID"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid item ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, fmt.Sprintf("Invalid item ID: %v", err))
+		return
+	}
+
+	resolveDTO := &dto.ResolveItemDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, resolveDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	job, err := handlers.usecase.ResolveItem(request.Context(), userID, jobID, itemID, resolveDTO.TrackID)
+	handlers.respond(response, requestID, http.StatusOK, job, err)
+}
+
+func (handlers *migrationHandlers) jobRequest(response http.ResponseWriter, request *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	jobID, err := uuid.Parse(mux.Vars(request)["jobID"])
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid migration ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, fmt.Sprintf("Invalid migration ID: %v", err))
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return userID, jobID, true
+}
+
+func (handlers *migrationHandlers) respond(response http.ResponseWriter, requestID interface{}, status int, jobDTO *dto.JobDTO, err error) {
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Migration request failed: %v", err), requestID)
+		switch {
+		case errors.Is(err, migration.ErrEmptyLibrary),
+			errors.Is(err, migration.ErrInvalidChoice):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		case errors.Is(err, migration.ErrJobNotFound),
+			errors.Is(err, migration.ErrItemNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, migration.ErrJobNotFinished),
+			errors.Is(err, migration.ErrItemResolved):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	handlers.write(response, requestID, status, jobDTO)
+}
+
+func (handlers *migrationHandlers) write(response http.ResponseWriter, requestID interface{}, status int, value easyjson.Marshaler) {
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(value)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode migration: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode migration: %v", err))
+		return
+	}
+
+	response.WriteHeader(status)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
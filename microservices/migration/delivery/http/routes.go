@@ -0,0 +1,58 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/delivery/worker"
+	migrationRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/repository"
+	migrationUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/usecase"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	trackRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/repository"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(s *httpServer.Server) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	migrationRepo := migrationRepo.NewMigrationPGRepository(s.PG)
+	trackRepo := trackRepo.NewTrackPGRepository(s.PG)
+	playlistRepo := playlistRepo.NewPlaylistRepository(s.PG)
+	migrationUsecase := migrationUsecase.NewMigrationUsecase(migrationRepo, trackRepo, playlistRepo, s.Logger)
+	migrationHandlers := NewMigrationHandlers(migrationUsecase, s.Logger)
+
+	idempotencyStore := idempotency.NewPGStore(s.PG)
+
+	s.Lifecycle.Go("migration worker", func(ctx context.Context) error {
+		return worker.Run(ctx, migrationUsecase, s.Logger)
+	})
+
+	s.MUX.Handle(
+		"/api/v1/migrations",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.IdempotencyMiddleware(
+				&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+				http.HandlerFunc(migrationHandlers.StartMigration),
+			),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/migrations/{jobID}",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(migrationHandlers.GetJob)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/migrations/{jobID}/review",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(migrationHandlers.GetReview)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/migrations/{jobID}/items/{itemID:[0-9]+}",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(migrationHandlers.ResolveItem)),
+	).Methods("POST")
+}
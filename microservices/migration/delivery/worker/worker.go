@@ -0,0 +1,35 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+// pollInterval is how often new migrations are looked for when there is
+// nothing to do.
+const pollInterval = 5 * time.Second
+
+// Run processes migrations until ctx is cancelled, a migration in progress
+// is finished rather than interrupted.
+func Run(ctx context.Context, usecase migration.Usecase, logger logger.Logger) error {
+	for {
+		processed, err := usecase.ProcessNext(context.WithoutCancel(ctx))
+		if err != nil {
+			logger.Errorf("failed to process migration: %v", err)
+		}
+
+		wait := time.Duration(0)
+		if !processed || err != nil {
+			wait = pollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
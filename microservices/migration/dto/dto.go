@@ -0,0 +1,92 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+//easyjson:json
+type JobDTO struct {
+	ID        uuid.UUID `json:"id"`
+	Status    string    `json:"status"`
+	Total     uint64    `json:"total"`
+	Processed uint64    `json:"processed"`
+	Matched   uint64    `json:"matched"`
+	Ambiguous uint64    `json:"ambiguous"`
+	Unmatched uint64    `json:"unmatched"`
+	// Progress is the share of processed tracks, in percent.
+	Progress  uint64    `json:"progress"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func NewJobDTO(job *models.MigrationJob) *JobDTO {
+	var progress uint64 = 100
+	if job.Total > 0 {
+		progress = job.Processed * 100 / job.Total
+	}
+
+	return &JobDTO{
+		ID:        job.ID,
+		Status:    job.Status,
+		Total:     job.Total,
+		Processed: job.Processed,
+		Matched:   job.Matched,
+		Ambiguous: job.Ambiguous,
+		Unmatched: job.Unmatched,
+		Progress:  progress,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+}
+
+//easyjson:json
+type CandidateDTO struct {
+	TrackID  uint64  `json:"trackID"`
+	Name     string  `json:"name"`
+	Artist   string  `json:"artist"`
+	Album    string  `json:"album"`
+	Duration uint64  `json:"duration"`
+	Image    string  `json:"image"`
+	Score    float64 `json:"score"`
+}
+
+func NewCandidateDTO(candidate *models.MigrationCandidate, score float64) *CandidateDTO {
+	return &CandidateDTO{
+		TrackID:  candidate.TrackID,
+		Name:     candidate.Name,
+		Artist:   candidate.Artist,
+		Album:    candidate.Album,
+		Duration: candidate.Duration,
+		Image:    candidate.Image,
+		Score:    score,
+	}
+}
+
+// ReviewItemDTO is a track of the library matching several catalog tracks,
+// most likely first.
+//
+//easyjson:json
+type ReviewItemDTO struct {
+	ID         uint64          `json:"id"`
+	Playlist   string          `json:"playlist"`
+	Position   uint64          `json:"position"`
+	Title      string          `json:"title"`
+	Artist     string          `json:"artist"`
+	Album      string          `json:"album"`
+	Candidates []*CandidateDTO `json:"candidates"`
+}
+
+//easyjson:json
+type ReviewItemDTOs []*ReviewItemDTO
+
+// ResolveItemDTO picks a candidate of an item, TrackID 0 skips it.
+//
+//easyjson:json
+type ResolveItemDTO struct {
+	TrackID uint64 `json:"trackID"`
+}
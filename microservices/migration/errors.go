@@ -0,0 +1,12 @@
+package migration
+
+import "errors"
+
+var (
+	ErrEmptyLibrary   = errors.New("Library has no tracks")
+	ErrJobNotFound    = errors.New("Migration wasn't found")
+	ErrJobNotFinished = errors.New("Migration is still running")
+	ErrItemNotFound   = errors.New("Migration item wasn't found")
+	ErrItemResolved   = errors.New("Migration item was already reviewed")
+	ErrInvalidChoice  = errors.New("Track isn't a candidate of the item")
+)
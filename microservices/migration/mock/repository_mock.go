@@ -0,0 +1,231 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/migration/repository.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepo is a mock of Repo interface.
+type MockRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepoMockRecorder
+}
+
+// MockRepoMockRecorder is the mock recorder for MockRepo.
+type MockRepoMockRecorder struct {
+	mock *MockRepo
+}
+
+// NewMockRepo creates a new mock instance.
+func NewMockRepo(ctrl *gomock.Controller) *MockRepo {
+	mock := &MockRepo{ctrl: ctrl}
+	mock.recorder = &MockRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepo) EXPECT() *MockRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimJob mocks base method.
+func (m *MockRepo) ClaimJob(ctx context.Context, staleAfter time.Duration) (*models.MigrationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimJob", ctx, staleAfter)
+	ret0, _ := ret[0].(*models.MigrationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimJob indicates an expected call of ClaimJob.
+func (mr *MockRepoMockRecorder) ClaimJob(ctx, staleAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimJob", reflect.TypeOf((*MockRepo)(nil).ClaimJob), ctx, staleAfter)
+}
+
+// CreateJob mocks base method.
+func (m *MockRepo) CreateJob(ctx context.Context, userID uuid.UUID, items []*models.MigrationItem) (*models.MigrationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, userID, items)
+	ret0, _ := ret[0].(*models.MigrationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockRepoMockRecorder) CreateJob(ctx, userID, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockRepo)(nil).CreateJob), ctx, userID, items)
+}
+
+// CreatePlaylist mocks base method.
+func (m *MockRepo) CreatePlaylist(ctx context.Context, jobID uuid.UUID, name string, newPlaylist *models.Playlist, trackIDs []uint64) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, jobID, name, newPlaylist, trackIDs)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlaylist indicates an expected call of CreatePlaylist.
+func (mr *MockRepoMockRecorder) CreatePlaylist(ctx, jobID, name, newPlaylist, trackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockRepo)(nil).CreatePlaylist), ctx, jobID, name, newPlaylist, trackIDs)
+}
+
+// FindByISRC mocks base method.
+func (m *MockRepo) FindByISRC(ctx context.Context, isrc string) ([]*models.MigrationCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByISRC", ctx, isrc)
+	ret0, _ := ret[0].([]*models.MigrationCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByISRC indicates an expected call of FindByISRC.
+func (mr *MockRepoMockRecorder) FindByISRC(ctx, isrc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByISRC", reflect.TypeOf((*MockRepo)(nil).FindByISRC), ctx, isrc)
+}
+
+// FindCandidates mocks base method.
+func (m *MockRepo) FindCandidates(ctx context.Context, titles, artists []string, limit int) ([]*models.MigrationCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCandidates", ctx, titles, artists, limit)
+	ret0, _ := ret[0].([]*models.MigrationCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCandidates indicates an expected call of FindCandidates.
+func (mr *MockRepoMockRecorder) FindCandidates(ctx, titles, artists, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCandidates", reflect.TypeOf((*MockRepo)(nil).FindCandidates), ctx, titles, artists, limit)
+}
+
+// FindItem mocks base method.
+func (m *MockRepo) FindItem(ctx context.Context, jobID uuid.UUID, itemID uint64) (*models.MigrationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItem", ctx, jobID, itemID)
+	ret0, _ := ret[0].(*models.MigrationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindItem indicates an expected call of FindItem.
+func (mr *MockRepoMockRecorder) FindItem(ctx, jobID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItem", reflect.TypeOf((*MockRepo)(nil).FindItem), ctx, jobID, itemID)
+}
+
+// FindJob mocks base method.
+func (m *MockRepo) FindJob(ctx context.Context, jobID uuid.UUID) (*models.MigrationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindJob", ctx, jobID)
+	ret0, _ := ret[0].(*models.MigrationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindJob indicates an expected call of FindJob.
+func (mr *MockRepoMockRecorder) FindJob(ctx, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindJob", reflect.TypeOf((*MockRepo)(nil).FindJob), ctx, jobID)
+}
+
+// FindPlaylist mocks base method.
+func (m *MockRepo) FindPlaylist(ctx context.Context, jobID uuid.UUID, name string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPlaylist", ctx, jobID, name)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPlaylist indicates an expected call of FindPlaylist.
+func (mr *MockRepoMockRecorder) FindPlaylist(ctx, jobID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPlaylist", reflect.TypeOf((*MockRepo)(nil).FindPlaylist), ctx, jobID, name)
+}
+
+// FinishJob mocks base method.
+func (m *MockRepo) FinishJob(ctx context.Context, jobID uuid.UUID, status, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishJob", ctx, jobID, status, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishJob indicates an expected call of FinishJob.
+func (mr *MockRepoMockRecorder) FinishJob(ctx, jobID, status, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishJob", reflect.TypeOf((*MockRepo)(nil).FinishJob), ctx, jobID, status, message)
+}
+
+// GetCandidates mocks base method.
+func (m *MockRepo) GetCandidates(ctx context.Context, trackIDs []uint64) ([]*models.MigrationCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCandidates", ctx, trackIDs)
+	ret0, _ := ret[0].([]*models.MigrationCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCandidates indicates an expected call of GetCandidates.
+func (mr *MockRepoMockRecorder) GetCandidates(ctx, trackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCandidates", reflect.TypeOf((*MockRepo)(nil).GetCandidates), ctx, trackIDs)
+}
+
+// GetItems mocks base method.
+func (m *MockRepo) GetItems(ctx context.Context, jobID uuid.UUID, statuses []string, afterID uint64, limit int) ([]*models.MigrationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", ctx, jobID, statuses, afterID, limit)
+	ret0, _ := ret[0].([]*models.MigrationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockRepoMockRecorder) GetItems(ctx, jobID, statuses, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockRepo)(nil).GetItems), ctx, jobID, statuses, afterID, limit)
+}
+
+// ResolveItem mocks base method.
+func (m *MockRepo) ResolveItem(ctx context.Context, jobID uuid.UUID, itemID, trackID uint64) (*models.MigrationJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveItem", ctx, jobID, itemID, trackID)
+	ret0, _ := ret[0].(*models.MigrationJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveItem indicates an expected call of ResolveItem.
+func (mr *MockRepoMockRecorder) ResolveItem(ctx, jobID, itemID, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveItem", reflect.TypeOf((*MockRepo)(nil).ResolveItem), ctx, jobID, itemID, trackID)
+}
+
+// SaveMatch mocks base method.
+func (m *MockRepo) SaveMatch(ctx context.Context, item *models.MigrationItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMatch", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMatch indicates an expected call of SaveMatch.
+func (mr *MockRepoMockRecorder) SaveMatch(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMatch", reflect.TypeOf((*MockRepo)(nil).SaveMatch), ctx, item)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/migration/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/dto"
+	libraryexport "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/libraryexport"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetJob mocks base method.
+func (m *MockUsecase) GetJob(ctx context.Context, userID, jobID uuid.UUID) (*dto.JobDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, userID, jobID)
+	ret0, _ := ret[0].(*dto.JobDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockUsecaseMockRecorder) GetJob(ctx, userID, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockUsecase)(nil).GetJob), ctx, userID, jobID)
+}
+
+// GetReview mocks base method.
+func (m *MockUsecase) GetReview(ctx context.Context, userID, jobID uuid.UUID) ([]*dto.ReviewItemDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, userID, jobID)
+	ret0, _ := ret[0].([]*dto.ReviewItemDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockUsecaseMockRecorder) GetReview(ctx, userID, jobID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockUsecase)(nil).GetReview), ctx, userID, jobID)
+}
+
+// ProcessNext mocks base method.
+func (m *MockUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessNext", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessNext indicates an expected call of ProcessNext.
+func (mr *MockUsecaseMockRecorder) ProcessNext(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessNext", reflect.TypeOf((*MockUsecase)(nil).ProcessNext), ctx)
+}
+
+// ResolveItem mocks base method.
+func (m *MockUsecase) ResolveItem(ctx context.Context, userID, jobID uuid.UUID, itemID, trackID uint64) (*dto.JobDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveItem", ctx, userID, jobID, itemID, trackID)
+	ret0, _ := ret[0].(*dto.JobDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveItem indicates an expected call of ResolveItem.
+func (mr *MockUsecaseMockRecorder) ResolveItem(ctx, userID, jobID, itemID, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveItem", reflect.TypeOf((*MockUsecase)(nil).ResolveItem), ctx, userID, jobID, itemID, trackID)
+}
+
+// StartMigration mocks base method.
+func (m *MockUsecase) StartMigration(ctx context.Context, userID uuid.UUID, entries []*libraryexport.Entry) (*dto.JobDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartMigration", ctx, userID, entries)
+	ret0, _ := ret[0].(*dto.JobDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartMigration indicates an expected call of StartMigration.
+func (mr *MockUsecaseMockRecorder) StartMigration(ctx, userID, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartMigration", reflect.TypeOf((*MockUsecase)(nil).StartMigration), ctx, userID, entries)
+}
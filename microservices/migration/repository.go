@@ -0,0 +1,40 @@
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+type Repo interface {
+	CreateJob(ctx context.Context, userID uuid.UUID, items []*models.MigrationItem) (*models.MigrationJob, error)
+	FindJob(ctx context.Context, jobID uuid.UUID) (*models.MigrationJob, error)
+	// ClaimJob marks the oldest pending job, or a running one that wasn't
+	// updated for staleAfter, as running. It returns sql.ErrNoRows when
+	// there is nothing to do.
+	ClaimJob(ctx context.Context, staleAfter time.Duration) (*models.MigrationJob, error)
+	FinishJob(ctx context.Context, jobID uuid.UUID, status string, message string) error
+	GetItems(ctx context.Context, jobID uuid.UUID, statuses []string, afterID uint64, limit int) ([]*models.MigrationItem, error)
+	FindItem(ctx context.Context, jobID uuid.UUID, itemID uint64) (*models.MigrationItem, error)
+	// SaveMatch stores the outcome of matching a pending item and counts it
+	// in the progress of its job.
+	SaveMatch(ctx context.Context, item *models.MigrationItem) error
+	// ResolveItem stores the track chosen for an ambiguous item, 0 to skip
+	// it. It returns sql.ErrNoRows when the item isn't ambiguous.
+	ResolveItem(ctx context.Context, jobID uuid.UUID, itemID uint64, trackID uint64) (*models.MigrationJob, error)
+	FindByISRC(ctx context.Context, isrc string) ([]*models.MigrationCandidate, error)
+	// FindCandidates returns the tracks with a title similar to any of the
+	// given ones, ranked by the similarity of the title and of the artist to
+	// any of the given artists.
+	FindCandidates(ctx context.Context, titles []string, artists []string, limit int) ([]*models.MigrationCandidate, error)
+	GetCandidates(ctx context.Context, trackIDs []uint64) ([]*models.MigrationCandidate, error)
+	// FindPlaylist returns the playlist created for a playlist of the
+	// library, sql.ErrNoRows when there is none yet.
+	FindPlaylist(ctx context.Context, jobID uuid.UUID, name string) (uint64, error)
+	// CreatePlaylist creates the playlist for a playlist of the library
+	// together with the record of it, so a retried job doesn't create it
+	// twice. It returns playlist.ErrNameTaken when the name is taken.
+	CreatePlaylist(ctx context.Context, jobID uuid.UUID, name string, newPlaylist *models.Playlist, trackIDs []uint64) (*models.Playlist, error)
+}
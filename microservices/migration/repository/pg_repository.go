@@ -0,0 +1,304 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type MigrationRepository struct {
+	db *sql.DB
+}
+
+func NewMigrationPGRepository(db *sql.DB) *MigrationRepository {
+	return &MigrationRepository{db: db}
+}
+
+func (r *MigrationRepository) CreateJob(ctx context.Context, userID uuid.UUID, items []*models.MigrationItem) (*models.MigrationJob, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateJob.Begin")
+	}
+	defer tx.Rollback()
+
+	job, err := scanJob(tx.QueryRowContext(ctx, createJobQuery, userID, len(items)))
+	if err != nil {
+		return nil, errors.Wrap(err, "CreateJob.Query")
+	}
+
+	for _, item := range items {
+		if _, err := tx.ExecContext(
+			ctx,
+			createItemQuery,
+			job.ID,
+			item.Playlist,
+			item.Position,
+			item.Title,
+			item.Artist,
+			item.Album,
+			item.ISRC,
+		); err != nil {
+			return nil, errors.Wrap(err, "CreateJob.Exec")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "CreateJob.Commit")
+	}
+
+	return job, nil
+}
+
+func (r *MigrationRepository) FindJob(ctx context.Context, jobID uuid.UUID) (*models.MigrationJob, error) {
+	job, err := scanJob(r.db.QueryRowContext(ctx, findJobQuery, jobID))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindJob.Query")
+	}
+
+	return job, nil
+}
+
+func (r *MigrationRepository) ClaimJob(ctx context.Context, staleAfter time.Duration) (*models.MigrationJob, error) {
+	job, err := scanJob(r.db.QueryRowContext(ctx, claimJobQuery, staleAfter.Seconds()))
+	if err != nil {
+		return nil, errors.Wrap(err, "ClaimJob.Query")
+	}
+
+	return job, nil
+}
+
+func (r *MigrationRepository) FinishJob(ctx context.Context, jobID uuid.UUID, status string, message string) error {
+	if _, err := r.db.ExecContext(ctx, finishJobQuery, jobID, status, message); err != nil {
+		return errors.Wrap(err, "FinishJob.Query")
+	}
+
+	return nil
+}
+
+func (r *MigrationRepository) GetItems(ctx context.Context, jobID uuid.UUID, statuses []string, afterID uint64, limit int) ([]*models.MigrationItem, error) {
+	rows, err := r.db.QueryContext(ctx, getItemsQuery, jobID, pq.Array(statuses), afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetItems.Query")
+	}
+	defer rows.Close()
+
+	var items []*models.MigrationItem
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetItems.Query")
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetItems.Query")
+	}
+
+	return items, nil
+}
+
+func (r *MigrationRepository) FindItem(ctx context.Context, jobID uuid.UUID, itemID uint64) (*models.MigrationItem, error) {
+	item, err := scanItem(r.db.QueryRowContext(ctx, findItemQuery, jobID, itemID))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindItem.Query")
+	}
+
+	return item, nil
+}
+
+func (r *MigrationRepository) SaveMatch(ctx context.Context, item *models.MigrationItem) error {
+	if _, err := r.db.ExecContext(
+		ctx,
+		saveMatchQuery,
+		item.ID,
+		item.Status,
+		item.TrackID,
+		item.Score,
+		pq.Array(toInt64s(item.Candidates)),
+		pq.Array(item.CandidateScores),
+	); err != nil {
+		return errors.Wrap(err, "SaveMatch.Query")
+	}
+
+	return nil
+}
+
+func (r *MigrationRepository) ResolveItem(ctx context.Context, jobID uuid.UUID, itemID uint64, trackID uint64) (*models.MigrationJob, error) {
+	job, err := scanJob(r.db.QueryRowContext(ctx, resolveItemQuery, jobID, itemID, trackID))
+	if err != nil {
+		return nil, errors.Wrap(err, "ResolveItem.Query")
+	}
+
+	return job, nil
+}
+
+func (r *MigrationRepository) FindByISRC(ctx context.Context, isrc string) ([]*models.MigrationCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, findByISRCQuery, isrc)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByISRC.Query")
+	}
+
+	candidates, err := scanCandidates(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByISRC.Query")
+	}
+	return candidates, nil
+}
+
+func (r *MigrationRepository) FindCandidates(ctx context.Context, titles []string, artists []string, limit int) ([]*models.MigrationCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, findCandidatesQuery, pq.Array(titles), pq.Array(artists), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindCandidates.Query")
+	}
+
+	candidates, err := scanCandidates(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindCandidates.Query")
+	}
+	return candidates, nil
+}
+
+func (r *MigrationRepository) GetCandidates(ctx context.Context, trackIDs []uint64) ([]*models.MigrationCandidate, error) {
+	rows, err := r.db.QueryContext(ctx, getCandidatesQuery, pq.Array(toInt64s(trackIDs)))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCandidates.Query")
+	}
+
+	candidates, err := scanCandidates(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetCandidates.Query")
+	}
+	return candidates, nil
+}
+
+func (r *MigrationRepository) FindPlaylist(ctx context.Context, jobID uuid.UUID, name string) (uint64, error) {
+	var playlistID uint64
+	if err := r.db.QueryRowContext(ctx, findPlaylistQuery, jobID, name).Scan(&playlistID); err != nil {
+		return 0, errors.Wrap(err, "FindPlaylist.Query")
+	}
+
+	return playlistID, nil
+}
+
+func (r *MigrationRepository) CreatePlaylist(ctx context.Context, jobID uuid.UUID, name string, newPlaylist *models.Playlist, trackIDs []uint64) (*models.Playlist, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Begin")
+	}
+	defer tx.Rollback()
+
+	created, err := playlistRepo.InsertPlaylistWithTracks(ctx, tx, newPlaylist, trackIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, savePlaylistQuery, jobID, name, created.ID); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Exec")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Commit")
+	}
+
+	return created, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanJob(row scanner) (*models.MigrationJob, error) {
+	job := &models.MigrationJob{}
+	if err := row.Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Status,
+		&job.Total,
+		&job.Processed,
+		&job.Matched,
+		&job.Ambiguous,
+		&job.Unmatched,
+		&job.Attempts,
+		&job.Error,
+		&job.CreatedAt,
+		&job.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func scanItem(row scanner) (*models.MigrationItem, error) {
+	var candidates []int64
+	item := &models.MigrationItem{}
+	if err := row.Scan(
+		&item.ID,
+		&item.JobID,
+		&item.Playlist,
+		&item.Position,
+		&item.Title,
+		&item.Artist,
+		&item.Album,
+		&item.ISRC,
+		&item.Status,
+		&item.TrackID,
+		&item.Score,
+		pq.Array(&candidates),
+		pq.Array(&item.CandidateScores),
+	); err != nil {
+		return nil, err
+	}
+
+	item.Candidates = toUint64s(candidates)
+	return item, nil
+}
+
+func scanCandidates(rows *sql.Rows) ([]*models.MigrationCandidate, error) {
+	defer rows.Close()
+
+	var candidates []*models.MigrationCandidate
+	for rows.Next() {
+		candidate := &models.MigrationCandidate{}
+		if err := rows.Scan(
+			&candidate.TrackID,
+			&candidate.Name,
+			&candidate.Artist,
+			&candidate.Album,
+			&candidate.Duration,
+			&candidate.Image,
+		); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+func toInt64s(ids []uint64) []int64 {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int64(id))
+	}
+	return result
+}
+
+func toUint64s(ids []int64) []uint64 {
+	result := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint64(id))
+	}
+	return result
+}
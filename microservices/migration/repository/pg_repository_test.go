@@ -0,0 +1,241 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var jobColumnNames = []string{
+	"id", "user_id", "status", "total", "processed", "matched", "ambiguous", "unmatched", "attempts", "error", "created_at", "updated_at",
+}
+
+func jobRows(job *models.MigrationJob) *sqlmock.Rows {
+	return sqlmock.NewRows(jobColumnNames).AddRow(
+		job.ID,
+		job.UserID,
+		job.Status,
+		job.Total,
+		job.Processed,
+		job.Matched,
+		job.Ambiguous,
+		job.Unmatched,
+		job.Attempts,
+		job.Error,
+		job.CreatedAt,
+		job.UpdatedAt,
+	)
+}
+
+func TestMigrationRepositoryCreateJob(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New(), Status: models.MigrationPending, Total: 2, CreatedAt: time.Now(), UpdatedAt: time.Now()}
+	items := []*models.MigrationItem{
+		{Position: 1, Title: "Группа крови", Artist: "Кино", ISRC: "RUA128800001"},
+		{Playlist: "Road trip", Position: 1, Title: "Intro", Artist: "EKKSTACY", Album: "misery"},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(createJobQuery).WithArgs(job.UserID, 2).WillReturnRows(jobRows(job))
+	for _, item := range items {
+		mock.ExpectExec(createItemQuery).
+			WithArgs(job.ID, item.Playlist, item.Position, item.Title, item.Artist, item.Album, item.ISRC).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	created, err := migrationPGRepository.CreateJob(context.Background(), job.UserID, items)
+	require.NoError(t, err)
+	require.Equal(t, job, created)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryClaimJob(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New(), Status: models.MigrationRunning, Attempts: 1}
+
+	mock.ExpectQuery(claimJobQuery).WithArgs(600.0).WillReturnRows(jobRows(job))
+	claimed, err := migrationPGRepository.ClaimJob(context.Background(), 10*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job, claimed)
+
+	mock.ExpectQuery(claimJobQuery).WithArgs(600.0).WillReturnError(sql.ErrNoRows)
+	_, err = migrationPGRepository.ClaimJob(context.Background(), 10*time.Minute)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryGetItems(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	jobID := uuid.New()
+	columns := []string{
+		"id", "job_id", "playlist", "position", "title", "artist", "album", "isrc", "status",
+		"track_id", "score", "candidates", "candidate_scores",
+	}
+	rows := sqlmock.NewRows(columns).
+		AddRow(3, jobID, "", 1, "Группа крови", "Кино", "", "", models.MigrationItemAmbiguous, 0, 0.7, "{2,1}", "{0.7,0.65}").
+		AddRow(4, jobID, "Mix", 1, "Intro", "EKKSTACY", "", "", models.MigrationItemAmbiguous, 0, 0.5, "{5}", "{0.5}")
+
+	statuses := []string{models.MigrationItemAmbiguous}
+	mock.ExpectQuery(getItemsQuery).WithArgs(jobID, pq.Array(statuses), 2, 100).WillReturnRows(rows)
+
+	items, err := migrationPGRepository.GetItems(context.Background(), jobID, statuses, 2, 100)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, []uint64{2, 1}, items[0].Candidates)
+	require.Equal(t, []float64{0.7, 0.65}, items[0].CandidateScores)
+	require.Equal(t, "Mix", items[1].Playlist)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositorySaveMatch(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	item := &models.MigrationItem{
+		ID:              3,
+		Status:          models.MigrationItemAmbiguous,
+		Score:           0.7,
+		Candidates:      []uint64{2, 1},
+		CandidateScores: []float64{0.7, 0.65},
+	}
+
+	mock.ExpectExec(saveMatchQuery).
+		WithArgs(item.ID, item.Status, item.TrackID, item.Score, pq.Array([]int64{2, 1}), pq.Array(item.CandidateScores)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, migrationPGRepository.SaveMatch(context.Background(), item))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryResolveItem(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New(), Status: models.MigrationCompleted, Matched: 1}
+
+	mock.ExpectQuery(resolveItemQuery).WithArgs(job.ID, 3, 2).WillReturnRows(jobRows(job))
+	resolved, err := migrationPGRepository.ResolveItem(context.Background(), job.ID, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, job, resolved)
+
+	mock.ExpectQuery(resolveItemQuery).WithArgs(job.ID, 3, 2).WillReturnRows(sqlmock.NewRows(jobColumnNames))
+	_, err = migrationPGRepository.ResolveItem(context.Background(), job.ID, 3, 2)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryFindCandidates(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	titles := []string{"gruppa krovi", "группа крови"}
+	artists := []string{"kino", "кино"}
+	rows := sqlmock.NewRows([]string{"id", "name", "name", "name", "duration", "image"}).
+		AddRow(1, "Группа крови", "Кино", "Группа крови", 285, "kino.webp")
+
+	mock.ExpectQuery(findCandidatesQuery).WithArgs(pq.Array(titles), pq.Array(artists), 20).WillReturnRows(rows)
+
+	candidates, err := migrationPGRepository.FindCandidates(context.Background(), titles, artists, 20)
+	require.NoError(t, err)
+	require.Equal(t, []*models.MigrationCandidate{
+		{TrackID: 1, Name: "Группа крови", Artist: "Кино", Album: "Группа крови", Duration: 285, Image: "kino.webp"},
+	}, candidates)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryFindPlaylist(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	jobID := uuid.New()
+
+	mock.ExpectQuery(findPlaylistQuery).WithArgs(jobID, "Road trip").
+		WillReturnRows(sqlmock.NewRows([]string{"playlist_id"}).AddRow(7))
+	playlistID, err := migrationPGRepository.FindPlaylist(context.Background(), jobID, "Road trip")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), playlistID)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrationRepositoryCreatePlaylist(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrationPGRepository := NewMigrationPGRepository(db)
+	jobID := uuid.New()
+	ownerID := uuid.New()
+	newPlaylist := &models.Playlist{Name: "Mix", Image: "default.webp", OwnerID: ownerID}
+	columns := []string{"id", "name", "image", "owner_id", "is_private", "created_at", "updated_at"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(playlistRepo.CreatePlaylistQuery).WithArgs("Mix", "default.webp", ownerID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(8, "Mix", "default.webp", ownerID, false, time.Now(), time.Now()))
+	mock.ExpectExec(playlistRepo.AddToPlaylistQuery).WithArgs(uint64(8), uint64(1), uint64(7)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(playlistRepo.RecordVersionQuery).WithArgs(uint64(8), ownerID, models.PlaylistChangeCreate, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}).
+			AddRow(8, 1, ownerID, "create", nil, "Mix", "{7}", time.Now()))
+	mock.ExpectExec(savePlaylistQuery).WithArgs(jobID, "Mix", uint64(8)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	created, err := migrationPGRepository.CreatePlaylist(context.Background(), jobID, "Mix", newPlaylist, []uint64{7})
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), created.ID)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(playlistRepo.CreatePlaylistQuery).WithArgs("Mix", "default.webp", ownerID).
+		WillReturnError(&pq.Error{Code: "23505"})
+	mock.ExpectRollback()
+
+	_, err = migrationPGRepository.CreatePlaylist(context.Background(), jobID, "Mix", newPlaylist, []uint64{7})
+	require.ErrorIs(t, err, playlist.ErrNameTaken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,131 @@
+package repository
+
+const (
+	jobColumns = `id, user_id, status, total, processed, matched, ambiguous, unmatched, attempts, error, created_at, updated_at`
+
+	createJobQuery = `
+    INSERT INTO migration_job (user_id, total)
+    VALUES ($1, $2)
+    RETURNING ` + jobColumns
+
+	createItemQuery = `
+    INSERT INTO migration_item (job_id, playlist, position, title, artist, album, isrc)
+    VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	findJobQuery = `
+    SELECT ` + jobColumns + `
+    FROM migration_job
+    WHERE id = $1`
+
+	// claimJob skips the jobs locked by other workers, so every job is
+	// run by one of them at a time.
+	claimJobQuery = `
+    UPDATE migration_job
+    SET status = 'running', attempts = attempts + 1, updated_at = NOW()
+    WHERE id = (
+      SELECT id
+      FROM migration_job
+      WHERE status = 'pending'
+        OR status = 'running' AND updated_at < NOW() - make_interval(secs => $1)
+      ORDER BY created_at
+      FOR UPDATE SKIP LOCKED
+      LIMIT 1
+    )
+    RETURNING ` + jobColumns
+
+	finishJobQuery = `
+    UPDATE migration_job
+    SET status = $2, error = $3, updated_at = NOW()
+    WHERE id = $1`
+
+	itemColumns = `id, job_id, playlist, position, title, artist, album, isrc, status,
+      COALESCE(track_id, 0), score, candidates, candidate_scores`
+
+	getItemsQuery = `
+    SELECT ` + itemColumns + `
+    FROM migration_item
+    WHERE job_id = $1 AND status = ANY($2) AND id > $3
+    ORDER BY id
+    LIMIT $4`
+
+	findItemQuery = `
+    SELECT ` + itemColumns + `
+    FROM migration_item
+    WHERE job_id = $1 AND id = $2`
+
+	// saveMatch also bumps updated_at of the job, which tells that its
+	// worker is still alive.
+	saveMatchQuery = `
+    WITH item AS (
+      UPDATE migration_item
+      SET status = $2, track_id = NULLIF($3::INT, 0), score = $4, candidates = $5, candidate_scores = $6
+      WHERE id = $1 AND status = 'pending'
+      RETURNING job_id, status
+    )
+    UPDATE migration_job AS j
+    SET processed = j.processed + 1,
+      matched = j.matched + (item.status = 'matched')::INT,
+      ambiguous = j.ambiguous + (item.status = 'ambiguous')::INT,
+      unmatched = j.unmatched + (item.status = 'unmatched')::INT,
+      updated_at = NOW()
+    FROM item
+    WHERE j.id = item.job_id`
+
+	resolveItemQuery = `
+    WITH item AS (
+      UPDATE migration_item
+      SET status = CASE WHEN $3::INT = 0 THEN 'skipped' ELSE 'resolved' END, track_id = NULLIF($3::INT, 0)
+      WHERE job_id = $1 AND id = $2 AND status = 'ambiguous'
+      RETURNING job_id, status
+    )
+    UPDATE migration_job AS j
+    SET ambiguous = j.ambiguous - 1,
+      matched = j.matched + (item.status = 'resolved')::INT,
+      unmatched = j.unmatched + (item.status = 'skipped')::INT,
+      updated_at = NOW()
+    FROM item
+    WHERE j.id = item.job_id
+    RETURNING j.id, j.user_id, j.status, j.total, j.processed, j.matched, j.ambiguous, j.unmatched,
+      j.attempts, j.error, j.created_at, j.updated_at`
+
+	candidateColumns = `t.id, t.name, ar.name, al.name, COALESCE(t.duration, 0), COALESCE(t.image, '')`
+
+	findByISRCQuery = `
+    SELECT ` + candidateColumns + `
+    FROM track AS t
+      JOIN artist AS ar ON ar.id = t.artist_id
+      JOIN album AS al ON al.id = t.album_id
+    WHERE t.isrc = $1
+    ORDER BY t.id`
+
+	// findCandidates relies on the trigram indexes, % is true for the
+	// titles over the pg_trgm similarity threshold. The artist similarity
+	// is added to the rank so the track of the right artist isn't cut off
+	// by the limit when many tracks share the title.
+	findCandidatesQuery = `
+    SELECT ` + candidateColumns + `
+    FROM track AS t
+      JOIN artist AS ar ON ar.id = t.artist_id
+      JOIN album AS al ON al.id = t.album_id
+    WHERE lower(t.name) % ANY($1)
+    ORDER BY (SELECT max(similarity(lower(t.name), title)) FROM unnest($1::TEXT[]) AS title)
+      + COALESCE((SELECT max(similarity(normalize_name(ar.name), artist)) FROM unnest($2::TEXT[]) AS artist), 0) DESC, t.id
+    LIMIT $3`
+
+	getCandidatesQuery = `
+    SELECT ` + candidateColumns + `
+    FROM track AS t
+      JOIN artist AS ar ON ar.id = t.artist_id
+      JOIN album AS al ON al.id = t.album_id
+    WHERE t.id = ANY($1)`
+
+	findPlaylistQuery = `
+    SELECT playlist_id
+    FROM migration_playlist
+    WHERE job_id = $1 AND name = $2`
+
+	savePlaylistQuery = `
+    INSERT INTO migration_playlist (job_id, name, playlist_id)
+    VALUES ($1, $2, $3)
+    ON CONFLICT (job_id, name) DO UPDATE SET playlist_id = EXCLUDED.playlist_id`
+)
@@ -0,0 +1,19 @@
+package migration
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/libraryexport"
+	uuid "github.com/google/uuid"
+)
+
+type Usecase interface {
+	StartMigration(ctx context.Context, userID uuid.UUID, entries []*libraryexport.Entry) (*dto.JobDTO, error)
+	GetJob(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*dto.JobDTO, error)
+	GetReview(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) ([]*dto.ReviewItemDTO, error)
+	ResolveItem(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, itemID uint64, trackID uint64) (*dto.JobDTO, error)
+	// ProcessNext runs the next migration waiting for the worker and reports
+	// whether there was one.
+	ProcessNext(ctx context.Context) (bool, error)
+}
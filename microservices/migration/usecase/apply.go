@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+)
+
+const (
+	defaultImage = "default.webp"
+	// maxNameAttempts bounds the suffixes tried when a name is taken.
+	maxNameAttempts = 10
+)
+
+// applyMatches adds the matched tracks to the favorites and to new playlists
+// named after the ones of the library. It is safe to run again after a
+// failure: favorites are added once and playlists are created once per job.
+func (usecase *migrationUsecase) applyMatches(ctx context.Context, job *models.MigrationJob) error {
+	var names []string
+	tracks := make(map[string][]uint64)
+	seen := make(map[string]map[uint64]bool)
+
+	matched := []string{models.MigrationItemMatched}
+	for afterID := uint64(0); ; {
+		items, err := usecase.migrationRepo.GetItems(ctx, job.ID, matched, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			break
+		}
+
+		for _, item := range items {
+			afterID = item.ID
+			if seen[item.Playlist] == nil {
+				seen[item.Playlist] = make(map[uint64]bool)
+				names = append(names, item.Playlist)
+			}
+			if !seen[item.Playlist][item.TrackID] {
+				seen[item.Playlist][item.TrackID] = true
+				tracks[item.Playlist] = append(tracks[item.Playlist], item.TrackID)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if err := usecase.apply(ctx, job, name, tracks[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apply adds the tracks to the favorites when name is empty, otherwise to
+// the playlist created for the library playlist of this name.
+func (usecase *migrationUsecase) apply(ctx context.Context, job *models.MigrationJob, name string, trackIDs []uint64) error {
+	if name == "" {
+		for _, trackID := range trackIDs {
+			if err := usecase.trackRepo.AddFavoriteTrack(ctx, job.UserID, trackID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	playlistID, err := usecase.migrationRepo.FindPlaylist(ctx, job.ID, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return usecase.createPlaylist(ctx, job, name, trackIDs)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, trackID := range trackIDs {
+		length, err := usecase.playlistRepo.GetLengthPlaylist(ctx, playlistID)
+		if err != nil {
+			return err
+		}
+		if _, err := usecase.playlistRepo.AddToPlaylist(ctx, playlistID, length+1, trackID, job.UserID); err != nil {
+			if errors.Is(err, playlist.ErrTrackInPlaylist) {
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// createPlaylist creates the playlist of the user for a library playlist,
+// adding a number to the name when the user already has one like it.
+func (usecase *migrationUsecase) createPlaylist(ctx context.Context, job *models.MigrationJob, name string, trackIDs []uint64) error {
+	for attempt := 1; attempt <= maxNameAttempts; attempt++ {
+		newPlaylist := &models.Playlist{Name: playlistName(name, attempt), Image: defaultImage, OwnerID: job.UserID}
+		_, err := usecase.migrationRepo.CreatePlaylist(ctx, job.ID, name, newPlaylist, trackIDs)
+		if errors.Is(err, playlist.ErrNameTaken) {
+			continue
+		}
+		return err
+	}
+
+	return fmt.Errorf("no free name for playlist '%s': %w", name, playlist.ErrNameTaken)
+}
+
+// playlistName returns the name of the playlist created for a library
+// playlist, numbered from the second attempt and cut to fit the column.
+func playlistName(name string, attempt int) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = playlist.ImportedName
+	}
+
+	suffix := ""
+	if attempt > 1 {
+		suffix = fmt.Sprintf(" (%d)", attempt)
+	}
+	if runes := []rune(name); len(runes)+len(suffix) > playlist.MaxNameLength {
+		name = strings.TrimSpace(string(runes[:playlist.MaxNameLength-len(suffix)]))
+	}
+	return name + suffix
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/matching"
+)
+
+const (
+	// confidentScore is the score a track is matched with, provided that
+	// it leads the next candidate by confidentMargin.
+	confidentScore  = 0.8
+	confidentMargin = 0.1
+	// reviewScore is the lowest score of a candidate shown for review.
+	reviewScore = 0.45
+	// maxCandidates bounds the tracks looked up for an item.
+	maxCandidates = 20
+	// maxReviewCandidates bounds the candidates kept for review.
+	maxReviewCandidates = 5
+)
+
+type scoredCandidate struct {
+	*models.MigrationCandidate
+	score float64
+}
+
+func (usecase *migrationUsecase) matchItems(ctx context.Context, job *models.MigrationJob) error {
+	pending := []string{models.MigrationItemPending}
+	for afterID := uint64(0); ; {
+		items, err := usecase.migrationRepo.GetItems(ctx, job.ID, pending, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+
+		for _, item := range items {
+			if err := usecase.matchItem(ctx, item); err != nil {
+				return err
+			}
+			if err := usecase.migrationRepo.SaveMatch(ctx, item); err != nil {
+				return err
+			}
+			afterID = item.ID
+		}
+	}
+}
+
+// matchItem sets the status of the item: matched to the only track with its
+// ISRC or to a similar track well ahead of the others, ambiguous when several
+// are alike and unmatched when none is.
+func (usecase *migrationUsecase) matchItem(ctx context.Context, item *models.MigrationItem) error {
+	if item.ISRC != "" {
+		tracks, err := usecase.migrationRepo.FindByISRC(ctx, item.ISRC)
+		if err != nil {
+			return err
+		}
+		if len(tracks) == 1 {
+			item.Status = models.MigrationItemMatched
+			item.TrackID = tracks[0].TrackID
+			item.Score = 1
+			return nil
+		}
+	}
+
+	item.Status = models.MigrationItemUnmatched
+	titles := matching.Variants(item.Title)
+	if len(titles) == 0 {
+		return nil
+	}
+
+	found, err := usecase.migrationRepo.FindCandidates(ctx, titles, artistVariants(item.Artist), maxCandidates)
+	if err != nil {
+		return err
+	}
+	candidates := rankCandidates(found, item)
+	if len(candidates) == 0 || candidates[0].score < reviewScore {
+		return nil
+	}
+
+	best := candidates[0]
+	if best.score >= confidentScore && (len(candidates) == 1 || best.score-candidates[1].score >= confidentMargin) {
+		item.Status = models.MigrationItemMatched
+		item.TrackID = best.TrackID
+		item.Score = best.score
+		return nil
+	}
+
+	item.Status = models.MigrationItemAmbiguous
+	item.Score = best.score
+	for _, candidate := range candidates {
+		if candidate.score < reviewScore || len(item.Candidates) == maxReviewCandidates {
+			break
+		}
+		item.Candidates = append(item.Candidates, candidate.TrackID)
+		item.CandidateScores = append(item.CandidateScores, candidate.score)
+	}
+	return nil
+}
+
+// artistVariants returns the credited artists of an item in the forms
+// compared with the catalog names.
+func artistVariants(artist string) []string {
+	var variants []string
+	for _, name := range matching.Artists(artist) {
+		variants = append(variants, matching.Variants(name)...)
+	}
+	return variants
+}
+
+func rankCandidates(found []*models.MigrationCandidate, item *models.MigrationItem) []scoredCandidate {
+	candidates := make([]scoredCandidate, 0, len(found))
+	for _, candidate := range found {
+		track := &matching.Track{
+			ID:     candidate.TrackID,
+			Title:  candidate.Name,
+			Artist: candidate.Artist,
+			Album:  candidate.Album,
+		}
+		candidates = append(candidates, scoredCandidate{candidate, matching.Score(track, item.Title, item.Artist, item.Album)})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].TrackID < candidates[j].TrackID
+	})
+	return candidates
+}
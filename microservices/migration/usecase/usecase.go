@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/libraryexport"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	uuid "github.com/google/uuid"
+)
+
+const (
+	// staleAfter is how long a running job may go without progress before
+	// another worker takes it over.
+	staleAfter = 10 * time.Minute
+	// maxAttempts bounds the runs of a job that keeps failing.
+	maxAttempts = 3
+	batchSize   = 100
+	// maxReviewItems bounds the ambiguous items returned for review.
+	maxReviewItems = 1000
+)
+
+type migrationUsecase struct {
+	migrationRepo migration.Repo
+	trackRepo     track.Repo
+	playlistRepo  playlist.Repository
+	logger        logger.Logger
+}
+
+func NewMigrationUsecase(migrationRepo migration.Repo, trackRepo track.Repo, playlistRepo playlist.Repository, logger logger.Logger) migration.Usecase {
+	return &migrationUsecase{migrationRepo, trackRepo, playlistRepo, logger}
+}
+
+func (usecase *migrationUsecase) StartMigration(ctx context.Context, userID uuid.UUID, entries []*libraryexport.Entry) (*dto.JobDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if len(entries) == 0 {
+		return nil, migration.ErrEmptyLibrary
+	}
+
+	positions := make(map[string]uint64)
+	items := make([]*models.MigrationItem, 0, len(entries))
+	for _, entry := range entries {
+		positions[entry.Playlist]++
+		items = append(items, &models.MigrationItem{
+			Playlist: entry.Playlist,
+			Position: positions[entry.Playlist],
+			Title:    entry.Title,
+			Artist:   entry.Artist,
+			Album:    entry.Album,
+			ISRC:     entry.ISRC,
+		})
+	}
+
+	job, err := usecase.migrationRepo.CreateJob(ctx, userID, items)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't create migration of user %v: %v", userID, err), requestID)
+		return nil, fmt.Errorf("Can't create migration")
+	}
+	usecase.logger.Infof("Created migration %v of %d tracks for user %v", job.ID, len(items), userID)
+
+	return dto.NewJobDTO(job), nil
+}
+
+func (usecase *migrationUsecase) GetJob(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*dto.JobDTO, error) {
+	job, err := usecase.findJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.NewJobDTO(job), nil
+}
+
+func (usecase *migrationUsecase) GetReview(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) ([]*dto.ReviewItemDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if _, err := usecase.findJob(ctx, userID, jobID); err != nil {
+		return nil, err
+	}
+
+	items, err := usecase.migrationRepo.GetItems(ctx, jobID, []string{models.MigrationItemAmbiguous}, 0, maxReviewItems)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load ambiguous items of migration %v: %v", jobID, err), requestID)
+		return nil, fmt.Errorf("Can't load migration review")
+	}
+
+	var trackIDs []uint64
+	for _, item := range items {
+		trackIDs = append(trackIDs, item.Candidates...)
+	}
+	tracks := make(map[uint64]*models.MigrationCandidate)
+	if len(trackIDs) > 0 {
+		candidates, err := usecase.migrationRepo.GetCandidates(ctx, trackIDs)
+		if err != nil {
+			usecase.logger.Warn(fmt.Sprintf("Can't load candidates of migration %v: %v", jobID, err), requestID)
+			return nil, fmt.Errorf("Can't load migration review")
+		}
+		for _, candidate := range candidates {
+			tracks[candidate.TrackID] = candidate
+		}
+	}
+
+	review := make([]*dto.ReviewItemDTO, 0, len(items))
+	for _, item := range items {
+		reviewItem := &dto.ReviewItemDTO{
+			ID:         item.ID,
+			Playlist:   item.Playlist,
+			Position:   item.Position,
+			Title:      item.Title,
+			Artist:     item.Artist,
+			Album:      item.Album,
+			Candidates: []*dto.CandidateDTO{},
+		}
+		for i, trackID := range item.Candidates {
+			// the track may have been removed from the catalog since
+			candidate, ok := tracks[trackID]
+			if !ok {
+				continue
+			}
+			var score float64
+			if i < len(item.CandidateScores) {
+				score = item.CandidateScores[i]
+			}
+			reviewItem.Candidates = append(reviewItem.Candidates, dto.NewCandidateDTO(candidate, score))
+		}
+		review = append(review, reviewItem)
+	}
+
+	return review, nil
+}
+
+func (usecase *migrationUsecase) ResolveItem(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, itemID uint64, trackID uint64) (*dto.JobDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	job, err := usecase.findJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	// the worker applies the resolved items of a running job too, so the
+	// review waits for it to avoid applying them twice
+	if job.Status != models.MigrationCompleted {
+		return nil, migration.ErrJobNotFinished
+	}
+
+	item, err := usecase.migrationRepo.FindItem(ctx, jobID, itemID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find item %d of migration %v: %v", itemID, jobID, err), requestID)
+		return nil, migration.ErrItemNotFound
+	}
+	if item.Status != models.MigrationItemAmbiguous {
+		return nil, migration.ErrItemResolved
+	}
+	if trackID != 0 && !containsID(item.Candidates, trackID) {
+		return nil, migration.ErrInvalidChoice
+	}
+
+	updatedJob, err := usecase.migrationRepo.ResolveItem(ctx, jobID, itemID, trackID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't resolve item %d of migration %v: %v", itemID, jobID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, migration.ErrItemResolved
+		}
+		return nil, fmt.Errorf("Can't resolve migration item")
+	}
+
+	if trackID != 0 {
+		if err := usecase.apply(ctx, job, item.Playlist, []uint64{trackID}); err != nil {
+			usecase.logger.Warn(fmt.Sprintf("Can't apply item %d of migration %v: %v", itemID, jobID, err), requestID)
+			return nil, fmt.Errorf("Can't apply migration item")
+		}
+	}
+
+	return dto.NewJobDTO(updatedJob), nil
+}
+
+func (usecase *migrationUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	job, err := usecase.migrationRepo.ClaimJob(ctx, staleAfter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("can't claim migration: %w", err)
+	}
+
+	if job.Attempts > maxAttempts {
+		usecase.logger.Warnf("Giving up migration %v after %d attempts", job.ID, maxAttempts)
+		if err := usecase.migrationRepo.FinishJob(ctx, job.ID, models.MigrationFailed, "Migration failed, try again later"); err != nil {
+			return true, fmt.Errorf("can't fail migration %v: %w", job.ID, err)
+		}
+		return true, nil
+	}
+
+	// a failed run leaves the job running, it is retried once stale
+	if err := usecase.matchItems(ctx, job); err != nil {
+		return true, fmt.Errorf("can't match migration %v: %w", job.ID, err)
+	}
+	if err := usecase.applyMatches(ctx, job); err != nil {
+		return true, fmt.Errorf("can't apply migration %v: %w", job.ID, err)
+	}
+	if err := usecase.migrationRepo.FinishJob(ctx, job.ID, models.MigrationCompleted, ""); err != nil {
+		return true, fmt.Errorf("can't complete migration %v: %w", job.ID, err)
+	}
+	usecase.logger.Infof("Completed migration %v", job.ID)
+
+	return true, nil
+}
+
+func (usecase *migrationUsecase) findJob(ctx context.Context, userID uuid.UUID, jobID uuid.UUID) (*models.MigrationJob, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	job, err := usecase.migrationRepo.FindJob(ctx, jobID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find migration %v: %v", jobID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, migration.ErrJobNotFound
+		}
+		return nil, fmt.Errorf("Can't load migration")
+	}
+	if job.UserID != userID {
+		usecase.logger.Warn(fmt.Sprintf("User %v can't access migration %v", userID, jobID), requestID)
+		return nil, migration.ErrJobNotFound
+	}
+
+	return job, nil
+}
+
+func containsID(ids []uint64, id uint64) bool {
+	for _, x := range ids {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
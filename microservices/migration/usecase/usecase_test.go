@@ -0,0 +1,304 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration"
+	mockMigration "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/migration/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	mockPlaylist "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	mockTrack "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/libraryexport"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeps struct {
+	repo         *mockMigration.MockRepo
+	trackRepo    *mockTrack.MockRepo
+	playlistRepo *mockPlaylist.MockRepository
+	usecase      *migrationUsecase
+}
+
+func newTestUsecase(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	deps := &testDeps{
+		repo:         mockMigration.NewMockRepo(ctrl),
+		trackRepo:    mockTrack.NewMockRepo(ctrl),
+		playlistRepo: mockPlaylist.NewMockRepository(ctrl),
+	}
+	deps.usecase = NewMigrationUsecase(deps.repo, deps.trackRepo, deps.playlistRepo, logger.New(&cfg.Service.Logger)).(*migrationUsecase)
+
+	return deps
+}
+
+func TestUsecase_StartMigration(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	entries := []*libraryexport.Entry{
+		{Title: "Группа крови", Artist: "Кино"},
+		{Playlist: "Road trip", Title: "Intro", Artist: "EKKSTACY"},
+		{Title: "Кукушка", Artist: "Кино", ISRC: "RUA128900001"},
+	}
+
+	job := &models.MigrationJob{ID: uuid.New(), UserID: userID, Status: models.MigrationPending, Total: 3}
+	deps.repo.EXPECT().CreateJob(gomock.Any(), userID, []*models.MigrationItem{
+		{Position: 1, Title: "Группа крови", Artist: "Кино"},
+		{Playlist: "Road trip", Position: 1, Title: "Intro", Artist: "EKKSTACY"},
+		{Position: 2, Title: "Кукушка", Artist: "Кино", ISRC: "RUA128900001"},
+	}).Return(job, nil)
+
+	jobDTO, err := deps.usecase.StartMigration(context.Background(), userID, entries)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, jobDTO.ID)
+	require.Equal(t, uint64(0), jobDTO.Progress)
+
+	_, err = deps.usecase.StartMigration(context.Background(), userID, nil)
+	require.ErrorIs(t, err, migration.ErrEmptyLibrary)
+}
+
+func TestUsecase_GetJob_OtherUser(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New()}
+	deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+
+	_, err := deps.usecase.GetJob(context.Background(), uuid.New(), job.ID)
+	require.ErrorIs(t, err, migration.ErrJobNotFound)
+
+	missingID := uuid.New()
+	deps.repo.EXPECT().FindJob(gomock.Any(), missingID).Return(nil, sql.ErrNoRows)
+	_, err = deps.usecase.GetJob(context.Background(), job.UserID, missingID)
+	require.ErrorIs(t, err, migration.ErrJobNotFound)
+}
+
+func TestUsecase_MatchItem(t *testing.T) {
+	t.Parallel()
+
+	kino := &models.MigrationCandidate{TrackID: 1, Name: "Группа крови", Artist: "Кино", Album: "Группа крови"}
+	cover := &models.MigrationCandidate{TrackID: 2, Name: "Группа крови", Artist: "Кино", Album: "Кино. Трибьют"}
+	other := &models.MigrationCandidate{TrackID: 3, Name: "Группа", Artist: "Другие"}
+
+	t.Run("ISRC", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		item := &models.MigrationItem{Title: "Blood Type", ISRC: "RUA128800001"}
+		deps.repo.EXPECT().FindByISRC(gomock.Any(), "RUA128800001").Return([]*models.MigrationCandidate{kino}, nil)
+
+		require.NoError(t, deps.usecase.matchItem(context.Background(), item))
+		require.Equal(t, models.MigrationItemMatched, item.Status)
+		require.Equal(t, uint64(1), item.TrackID)
+	})
+
+	t.Run("Transliterated", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		item := &models.MigrationItem{Title: "Gruppa Krovi", Artist: "Kino"}
+		deps.repo.EXPECT().FindCandidates(gomock.Any(), []string{"gruppa krovi", "группа крови"}, []string{"kino", "кино"}, maxCandidates).
+			Return([]*models.MigrationCandidate{other, kino}, nil)
+
+		require.NoError(t, deps.usecase.matchItem(context.Background(), item))
+		require.Equal(t, models.MigrationItemMatched, item.Status)
+		require.Equal(t, uint64(1), item.TrackID)
+		require.InDelta(t, 1.0, item.Score, 1e-9)
+	})
+
+	t.Run("Ambiguous", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		item := &models.MigrationItem{Title: "Группа крови", Artist: "Кино"}
+		deps.repo.EXPECT().FindCandidates(gomock.Any(), gomock.Any(), gomock.Any(), maxCandidates).
+			Return([]*models.MigrationCandidate{cover, kino, other}, nil)
+
+		require.NoError(t, deps.usecase.matchItem(context.Background(), item))
+		require.Equal(t, models.MigrationItemAmbiguous, item.Status)
+		require.Equal(t, []uint64{1, 2}, item.Candidates)
+		require.Len(t, item.CandidateScores, 2)
+	})
+
+	t.Run("Unmatched", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		item := &models.MigrationItem{Title: "Something else", Artist: "Nobody"}
+		deps.repo.EXPECT().FindCandidates(gomock.Any(), gomock.Any(), gomock.Any(), maxCandidates).
+			Return([]*models.MigrationCandidate{other}, nil)
+
+		require.NoError(t, deps.usecase.matchItem(context.Background(), item))
+		require.Equal(t, models.MigrationItemUnmatched, item.Status)
+		require.Zero(t, item.TrackID)
+	})
+}
+
+func TestUsecase_ProcessNext(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New(), Status: models.MigrationRunning, Attempts: 1}
+	pending := []*models.MigrationItem{
+		{ID: 1, JobID: job.ID, Title: "Liked", ISRC: "RUA128800001"},
+		{ID: 2, JobID: job.ID, Playlist: "Road trip", Title: "Intro", ISRC: "USUM71703861"},
+	}
+	matched := []*models.MigrationItem{
+		{ID: 1, JobID: job.ID, Status: models.MigrationItemMatched, TrackID: 10},
+		{ID: 2, JobID: job.ID, Playlist: "Road trip", Status: models.MigrationItemMatched, TrackID: 20},
+	}
+
+	deps.repo.EXPECT().ClaimJob(gomock.Any(), staleAfter).Return(job, nil)
+	gomock.InOrder(
+		deps.repo.EXPECT().GetItems(gomock.Any(), job.ID, []string{models.MigrationItemPending}, uint64(0), batchSize).Return(pending, nil),
+		deps.repo.EXPECT().GetItems(gomock.Any(), job.ID, []string{models.MigrationItemPending}, uint64(2), batchSize).Return(nil, nil),
+	)
+	deps.repo.EXPECT().FindByISRC(gomock.Any(), "RUA128800001").Return([]*models.MigrationCandidate{{TrackID: 10}}, nil)
+	deps.repo.EXPECT().FindByISRC(gomock.Any(), "USUM71703861").Return([]*models.MigrationCandidate{{TrackID: 20}}, nil)
+	deps.repo.EXPECT().SaveMatch(gomock.Any(), gomock.Any()).Times(2)
+
+	gomock.InOrder(
+		deps.repo.EXPECT().GetItems(gomock.Any(), job.ID, []string{models.MigrationItemMatched}, uint64(0), batchSize).Return(matched, nil),
+		deps.repo.EXPECT().GetItems(gomock.Any(), job.ID, []string{models.MigrationItemMatched}, uint64(2), batchSize).Return(nil, nil),
+	)
+	deps.trackRepo.EXPECT().AddFavoriteTrack(gomock.Any(), job.UserID, uint64(10)).Return(nil)
+	deps.repo.EXPECT().FindPlaylist(gomock.Any(), job.ID, "Road trip").Return(uint64(0), sql.ErrNoRows)
+	gomock.InOrder(
+		deps.repo.EXPECT().CreatePlaylist(gomock.Any(), job.ID, "Road trip", &models.Playlist{Name: "Road trip", Image: defaultImage, OwnerID: job.UserID}, []uint64{20}).
+			Return(nil, playlist.ErrNameTaken),
+		deps.repo.EXPECT().CreatePlaylist(gomock.Any(), job.ID, "Road trip", &models.Playlist{Name: "Road trip (2)", Image: defaultImage, OwnerID: job.UserID}, []uint64{20}).
+			Return(&models.Playlist{ID: 7}, nil),
+	)
+	deps.repo.EXPECT().FinishJob(gomock.Any(), job.ID, models.MigrationCompleted, "").Return(nil)
+
+	processed, err := deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+	require.Equal(t, models.MigrationItemMatched, pending[0].Status)
+	require.Equal(t, uint64(20), pending[1].TrackID)
+}
+
+func TestUsecase_ProcessNext_Idle(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	deps.repo.EXPECT().ClaimJob(gomock.Any(), staleAfter).Return(nil, sql.ErrNoRows)
+
+	processed, err := deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.False(t, processed)
+}
+
+func TestUsecase_ProcessNext_TooManyAttempts(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	job := &models.MigrationJob{ID: uuid.New(), Status: models.MigrationRunning, Attempts: maxAttempts + 1}
+	deps.repo.EXPECT().ClaimJob(gomock.Any(), staleAfter).Return(job, nil)
+	deps.repo.EXPECT().FinishJob(gomock.Any(), job.ID, models.MigrationFailed, gomock.Any()).Return(nil)
+
+	processed, err := deps.usecase.ProcessNext(context.Background())
+	require.NoError(t, err)
+	require.True(t, processed)
+}
+
+func TestUsecase_GetReview(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	job := &models.MigrationJob{ID: uuid.New(), UserID: uuid.New(), Status: models.MigrationCompleted}
+	deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+	deps.repo.EXPECT().GetItems(gomock.Any(), job.ID, []string{models.MigrationItemAmbiguous}, uint64(0), maxReviewItems).Return(
+		[]*models.MigrationItem{{ID: 5, Title: "Группа крови", Candidates: []uint64{2, 1}, CandidateScores: []float64{0.9, 0.85}}}, nil,
+	)
+	deps.repo.EXPECT().GetCandidates(gomock.Any(), []uint64{2, 1}).Return(
+		[]*models.MigrationCandidate{{TrackID: 1, Name: "Группа крови"}, {TrackID: 2, Name: "Группа крови"}}, nil,
+	)
+
+	review, err := deps.usecase.GetReview(context.Background(), job.UserID, job.ID)
+	require.NoError(t, err)
+	require.Len(t, review, 1)
+	require.Len(t, review[0].Candidates, 2)
+	require.Equal(t, uint64(2), review[0].Candidates[0].TrackID)
+	require.Equal(t, 0.9, review[0].Candidates[0].Score)
+}
+
+func TestUsecase_ResolveItem(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	newJob := func() *models.MigrationJob {
+		return &models.MigrationJob{ID: uuid.New(), UserID: userID, Status: models.MigrationCompleted, Ambiguous: 1}
+	}
+	ambiguous := func(jobID uuid.UUID, playlist string) *models.MigrationItem {
+		return &models.MigrationItem{ID: 5, JobID: jobID, Playlist: playlist, Status: models.MigrationItemAmbiguous, Candidates: []uint64{1, 2}}
+	}
+
+	t.Run("Playlist", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		job := newJob()
+		deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+		deps.repo.EXPECT().FindItem(gomock.Any(), job.ID, uint64(5)).Return(ambiguous(job.ID, "Road trip"), nil)
+		deps.repo.EXPECT().ResolveItem(gomock.Any(), job.ID, uint64(5), uint64(2)).Return(&models.MigrationJob{ID: job.ID, Matched: 1}, nil)
+		deps.repo.EXPECT().FindPlaylist(gomock.Any(), job.ID, "Road trip").Return(uint64(7), nil)
+		deps.playlistRepo.EXPECT().GetLengthPlaylist(gomock.Any(), uint64(7)).Return(uint64(3), nil)
+		deps.playlistRepo.EXPECT().AddToPlaylist(gomock.Any(), uint64(7), uint64(4), uint64(2), job.UserID).Return(nil, playlist.ErrTrackInPlaylist)
+
+		jobDTO, err := deps.usecase.ResolveItem(context.Background(), userID, job.ID, 5, 2)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), jobDTO.Matched)
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		job := newJob()
+		deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+		deps.repo.EXPECT().FindItem(gomock.Any(), job.ID, uint64(5)).Return(ambiguous(job.ID, ""), nil)
+		deps.repo.EXPECT().ResolveItem(gomock.Any(), job.ID, uint64(5), uint64(0)).Return(&models.MigrationJob{ID: job.ID, Unmatched: 1}, nil)
+
+		jobDTO, err := deps.usecase.ResolveItem(context.Background(), userID, job.ID, 5, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), jobDTO.Unmatched)
+	})
+
+	t.Run("NotCandidate", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		job := newJob()
+		deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+		deps.repo.EXPECT().FindItem(gomock.Any(), job.ID, uint64(5)).Return(ambiguous(job.ID, ""), nil)
+
+		_, err := deps.usecase.ResolveItem(context.Background(), userID, job.ID, 5, 3)
+		require.ErrorIs(t, err, migration.ErrInvalidChoice)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		job := newJob()
+		job.Status = models.MigrationRunning
+		deps.repo.EXPECT().FindJob(gomock.Any(), job.ID).Return(job, nil)
+
+		_, err := deps.usecase.ResolveItem(context.Background(), userID, job.ID, 5, 2)
+		require.ErrorIs(t, err, migration.ErrJobNotFinished)
+	})
+}
+
+func TestPlaylistName(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Road trip", playlistName(" Road trip ", 1))
+	require.Equal(t, "Road trip (2)", playlistName("Road trip", 2))
+	require.Equal(t, playlist.ImportedName, playlistName("", 1))
+	require.Equal(t, "A very long name of a play (10)", playlistName("A very long name of a playlist from Spotify", 10))
+}
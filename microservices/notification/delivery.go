@@ -0,0 +1,11 @@
+package notification
+
+import "net/http"
+
+type Handlers interface {
+	GetNotifications(response http.ResponseWriter, request *http.Request)
+	GetUnreadCount(response http.ResponseWriter, request *http.Request)
+	MarkRead(response http.ResponseWriter, request *http.Request)
+	MarkAllRead(response http.ResponseWriter, request *http.Request)
+	Stream(response http.ResponseWriter, request *http.Request)
+}
@@ -0,0 +1,268 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+// heartbeatInterval keeps idle event streams alive through proxies.
+const heartbeatInterval = 25 * time.Second
+
+type notificationHandlers struct {
+	usecase notification.Usecase
+	logger  logger.Logger
+}
+
+func NewNotificationHandlers(usecase notification.Usecase, logger logger.Logger) notification.Handlers {
+	return &notificationHandlers{usecase, logger}
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Retrieves the latest notifications of the authenticated user.
+// @Success 200 {array} dto.NotificationDTO "List of notifications"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get notifications"
+// @Router /api/v1/notifications [get]
+func (handlers *notificationHandlers) GetNotifications(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	notifications, err := handlers.usecase.GetNotifications(request.Context(), userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get notifications: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to get notifications: %v", err))
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(dto.NotificationDTOs(notifications))
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode notifications: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode notifications: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+// GetUnreadCount godoc
+// @Summary Get unread notifications count
+// @Description Returns how many notifications of the authenticated user are unread.
+// @Success 200 {object} dto.UnreadCountDTO "Unread notifications count"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to count notifications"
+// @Router /api/v1/notifications/unread [get]
+func (handlers *notificationHandlers) GetUnreadCount(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	count, err := handlers.usecase.GetUnreadCount(request.Context(), userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to count notifications: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Failed to count notifications")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(&dto.UnreadCountDTO{Count: count})
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+// MarkRead godoc
+// @Summary Mark notification as read
+// @Description Marks a single notification of the authenticated user as read.
+// @Param notificationID path int true "Notification ID"
+// @Success 200
+// @Failure 400 {object} utils.ErrorResponse "Invalid notification ID"
+// @Failure 404 {object} utils.ErrorResponse "Notification not found"
+// @Failure 500 {object} utils.ErrorResponse "Can't mark notification as read"
+// @Router /api/v1/notifications/{notificationID}/read [post]
+func (handlers *notificationHandlers) MarkRead(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	notificationID, err := strconv.ParseUint(vars["notificationID"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid notification ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, fmt.Sprintf("Invalid notification ID: %v", err))
+		return
+	}
+
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	if err := handlers.usecase.MarkRead(request.Context(), userID, notificationID); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Can't mark notification as read: %v", err), requestID)
+		if errors.Is(err, notification.ErrNotificationNotFound) {
+			utils.JSONError(response, http.StatusNotFound, "Notification wasn't found")
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "Can't mark notification as read")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// MarkAllRead godoc
+// @Summary Mark all notifications as read
+// @Description Marks every notification of the authenticated user as read.
+// @Success 200
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Can't mark notifications as read"
+// @Router /api/v1/notifications/read [post]
+func (handlers *notificationHandlers) MarkAllRead(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	if err := handlers.usecase.MarkAllRead(request.Context(), userID); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Can't mark notifications as read: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Can't mark notifications as read")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// Stream godoc
+// @Summary Stream notifications
+// @Description Opens a Server-Sent Events stream. The "unread" event carries the current unread count, every "notification" event carries a new notification.
+// @Produce text/event-stream
+// @Success 200
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Streaming is not supported"
+// @Router /api/v1/notifications/stream [get]
+func (handlers *notificationHandlers) Stream(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	controller := http.NewResponseController(response)
+	// the stream outlives the server write timeout
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		handlers.logger.Error(fmt.Sprintf("Failed to reset write deadline: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	notifications, unsubscribe := handlers.usecase.Subscribe(userID)
+	defer unsubscribe()
+
+	count, err := handlers.usecase.GetUnreadCount(request.Context(), userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to count notifications: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Failed to count notifications")
+		return
+	}
+
+	response.Header().Set("Content-Type", "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("Connection", "keep-alive")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+
+	rawBytes, _ := easyjson.Marshal(&dto.UnreadCountDTO{Count: count})
+	if err := handlers.writeEvent(controller, response, "unread", "", rawBytes); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write event: %v", err), requestID)
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-request.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(response, ": ping\n\n"); err != nil {
+				return
+			}
+			if err := controller.Flush(); err != nil {
+				return
+			}
+		case notification, ok := <-notifications:
+			if !ok {
+				return
+			}
+
+			rawBytes, err := easyjson.Marshal(notification)
+			if err != nil {
+				handlers.logger.Error(fmt.Sprintf("Failed to encode notification: %v", err), requestID)
+				continue
+			}
+
+			if err := handlers.writeEvent(controller, response, "notification", strconv.FormatUint(notification.ID, 10), rawBytes); err != nil {
+				handlers.logger.Error(fmt.Sprintf("Failed to write event: %v", err), requestID)
+				return
+			}
+		}
+	}
+}
+
+func (handlers *notificationHandlers) writeEvent(controller *http.ResponseController, response http.ResponseWriter, event, id string, data []byte) error {
+	if id != "" {
+		if _, err := fmt.Fprintf(response, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(response, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+
+	return controller.Flush()
+}
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationHandlers_GetUnreadCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	notificationHandlers := NewNotificationHandlers(usecaseMock, logger)
+
+	userID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		usecaseMock.EXPECT().GetUnreadCount(gomock.Any(), userID).Return(uint64(3), nil)
+
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/notifications/unread", nil)
+		request = request.WithContext(context.WithValue(request.Context(), utils.UserIDKey{}, userID))
+		response := httptest.NewRecorder()
+
+		notificationHandlers.GetUnreadCount(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		var unread dto.UnreadCountDTO
+		err := json.NewDecoder(response.Body).Decode(&unread)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), unread.Count)
+	})
+
+	t.Run("Missing user", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/notifications/unread", nil)
+		response := httptest.NewRecorder()
+
+		notificationHandlers.GetUnreadCount(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestNotificationHandlers_MarkRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	notificationHandlers := NewNotificationHandlers(usecaseMock, logger)
+
+	userID := uuid.New()
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/notifications/{notificationID:[0-9]+}/read", func(w http.ResponseWriter, r *http.Request) {
+		r = r.WithContext(context.WithValue(r.Context(), utils.UserIDKey{}, userID))
+		notificationHandlers.MarkRead(w, r)
+	}).Methods(http.MethodPost)
+
+	t.Run("Success", func(t *testing.T) {
+		usecaseMock.EXPECT().MarkRead(gomock.Any(), userID, uint64(1)).Return(nil)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/notifications/1/read", nil)
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		usecaseMock.EXPECT().MarkRead(gomock.Any(), userID, uint64(2)).Return(notification.ErrNotificationNotFound)
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/notifications/2/read", nil)
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("Usecase error", func(t *testing.T) {
+		usecaseMock.EXPECT().MarkRead(gomock.Any(), userID, uint64(3)).Return(errors.New("usecase error"))
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/notifications/3/read", nil)
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Code)
+	})
+}
+
+func TestNotificationHandlers_Stream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	notificationHandlers := NewNotificationHandlers(usecaseMock, logger)
+
+	userID := uuid.New()
+	notifications := make(chan *dto.NotificationDTO, 1)
+	notifications <- &dto.NotificationDTO{ID: 7, Type: "album_released", Message: "new album"}
+	close(notifications)
+
+	unsubscribed := false
+	usecaseMock.EXPECT().Subscribe(userID).Return((<-chan *dto.NotificationDTO)(notifications), func() { unsubscribed = true })
+	usecaseMock.EXPECT().GetUnreadCount(gomock.Any(), userID).Return(uint64(2), nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/notifications/stream", nil)
+	request = request.WithContext(context.WithValue(request.Context(), utils.UserIDKey{}, userID))
+	response := httptest.NewRecorder()
+
+	notificationHandlers.Stream(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "text/event-stream", response.Header().Get("Content-Type"))
+	assert.True(t, unsubscribed)
+
+	body := response.Body.String()
+	assert.True(t, strings.HasPrefix(body, "event: unread\ndata: {\"count\":2}\n\n"))
+	assert.Contains(t, body, "id: 7\nevent: notification\n")
+}
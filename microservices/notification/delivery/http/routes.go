@@ -0,0 +1,59 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/delivery/listener"
+	notificationHub "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/hub"
+	notificationRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/repository"
+	notificationUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/usecase"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(s *httpServer.Server, pgListener *pq.Listener) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	notificationRepo := notificationRepo.NewNotificationPGRepository(s.PG)
+	notificationHub := notificationHub.New()
+	notificationUsecase := notificationUsecase.NewNotificationUsecase(notificationRepo, notificationHub, s.Logger)
+	notificationHandlers := NewNotificationHandlers(notificationUsecase, s.Logger)
+
+	s.Lifecycle.Go("notification listener", func(ctx context.Context) error {
+		return listener.Run(ctx, pgListener, notificationUsecase, s.Logger)
+	})
+	// open streams would otherwise hold the server until the drain deadline
+	s.Lifecycle.Go("notification streams", func(ctx context.Context) error {
+		<-ctx.Done()
+		notificationHub.Close()
+		return nil
+	})
+
+	s.MUX.Handle(
+		"/api/v1/notifications",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(notificationHandlers.GetNotifications)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/notifications/unread",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(notificationHandlers.GetUnreadCount)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/notifications/stream",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(notificationHandlers.Stream)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/notifications/read",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(notificationHandlers.MarkAllRead)),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/notifications/{notificationID:[0-9]+}/read",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(notificationHandlers.MarkRead)),
+	).Methods("POST")
+}
@@ -0,0 +1,55 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/lib/pq"
+	"github.com/mailru/easyjson"
+)
+
+// Channel is the postgres channel notifications are announced on, it is
+// filled by the trigger on the notification table.
+const Channel = "notification_created"
+
+// pingInterval makes a dead connection noticed even when nothing happens.
+const pingInterval = 90 * time.Second
+
+// Run dispatches announced notifications until ctx is cancelled.
+func Run(ctx context.Context, listener *pq.Listener, usecase notification.Usecase, logger logger.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-listener.Notify:
+			if !ok {
+				return nil
+			}
+
+			// nil is sent after the connection was re-established
+			if event == nil {
+				logger.Info("notification listener reconnected", nil)
+				continue
+			}
+
+			payload := &dto.NotificationEventDTO{}
+			if err := easyjson.Unmarshal([]byte(event.Extra), payload); err != nil {
+				logger.Errorf("failed to decode notification event: %v", err)
+				continue
+			}
+
+			if err := usecase.Dispatch(context.WithoutCancel(ctx), payload); err != nil {
+				logger.Errorf("failed to dispatch notification: %v", err)
+			}
+		case <-time.After(pingInterval):
+			go func() {
+				if err := listener.Ping(); err != nil {
+					logger.Warnf("notification listener ping failed: %v", err)
+				}
+			}()
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+//easyjson:json
+type NotificationDTO struct {
+	ID        uint64    `json:"id"`
+	Type      string    `json:"type"`
+	EntityID  uint64    `json:"entityID"`
+	Message   string    `json:"message"`
+	IsRead    bool      `json:"isRead"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewNotificationDTO(notification *models.Notification) *NotificationDTO {
+	return &NotificationDTO{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		EntityID:  notification.EntityID,
+		Message:   notification.Message,
+		IsRead:    notification.IsRead,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+//easyjson:json
+type NotificationDTOs []*NotificationDTO
+
+//easyjson:json
+type UnreadCountDTO struct {
+	Count uint64 `json:"count"`
+}
+
+// NotificationEventDTO is the payload sent by postgres on the
+// notification_created channel.
+//
+//easyjson:json
+type NotificationEventDTO struct {
+	ID     uint64    `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
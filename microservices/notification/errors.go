@@ -0,0 +1,5 @@
+package notification
+
+import "errors"
+
+var ErrNotificationNotFound = errors.New("Notification wasn't found")
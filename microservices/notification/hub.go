@@ -0,0 +1,12 @@
+package notification
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	uuid "github.com/google/uuid"
+)
+
+type Hub interface {
+	Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func())
+	HasSubscribers(userID uuid.UUID) bool
+	Publish(userID uuid.UUID, notification *dto.NotificationDTO)
+}
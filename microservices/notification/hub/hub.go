@@ -0,0 +1,93 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	uuid "github.com/google/uuid"
+)
+
+// subscriberBuffer is how many notifications may wait for a slow client
+// before new ones are dropped for it.
+const subscriberBuffer = 16
+
+// Hub keeps clients connected to this instance and routes notifications to
+// the clients of their recipient.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[chan *dto.NotificationDTO]struct{}
+	closed      bool
+}
+
+func New() *Hub {
+	return &Hub{subscribers: make(map[uuid.UUID]map[chan *dto.NotificationDTO]struct{})}
+}
+
+func (h *Hub) Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func()) {
+	ch := make(chan *dto.NotificationDTO, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if _, ok := h.subscribers[userID]; !ok {
+		h.subscribers[userID] = make(map[chan *dto.NotificationDTO]struct{})
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			// the channel is already closed if the hub was closed
+			if _, ok := h.subscribers[userID][ch]; !ok {
+				return
+			}
+			delete(h.subscribers[userID], ch)
+			if len(h.subscribers[userID]) == 0 {
+				delete(h.subscribers, userID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (h *Hub) HasSubscribers(userID uuid.UUID) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers[userID]) > 0
+}
+
+func (h *Hub) Publish(userID uuid.UUID, notification *dto.NotificationDTO) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[userID] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
+
+// Close ends every subscription, so that streams of connected clients
+// finish and the server is able to shut down.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for userID, subscribers := range h.subscribers {
+		for ch := range subscribers {
+			close(ch)
+		}
+		delete(h.subscribers, userID)
+	}
+}
@@ -0,0 +1,87 @@
+package hub
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubPublish(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+	otherID := uuid.New()
+
+	first, unsubscribeFirst := h.Subscribe(userID)
+	second, unsubscribeSecond := h.Subscribe(userID)
+	other, unsubscribeOther := h.Subscribe(otherID)
+	defer unsubscribeFirst()
+	defer unsubscribeSecond()
+	defer unsubscribeOther()
+
+	notification := &dto.NotificationDTO{ID: 1, Message: "hello"}
+	h.Publish(userID, notification)
+
+	require.Equal(t, notification, <-first)
+	require.Equal(t, notification, <-second)
+	require.Len(t, other, 0)
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	require.True(t, h.HasSubscribers(userID))
+
+	unsubscribe()
+	unsubscribe()
+	require.False(t, h.HasSubscribers(userID))
+
+	_, ok := <-ch
+	require.False(t, ok)
+
+	h.Publish(userID, &dto.NotificationDTO{ID: 1})
+}
+
+func TestHubPublishDropsForSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	defer unsubscribe()
+
+	for i := 0; i < subscriberBuffer+5; i++ {
+		h.Publish(userID, &dto.NotificationDTO{ID: uint64(i)})
+	}
+
+	require.Len(t, ch, subscriberBuffer)
+}
+
+func TestHubClose(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	h.Close()
+
+	_, ok := <-ch
+	require.False(t, ok)
+	require.False(t, h.HasSubscribers(userID))
+	unsubscribe()
+
+	late, unsubscribeLate := h.Subscribe(userID)
+	defer unsubscribeLate()
+
+	_, ok = <-late
+	require.False(t, ok)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/notification/hub.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockHub is a mock of Hub interface.
+type MockHub struct {
+	ctrl     *gomock.Controller
+	recorder *MockHubMockRecorder
+}
+
+// MockHubMockRecorder is the mock recorder for MockHub.
+type MockHubMockRecorder struct {
+	mock *MockHub
+}
+
+// NewMockHub creates a new mock instance.
+func NewMockHub(ctrl *gomock.Controller) *MockHub {
+	mock := &MockHub{ctrl: ctrl}
+	mock.recorder = &MockHubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHub) EXPECT() *MockHubMockRecorder {
+	return m.recorder
+}
+
+// HasSubscribers mocks base method.
+func (m *MockHub) HasSubscribers(userID uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasSubscribers", userID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasSubscribers indicates an expected call of HasSubscribers.
+func (mr *MockHubMockRecorder) HasSubscribers(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSubscribers", reflect.TypeOf((*MockHub)(nil).HasSubscribers), userID)
+}
+
+// Publish mocks base method.
+func (m *MockHub) Publish(userID uuid.UUID, notification *dto.NotificationDTO) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", userID, notification)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockHubMockRecorder) Publish(userID, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockHub)(nil).Publish), userID, notification)
+}
+
+// Subscribe mocks base method.
+func (m *MockHub) Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID)
+	ret0, _ := ret[0].(<-chan *dto.NotificationDTO)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockHubMockRecorder) Subscribe(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockHub)(nil).Subscribe), userID)
+}
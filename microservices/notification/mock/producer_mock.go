@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/notification/producer.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockProducer is a mock of Producer interface.
+type MockProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockProducerMockRecorder
+}
+
+// MockProducerMockRecorder is the mock recorder for MockProducer.
+type MockProducerMockRecorder struct {
+	mock *MockProducer
+}
+
+// NewMockProducer creates a new mock instance.
+func NewMockProducer(ctrl *gomock.Controller) *MockProducer {
+	mock := &MockProducer{ctrl: ctrl}
+	mock.recorder = &MockProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProducer) EXPECT() *MockProducerMockRecorder {
+	return m.recorder
+}
+
+// AlbumReleased mocks base method.
+func (m *MockProducer) AlbumReleased(ctx context.Context, albumID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AlbumReleased", ctx, albumID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AlbumReleased indicates an expected call of AlbumReleased.
+func (mr *MockProducerMockRecorder) AlbumReleased(ctx, albumID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlbumReleased", reflect.TypeOf((*MockProducer)(nil).AlbumReleased), ctx, albumID)
+}
+
+// DataExportReady mocks base method.
+func (m *MockProducer) DataExportReady(ctx context.Context, userID uuid.UUID, exportID uint64, link string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataExportReady", ctx, userID, exportID, link, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DataExportReady indicates an expected call of DataExportReady.
+func (mr *MockProducerMockRecorder) DataExportReady(ctx, userID, exportID, link, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataExportReady", reflect.TypeOf((*MockProducer)(nil).DataExportReady), ctx, userID, exportID, link, expiresAt)
+}
+
+// PlaylistFavorited mocks base method.
+func (m *MockProducer) PlaylistFavorited(ctx context.Context, playlistID uint64, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaylistFavorited", ctx, playlistID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaylistFavorited indicates an expected call of PlaylistFavorited.
+func (mr *MockProducerMockRecorder) PlaylistFavorited(ctx, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaylistFavorited", reflect.TypeOf((*MockProducer)(nil).PlaylistFavorited), ctx, playlistID, userID)
+}
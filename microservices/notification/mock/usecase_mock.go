@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/notification/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method.
+func (m *MockUsecase) Dispatch(ctx context.Context, event *dto.NotificationEventDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockUsecaseMockRecorder) Dispatch(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockUsecase)(nil).Dispatch), ctx, event)
+}
+
+// GetNotifications mocks base method.
+func (m *MockUsecase) GetNotifications(ctx context.Context, userID uuid.UUID) ([]*dto.NotificationDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID)
+	ret0, _ := ret[0].([]*dto.NotificationDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockUsecaseMockRecorder) GetNotifications(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockUsecase)(nil).GetNotifications), ctx, userID)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockUsecase) GetUnreadCount(ctx context.Context, userID uuid.UUID) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockUsecaseMockRecorder) GetUnreadCount(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockUsecase)(nil).GetUnreadCount), ctx, userID)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockUsecase) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockUsecaseMockRecorder) MarkAllRead(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockUsecase)(nil).MarkAllRead), ctx, userID)
+}
+
+// MarkRead mocks base method.
+func (m *MockUsecase) MarkRead(ctx context.Context, userID uuid.UUID, notificationID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUsecaseMockRecorder) MarkRead(ctx, userID, notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUsecase)(nil).MarkRead), ctx, userID, notificationID)
+}
+
+// Subscribe mocks base method.
+func (m *MockUsecase) Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID)
+	ret0, _ := ret[0].(<-chan *dto.NotificationDTO)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockUsecaseMockRecorder) Subscribe(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUsecase)(nil).Subscribe), userID)
+}
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Producer creates notifications on behalf of other services. Every created
+// notification is delivered to connected clients by the notification service.
+type Producer interface {
+	AlbumReleased(ctx context.Context, albumID uint64) error
+	PlaylistFavorited(ctx context.Context, playlistID uint64, userID uuid.UUID) error
+	DataExportReady(ctx context.Context, userID uuid.UUID, exportID uint64, link string, expiresAt time.Time) error
+}
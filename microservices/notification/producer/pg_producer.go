@@ -0,0 +1,47 @@
+package producer
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	uuid "github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// exportDateLayout is how the expiration of a data export is shown.
+const exportDateLayout = "2006-01-02 15:04 UTC"
+
+// NotificationProducer writes notifications straight into the shared
+// database, the notification service picks them up via LISTEN/NOTIFY.
+type NotificationProducer struct {
+	db *sql.DB
+}
+
+func NewNotificationPGProducer(db *sql.DB) *NotificationProducer {
+	return &NotificationProducer{db: db}
+}
+
+func (p *NotificationProducer) AlbumReleased(ctx context.Context, albumID uint64) error {
+	if _, err := p.db.ExecContext(ctx, albumReleasedQuery, albumID); err != nil {
+		return errors.Wrap(err, "AlbumReleased.Query")
+	}
+
+	return nil
+}
+
+func (p *NotificationProducer) PlaylistFavorited(ctx context.Context, playlistID uint64, userID uuid.UUID) error {
+	if _, err := p.db.ExecContext(ctx, playlistFavoritedQuery, playlistID, userID); err != nil {
+		return errors.Wrap(err, "PlaylistFavorited.Query")
+	}
+
+	return nil
+}
+
+func (p *NotificationProducer) DataExportReady(ctx context.Context, userID uuid.UUID, exportID uint64, link string, expiresAt time.Time) error {
+	if _, err := p.db.ExecContext(ctx, dataExportReadyQuery, userID, exportID, link, expiresAt.UTC().Format(exportDateLayout)); err != nil {
+		return errors.Wrap(err, "DataExportReady.Query")
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationProducerAlbumReleased(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationProducer := NewNotificationPGProducer(db)
+
+	mock.ExpectExec(albumReleasedQuery).WithArgs(uint64(7)).WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = notificationProducer.AlbumReleased(context.Background(), 7)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationProducerPlaylistFavorited(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationProducer := NewNotificationPGProducer(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(playlistFavoritedQuery).WithArgs(uint64(3), userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	err = notificationProducer.PlaylistFavorited(context.Background(), 3, userID)
+	require.NoError(t, err)
+
+	mock.ExpectExec(playlistFavoritedQuery).WithArgs(uint64(3), userID).WillReturnError(errors.New("db error"))
+	err = notificationProducer.PlaylistFavorited(context.Background(), 3, userID)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotificationProducerDataExportReady(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationProducer := NewNotificationPGProducer(db)
+	userID := uuid.New()
+	expiresAt := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+
+	mock.ExpectExec(dataExportReadyQuery).
+		WithArgs(userID, uint64(5), "/api/v1/exports/download/token", "2025-01-02 15:04 UTC").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = notificationProducer.DataExportReady(context.Background(), userID, 5, "/api/v1/exports/download/token", expiresAt)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
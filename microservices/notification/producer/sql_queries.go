@@ -0,0 +1,24 @@
+package producer
+
+// the fan-out messages are cut to the notification_message_length limit, so a
+// long name doesn't fail the insert for every recipient
+const (
+	albumReleasedQuery = `
+    INSERT INTO notification (user_id, type, entity_id, message)
+    SELECT fa.user_id, 'album_released', a.id, left(format('%s released a new album "%s"', ar.name, a.name), 255)
+    FROM album AS a
+      JOIN artist AS ar ON ar.id = a.artist_id
+      JOIN favorite_artist AS fa ON fa.artist_id = a.artist_id
+    WHERE a.id = $1`
+
+	playlistFavoritedQuery = `
+    INSERT INTO notification (user_id, type, entity_id, message)
+    SELECT p.owner_id, 'playlist_favorited', p.id, left(format('%s added your playlist "%s" to favorites', u.username, p.name), 255)
+    FROM playlist AS p
+      JOIN "user" AS u ON u.id = $2
+    WHERE p.id = $1 AND p.owner_id <> $2 AND p.deleted_at IS NULL`
+
+	dataExportReadyQuery = `
+    INSERT INTO notification (user_id, type, entity_id, message)
+    VALUES ($1, 'data_export_ready', $2, format('Your data export is ready, download it before %s: %s', $4::TEXT, $3::TEXT))`
+)
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+type Repo interface {
+	FindByID(ctx context.Context, notificationID uint64) (*models.Notification, error)
+	GetByUser(ctx context.Context, userID uuid.UUID, limit uint64) ([]*models.Notification, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (uint64, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, notificationID uint64) (bool, error)
+	MarkAllRead(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationPGRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) FindByID(ctx context.Context, notificationID uint64) (*models.Notification, error) {
+	notification := &models.Notification{}
+	row := r.db.QueryRowContext(ctx, findByIDQuery, notificationID)
+	if err := row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Type,
+		&notification.EntityID,
+		&notification.Message,
+		&notification.IsRead,
+		&notification.CreatedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "FindByID.Query")
+	}
+
+	return notification, nil
+}
+
+func (r *NotificationRepository) GetByUser(ctx context.Context, userID uuid.UUID, limit uint64) ([]*models.Notification, error) {
+	var notifications []*models.Notification
+	rows, err := r.db.QueryContext(ctx, getByUserQuery, userID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetByUser.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		notification := &models.Notification{}
+		err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.EntityID,
+			&notification.Message,
+			&notification.IsRead,
+			&notification.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetByUser.Query")
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uuid.UUID) (uint64, error) {
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, countUnreadQuery, userID).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "CountUnread.Query")
+	}
+
+	return count, nil
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID uuid.UUID, notificationID uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, markReadQuery, userID, notificationID)
+	if err != nil {
+		return false, errors.Wrap(err, "MarkRead.Query")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "MarkRead.RowsAffected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.db.ExecContext(ctx, markAllReadQuery, userID); err != nil {
+		return errors.Wrap(err, "MarkAllRead.Query")
+	}
+
+	return nil
+}
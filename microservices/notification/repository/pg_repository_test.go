@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepositoryFindByID(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationPGRepository := NewNotificationPGRepository(db)
+	mockNotification := &models.Notification{
+		ID:        1,
+		UserID:    uuid.New(),
+		Type:      models.NotificationAlbumReleased,
+		EntityID:  7,
+		Message:   "quinn released a new album \"Sunrise\"",
+		CreatedAt: time.Now(),
+	}
+
+	columns := []string{"id", "user_id", "type", "entity_id", "message", "is_read", "created_at"}
+	rows := sqlmock.NewRows(columns).AddRow(
+		mockNotification.ID,
+		mockNotification.UserID,
+		mockNotification.Type,
+		mockNotification.EntityID,
+		mockNotification.Message,
+		mockNotification.IsRead,
+		mockNotification.CreatedAt,
+	)
+
+	mock.ExpectQuery(findByIDQuery).WithArgs(mockNotification.ID).WillReturnRows(rows)
+
+	foundNotification, err := notificationPGRepository.FindByID(context.Background(), mockNotification.ID)
+	require.NoError(t, err)
+	require.Equal(t, mockNotification, foundNotification)
+}
+
+func TestNotificationRepositoryGetByUser(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationPGRepository := NewNotificationPGRepository(db)
+	userID := uuid.New()
+	notifications := []*models.Notification{
+		{
+			ID:        2,
+			UserID:    userID,
+			Type:      models.NotificationPlaylistFavorited,
+			EntityID:  3,
+			Message:   "bob added your playlist \"Road\" to favorites",
+			CreatedAt: time.Now(),
+		},
+		{
+			ID:        1,
+			UserID:    userID,
+			Type:      models.NotificationAlbumReleased,
+			EntityID:  7,
+			Message:   "quinn released a new album \"Sunrise\"",
+			IsRead:    true,
+			CreatedAt: time.Now(),
+		},
+	}
+
+	columns := []string{"id", "user_id", "type", "entity_id", "message", "is_read", "created_at"}
+	rows := sqlmock.NewRows(columns)
+	for _, notification := range notifications {
+		rows.AddRow(
+			notification.ID,
+			notification.UserID,
+			notification.Type,
+			notification.EntityID,
+			notification.Message,
+			notification.IsRead,
+			notification.CreatedAt,
+		)
+	}
+
+	mock.ExpectQuery(getByUserQuery).WithArgs(userID, uint64(50)).WillReturnRows(rows)
+
+	foundNotifications, err := notificationPGRepository.GetByUser(context.Background(), userID, 50)
+	require.NoError(t, err)
+	require.Equal(t, notifications, foundNotifications)
+}
+
+func TestNotificationRepositoryCountUnread(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationPGRepository := NewNotificationPGRepository(db)
+	userID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+	mock.ExpectQuery(countUnreadQuery).WithArgs(userID).WillReturnRows(rows)
+
+	count, err := notificationPGRepository.CountUnread(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestNotificationRepositoryMarkRead(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationPGRepository := NewNotificationPGRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(markReadQuery).WithArgs(userID, uint64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	found, err := notificationPGRepository.MarkRead(context.Background(), userID, 1)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	mock.ExpectExec(markReadQuery).WithArgs(userID, uint64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+	found, err = notificationPGRepository.MarkRead(context.Background(), userID, 2)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestNotificationRepositoryMarkAllRead(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	notificationPGRepository := NewNotificationPGRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectExec(markAllReadQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 4))
+
+	err = notificationPGRepository.MarkAllRead(context.Background(), userID)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,18 @@
+package repository
+
+const (
+	findByIDQuery = `SELECT id, user_id, type, entity_id, message, is_read, created_at FROM notification WHERE id = $1`
+
+	getByUserQuery = `
+    SELECT id, user_id, type, entity_id, message, is_read, created_at
+    FROM notification
+    WHERE user_id = $1
+    ORDER BY created_at DESC, id DESC
+    LIMIT $2`
+
+	countUnreadQuery = `SELECT COUNT(*) FROM notification WHERE user_id = $1 AND NOT is_read`
+
+	markReadQuery = `UPDATE notification SET is_read = true WHERE user_id = $1 AND id = $2`
+
+	markAllReadQuery = `UPDATE notification SET is_read = true WHERE user_id = $1 AND NOT is_read`
+)
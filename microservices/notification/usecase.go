@@ -0,0 +1,17 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	uuid "github.com/google/uuid"
+)
+
+type Usecase interface {
+	GetNotifications(ctx context.Context, userID uuid.UUID) ([]*dto.NotificationDTO, error)
+	GetUnreadCount(ctx context.Context, userID uuid.UUID) (uint64, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, notificationID uint64) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) error
+	Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func())
+	Dispatch(ctx context.Context, event *dto.NotificationEventDTO) error
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	uuid "github.com/google/uuid"
+)
+
+const notificationsLimit = 50
+
+type notificationUsecase struct {
+	notificationRepo notification.Repo
+	hub              notification.Hub
+	logger           logger.Logger
+}
+
+func NewNotificationUsecase(notificationRepo notification.Repo, hub notification.Hub, logger logger.Logger) notification.Usecase {
+	return &notificationUsecase{notificationRepo, hub, logger}
+}
+
+func (usecase *notificationUsecase) GetNotifications(ctx context.Context, userID uuid.UUID) ([]*dto.NotificationDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	notifications, err := usecase.notificationRepo.GetByUser(ctx, userID, notificationsLimit)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load notifications for user %v: %v", userID, err), requestID)
+		return nil, fmt.Errorf("Can't load notifications")
+	}
+	usecase.logger.Infof("Found %d notifications for user ID %v", len(notifications), userID)
+
+	dtoNotifications := make([]*dto.NotificationDTO, 0, len(notifications))
+	for _, notification := range notifications {
+		dtoNotifications = append(dtoNotifications, dto.NewNotificationDTO(notification))
+	}
+
+	return dtoNotifications, nil
+}
+
+func (usecase *notificationUsecase) GetUnreadCount(ctx context.Context, userID uuid.UUID) (uint64, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	count, err := usecase.notificationRepo.CountUnread(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't count unread notifications for user %v: %v", userID, err), requestID)
+		return 0, fmt.Errorf("Can't count unread notifications")
+	}
+
+	return count, nil
+}
+
+func (usecase *notificationUsecase) MarkRead(ctx context.Context, userID uuid.UUID, notificationID uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	found, err := usecase.notificationRepo.MarkRead(ctx, userID, notificationID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't mark notification %d as read for user %v: %v", notificationID, userID, err), requestID)
+		return fmt.Errorf("Can't mark notification as read")
+	}
+
+	if !found {
+		usecase.logger.Warn(fmt.Sprintf("Notification %d of user %v wasn't found", notificationID, userID), requestID)
+		return notification.ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (usecase *notificationUsecase) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := usecase.notificationRepo.MarkAllRead(ctx, userID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't mark notifications as read for user %v: %v", userID, err), requestID)
+		return fmt.Errorf("Can't mark notifications as read")
+	}
+
+	return nil
+}
+
+func (usecase *notificationUsecase) Subscribe(userID uuid.UUID) (<-chan *dto.NotificationDTO, func()) {
+	return usecase.hub.Subscribe(userID)
+}
+
+func (usecase *notificationUsecase) Dispatch(ctx context.Context, event *dto.NotificationEventDTO) error {
+	// every instance receives every event, but only the ones
+	// holding a connection of the recipient have work to do
+	if !usecase.hub.HasSubscribers(event.UserID) {
+		return nil
+	}
+
+	notification, err := usecase.notificationRepo.FindByID(ctx, event.ID)
+	if err != nil {
+		usecase.logger.Warnf("Can't load notification %d: %v", event.ID, err)
+		return fmt.Errorf("Can't load notification %d", event.ID)
+	}
+
+	usecase.hub.Publish(event.UserID, dto.NewNotificationDTO(notification))
+	return nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/dto"
+	mockNotification "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLogger() logger.Logger {
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	return logger.New(&cfg.Service.Logger)
+}
+
+func TestUsecase_GetNotifications(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mockNotification.NewMockRepo(ctrl)
+	hubMock := mockNotification.NewMockHub(ctrl)
+	notificationUsecase := NewNotificationUsecase(repoMock, hubMock, newTestLogger())
+
+	userID := uuid.New()
+	notifications := []*models.Notification{
+		{ID: 2, UserID: userID, Type: models.NotificationAlbumReleased, EntityID: 7, Message: "new album", CreatedAt: time.Now()},
+		{ID: 1, UserID: userID, Type: models.NotificationPlaylistFavorited, EntityID: 3, Message: "favorited", IsRead: true, CreatedAt: time.Now()},
+	}
+
+	ctx := context.Background()
+	repoMock.EXPECT().GetByUser(ctx, userID, uint64(notificationsLimit)).Return(notifications, nil)
+
+	dtoNotifications, err := notificationUsecase.GetNotifications(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, dtoNotifications, 2)
+	require.Equal(t, uint64(2), dtoNotifications[0].ID)
+	require.True(t, dtoNotifications[1].IsRead)
+
+	repoMock.EXPECT().GetByUser(ctx, userID, uint64(notificationsLimit)).Return(nil, errors.New("db error"))
+
+	dtoNotifications, err = notificationUsecase.GetNotifications(ctx, userID)
+	require.Error(t, err)
+	require.Nil(t, dtoNotifications)
+}
+
+func TestUsecase_GetUnreadCount(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mockNotification.NewMockRepo(ctrl)
+	notificationUsecase := NewNotificationUsecase(repoMock, nil, newTestLogger())
+
+	userID := uuid.New()
+	ctx := context.Background()
+	repoMock.EXPECT().CountUnread(ctx, userID).Return(uint64(4), nil)
+
+	count, err := notificationUsecase.GetUnreadCount(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+}
+
+func TestUsecase_MarkRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mockNotification.NewMockRepo(ctrl)
+	notificationUsecase := NewNotificationUsecase(repoMock, nil, newTestLogger())
+
+	userID := uuid.New()
+	ctx := context.Background()
+
+	t.Run("success", func(t *testing.T) {
+		repoMock.EXPECT().MarkRead(ctx, userID, uint64(1)).Return(true, nil)
+		require.NoError(t, notificationUsecase.MarkRead(ctx, userID, 1))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repoMock.EXPECT().MarkRead(ctx, userID, uint64(2)).Return(false, nil)
+		err := notificationUsecase.MarkRead(ctx, userID, 2)
+		require.ErrorIs(t, err, notification.ErrNotificationNotFound)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repoMock.EXPECT().MarkRead(ctx, userID, uint64(3)).Return(false, errors.New("db error"))
+		err := notificationUsecase.MarkRead(ctx, userID, 3)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, notification.ErrNotificationNotFound)
+	})
+}
+
+func TestUsecase_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoMock := mockNotification.NewMockRepo(ctrl)
+	hubMock := mockNotification.NewMockHub(ctrl)
+	notificationUsecase := NewNotificationUsecase(repoMock, hubMock, newTestLogger())
+
+	ctx := context.Background()
+	userID := uuid.New()
+	event := &dto.NotificationEventDTO{ID: 1, UserID: userID}
+
+	t.Run("no subscribers", func(t *testing.T) {
+		hubMock.EXPECT().HasSubscribers(userID).Return(false)
+		require.NoError(t, notificationUsecase.Dispatch(ctx, event))
+	})
+
+	t.Run("publishes to subscribers", func(t *testing.T) {
+		found := &models.Notification{ID: 1, UserID: userID, Type: models.NotificationAlbumReleased, Message: "new album"}
+		hubMock.EXPECT().HasSubscribers(userID).Return(true)
+		repoMock.EXPECT().FindByID(ctx, event.ID).Return(found, nil)
+		hubMock.EXPECT().Publish(userID, dto.NewNotificationDTO(found))
+		require.NoError(t, notificationUsecase.Dispatch(ctx, event))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		hubMock.EXPECT().HasSubscribers(userID).Return(true)
+		repoMock.EXPECT().FindByID(ctx, event.ID).Return(nil, errors.New("db error"))
+		require.Error(t, notificationUsecase.Dispatch(ctx, event))
+	})
+}
@@ -0,0 +1,9 @@
+package pages
+
+import "net/http"
+
+type Handlers interface {
+	GetArtistPage(response http.ResponseWriter, request *http.Request)
+	GetAlbumPage(response http.ResponseWriter, request *http.Request)
+	GetHomePage(response http.ResponseWriter, request *http.Request)
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+type pagesHandlers struct {
+	usecase pages.Usecase
+	logger  logger.Logger
+}
+
+func NewPagesHandlers(usecase pages.Usecase, logger logger.Logger) pages.Handlers {
+	return &pagesHandlers{usecase, logger}
+}
+
+// GetArtistPage godoc
+// @Summary Get artist page
+// @Description Retrieves artist with albums, tracks and genres in one response. Sections that failed to load are listed in unavailable.
+// @Param id path int true "Artist ID"
+// @Success 200 {object} dto.ArtistPageDTO "Artist page"
+// @Failure 400 {object} utils.ErrorResponse "Invalid artist ID"
+// @Failure 404 {object} utils.ErrorResponse "Artist not found"
+// @Failure 503 {object} utils.ErrorResponse "Page is unavailable"
+// @Router /api/v1/pages/artist/{id} [get]
+func (handlers *pagesHandlers) GetArtistPage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	artistID, err := strconv.ParseUint(mux.Vars(request)["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid artist ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Invalid artist ID")
+		return
+	}
+
+	userID, _ := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	page, err := handlers.usecase.GetArtistPage(request.Context(), artistID, userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get artist page: %v", err), requestID)
+		handlers.writeError(response, err)
+		return
+	}
+
+	handlers.write(response, requestID, page)
+}
+
+// GetAlbumPage godoc
+// @Summary Get album page
+// @Description Retrieves album with its tracks in one response. Sections that failed to load are listed in unavailable.
+// @Param id path int true "Album ID"
+// @Success 200 {object} dto.AlbumPageDTO "Album page"
+// @Failure 400 {object} utils.ErrorResponse "Invalid album ID"
+// @Failure 404 {object} utils.ErrorResponse "Album not found"
+// @Failure 503 {object} utils.ErrorResponse "Page is unavailable"
+// @Router /api/v1/pages/album/{id} [get]
+func (handlers *pagesHandlers) GetAlbumPage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	albumID, err := strconv.ParseUint(mux.Vars(request)["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid album ID: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Invalid album ID")
+		return
+	}
+
+	userID, _ := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	page, err := handlers.usecase.GetAlbumPage(request.Context(), albumID, userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get album page: %v", err), requestID)
+		handlers.writeError(response, err)
+		return
+	}
+
+	handlers.write(response, requestID, page)
+}
+
+// GetHomePage godoc
+// @Summary Get home page
+// @Description Retrieves popular tracks and artists, recent releases and, for signed in users, their favorites. Sections that failed to load are listed in unavailable.
+// @Success 200 {object} dto.HomePageDTO "Home page"
+// @Failure 503 {object} utils.ErrorResponse "Page is unavailable"
+// @Router /api/v1/pages/home [get]
+func (handlers *pagesHandlers) GetHomePage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, _ := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	page, err := handlers.usecase.GetHomePage(request.Context(), userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get home page: %v", err), requestID)
+		handlers.writeError(response, err)
+		return
+	}
+
+	handlers.write(response, requestID, page)
+}
+
+func (handlers *pagesHandlers) writeError(response http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, pages.ErrArtistNotFound), errors.Is(err, pages.ErrAlbumNotFound):
+		utils.JSONError(response, http.StatusNotFound, err.Error())
+	case errors.Is(err, pages.ErrPageUnavailable):
+		utils.JSONError(response, http.StatusServiceUnavailable, err.Error())
+	default:
+		utils.JSONError(response, http.StatusInternalServerError, "Page load fail")
+	}
+}
+
+func (handlers *pagesHandlers) write(response http.ResponseWriter, requestID interface{}, page easyjson.Marshaler) {
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(page)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode page: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	artistDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagesHandlers_GetArtistPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mock.NewMockUsecase(ctrl)
+	pagesHandlers := NewPagesHandlers(usecaseMock, logger)
+
+	t.Run("Successful got artist page", func(t *testing.T) {
+		userID := uuid.New()
+		favorite := true
+		page := &dto.ArtistPageDTO{
+			Artist:      &artistDTO.ArtistDTO{ID: 1, Name: "artist"},
+			IsFavorite:  &favorite,
+			Unavailable: []string{"tracks"},
+		}
+
+		ctx := context.WithValue(context.Background(), utils.UserIDKey{}, userID)
+		usecaseMock.EXPECT().GetArtistPage(gomock.Any(), uint64(1), userID).Return(page, nil)
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/pages/artist/1", nil)
+		assert.NoError(t, err)
+		request = mux.SetURLVars(request, map[string]string{"id": "1"})
+
+		response := httptest.NewRecorder()
+		pagesHandlers.GetArtistPage(response, request)
+		res := response.Result()
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		var got dto.ArtistPageDTO
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&got))
+		assert.Equal(t, "artist", got.Artist.Name)
+		assert.True(t, *got.IsFavorite)
+		assert.Equal(t, []string{"tracks"}, got.Unavailable)
+	})
+
+	t.Run("Artist not found", func(t *testing.T) {
+		usecaseMock.EXPECT().GetArtistPage(gomock.Any(), uint64(2), uuid.Nil).Return(nil, pages.ErrArtistNotFound)
+
+		request, err := http.NewRequest(http.MethodGet, "/api/v1/pages/artist/2", nil)
+		assert.NoError(t, err)
+		request = mux.SetURLVars(request, map[string]string{"id": "2"})
+
+		response := httptest.NewRecorder()
+		pagesHandlers.GetArtistPage(response, request)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func TestPagesHandlers_GetHomePage_Unavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mock.NewMockUsecase(ctrl)
+	pagesHandlers := NewPagesHandlers(usecaseMock, logger)
+
+	ctx := context.Background()
+	usecaseMock.EXPECT().GetHomePage(ctx, uuid.Nil).Return(nil, pages.ErrPageUnavailable)
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v1/pages/home", nil)
+	assert.NoError(t, err)
+
+	response := httptest.NewRecorder()
+	pagesHandlers.GetHomePage(response, request)
+	assert.Equal(t, http.StatusServiceUnavailable, response.Code)
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	pagesUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/usecase"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(
+	s *httpServer.Server,
+	artistClient artistService.ArtistServiceClient,
+	albumClient albumService.AlbumServiceClient,
+	trackClient trackService.TrackServiceClient,
+	genreClient genreService.GenreServiceClient,
+	playlistClient playlistService.PlaylistServiceClient,
+) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	pagesUsecase := pagesUsecase.NewPagesUsecase(artistClient, albumClient, trackClient, genreClient, playlistClient, s.Logger)
+	pagesHandlers := NewPagesHandlers(pagesUsecase, s.Logger)
+
+	s.MUX.Handle(
+		"/api/v1/pages/artist/{id:[0-9]+}",
+		middleware.OptionalAuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(pagesHandlers.GetArtistPage)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/pages/album/{id:[0-9]+}",
+		middleware.OptionalAuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(pagesHandlers.GetAlbumPage)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/pages/home",
+		middleware.OptionalAuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(pagesHandlers.GetHomePage)),
+	).Methods("GET")
+}
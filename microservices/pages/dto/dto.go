@@ -0,0 +1,43 @@
+package dto
+
+import (
+	albumDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	artistDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	genreDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/dto"
+	playlistDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	trackDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+)
+
+// Sections of a page that failed or timed out are null and their names are
+// listed in Unavailable, the rest of the page is still returned.
+
+//easyjson:json
+type ArtistPageDTO struct {
+	Artist      *artistDTO.ArtistDTO `json:"artist"`
+	Albums      []*albumDTO.AlbumDTO `json:"albums"`
+	Tracks      []*trackDTO.TrackDTO `json:"tracks"`
+	Genres      []*genreDTO.GenreDTO `json:"genres"`
+	IsFavorite  *bool                `json:"isFavorite,omitempty"`
+	Unavailable []string             `json:"unavailable,omitempty"`
+}
+
+//easyjson:json
+type AlbumPageDTO struct {
+	Album       *albumDTO.AlbumDTO   `json:"album"`
+	Tracks      []*trackDTO.TrackDTO `json:"tracks"`
+	IsFavorite  *bool                `json:"isFavorite,omitempty"`
+	Unavailable []string             `json:"unavailable,omitempty"`
+}
+
+// HomePageDTO carries the favorites only for signed in users.
+//
+//easyjson:json
+type HomePageDTO struct {
+	PopularTracks     []*trackDTO.TrackDTO       `json:"popularTracks"`
+	PopularArtists    []*artistDTO.ArtistDTO     `json:"popularArtists"`
+	RecentReleases    []*albumDTO.AlbumDTO       `json:"recentReleases"`
+	FavoriteTracks    []*trackDTO.TrackDTO       `json:"favoriteTracks,omitempty"`
+	FavoriteAlbums    []*albumDTO.AlbumDTO       `json:"favoriteAlbums,omitempty"`
+	FavoritePlaylists []*playlistDTO.PlaylistDTO `json:"favoritePlaylists,omitempty"`
+	Unavailable       []string                   `json:"unavailable,omitempty"`
+}
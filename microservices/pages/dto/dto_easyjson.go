@@ -0,0 +1,884 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	dto2 "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	dto1 "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	dto4 "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/dto"
+	dto3 "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(in *jlexer.Lexer, out *HomePageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "popularTracks":
+			if in.IsNull() {
+				in.Skip()
+				out.PopularTracks = nil
+			} else {
+				in.Delim('[')
+				if out.PopularTracks == nil {
+					if !in.IsDelim(']') {
+						out.PopularTracks = make([]*dto.TrackDTO, 0, 8)
+					} else {
+						out.PopularTracks = []*dto.TrackDTO{}
+					}
+				} else {
+					out.PopularTracks = (out.PopularTracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 *dto.TrackDTO
+					if in.IsNull() {
+						in.Skip()
+						v1 = nil
+					} else {
+						if v1 == nil {
+							v1 = new(dto.TrackDTO)
+						}
+						(*v1).UnmarshalEasyJSON(in)
+					}
+					out.PopularTracks = append(out.PopularTracks, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "popularArtists":
+			if in.IsNull() {
+				in.Skip()
+				out.PopularArtists = nil
+			} else {
+				in.Delim('[')
+				if out.PopularArtists == nil {
+					if !in.IsDelim(']') {
+						out.PopularArtists = make([]*dto1.ArtistDTO, 0, 8)
+					} else {
+						out.PopularArtists = []*dto1.ArtistDTO{}
+					}
+				} else {
+					out.PopularArtists = (out.PopularArtists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 *dto1.ArtistDTO
+					if in.IsNull() {
+						in.Skip()
+						v2 = nil
+					} else {
+						if v2 == nil {
+							v2 = new(dto1.ArtistDTO)
+						}
+						(*v2).UnmarshalEasyJSON(in)
+					}
+					out.PopularArtists = append(out.PopularArtists, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "recentReleases":
+			if in.IsNull() {
+				in.Skip()
+				out.RecentReleases = nil
+			} else {
+				in.Delim('[')
+				if out.RecentReleases == nil {
+					if !in.IsDelim(']') {
+						out.RecentReleases = make([]*dto2.AlbumDTO, 0, 8)
+					} else {
+						out.RecentReleases = []*dto2.AlbumDTO{}
+					}
+				} else {
+					out.RecentReleases = (out.RecentReleases)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v3 *dto2.AlbumDTO
+					if in.IsNull() {
+						in.Skip()
+						v3 = nil
+					} else {
+						if v3 == nil {
+							v3 = new(dto2.AlbumDTO)
+						}
+						(*v3).UnmarshalEasyJSON(in)
+					}
+					out.RecentReleases = append(out.RecentReleases, v3)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "favoriteTracks":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteTracks = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteTracks == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteTracks = make([]*dto.TrackDTO, 0, 8)
+					} else {
+						out.FavoriteTracks = []*dto.TrackDTO{}
+					}
+				} else {
+					out.FavoriteTracks = (out.FavoriteTracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *dto.TrackDTO
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(dto.TrackDTO)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.FavoriteTracks = append(out.FavoriteTracks, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "favoriteAlbums":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoriteAlbums = nil
+			} else {
+				in.Delim('[')
+				if out.FavoriteAlbums == nil {
+					if !in.IsDelim(']') {
+						out.FavoriteAlbums = make([]*dto2.AlbumDTO, 0, 8)
+					} else {
+						out.FavoriteAlbums = []*dto2.AlbumDTO{}
+					}
+				} else {
+					out.FavoriteAlbums = (out.FavoriteAlbums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 *dto2.AlbumDTO
+					if in.IsNull() {
+						in.Skip()
+						v5 = nil
+					} else {
+						if v5 == nil {
+							v5 = new(dto2.AlbumDTO)
+						}
+						(*v5).UnmarshalEasyJSON(in)
+					}
+					out.FavoriteAlbums = append(out.FavoriteAlbums, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "favoritePlaylists":
+			if in.IsNull() {
+				in.Skip()
+				out.FavoritePlaylists = nil
+			} else {
+				in.Delim('[')
+				if out.FavoritePlaylists == nil {
+					if !in.IsDelim(']') {
+						out.FavoritePlaylists = make([]*dto3.PlaylistDTO, 0, 8)
+					} else {
+						out.FavoritePlaylists = []*dto3.PlaylistDTO{}
+					}
+				} else {
+					out.FavoritePlaylists = (out.FavoritePlaylists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v6 *dto3.PlaylistDTO
+					if in.IsNull() {
+						in.Skip()
+						v6 = nil
+					} else {
+						if v6 == nil {
+							v6 = new(dto3.PlaylistDTO)
+						}
+						(*v6).UnmarshalEasyJSON(in)
+					}
+					out.FavoritePlaylists = append(out.FavoritePlaylists, v6)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "unavailable":
+			if in.IsNull() {
+				in.Skip()
+				out.Unavailable = nil
+			} else {
+				in.Delim('[')
+				if out.Unavailable == nil {
+					if !in.IsDelim(']') {
+						out.Unavailable = make([]string, 0, 4)
+					} else {
+						out.Unavailable = []string{}
+					}
+				} else {
+					out.Unavailable = (out.Unavailable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Unavailable = append(out.Unavailable, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(out *jwriter.Writer, in HomePageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"popularTracks\":"
+		out.RawString(prefix[1:])
+		if in.PopularTracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.PopularTracks {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"popularArtists\":"
+		out.RawString(prefix)
+		if in.PopularArtists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.PopularArtists {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				if v11 == nil {
+					out.RawString("null")
+				} else {
+					(*v11).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"recentReleases\":"
+		out.RawString(prefix)
+		if in.RecentReleases == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v12, v13 := range in.RecentReleases {
+				if v12 > 0 {
+					out.RawByte(',')
+				}
+				if v13 == nil {
+					out.RawString("null")
+				} else {
+					(*v13).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.FavoriteTracks) != 0 {
+		const prefix string = ",\"favoriteTracks\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v14, v15 := range in.FavoriteTracks {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				if v15 == nil {
+					out.RawString("null")
+				} else {
+					(*v15).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.FavoriteAlbums) != 0 {
+		const prefix string = ",\"favoriteAlbums\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v16, v17 := range in.FavoriteAlbums {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				if v17 == nil {
+					out.RawString("null")
+				} else {
+					(*v17).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.FavoritePlaylists) != 0 {
+		const prefix string = ",\"favoritePlaylists\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v18, v19 := range in.FavoritePlaylists {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				if v19 == nil {
+					out.RawString("null")
+				} else {
+					(*v19).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Unavailable) != 0 {
+		const prefix string = ",\"unavailable\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v20, v21 := range in.Unavailable {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v21))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v HomePageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v HomePageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *HomePageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *HomePageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(in *jlexer.Lexer, out *ArtistPageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artist":
+			if in.IsNull() {
+				in.Skip()
+				out.Artist = nil
+			} else {
+				if out.Artist == nil {
+					out.Artist = new(dto1.ArtistDTO)
+				}
+				(*out.Artist).UnmarshalEasyJSON(in)
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]*dto2.AlbumDTO, 0, 8)
+					} else {
+						out.Albums = []*dto2.AlbumDTO{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 *dto2.AlbumDTO
+					if in.IsNull() {
+						in.Skip()
+						v22 = nil
+					} else {
+						if v22 == nil {
+							v22 = new(dto2.AlbumDTO)
+						}
+						(*v22).UnmarshalEasyJSON(in)
+					}
+					out.Albums = append(out.Albums, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]*dto.TrackDTO, 0, 8)
+					} else {
+						out.Tracks = []*dto.TrackDTO{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 *dto.TrackDTO
+					if in.IsNull() {
+						in.Skip()
+						v23 = nil
+					} else {
+						if v23 == nil {
+							v23 = new(dto.TrackDTO)
+						}
+						(*v23).UnmarshalEasyJSON(in)
+					}
+					out.Tracks = append(out.Tracks, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genres":
+			if in.IsNull() {
+				in.Skip()
+				out.Genres = nil
+			} else {
+				in.Delim('[')
+				if out.Genres == nil {
+					if !in.IsDelim(']') {
+						out.Genres = make([]*dto4.GenreDTO, 0, 8)
+					} else {
+						out.Genres = []*dto4.GenreDTO{}
+					}
+				} else {
+					out.Genres = (out.Genres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v24 *dto4.GenreDTO
+					if in.IsNull() {
+						in.Skip()
+						v24 = nil
+					} else {
+						if v24 == nil {
+							v24 = new(dto4.GenreDTO)
+						}
+						(*v24).UnmarshalEasyJSON(in)
+					}
+					out.Genres = append(out.Genres, v24)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "isFavorite":
+			if in.IsNull() {
+				in.Skip()
+				out.IsFavorite = nil
+			} else {
+				if out.IsFavorite == nil {
+					out.IsFavorite = new(bool)
+				}
+				*out.IsFavorite = bool(in.Bool())
+			}
+		case "unavailable":
+			if in.IsNull() {
+				in.Skip()
+				out.Unavailable = nil
+			} else {
+				in.Delim('[')
+				if out.Unavailable == nil {
+					if !in.IsDelim(']') {
+						out.Unavailable = make([]string, 0, 4)
+					} else {
+						out.Unavailable = []string{}
+					}
+				} else {
+					out.Unavailable = (out.Unavailable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 string
+					v25 = string(in.String())
+					out.Unavailable = append(out.Unavailable, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(out *jwriter.Writer, in ArtistPageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix[1:])
+		if in.Artist == nil {
+			out.RawString("null")
+		} else {
+			(*in.Artist).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Albums {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				if v27 == nil {
+					out.RawString("null")
+				} else {
+					(*v27).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v28, v29 := range in.Tracks {
+				if v28 > 0 {
+					out.RawByte(',')
+				}
+				if v29 == nil {
+					out.RawString("null")
+				} else {
+					(*v29).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genres\":"
+		out.RawString(prefix)
+		if in.Genres == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.Genres {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				if v31 == nil {
+					out.RawString("null")
+				} else {
+					(*v31).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.IsFavorite != nil {
+		const prefix string = ",\"isFavorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.IsFavorite))
+	}
+	if len(in.Unavailable) != 0 {
+		const prefix string = ",\"unavailable\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v32, v33 := range in.Unavailable {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v33))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ArtistPageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ArtistPageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ArtistPageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ArtistPageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto1(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(in *jlexer.Lexer, out *AlbumPageDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "album":
+			if in.IsNull() {
+				in.Skip()
+				out.Album = nil
+			} else {
+				if out.Album == nil {
+					out.Album = new(dto2.AlbumDTO)
+				}
+				(*out.Album).UnmarshalEasyJSON(in)
+			}
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]*dto.TrackDTO, 0, 8)
+					} else {
+						out.Tracks = []*dto.TrackDTO{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 *dto.TrackDTO
+					if in.IsNull() {
+						in.Skip()
+						v34 = nil
+					} else {
+						if v34 == nil {
+							v34 = new(dto.TrackDTO)
+						}
+						(*v34).UnmarshalEasyJSON(in)
+					}
+					out.Tracks = append(out.Tracks, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "isFavorite":
+			if in.IsNull() {
+				in.Skip()
+				out.IsFavorite = nil
+			} else {
+				if out.IsFavorite == nil {
+					out.IsFavorite = new(bool)
+				}
+				*out.IsFavorite = bool(in.Bool())
+			}
+		case "unavailable":
+			if in.IsNull() {
+				in.Skip()
+				out.Unavailable = nil
+			} else {
+				in.Delim('[')
+				if out.Unavailable == nil {
+					if !in.IsDelim(']') {
+						out.Unavailable = make([]string, 0, 4)
+					} else {
+						out.Unavailable = []string{}
+					}
+				} else {
+					out.Unavailable = (out.Unavailable)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v35 string
+					v35 = string(in.String())
+					out.Unavailable = append(out.Unavailable, v35)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(out *jwriter.Writer, in AlbumPageDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix[1:])
+		if in.Album == nil {
+			out.RawString("null")
+		} else {
+			(*in.Album).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v36, v37 := range in.Tracks {
+				if v36 > 0 {
+					out.RawByte(',')
+				}
+				if v37 == nil {
+					out.RawString("null")
+				} else {
+					(*v37).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.IsFavorite != nil {
+		const prefix string = ",\"isFavorite\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.IsFavorite))
+	}
+	if len(in.Unavailable) != 0 {
+		const prefix string = ",\"unavailable\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v38, v39 := range in.Unavailable {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v39))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AlbumPageDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AlbumPageDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AlbumPageDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AlbumPageDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPagesDto2(l, v)
+}
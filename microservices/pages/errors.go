@@ -0,0 +1,9 @@
+package pages
+
+import "errors"
+
+var (
+	ErrArtistNotFound  = errors.New("Artist wasn't found")
+	ErrAlbumNotFound   = errors.New("Album wasn't found")
+	ErrPageUnavailable = errors.New("Page is unavailable")
+)
@@ -0,0 +1,247 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/genre/genre_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockGenreServiceClient is a mock of GenreServiceClient interface.
+type MockGenreServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenreServiceClientMockRecorder
+}
+
+// MockGenreServiceClientMockRecorder is the mock recorder for MockGenreServiceClient.
+type MockGenreServiceClientMockRecorder struct {
+	mock *MockGenreServiceClient
+}
+
+// NewMockGenreServiceClient creates a new mock instance.
+func NewMockGenreServiceClient(ctrl *gomock.Controller) *MockGenreServiceClient {
+	mock := &MockGenreServiceClient{ctrl: ctrl}
+	mock.recorder = &MockGenreServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenreServiceClient) EXPECT() *MockGenreServiceClientMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGenreServiceClient) FindByID(ctx context.Context, in *genreService.FindByIDRequest, opts ...grpc.CallOption) (*genreService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByID", varargs...)
+	ret0, _ := ret[0].(*genreService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGenreServiceClientMockRecorder) FindByID(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGenreServiceClient)(nil).FindByID), varargs...)
+}
+
+// FindByIDs mocks base method.
+func (m *MockGenreServiceClient) FindByIDs(ctx context.Context, in *genreService.FindByIDsRequest, opts ...grpc.CallOption) (*genreService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByIDs", varargs...)
+	ret0, _ := ret[0].(*genreService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockGenreServiceClientMockRecorder) FindByIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockGenreServiceClient)(nil).FindByIDs), varargs...)
+}
+
+// ListByArtist mocks base method.
+func (m *MockGenreServiceClient) ListByArtist(ctx context.Context, in *genreService.ListByArtistRequest, opts ...grpc.CallOption) (*genreService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByArtist", varargs...)
+	ret0, _ := ret[0].(*genreService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockGenreServiceClientMockRecorder) ListByArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockGenreServiceClient)(nil).ListByArtist), varargs...)
+}
+
+// ListByTrack mocks base method.
+func (m *MockGenreServiceClient) ListByTrack(ctx context.Context, in *genreService.ListByTrackRequest, opts ...grpc.CallOption) (*genreService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByTrack", varargs...)
+	ret0, _ := ret[0].(*genreService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTrack indicates an expected call of ListByTrack.
+func (mr *MockGenreServiceClientMockRecorder) ListByTrack(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTrack", reflect.TypeOf((*MockGenreServiceClient)(nil).ListByTrack), varargs...)
+}
+
+// MockGenreServiceServer is a mock of GenreServiceServer interface.
+type MockGenreServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenreServiceServerMockRecorder
+}
+
+// MockGenreServiceServerMockRecorder is the mock recorder for MockGenreServiceServer.
+type MockGenreServiceServerMockRecorder struct {
+	mock *MockGenreServiceServer
+}
+
+// NewMockGenreServiceServer creates a new mock instance.
+func NewMockGenreServiceServer(ctrl *gomock.Controller) *MockGenreServiceServer {
+	mock := &MockGenreServiceServer{ctrl: ctrl}
+	mock.recorder = &MockGenreServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenreServiceServer) EXPECT() *MockGenreServiceServerMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockGenreServiceServer) FindByID(arg0 context.Context, arg1 *genreService.FindByIDRequest) (*genreService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*genreService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockGenreServiceServerMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockGenreServiceServer)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockGenreServiceServer) FindByIDs(arg0 context.Context, arg1 *genreService.FindByIDsRequest) (*genreService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].(*genreService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockGenreServiceServerMockRecorder) FindByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockGenreServiceServer)(nil).FindByIDs), arg0, arg1)
+}
+
+// ListByArtist mocks base method.
+func (m *MockGenreServiceServer) ListByArtist(arg0 context.Context, arg1 *genreService.ListByArtistRequest) (*genreService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArtist", arg0, arg1)
+	ret0, _ := ret[0].(*genreService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockGenreServiceServerMockRecorder) ListByArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockGenreServiceServer)(nil).ListByArtist), arg0, arg1)
+}
+
+// ListByTrack mocks base method.
+func (m *MockGenreServiceServer) ListByTrack(arg0 context.Context, arg1 *genreService.ListByTrackRequest) (*genreService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTrack", arg0, arg1)
+	ret0, _ := ret[0].(*genreService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTrack indicates an expected call of ListByTrack.
+func (mr *MockGenreServiceServerMockRecorder) ListByTrack(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTrack", reflect.TypeOf((*MockGenreServiceServer)(nil).ListByTrack), arg0, arg1)
+}
+
+// mustEmbedUnimplementedGenreServiceServer mocks base method.
+func (m *MockGenreServiceServer) mustEmbedUnimplementedGenreServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedGenreServiceServer")
+}
+
+// mustEmbedUnimplementedGenreServiceServer indicates an expected call of mustEmbedUnimplementedGenreServiceServer.
+func (mr *MockGenreServiceServerMockRecorder) mustEmbedUnimplementedGenreServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedGenreServiceServer", reflect.TypeOf((*MockGenreServiceServer)(nil).mustEmbedUnimplementedGenreServiceServer))
+}
+
+// MockUnsafeGenreServiceServer is a mock of UnsafeGenreServiceServer interface.
+type MockUnsafeGenreServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeGenreServiceServerMockRecorder
+}
+
+// MockUnsafeGenreServiceServerMockRecorder is the mock recorder for MockUnsafeGenreServiceServer.
+type MockUnsafeGenreServiceServerMockRecorder struct {
+	mock *MockUnsafeGenreServiceServer
+}
+
+// NewMockUnsafeGenreServiceServer creates a new mock instance.
+func NewMockUnsafeGenreServiceServer(ctrl *gomock.Controller) *MockUnsafeGenreServiceServer {
+	mock := &MockUnsafeGenreServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeGenreServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeGenreServiceServer) EXPECT() *MockUnsafeGenreServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedGenreServiceServer mocks base method.
+func (m *MockUnsafeGenreServiceServer) mustEmbedUnimplementedGenreServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedGenreServiceServer")
+}
+
+// mustEmbedUnimplementedGenreServiceServer indicates an expected call of mustEmbedUnimplementedGenreServiceServer.
+func (mr *MockUnsafeGenreServiceServerMockRecorder) mustEmbedUnimplementedGenreServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedGenreServiceServer", reflect.TypeOf((*MockUnsafeGenreServiceServer)(nil).mustEmbedUnimplementedGenreServiceServer))
+}
@@ -0,0 +1,352 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/track/track_grpc.pb.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTrackServiceClient is a mock of TrackServiceClient interface.
+type MockTrackServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrackServiceClientMockRecorder
+}
+
+// MockTrackServiceClientMockRecorder is the mock recorder for MockTrackServiceClient.
+type MockTrackServiceClientMockRecorder struct {
+	mock *MockTrackServiceClient
+}
+
+// NewMockTrackServiceClient creates a new mock instance.
+func NewMockTrackServiceClient(ctrl *gomock.Controller) *MockTrackServiceClient {
+	mock := &MockTrackServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTrackServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrackServiceClient) EXPECT() *MockTrackServiceClientMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockTrackServiceClient) FindByID(ctx context.Context, in *trackService.FindByIDRequest, opts ...grpc.CallOption) (*trackService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByID", varargs...)
+	ret0, _ := ret[0].(*trackService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTrackServiceClientMockRecorder) FindByID(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTrackServiceClient)(nil).FindByID), varargs...)
+}
+
+// FindByIDs mocks base method.
+func (m *MockTrackServiceClient) FindByIDs(ctx context.Context, in *trackService.FindByIDsRequest, opts ...grpc.CallOption) (*trackService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByIDs", varargs...)
+	ret0, _ := ret[0].(*trackService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockTrackServiceClientMockRecorder) FindByIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockTrackServiceClient)(nil).FindByIDs), varargs...)
+}
+
+// IsFavorite mocks base method.
+func (m *MockTrackServiceClient) IsFavorite(ctx context.Context, in *trackService.IsFavoriteRequest, opts ...grpc.CallOption) (*trackService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsFavorite", varargs...)
+	ret0, _ := ret[0].(*trackService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockTrackServiceClientMockRecorder) IsFavorite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockTrackServiceClient)(nil).IsFavorite), varargs...)
+}
+
+// ListByAlbum mocks base method.
+func (m *MockTrackServiceClient) ListByAlbum(ctx context.Context, in *trackService.ListByAlbumRequest, opts ...grpc.CallOption) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByAlbum", varargs...)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAlbum indicates an expected call of ListByAlbum.
+func (mr *MockTrackServiceClientMockRecorder) ListByAlbum(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAlbum", reflect.TypeOf((*MockTrackServiceClient)(nil).ListByAlbum), varargs...)
+}
+
+// ListByArtist mocks base method.
+func (m *MockTrackServiceClient) ListByArtist(ctx context.Context, in *trackService.ListByArtistRequest, opts ...grpc.CallOption) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByArtist", varargs...)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockTrackServiceClientMockRecorder) ListByArtist(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockTrackServiceClient)(nil).ListByArtist), varargs...)
+}
+
+// ListFavorites mocks base method.
+func (m *MockTrackServiceClient) ListFavorites(ctx context.Context, in *trackService.ListFavoritesRequest, opts ...grpc.CallOption) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFavorites", varargs...)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockTrackServiceClientMockRecorder) ListFavorites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockTrackServiceClient)(nil).ListFavorites), varargs...)
+}
+
+// ListPopular mocks base method.
+func (m *MockTrackServiceClient) ListPopular(ctx context.Context, in *trackService.ListPopularRequest, opts ...grpc.CallOption) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPopular", varargs...)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPopular indicates an expected call of ListPopular.
+func (mr *MockTrackServiceClientMockRecorder) ListPopular(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPopular", reflect.TypeOf((*MockTrackServiceClient)(nil).ListPopular), varargs...)
+}
+
+// MockTrackServiceServer is a mock of TrackServiceServer interface.
+type MockTrackServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrackServiceServerMockRecorder
+}
+
+// MockTrackServiceServerMockRecorder is the mock recorder for MockTrackServiceServer.
+type MockTrackServiceServerMockRecorder struct {
+	mock *MockTrackServiceServer
+}
+
+// NewMockTrackServiceServer creates a new mock instance.
+func NewMockTrackServiceServer(ctrl *gomock.Controller) *MockTrackServiceServer {
+	mock := &MockTrackServiceServer{ctrl: ctrl}
+	mock.recorder = &MockTrackServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrackServiceServer) EXPECT() *MockTrackServiceServerMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockTrackServiceServer) FindByID(arg0 context.Context, arg1 *trackService.FindByIDRequest) (*trackService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockTrackServiceServerMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockTrackServiceServer)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockTrackServiceServer) FindByIDs(arg0 context.Context, arg1 *trackService.FindByIDsRequest) (*trackService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockTrackServiceServerMockRecorder) FindByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockTrackServiceServer)(nil).FindByIDs), arg0, arg1)
+}
+
+// IsFavorite mocks base method.
+func (m *MockTrackServiceServer) IsFavorite(arg0 context.Context, arg1 *trackService.IsFavoriteRequest) (*trackService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockTrackServiceServerMockRecorder) IsFavorite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockTrackServiceServer)(nil).IsFavorite), arg0, arg1)
+}
+
+// ListByAlbum mocks base method.
+func (m *MockTrackServiceServer) ListByAlbum(arg0 context.Context, arg1 *trackService.ListByAlbumRequest) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAlbum indicates an expected call of ListByAlbum.
+func (mr *MockTrackServiceServerMockRecorder) ListByAlbum(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAlbum", reflect.TypeOf((*MockTrackServiceServer)(nil).ListByAlbum), arg0, arg1)
+}
+
+// ListByArtist mocks base method.
+func (m *MockTrackServiceServer) ListByArtist(arg0 context.Context, arg1 *trackService.ListByArtistRequest) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArtist", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByArtist indicates an expected call of ListByArtist.
+func (mr *MockTrackServiceServerMockRecorder) ListByArtist(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArtist", reflect.TypeOf((*MockTrackServiceServer)(nil).ListByArtist), arg0, arg1)
+}
+
+// ListFavorites mocks base method.
+func (m *MockTrackServiceServer) ListFavorites(arg0 context.Context, arg1 *trackService.ListFavoritesRequest) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockTrackServiceServerMockRecorder) ListFavorites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockTrackServiceServer)(nil).ListFavorites), arg0, arg1)
+}
+
+// ListPopular mocks base method.
+func (m *MockTrackServiceServer) ListPopular(arg0 context.Context, arg1 *trackService.ListPopularRequest) (*trackService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPopular", arg0, arg1)
+	ret0, _ := ret[0].(*trackService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPopular indicates an expected call of ListPopular.
+func (mr *MockTrackServiceServerMockRecorder) ListPopular(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPopular", reflect.TypeOf((*MockTrackServiceServer)(nil).ListPopular), arg0, arg1)
+}
+
+// mustEmbedUnimplementedTrackServiceServer mocks base method.
+func (m *MockTrackServiceServer) mustEmbedUnimplementedTrackServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTrackServiceServer")
+}
+
+// mustEmbedUnimplementedTrackServiceServer indicates an expected call of mustEmbedUnimplementedTrackServiceServer.
+func (mr *MockTrackServiceServerMockRecorder) mustEmbedUnimplementedTrackServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTrackServiceServer", reflect.TypeOf((*MockTrackServiceServer)(nil).mustEmbedUnimplementedTrackServiceServer))
+}
+
+// MockUnsafeTrackServiceServer is a mock of UnsafeTrackServiceServer interface.
+type MockUnsafeTrackServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeTrackServiceServerMockRecorder
+}
+
+// MockUnsafeTrackServiceServerMockRecorder is the mock recorder for MockUnsafeTrackServiceServer.
+type MockUnsafeTrackServiceServerMockRecorder struct {
+	mock *MockUnsafeTrackServiceServer
+}
+
+// NewMockUnsafeTrackServiceServer creates a new mock instance.
+func NewMockUnsafeTrackServiceServer(ctrl *gomock.Controller) *MockUnsafeTrackServiceServer {
+	mock := &MockUnsafeTrackServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeTrackServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeTrackServiceServer) EXPECT() *MockUnsafeTrackServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedTrackServiceServer mocks base method.
+func (m *MockUnsafeTrackServiceServer) mustEmbedUnimplementedTrackServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedTrackServiceServer")
+}
+
+// mustEmbedUnimplementedTrackServiceServer indicates an expected call of mustEmbedUnimplementedTrackServiceServer.
+func (mr *MockUnsafeTrackServiceServerMockRecorder) mustEmbedUnimplementedTrackServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedTrackServiceServer", reflect.TypeOf((*MockUnsafeTrackServiceServer)(nil).mustEmbedUnimplementedTrackServiceServer))
+}
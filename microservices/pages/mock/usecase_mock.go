@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/pages/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetAlbumPage mocks base method.
+func (m *MockUsecase) GetAlbumPage(ctx context.Context, albumID uint64, userID uuid.UUID) (*dto.AlbumPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumPage", ctx, albumID, userID)
+	ret0, _ := ret[0].(*dto.AlbumPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumPage indicates an expected call of GetAlbumPage.
+func (mr *MockUsecaseMockRecorder) GetAlbumPage(ctx, albumID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumPage", reflect.TypeOf((*MockUsecase)(nil).GetAlbumPage), ctx, albumID, userID)
+}
+
+// GetArtistPage mocks base method.
+func (m *MockUsecase) GetArtistPage(ctx context.Context, artistID uint64, userID uuid.UUID) (*dto.ArtistPageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtistPage", ctx, artistID, userID)
+	ret0, _ := ret[0].(*dto.ArtistPageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtistPage indicates an expected call of GetArtistPage.
+func (mr *MockUsecaseMockRecorder) GetArtistPage(ctx, artistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistPage", reflect.TypeOf((*MockUsecase)(nil).GetArtistPage), ctx, artistID, userID)
+}
+
+// GetHomePage mocks base method.
+func (m *MockUsecase) GetHomePage(ctx context.Context, userID uuid.UUID) (*dto.HomePageDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHomePage", ctx, userID)
+	ret0, _ := ret[0].(*dto.HomePageDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHomePage indicates an expected call of GetHomePage.
+func (mr *MockUsecaseMockRecorder) GetHomePage(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHomePage", reflect.TypeOf((*MockUsecase)(nil).GetHomePage), ctx, userID)
+}
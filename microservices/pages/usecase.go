@@ -0,0 +1,16 @@
+package pages
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/dto"
+	"github.com/google/uuid"
+)
+
+// Usecase composes pages of other services, userID is uuid.Nil for
+// anonymous users and the personal sections are left out for them.
+type Usecase interface {
+	GetArtistPage(ctx context.Context, artistID uint64, userID uuid.UUID) (*dto.ArtistPageDTO, error)
+	GetAlbumPage(ctx context.Context, albumID uint64, userID uuid.UUID) (*dto.AlbumPageDTO, error)
+	GetHomePage(ctx context.Context, userID uuid.UUID) (*dto.HomePageDTO, error)
+}
@@ -0,0 +1,352 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sharedDTO "github.com/go-park-mail-ru/2024_2_NovaCode/internal/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	albumDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/dto"
+	artistDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/dto"
+	genreDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/genre/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/dto"
+	playlistDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	trackDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sectionTimeout limits every section, so a slow service only costs its
+// own part of the page.
+const sectionTimeout = time.Second
+
+const recentReleasesLimit = 20
+
+type pagesUsecase struct {
+	artistClient   artistService.ArtistServiceClient
+	albumClient    albumService.AlbumServiceClient
+	trackClient    trackService.TrackServiceClient
+	genreClient    genreService.GenreServiceClient
+	playlistClient playlistService.PlaylistServiceClient
+	logger         logger.Logger
+}
+
+func NewPagesUsecase(
+	artistClient artistService.ArtistServiceClient,
+	albumClient albumService.AlbumServiceClient,
+	trackClient trackService.TrackServiceClient,
+	genreClient genreService.GenreServiceClient,
+	playlistClient playlistService.PlaylistServiceClient,
+	logger logger.Logger,
+) pages.Usecase {
+	return &pagesUsecase{artistClient, albumClient, trackClient, genreClient, playlistClient, logger}
+}
+
+// section is a part of a page loaded concurrently with the others, a page
+// is not shown without its required sections.
+type section struct {
+	name     string
+	required bool
+	load     func(ctx context.Context) error
+}
+
+func (usecase *pagesUsecase) GetArtistPage(ctx context.Context, artistID uint64, userID uuid.UUID) (*dto.ArtistPageDTO, error) {
+	page := &dto.ArtistPageDTO{}
+	sections := []section{
+		{name: "artist", required: true, load: func(ctx context.Context) error {
+			response, err := usecase.artistClient.FindByID(ctx, &artistService.FindByIDRequest{Id: artistID})
+			if err != nil {
+				return err
+			}
+			page.Artist = artistFromProto(response.GetArtist())
+			return nil
+		}},
+		{name: "albums", load: func(ctx context.Context) error {
+			response, err := usecase.albumClient.ListByArtist(ctx, &albumService.ListByArtistRequest{ArtistID: artistID})
+			if err != nil {
+				return err
+			}
+			page.Albums = albumsFromProto(response.GetAlbums())
+			return nil
+		}},
+		{name: "tracks", load: func(ctx context.Context) error {
+			response, err := usecase.trackClient.ListByArtist(ctx, &trackService.ListByArtistRequest{ArtistID: artistID})
+			if err != nil {
+				return err
+			}
+			page.Tracks = tracksFromProto(response.GetTracks())
+			return nil
+		}},
+		{name: "genres", load: func(ctx context.Context) error {
+			response, err := usecase.genreClient.ListByArtist(ctx, &genreService.ListByArtistRequest{ArtistID: artistID})
+			if err != nil {
+				return err
+			}
+			page.Genres = genresFromProto(response.GetGenres())
+			return nil
+		}},
+	}
+	if userID != uuid.Nil {
+		sections = append(sections, section{name: "isFavorite", load: func(ctx context.Context) error {
+			response, err := usecase.artistClient.IsFavorite(ctx, &artistService.IsFavoriteRequest{UserUuid: userID.String(), Id: artistID})
+			if err != nil {
+				return err
+			}
+			favorite := response.GetFavorite()
+			page.IsFavorite = &favorite
+			return nil
+		}})
+	}
+
+	unavailable, err := usecase.loadSections(ctx, sections)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, pages.ErrArtistNotFound
+		}
+		return nil, pages.ErrPageUnavailable
+	}
+	page.Unavailable = unavailable
+
+	return page, nil
+}
+
+func (usecase *pagesUsecase) GetAlbumPage(ctx context.Context, albumID uint64, userID uuid.UUID) (*dto.AlbumPageDTO, error) {
+	page := &dto.AlbumPageDTO{}
+	sections := []section{
+		{name: "album", required: true, load: func(ctx context.Context) error {
+			response, err := usecase.albumClient.FindByID(ctx, &albumService.FindByIDRequest{Id: albumID})
+			if err != nil {
+				return err
+			}
+			page.Album = albumFromProto(response.GetAlbum())
+			return nil
+		}},
+		{name: "tracks", load: func(ctx context.Context) error {
+			response, err := usecase.trackClient.ListByAlbum(ctx, &trackService.ListByAlbumRequest{AlbumID: albumID})
+			if err != nil {
+				return err
+			}
+			page.Tracks = tracksFromProto(response.GetTracks())
+			return nil
+		}},
+	}
+	if userID != uuid.Nil {
+		sections = append(sections, section{name: "isFavorite", load: func(ctx context.Context) error {
+			response, err := usecase.albumClient.IsFavorite(ctx, &albumService.IsFavoriteRequest{UserUuid: userID.String(), Id: albumID})
+			if err != nil {
+				return err
+			}
+			favorite := response.GetFavorite()
+			page.IsFavorite = &favorite
+			return nil
+		}})
+	}
+
+	unavailable, err := usecase.loadSections(ctx, sections)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, pages.ErrAlbumNotFound
+		}
+		return nil, pages.ErrPageUnavailable
+	}
+	page.Unavailable = unavailable
+
+	return page, nil
+}
+
+func (usecase *pagesUsecase) GetHomePage(ctx context.Context, userID uuid.UUID) (*dto.HomePageDTO, error) {
+	page := &dto.HomePageDTO{}
+	sections := []section{
+		{name: "popularTracks", load: func(ctx context.Context) error {
+			response, err := usecase.trackClient.ListPopular(ctx, &trackService.ListPopularRequest{})
+			if err != nil {
+				return err
+			}
+			page.PopularTracks = tracksFromProto(response.GetTracks())
+			return nil
+		}},
+		{name: "popularArtists", load: func(ctx context.Context) error {
+			response, err := usecase.artistClient.ListPopular(ctx, &artistService.ListPopularRequest{})
+			if err != nil {
+				return err
+			}
+			page.PopularArtists = artistsFromProto(response.GetArtists())
+			return nil
+		}},
+		{name: "recentReleases", load: func(ctx context.Context) error {
+			response, err := usecase.albumClient.ListRecent(ctx, &albumService.ListRecentRequest{Limit: recentReleasesLimit})
+			if err != nil {
+				return err
+			}
+			page.RecentReleases = albumsFromProto(response.GetAlbums())
+			return nil
+		}},
+	}
+	if userID != uuid.Nil {
+		sections = append(sections,
+			section{name: "favoriteTracks", load: func(ctx context.Context) error {
+				response, err := usecase.trackClient.ListFavorites(ctx, &trackService.ListFavoritesRequest{UserUuid: userID.String()})
+				if err != nil {
+					return err
+				}
+				page.FavoriteTracks = tracksFromProto(response.GetTracks())
+				return nil
+			}},
+			section{name: "favoriteAlbums", load: func(ctx context.Context) error {
+				response, err := usecase.albumClient.ListFavorites(ctx, &albumService.ListFavoritesRequest{UserUuid: userID.String()})
+				if err != nil {
+					return err
+				}
+				page.FavoriteAlbums = albumsFromProto(response.GetAlbums())
+				return nil
+			}},
+			section{name: "favoritePlaylists", load: func(ctx context.Context) error {
+				response, err := usecase.playlistClient.ListFavorites(ctx, &playlistService.ListFavoritesRequest{UserUuid: userID.String()})
+				if err != nil {
+					return err
+				}
+				page.FavoritePlaylists = playlistsFromProto(response.GetPlaylists())
+				return nil
+			}},
+		)
+	}
+
+	unavailable, err := usecase.loadSections(ctx, sections)
+	if err != nil {
+		return nil, pages.ErrPageUnavailable
+	}
+	page.Unavailable = unavailable
+
+	return page, nil
+}
+
+// loadSections runs sections concurrently and returns names of the optional
+// ones that failed, or the error of a failed required section.
+func (usecase *pagesUsecase) loadSections(ctx context.Context, sections []section) ([]string, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+
+	errs := make([]error, len(sections))
+	var wg sync.WaitGroup
+	for i, section := range sections {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, sectionTimeout)
+			defer cancel()
+			errs[i] = section.load(ctx)
+		}()
+	}
+	wg.Wait()
+
+	var unavailable []string
+	for i, section := range sections {
+		if errs[i] == nil {
+			continue
+		}
+		if section.required {
+			usecase.logger.Warn(fmt.Sprintf("Can't load required section %s: %v", section.name, errs[i]), requestID)
+			return nil, errs[i]
+		}
+		usecase.logger.Warn(fmt.Sprintf("Section %s is unavailable: %v", section.name, errs[i]), requestID)
+		unavailable = append(unavailable, section.name)
+	}
+
+	return unavailable, nil
+}
+
+func artistFromProto(artist *artistService.Artist) *artistDTO.ArtistDTO {
+	return &artistDTO.ArtistDTO{
+		ID:      artist.GetId(),
+		Name:    artist.GetName(),
+		Bio:     artist.GetBio(),
+		Country: artist.GetCountry(),
+		Image:   artist.GetImage(),
+		Images:  artistDTO.NewImagesDTO(artist.GetImage()),
+	}
+}
+
+func artistsFromProto(artists []*artistService.Artist) []*artistDTO.ArtistDTO {
+	dtoArtists := make([]*artistDTO.ArtistDTO, 0, len(artists))
+	for _, artist := range artists {
+		dtoArtists = append(dtoArtists, artistFromProto(artist))
+	}
+	return dtoArtists
+}
+
+func albumFromProto(album *albumService.Album) *albumDTO.AlbumDTO {
+	return &albumDTO.AlbumDTO{
+		ID:          album.GetId(),
+		Name:        album.GetName(),
+		ReleaseDate: album.GetReleaseDate().AsTime(),
+		Image:       album.GetImage(),
+		ArtistName:  album.GetArtistName(),
+		ArtistID:    album.GetArtistID(),
+		Images:      albumDTO.NewImagesDTO(album.GetImage()),
+	}
+}
+
+func albumsFromProto(albums []*albumService.Album) []*albumDTO.AlbumDTO {
+	dtoAlbums := make([]*albumDTO.AlbumDTO, 0, len(albums))
+	for _, album := range albums {
+		dtoAlbums = append(dtoAlbums, albumFromProto(album))
+	}
+	return dtoAlbums
+}
+
+func tracksFromProto(tracks []*trackService.Track) []*trackDTO.TrackDTO {
+	dtoTracks := make([]*trackDTO.TrackDTO, 0, len(tracks))
+	for _, track := range tracks {
+		dtoTracks = append(dtoTracks, &trackDTO.TrackDTO{
+			ID:          track.GetId(),
+			Name:        track.GetName(),
+			Duration:    track.GetDuration(),
+			FilePath:    track.GetFilepath(),
+			Image:       track.GetImage(),
+			ArtistName:  track.GetArtistName(),
+			ArtistID:    track.GetArtistID(),
+			AlbumName:   track.GetAlbumName(),
+			AlbumID:     track.GetAlbumID(),
+			ReleaseDate: track.GetReleaseDate().AsTime(),
+		})
+	}
+	return dtoTracks
+}
+
+func genresFromProto(genres []*genreService.Genre) []*genreDTO.GenreDTO {
+	dtoGenres := make([]*genreDTO.GenreDTO, 0, len(genres))
+	for _, genre := range genres {
+		dtoGenres = append(dtoGenres, &genreDTO.GenreDTO{
+			ID:      genre.GetId(),
+			Name:    genre.GetName(),
+			RusName: genre.GetRusName(),
+		})
+	}
+	return dtoGenres
+}
+
+func playlistsFromProto(playlists []*playlistService.Playlist) []*playlistDTO.PlaylistDTO {
+	dtoPlaylists := make([]*playlistDTO.PlaylistDTO, 0, len(playlists))
+	for _, playlist := range playlists {
+		// owners come from the playlist service and are always valid
+		ownerID, _ := uuid.Parse(playlist.GetOwnerUuid())
+		dtoPlaylists = append(dtoPlaylists, &playlistDTO.PlaylistDTO{
+			Id:        playlist.GetId(),
+			Name:      playlist.GetName(),
+			Image:     playlist.GetImage(),
+			OwnerID:   ownerID,
+			OwnerName: playlist.GetOwnerName(),
+			Images:    sharedDTO.NewImagesDTO(playlist.GetImage()),
+		})
+	}
+	return dtoPlaylists
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/pages/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	genreService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/genre"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type clients struct {
+	artist   *mock.MockArtistServiceClient
+	album    *mock.MockAlbumServiceClient
+	track    *mock.MockTrackServiceClient
+	genre    *mock.MockGenreServiceClient
+	playlist *mock.MockPlaylistServiceClient
+}
+
+func newTestUsecase(t *testing.T) (pages.Usecase, *clients) {
+	ctrl := gomock.NewController(t)
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	c := &clients{
+		artist:   mock.NewMockArtistServiceClient(ctrl),
+		album:    mock.NewMockAlbumServiceClient(ctrl),
+		track:    mock.NewMockTrackServiceClient(ctrl),
+		genre:    mock.NewMockGenreServiceClient(ctrl),
+		playlist: mock.NewMockPlaylistServiceClient(ctrl),
+	}
+	usecase := NewPagesUsecase(c.artist, c.album, c.track, c.genre, c.playlist, logger.New(&cfg.Service.Logger))
+
+	return usecase, c
+}
+
+func TestPagesUsecase_GetArtistPage(t *testing.T) {
+	usecase, c := newTestUsecase(t)
+	userID := uuid.New()
+
+	c.artist.EXPECT().FindByID(gomock.Any(), &artistService.FindByIDRequest{Id: 1}).
+		Return(&artistService.FindByIDResponse{Artist: &artistService.Artist{Id: 1, Name: "artist"}}, nil)
+	c.artist.EXPECT().IsFavorite(gomock.Any(), &artistService.IsFavoriteRequest{UserUuid: userID.String(), Id: 1}).
+		Return(&artistService.IsFavoriteResponse{Favorite: true}, nil)
+	c.album.EXPECT().ListByArtist(gomock.Any(), &albumService.ListByArtistRequest{ArtistID: 1}).
+		Return(&albumService.ListResponse{Albums: []*albumService.Album{{Id: 2, Name: "album", ArtistID: 1}}}, nil)
+	c.track.EXPECT().ListByArtist(gomock.Any(), &trackService.ListByArtistRequest{ArtistID: 1}).
+		Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	c.genre.EXPECT().ListByArtist(gomock.Any(), &genreService.ListByArtistRequest{ArtistID: 1}).
+		Return(&genreService.ListResponse{Genres: []*genreService.Genre{{Id: 3, Name: "rock"}}}, nil)
+
+	page, err := usecase.GetArtistPage(context.Background(), 1, userID)
+	require.NoError(t, err)
+	require.Equal(t, "artist", page.Artist.Name)
+	require.Len(t, page.Albums, 1)
+	require.Len(t, page.Genres, 1)
+	require.Empty(t, page.Tracks)
+	require.True(t, *page.IsFavorite)
+	require.Equal(t, []string{"tracks"}, page.Unavailable)
+}
+
+func TestPagesUsecase_GetArtistPage_NotFound(t *testing.T) {
+	usecase, c := newTestUsecase(t)
+
+	c.artist.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "artist not found"))
+	c.album.EXPECT().ListByArtist(gomock.Any(), gomock.Any()).Return(&albumService.ListResponse{}, nil)
+	c.track.EXPECT().ListByArtist(gomock.Any(), gomock.Any()).Return(&trackService.ListResponse{}, nil)
+	c.genre.EXPECT().ListByArtist(gomock.Any(), gomock.Any()).Return(&genreService.ListResponse{}, nil)
+
+	page, err := usecase.GetArtistPage(context.Background(), 1, uuid.Nil)
+	require.ErrorIs(t, err, pages.ErrArtistNotFound)
+	require.Nil(t, page)
+}
+
+func TestPagesUsecase_GetAlbumPage_Unavailable(t *testing.T) {
+	usecase, c := newTestUsecase(t)
+
+	c.album.EXPECT().FindByID(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	c.track.EXPECT().ListByAlbum(gomock.Any(), gomock.Any()).Return(&trackService.ListResponse{}, nil)
+
+	page, err := usecase.GetAlbumPage(context.Background(), 1, uuid.Nil)
+	require.ErrorIs(t, err, pages.ErrPageUnavailable)
+	require.Nil(t, page)
+}
+
+func TestPagesUsecase_GetHomePage(t *testing.T) {
+	usecase, c := newTestUsecase(t)
+
+	c.track.EXPECT().ListPopular(gomock.Any(), gomock.Any()).
+		Return(&trackService.ListResponse{Tracks: []*trackService.Track{{Id: 1}, {Id: 2}}}, nil)
+	c.artist.EXPECT().ListPopular(gomock.Any(), gomock.Any()).
+		Return(&artistService.ListResponse{Artists: []*artistService.Artist{{Id: 1}}}, nil)
+	c.album.EXPECT().ListRecent(gomock.Any(), &albumService.ListRecentRequest{Limit: recentReleasesLimit}).
+		DoAndReturn(func(ctx context.Context, _ *albumService.ListRecentRequest, _ ...interface{}) (*albumService.ListResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	page, err := usecase.GetHomePage(context.Background(), uuid.Nil)
+	require.NoError(t, err)
+	require.Len(t, page.PopularTracks, 2)
+	require.Len(t, page.PopularArtists, 1)
+	require.Nil(t, page.FavoriteTracks)
+	require.Equal(t, []string{"recentReleases"}, page.Unavailable)
+}
+
+func TestPagesUsecase_GetHomePage_Favorites(t *testing.T) {
+	usecase, c := newTestUsecase(t)
+	userID := uuid.New()
+
+	c.track.EXPECT().ListPopular(gomock.Any(), gomock.Any()).Return(&trackService.ListResponse{}, nil)
+	c.artist.EXPECT().ListPopular(gomock.Any(), gomock.Any()).Return(&artistService.ListResponse{}, nil)
+	c.album.EXPECT().ListRecent(gomock.Any(), gomock.Any()).Return(&albumService.ListResponse{}, nil)
+	c.track.EXPECT().ListFavorites(gomock.Any(), &trackService.ListFavoritesRequest{UserUuid: userID.String()}).
+		Return(&trackService.ListResponse{Tracks: []*trackService.Track{{Id: 1}}}, nil)
+	c.album.EXPECT().ListFavorites(gomock.Any(), &albumService.ListFavoritesRequest{UserUuid: userID.String()}).
+		Return(nil, errors.New("connection refused"))
+	c.playlist.EXPECT().ListFavorites(gomock.Any(), &playlistService.ListFavoritesRequest{UserUuid: userID.String()}).
+		Return(&playlistService.ListResponse{Playlists: []*playlistService.Playlist{{Id: 1, OwnerUuid: userID.String()}}}, nil)
+
+	page, err := usecase.GetHomePage(context.Background(), userID)
+	require.NoError(t, err)
+	require.Len(t, page.FavoriteTracks, 1)
+	require.Len(t, page.FavoritePlaylists, 1)
+	require.Equal(t, userID, page.FavoritePlaylists[0].OwnerID)
+	require.Equal(t, []string{"favoriteAlbums"}, page.Unavailable)
+}
@@ -9,7 +9,16 @@ type Handlers interface {
 	GetUserPlaylists(response http.ResponseWriter, request *http.Request)
 	AddToPlaylist(response http.ResponseWriter, request *http.Request)
 	RemoveFromPlaylist(response http.ResponseWriter, request *http.Request)
+	RenamePlaylist(response http.ResponseWriter, request *http.Request)
+	MoveTrack(response http.ResponseWriter, request *http.Request)
+	ExportPlaylist(response http.ResponseWriter, request *http.Request)
+	ImportPlaylist(response http.ResponseWriter, request *http.Request)
+	UploadImage(response http.ResponseWriter, request *http.Request)
 	DeletePlaylist(response http.ResponseWriter, request *http.Request)
+	GetTrash(response http.ResponseWriter, request *http.Request)
+	RestorePlaylist(response http.ResponseWriter, request *http.Request)
+	GetVersions(response http.ResponseWriter, request *http.Request)
+	RevertPlaylist(response http.ResponseWriter, request *http.Request)
 	AddFavoritePlaylist(response http.ResponseWriter, request *http.Request)
 	DeleteFavoritePlaylist(response http.ResponseWriter, request *http.Request)
 	IsFavoritePlaylist(response http.ResponseWriter, request *http.Request)
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (service *playlistsService) FindByID(ctx context.Context, request *playlistService.FindByIDRequest) (*playlistService.FindByIDResponse, error) {
+	playlistID := request.GetId()
+	playlist, err := service.usecase.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		service.logger.Errorf("cannot find playlist by id: %v", err)
+		return nil, status.Errorf(codes.NotFound, "cannot find playlist by id: %v", err)
+	}
+
+	return &playlistService.FindByIDResponse{Playlist: service.playlistDTOToProto(playlist)}, nil
+}
+
+func (service *playlistsService) FindByIDs(ctx context.Context, request *playlistService.FindByIDsRequest) (*playlistService.FindByIDsResponse, error) {
+	playlists, err := service.usecase.GetPlaylists(ctx, request.GetIds())
+	if err != nil {
+		service.logger.Errorf("cannot find playlists by ids: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot find playlists by ids: %v", err)
+	}
+
+	return &playlistService.FindByIDsResponse{Playlists: service.playlistsDTOToProto(playlists)}, nil
+}
+
+func (service *playlistsService) ListByOwner(ctx context.Context, request *playlistService.ListByOwnerRequest) (*playlistService.ListResponse, error) {
+	ownerID, err := uuid.Parse(request.GetOwnerUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner uuid: %v", err)
+	}
+
+	playlists, err := service.usecase.GetUserPlaylists(ctx, ownerID)
+	if err != nil {
+		service.logger.Errorf("cannot list playlists by owner: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list playlists by owner: %v", err)
+	}
+
+	return &playlistService.ListResponse{Playlists: service.playlistsDTOToProto(playlists)}, nil
+}
+
+func (service *playlistsService) ListFavorites(ctx context.Context, request *playlistService.ListFavoritesRequest) (*playlistService.ListResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	playlists, err := service.usecase.GetFavoritePlaylists(ctx, userID)
+	if err != nil {
+		service.logger.Errorf("cannot list favorite playlists: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list favorite playlists: %v", err)
+	}
+
+	return &playlistService.ListResponse{Playlists: service.playlistsDTOToProto(playlists)}, nil
+}
+
+func (service *playlistsService) ListTrackIDs(ctx context.Context, request *playlistService.ListTrackIDsRequest) (*playlistService.ListTrackIDsResponse, error) {
+	trackIDs, err := service.usecase.GetTrackIDs(ctx, request.GetId())
+	if err != nil {
+		service.logger.Errorf("cannot list playlist tracks: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list playlist tracks: %v", err)
+	}
+
+	return &playlistService.ListTrackIDsResponse{TrackIDs: trackIDs}, nil
+}
+
+func (service *playlistsService) IsFavorite(ctx context.Context, request *playlistService.IsFavoriteRequest) (*playlistService.IsFavoriteResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	favorite, err := service.usecase.IsFavoritePlaylist(ctx, userID, request.GetId())
+	if err != nil {
+		service.logger.Errorf("cannot check favorite playlist: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot check favorite playlist: %v", err)
+	}
+
+	return &playlistService.IsFavoriteResponse{Favorite: favorite}, nil
+}
+
+func (service *playlistsService) playlistsDTOToProto(playlists []*dto.PlaylistDTO) []*playlistService.Playlist {
+	protoPlaylists := make([]*playlistService.Playlist, 0, len(playlists))
+	for _, playlist := range playlists {
+		protoPlaylists = append(protoPlaylists, service.playlistDTOToProto(playlist))
+	}
+	return protoPlaylists
+}
+
+func (service *playlistsService) playlistDTOToProto(playlist *dto.PlaylistDTO) *playlistService.Playlist {
+	return &playlistService.Playlist{
+		Id:        playlist.Id,
+		Name:      playlist.Name,
+		Image:     playlist.Image,
+		OwnerUuid: playlist.OwnerID.String(),
+		OwnerName: playlist.OwnerName,
+	}
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewPlaylistsService(mockUsecase, logger)
+
+	t.Run("successful find", func(t *testing.T) {
+		ownerID := uuid.New()
+		playlist := &dto.PlaylistDTO{
+			Id:        1,
+			Name:      "Test Playlist",
+			Image:     "test_image.jpg",
+			OwnerID:   ownerID,
+			OwnerName: "owner",
+		}
+
+		mockUsecase.EXPECT().GetPlaylist(gomock.Any(), uint64(1)).Return(playlist, nil)
+
+		resp, err := service.FindByID(context.Background(), &playlistService.FindByIDRequest{Id: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, &playlistService.FindByIDResponse{
+			Playlist: &playlistService.Playlist{
+				Id:        1,
+				Name:      "Test Playlist",
+				Image:     "test_image.jpg",
+				OwnerUuid: ownerID.String(),
+				OwnerName: "owner",
+			},
+		}, resp)
+	})
+
+	t.Run("not found error", func(t *testing.T) {
+		mockUsecase.EXPECT().GetPlaylist(gomock.Any(), uint64(1)).Return(nil, errors.New("playlist not found"))
+
+		_, err := service.FindByID(context.Background(), &playlistService.FindByIDRequest{Id: 1})
+		assert.Error(t, err)
+		assert.Equal(t, status.Errorf(codes.NotFound, "cannot find playlist by id: playlist not found"), err)
+	})
+}
+
+func TestListTrackIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewPlaylistsService(mockUsecase, logger)
+
+	mockUsecase.EXPECT().GetTrackIDs(gomock.Any(), uint64(1)).Return([]uint64{3, 1}, nil)
+
+	resp, err := service.ListTrackIDs(context.Background(), &playlistService.ListTrackIDsRequest{Id: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 1}, resp.GetTrackIDs())
+}
+
+func TestListByOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewPlaylistsService(mockUsecase, logger)
+
+	t.Run("successful list", func(t *testing.T) {
+		ownerID := uuid.New()
+		mockUsecase.EXPECT().GetUserPlaylists(gomock.Any(), ownerID).
+			Return([]*dto.PlaylistDTO{{Id: 1, OwnerID: ownerID}, {Id: 2, OwnerID: ownerID}}, nil)
+
+		resp, err := service.ListByOwner(context.Background(), &playlistService.ListByOwnerRequest{OwnerUuid: ownerID.String()})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetPlaylists(), 2)
+	})
+
+	t.Run("invalid owner uuid", func(t *testing.T) {
+		_, err := service.ListByOwner(context.Background(), &playlistService.ListByOwnerRequest{OwnerUuid: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
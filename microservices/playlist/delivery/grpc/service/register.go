@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	"google.golang.org/grpc"
+)
+
+func RegisterPlaylistService(usecase playlist.Usecase, logger logger.Logger) func(server *grpc.Server) {
+	return func(server *grpc.Server) {
+		playlistsServer := NewPlaylistsService(usecase, logger)
+		playlistService.RegisterPlaylistServiceServer(server, playlistsServer)
+	}
+}
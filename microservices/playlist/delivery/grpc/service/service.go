@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+)
+
+type playlistsService struct {
+	usecase playlist.Usecase
+	logger  logger.Logger
+
+	playlistService.UnimplementedPlaylistServiceServer
+}
+
+func NewPlaylistsService(usecase playlist.Usecase, logger logger.Logger) *playlistsService {
+	return &playlistsService{usecase, logger, playlistService.UnimplementedPlaylistServiceServer{}}
+}
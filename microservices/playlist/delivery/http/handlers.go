@@ -1,20 +1,34 @@
 package http
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/playlistfile"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 )
 
+// maxImportSize limits the size of an imported playlist file.
+const maxImportSize = 1 << 20
+
+const (
+	versionsDefaultLimit = 20
+	versionsMaxLimit     = 100
+)
+
 type playlistHandlers struct {
 	usecase playlist.Usecase
 	logger  logger.Logger
@@ -72,6 +86,10 @@ func (h *playlistHandlers) GetAllPlaylists(response http.ResponseWriter, request
 		return
 	}
 
+	if utils.NotModifiedList(response, request, playlists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.PlaylistDTOs(playlists))
 	if err != nil {
@@ -103,6 +121,10 @@ func (h *playlistHandlers) GetPlaylist(response http.ResponseWriter, request *ht
 		return
 	}
 
+	if utils.NotModified(response, request, playlist) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(playlist)
 	if err != nil {
@@ -134,6 +156,10 @@ func (h *playlistHandlers) GetUserPlaylists(response http.ResponseWriter, reques
 		return
 	}
 
+	if utils.NotModifiedList(response, request, playlists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.PlaylistDTOs(playlists))
 	if err != nil {
@@ -178,6 +204,10 @@ func (h *playlistHandlers) AddToPlaylist(response http.ResponseWriter, request *
 
 	playlistTrack, err := h.usecase.AddToPlaylist(request.Context(), playlistTrackDTO)
 	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
 		utils.JSONError(response, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -225,6 +255,10 @@ func (h *playlistHandlers) RemoveFromPlaylist(response http.ResponseWriter, requ
 
 	err = h.usecase.RemoveFromPlaylist(request.Context(), playlistTrackDTO)
 	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
 		utils.JSONError(response, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -246,6 +280,303 @@ func (h *playlistHandlers) RemoveFromPlaylist(response http.ResponseWriter, requ
 	}
 }
 
+func (h *playlistHandlers) RenamePlaylist(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	nameDTO := &dto.PlaylistNameDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, nameDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+	name := strings.TrimSpace(nameDTO.Name)
+	if name == "" {
+		utils.JSONError(response, http.StatusBadRequest, "Playlist name is empty")
+		return
+	}
+
+	playlistDTO, err := h.usecase.RenamePlaylist(request.Context(), playlistID, name, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrPlaylistNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, playlist.ErrNotOwner):
+			utils.JSONError(response, http.StatusForbidden, err.Error())
+		case errors.Is(err, playlist.ErrNameTaken):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err = easyjson.Marshal(playlistDTO)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) MoveTrack(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	positionDTO := &dto.TrackPositionDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, positionDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+	if positionDTO.Position == 0 {
+		utils.JSONError(response, http.StatusBadRequest, "Track position starts from 1")
+		return
+	}
+
+	err = h.usecase.MoveTrack(request.Context(), playlistID, positionDTO.TrackID, positionDTO.Position, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrPlaylistNotFound), errors.Is(err, playlist.ErrTrackNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, playlist.ErrNotOwner):
+			utils.JSONError(response, http.StatusForbidden, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	message := &utils.MessageResponse{}
+	rawBytes, err = easyjson.Marshal(message)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) UploadImage(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	file, _, err := request.FormFile("file")
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		h.logger.Error(fmt.Sprintf("failed to read file: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "failed to read file")
+		return
+	}
+
+	renditions, err := imaging.Process(fileBytes)
+	if err != nil {
+		h.logger.Error(fmt.Sprintf("invalid image: %v", err), requestID)
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat), errors.Is(err, imaging.ErrTooSmall), errors.Is(err, imaging.ErrTooLarge):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "failed to process image")
+		}
+		return
+	}
+
+	playlistDTO, err := h.usecase.UploadImage(request.Context(), playlistID, userID, renditions)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrPlaylistNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, playlist.ErrNotOwner):
+			utils.JSONError(response, http.StatusForbidden, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "failed to upload image")
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(playlistDTO)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) ExportPlaylist(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	format := playlistfile.FormatM3U8
+	if value := request.URL.Query().Get("format"); value != "" {
+		if format, err = playlistfile.ParseFormat(value); err != nil {
+			utils.JSONError(response, http.StatusBadRequest, "Format must be one of m3u8, xspf or json")
+			return
+		}
+	}
+
+	file, err := h.usecase.ExportPlaylist(request.Context(), playlistID, userID)
+	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := playlistfile.Encode(&buf, format, file); err != nil {
+		h.logger.Errorf("Failed to encode playlist %d: %v", playlistID, err)
+		utils.JSONError(response, http.StatusInternalServerError, "Failed to encode playlist")
+		return
+	}
+
+	filename := fmt.Sprintf("%s.%s", file.Title, format)
+	response.Header().Set("Content-Type", format.ContentType())
+	response.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write(buf.Bytes()); err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+	}
+}
+
+func (h *playlistHandlers) ImportPlaylist(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	request.Body = http.MaxBytesReader(response, request.Body, maxImportSize)
+	file, header, err := request.FormFile("file")
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	var format playlistfile.Format
+	if value := request.URL.Query().Get("format"); value != "" {
+		format, err = playlistfile.ParseFormat(value)
+	} else {
+		format, err = playlistfile.DetectFormat(header.Filename, data)
+	}
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Format must be one of m3u8, xspf or json")
+		return
+	}
+
+	playlistFile, err := playlistfile.Decode(data, format)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.usecase.ImportPlaylist(request.Context(), userID, request.FormValue("name"), playlistFile)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrEmptyImport):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		case errors.Is(err, playlist.ErrNameTaken):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(report)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusCreated)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
 func (h *playlistHandlers) DeletePlaylist(response http.ResponseWriter, request *http.Request) {
 	_, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
 	if !ok {
@@ -263,6 +594,10 @@ func (h *playlistHandlers) DeletePlaylist(response http.ResponseWriter, request
 
 	err = h.usecase.DeletePlaylist(request.Context(), playlistID)
 	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
 		utils.JSONError(response, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -284,6 +619,182 @@ func (h *playlistHandlers) DeletePlaylist(response http.ResponseWriter, request
 	}
 }
 
+func (h *playlistHandlers) GetTrash(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	trash, err := h.usecase.GetTrash(request.Context(), userID)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(dto.TrashedPlaylistDTOs(trash))
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) RestorePlaylist(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	playlistDTO, err := h.usecase.RestorePlaylist(request.Context(), playlistID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrPlaylistNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, playlist.ErrNameTaken):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(playlistDTO)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) GetVersions(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+
+	query := request.URL.Query()
+	var before uint64
+	if raw := query.Get("cursor"); raw != "" {
+		if before, err = strconv.ParseUint(raw, 10, 64); err != nil {
+			utils.JSONError(response, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+	limit, err := utils.ParseLimit(query.Get("limit"), versionsDefaultLimit, versionsMaxLimit)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid limit")
+		return
+	}
+
+	versions, err := h.usecase.GetVersions(request.Context(), playlistID, userID, before, limit)
+	if err != nil {
+		if errors.Is(err, playlist.ErrPlaylistNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(versions)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (h *playlistHandlers) RevertPlaylist(response http.ResponseWriter, request *http.Request) {
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		utils.JSONError(response, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	vars := mux.Vars(request)
+	playlistID, err := strconv.ParseUint(vars["playlistId"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist ID")
+		return
+	}
+	version, err := strconv.ParseUint(vars["version"], 10, 64)
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid playlist version")
+		return
+	}
+
+	versionDTO, err := h.usecase.RevertPlaylist(request.Context(), playlistID, version, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, playlist.ErrPlaylistNotFound), errors.Is(err, playlist.ErrVersionNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, playlist.ErrNotOwner):
+			utils.JSONError(response, http.StatusForbidden, err.Error())
+		case errors.Is(err, playlist.ErrNameTaken):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(versionDTO)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		h.logger.Errorf("Failed to write response: %v", err)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
 func (h *playlistHandlers) AddFavoritePlaylist(response http.ResponseWriter, request *http.Request) {
 	requestID := request.Context().Value(utils.RequestIDKey{})
 	vars := mux.Vars(request)
@@ -398,6 +909,10 @@ func (h *playlistHandlers) GetFavoritePlaylists(response http.ResponseWriter, re
 		return
 	}
 
+	if utils.NotModifiedList(response, request, playlists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.PlaylistDTOs(playlists))
 	if err != nil {
@@ -422,6 +937,10 @@ func (h *playlistHandlers) GetPopularPlaylists(response http.ResponseWriter, req
 		return
 	}
 
+	if utils.NotModifiedList(response, request, playlists) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.PlaylistDTOs(playlists))
 	if err != nil {
@@ -1,12 +1,17 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/idempotency"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	notificationProducer "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/producer"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/delivery/worker"
 	playlistRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/repository"
 	playlistUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/usecase"
+	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
 	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
@@ -15,23 +20,52 @@ func BindRoutes(s *httpServer.Server, userClient userService.UserServiceClient)
 	s.MUX.Handle("/metrics", promhttp.Handler())
 
 	playlistRepo := playlistRepo.NewPlaylistRepository(s.PG)
-	playlistUsecase := playlistUsecase.NewPlaylistUsecase(playlistRepo, userClient, s.Logger)
+	playlistS3Repo := s3Repo.NewS3Repository(s.S3, s.Logger)
+	notificationProducer := notificationProducer.NewNotificationPGProducer(s.PG)
+	playlistUsecase := playlistUsecase.NewPlaylistUsecase(&s.CFG.Minio, &s.CFG.Service.Trash, playlistRepo, playlistS3Repo, userClient, notificationProducer, s.Logger)
 	playlistHandleres := NewPlaylistHandlers(playlistUsecase, s.Logger)
 
-	s.MUX.HandleFunc("/api/v1/playlists", playlistHandleres.GetAllPlaylists).Methods("GET")
+	idempotencyStore := idempotency.NewPGStore(s.PG)
+
+	s.Lifecycle.Go("playlist trash purge", func(ctx context.Context) error {
+		return worker.Run(ctx, playlistUsecase, s.Logger)
+	})
+
+	s.MUX.Handle(
+		"/api/v1/playlists",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(playlistHandleres.GetAllPlaylists)),
+	).Methods("GET")
 
 	s.MUX.Handle(
 		"/api/v1/playlists",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.CreatePlaylist)),
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.IdempotencyMiddleware(
+				&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+				http.HandlerFunc(playlistHandleres.CreatePlaylist),
+			),
+		),
 	).Methods("POST")
 
-	s.MUX.HandleFunc("/api/v1/playlists/{playlistId:[0-9]+}", playlistHandleres.GetPlaylist).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(playlistHandleres.GetPlaylist)),
+	).Methods("GET")
 
-	s.MUX.HandleFunc("/api/v1/playlists/{userId:[0-9a-fA-F-]+}/allPlaylists", playlistHandleres.GetUserPlaylists).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/playlists/{userId:[0-9a-fA-F-]+}/allPlaylists",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(playlistHandleres.GetUserPlaylists)),
+	).Methods("GET")
 
 	s.MUX.Handle(
 		"/api/v1/playlists/{playlistId:[0-9]+}/tracks",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.AddToPlaylist)),
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.IdempotencyMiddleware(
+				&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+				http.HandlerFunc(playlistHandleres.AddToPlaylist),
+			),
+		),
 	).Methods("POST")
 
 	s.MUX.Handle(
@@ -39,14 +73,68 @@ func BindRoutes(s *httpServer.Server, userClient userService.UserServiceClient)
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.RemoveFromPlaylist)),
 	).Methods("DELETE")
 
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/tracks",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.MoveTrack)),
+	).Methods("PATCH")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.RenamePlaylist)),
+	).Methods("PATCH")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/export",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.ExportPlaylist)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/import",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.IdempotencyMiddleware(
+				&s.CFG.Service.Idempotency, idempotencyStore, s.Logger,
+				http.HandlerFunc(playlistHandleres.ImportPlaylist),
+			),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/image",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.UploadImage)),
+	).Methods("POST")
+
 	s.MUX.Handle(
 		"/api/v1/playlists/{playlistId:[0-9]+}",
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.DeletePlaylist)),
 	).Methods("DELETE")
 
+	s.MUX.Handle(
+		"/api/v1/playlists/trash",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.GetTrash)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/restore",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.RestorePlaylist)),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/versions",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.GetVersions)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/playlists/{playlistId:[0-9]+}/versions/{version:[0-9]+}/revert",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.RevertPlaylist)),
+	).Methods("POST")
+
 	s.MUX.Handle(
 		"/api/v1/playlists/favorite/byUser/{userID}",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.GetFavoritePlaylists)),
+		middleware.CacheMiddleware(
+			s.CFG.Service.Cache.Private,
+			middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.GetFavoritePlaylists)),
+		),
 	).Methods("GET")
 
 	s.MUX.Handle(
@@ -64,5 +152,8 @@ func BindRoutes(s *httpServer.Server, userClient userService.UserServiceClient)
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(playlistHandleres.DeleteFavoritePlaylist)),
 	).Methods("DELETE")
 
-	s.MUX.HandleFunc("/api/v1/playlists/popular", playlistHandleres.GetPopularPlaylists).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/playlists/popular",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(playlistHandleres.GetPopularPlaylists)),
+	).Methods("GET")
 }
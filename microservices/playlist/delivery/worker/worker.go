@@ -0,0 +1,28 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+// purgeInterval is how often the playlists whose retention is over are
+// deleted, they can't be restored before that already.
+const purgeInterval = time.Hour
+
+// Run purges the trash until ctx is cancelled.
+func Run(ctx context.Context, usecase playlist.Usecase, logger logger.Logger) error {
+	for {
+		if err := usecase.PurgeTrash(context.WithoutCancel(ctx)); err != nil {
+			logger.Errorf("failed to purge playlist trash: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(purgeInterval):
+		}
+	}
+}
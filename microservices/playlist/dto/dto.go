@@ -1,17 +1,22 @@
 package dto
 
 import (
+	"time"
+
+	sharedDTO "github.com/go-park-mail-ru/2024_2_NovaCode/internal/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/google/uuid"
 )
 
 //easyjson:json
 type PlaylistDTO struct {
-	Id        uint64    `json:"id,omitempty"`
-	Name      string    `json:"name"`
-	Image     string    `json:"image,omitempty"`
-	OwnerID   uuid.UUID `json:"owner_id,omitempty"`
-	OwnerName string    `json:"owner_name,omitempty"`
+	Id        uint64                `json:"id,omitempty"`
+	Name      string                `json:"name"`
+	Image     string                `json:"image,omitempty"`
+	OwnerID   uuid.UUID             `json:"owner_id,omitempty"`
+	OwnerName string                `json:"owner_name,omitempty"`
+	Images    []*sharedDTO.ImageDTO `json:"images,omitempty"`
+	UpdatedAt time.Time             `json:"-"`
 }
 
 //easyjson:json
@@ -31,10 +36,131 @@ type TrackIdDTO struct {
 	TrackID uint64 `json:"track_id"`
 }
 
+//easyjson:json
+type PlaylistNameDTO struct {
+	Name string `json:"name"`
+}
+
+// TrackPositionDTO moves a track, Position is 1-based.
+//
+//easyjson:json
+type TrackPositionDTO struct {
+	TrackID  uint64 `json:"track_id"`
+	Position uint64 `json:"position"`
+}
+
+//easyjson:json
+type ImportReportDTO struct {
+	Playlist  *PlaylistDTO         `json:"playlist"`
+	Matched   int                  `json:"matched"`
+	Unmatched []*UnmatchedEntryDTO `json:"unmatched"`
+}
+
+//easyjson:json
+type UnmatchedEntryDTO struct {
+	Position int    `json:"position"`
+	Title    string `json:"title"`
+	Artist   string `json:"artist,omitempty"`
+	Album    string `json:"album,omitempty"`
+	Duration uint64 `json:"duration,omitempty"`
+}
+
 func NewPlaylistFromPlaylistDTO(dto *PlaylistDTO) *models.Playlist {
 	return &models.Playlist{Name: dto.Name, Image: dto.Image, OwnerID: dto.OwnerID}
 }
 
 func NewPlaylistToPlaylistDTO(playlist *models.Playlist) *PlaylistDTO {
-	return &PlaylistDTO{Id: playlist.ID, Name: playlist.Name, Image: playlist.Image, OwnerID: playlist.OwnerID}
+	return &PlaylistDTO{
+		Id:        playlist.ID,
+		Name:      playlist.Name,
+		Image:     playlist.Image,
+		OwnerID:   playlist.OwnerID,
+		Images:    sharedDTO.NewImagesDTO(playlist.Image),
+		UpdatedAt: playlist.UpdatedAt,
+	}
+}
+
+func (playlist *PlaylistDTO) Version() (uint64, time.Time) {
+	return playlist.Id, playlist.UpdatedAt
+}
+
+// TrashedPlaylistDTO is a deleted playlist, it can be restored until
+// PurgeAt.
+//
+//easyjson:json
+type TrashedPlaylistDTO struct {
+	PlaylistDTO
+	DeletedAt time.Time `json:"deleted_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+}
+
+//easyjson:json
+type TrashedPlaylistDTOs []*TrashedPlaylistDTO
+
+func NewTrashedPlaylistDTO(playlist *models.Playlist, retention time.Duration) *TrashedPlaylistDTO {
+	trashedDTO := &TrashedPlaylistDTO{PlaylistDTO: *NewPlaylistToPlaylistDTO(playlist)}
+	if playlist.DeletedAt != nil {
+		trashedDTO.DeletedAt = *playlist.DeletedAt
+		trashedDTO.PurgeAt = playlist.DeletedAt.Add(retention)
+	}
+	return trashedDTO
+}
+
+// PlaylistVersionDTO is a version of a playlist with what changed since the
+// version before it. Positions are 1-based.
+//
+//easyjson:json
+type PlaylistVersionDTO struct {
+	Version      uint64            `json:"version"`
+	Change       string            `json:"change"`
+	RevertedTo   uint64            `json:"reverted_to,omitempty"`
+	AuthorID     *uuid.UUID        `json:"author_id,omitempty"`
+	Name         string            `json:"name"`
+	PreviousName string            `json:"previous_name,omitempty"`
+	TrackIDs     []uint64          `json:"track_ids"`
+	Added        []*TrackChangeDTO `json:"added"`
+	Removed      []*TrackChangeDTO `json:"removed"`
+	Moved        []*TrackMoveDTO   `json:"moved"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// PlaylistVersionsDTO is a page of versions, NextCursor is passed as the
+// cursor to get the next one and is zero on the last page.
+//
+//easyjson:json
+type PlaylistVersionsDTO struct {
+	Versions   []*PlaylistVersionDTO `json:"versions"`
+	NextCursor uint64                `json:"next_cursor,omitempty"`
+}
+
+//easyjson:json
+type TrackChangeDTO struct {
+	TrackID  uint64 `json:"track_id"`
+	Position int    `json:"position"`
+}
+
+//easyjson:json
+type TrackMoveDTO struct {
+	TrackID uint64 `json:"track_id"`
+	From    int    `json:"from"`
+	To      int    `json:"to"`
+}
+
+func NewPlaylistVersionDTO(version *models.PlaylistVersion) *PlaylistVersionDTO {
+	versionDTO := &PlaylistVersionDTO{
+		Version:    version.Version,
+		Change:     version.Change,
+		RevertedTo: version.RevertedTo,
+		Name:       version.Name,
+		TrackIDs:   version.TrackIDs,
+		Added:      []*TrackChangeDTO{},
+		Removed:    []*TrackChangeDTO{},
+		Moved:      []*TrackMoveDTO{},
+		CreatedAt:  version.CreatedAt,
+	}
+	if version.AuthorID != uuid.Nil {
+		authorID := version.AuthorID
+		versionDTO.AuthorID = &authorID
+	}
+	return versionDTO
 }
@@ -4,6 +4,8 @@ package dto
 
 import (
 	json "encoding/json"
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/internal/dto"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -17,7 +19,561 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(in *jlexer.Lexer, out *TrackIdDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(in *jlexer.Lexer, out *UnmatchedEntryDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "position":
+			out.Position = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "artist":
+			out.Artist = string(in.String())
+		case "album":
+			out.Album = string(in.String())
+		case "duration":
+			out.Duration = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(out *jwriter.Writer, in UnmatchedEntryDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.Artist != "" {
+		const prefix string = ",\"artist\":"
+		out.RawString(prefix)
+		out.String(string(in.Artist))
+	}
+	if in.Album != "" {
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		out.String(string(in.Album))
+	}
+	if in.Duration != 0 {
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Duration))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UnmatchedEntryDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UnmatchedEntryDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UnmatchedEntryDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UnmatchedEntryDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(in *jlexer.Lexer, out *TrashedPlaylistDTOs) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(TrashedPlaylistDTOs, 0, 8)
+			} else {
+				*out = TrashedPlaylistDTOs{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 *TrashedPlaylistDTO
+			if in.IsNull() {
+				in.Skip()
+				v1 = nil
+			} else {
+				if v1 == nil {
+					v1 = new(TrashedPlaylistDTO)
+				}
+				(*v1).UnmarshalEasyJSON(in)
+			}
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(out *jwriter.Writer, in TrashedPlaylistDTOs) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			if v3 == nil {
+				out.RawString("null")
+			} else {
+				(*v3).MarshalEasyJSON(out)
+			}
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrashedPlaylistDTOs) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrashedPlaylistDTOs) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrashedPlaylistDTOs) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrashedPlaylistDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(in *jlexer.Lexer, out *TrashedPlaylistDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "deleted_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeletedAt).UnmarshalJSON(data))
+			}
+		case "purge_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PurgeAt).UnmarshalJSON(data))
+			}
+		case "id":
+			out.Id = uint64(in.Uint64())
+		case "name":
+			out.Name = string(in.String())
+		case "image":
+			out.Image = string(in.String())
+		case "owner_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.OwnerID).UnmarshalText(data))
+			}
+		case "owner_name":
+			out.OwnerName = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]*dto.ImageDTO, 0, 8)
+					} else {
+						out.Images = []*dto.ImageDTO{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 *dto.ImageDTO
+					if in.IsNull() {
+						in.Skip()
+						v4 = nil
+					} else {
+						if v4 == nil {
+							v4 = new(dto.ImageDTO)
+						}
+						(*v4).UnmarshalEasyJSON(in)
+					}
+					out.Images = append(out.Images, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(out *jwriter.Writer, in TrashedPlaylistDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"deleted_at\":"
+		out.RawString(prefix[1:])
+		out.Raw((in.DeletedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"purge_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PurgeAt).MarshalJSON())
+	}
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Id))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Image != "" {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.String(string(in.Image))
+	}
+	if true {
+		const prefix string = ",\"owner_id\":"
+		out.RawString(prefix)
+		out.RawText((in.OwnerID).MarshalText())
+	}
+	if in.OwnerName != "" {
+		const prefix string = ",\"owner_name\":"
+		out.RawString(prefix)
+		out.String(string(in.OwnerName))
+	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Images {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				if v6 == nil {
+					out.RawString("null")
+				} else {
+					(*v6).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrashedPlaylistDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrashedPlaylistDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrashedPlaylistDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrashedPlaylistDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(in *jlexer.Lexer, out *TrackPositionDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = uint64(in.Uint64())
+		case "position":
+			out.Position = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(out *jwriter.Writer, in TrackPositionDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TrackID))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.Position))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackPositionDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackPositionDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackPositionDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackPositionDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(in *jlexer.Lexer, out *TrackMoveDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = uint64(in.Uint64())
+		case "from":
+			out.From = int(in.Int())
+		case "to":
+			out.To = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(out *jwriter.Writer, in TrackMoveDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TrackID))
+	}
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix)
+		out.Int(int(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.Int(int(in.To))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackMoveDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackMoveDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackMoveDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackMoveDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(in *jlexer.Lexer, out *TrackIdDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(out *jwriter.Writer, in TrackIdDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TrackID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackIdDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackIdDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackIdDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackIdDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto5(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(in *jlexer.Lexer, out *TrackChangeDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -38,6 +594,336 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		switch key {
 		case "track_id":
 			out.TrackID = uint64(in.Uint64())
+		case "position":
+			out.Position = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(out *jwriter.Writer, in TrackChangeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.Uint64(uint64(in.TrackID))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v TrackChangeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v TrackChangeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *TrackChangeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *TrackChangeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto6(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(in *jlexer.Lexer, out *PlaylistVersionsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "versions":
+			if in.IsNull() {
+				in.Skip()
+				out.Versions = nil
+			} else {
+				in.Delim('[')
+				if out.Versions == nil {
+					if !in.IsDelim(']') {
+						out.Versions = make([]*PlaylistVersionDTO, 0, 8)
+					} else {
+						out.Versions = []*PlaylistVersionDTO{}
+					}
+				} else {
+					out.Versions = (out.Versions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 *PlaylistVersionDTO
+					if in.IsNull() {
+						in.Skip()
+						v7 = nil
+					} else {
+						if v7 == nil {
+							v7 = new(PlaylistVersionDTO)
+						}
+						(*v7).UnmarshalEasyJSON(in)
+					}
+					out.Versions = append(out.Versions, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "next_cursor":
+			out.NextCursor = uint64(in.Uint64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(out *jwriter.Writer, in PlaylistVersionsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"versions\":"
+		out.RawString(prefix[1:])
+		if in.Versions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Versions {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				if v9 == nil {
+					out.RawString("null")
+				} else {
+					(*v9).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.NextCursor != 0 {
+		const prefix string = ",\"next_cursor\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.NextCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistVersionsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistVersionsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistVersionsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistVersionsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto7(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(in *jlexer.Lexer, out *PlaylistVersionDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "version":
+			out.Version = uint64(in.Uint64())
+		case "change":
+			out.Change = string(in.String())
+		case "reverted_to":
+			out.RevertedTo = uint64(in.Uint64())
+		case "author_id":
+			if in.IsNull() {
+				in.Skip()
+				out.AuthorID = nil
+			} else {
+				if out.AuthorID == nil {
+					out.AuthorID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.AuthorID).UnmarshalText(data))
+				}
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "previous_name":
+			out.PreviousName = string(in.String())
+		case "track_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackIDs = nil
+			} else {
+				in.Delim('[')
+				if out.TrackIDs == nil {
+					if !in.IsDelim(']') {
+						out.TrackIDs = make([]uint64, 0, 8)
+					} else {
+						out.TrackIDs = []uint64{}
+					}
+				} else {
+					out.TrackIDs = (out.TrackIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 uint64
+					v10 = uint64(in.Uint64())
+					out.TrackIDs = append(out.TrackIDs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "added":
+			if in.IsNull() {
+				in.Skip()
+				out.Added = nil
+			} else {
+				in.Delim('[')
+				if out.Added == nil {
+					if !in.IsDelim(']') {
+						out.Added = make([]*TrackChangeDTO, 0, 8)
+					} else {
+						out.Added = []*TrackChangeDTO{}
+					}
+				} else {
+					out.Added = (out.Added)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 *TrackChangeDTO
+					if in.IsNull() {
+						in.Skip()
+						v11 = nil
+					} else {
+						if v11 == nil {
+							v11 = new(TrackChangeDTO)
+						}
+						(*v11).UnmarshalEasyJSON(in)
+					}
+					out.Added = append(out.Added, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "removed":
+			if in.IsNull() {
+				in.Skip()
+				out.Removed = nil
+			} else {
+				in.Delim('[')
+				if out.Removed == nil {
+					if !in.IsDelim(']') {
+						out.Removed = make([]*TrackChangeDTO, 0, 8)
+					} else {
+						out.Removed = []*TrackChangeDTO{}
+					}
+				} else {
+					out.Removed = (out.Removed)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v12 *TrackChangeDTO
+					if in.IsNull() {
+						in.Skip()
+						v12 = nil
+					} else {
+						if v12 == nil {
+							v12 = new(TrackChangeDTO)
+						}
+						(*v12).UnmarshalEasyJSON(in)
+					}
+					out.Removed = append(out.Removed, v12)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "moved":
+			if in.IsNull() {
+				in.Skip()
+				out.Moved = nil
+			} else {
+				in.Delim('[')
+				if out.Moved == nil {
+					if !in.IsDelim(']') {
+						out.Moved = make([]*TrackMoveDTO, 0, 8)
+					} else {
+						out.Moved = []*TrackMoveDTO{}
+					}
+				} else {
+					out.Moved = (out.Moved)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 *TrackMoveDTO
+					if in.IsNull() {
+						in.Skip()
+						v13 = nil
+					} else {
+						if v13 == nil {
+							v13 = new(TrackMoveDTO)
+						}
+						(*v13).UnmarshalEasyJSON(in)
+					}
+					out.Moved = append(out.Moved, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -48,42 +934,148 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(out *jwriter.Writer, in TrackIdDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(out *jwriter.Writer, in PlaylistVersionDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"track_id\":"
+		const prefix string = ",\"version\":"
 		out.RawString(prefix[1:])
-		out.Uint64(uint64(in.TrackID))
+		out.Uint64(uint64(in.Version))
+	}
+	{
+		const prefix string = ",\"change\":"
+		out.RawString(prefix)
+		out.String(string(in.Change))
+	}
+	if in.RevertedTo != 0 {
+		const prefix string = ",\"reverted_to\":"
+		out.RawString(prefix)
+		out.Uint64(uint64(in.RevertedTo))
+	}
+	if in.AuthorID != nil {
+		const prefix string = ",\"author_id\":"
+		out.RawString(prefix)
+		out.RawText((*in.AuthorID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.PreviousName != "" {
+		const prefix string = ",\"previous_name\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviousName))
+	}
+	{
+		const prefix string = ",\"track_ids\":"
+		out.RawString(prefix)
+		if in.TrackIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.TrackIDs {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.Uint64(uint64(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"added\":"
+		out.RawString(prefix)
+		if in.Added == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Added {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				if v17 == nil {
+					out.RawString("null")
+				} else {
+					(*v17).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"removed\":"
+		out.RawString(prefix)
+		if in.Removed == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v18, v19 := range in.Removed {
+				if v18 > 0 {
+					out.RawByte(',')
+				}
+				if v19 == nil {
+					out.RawString("null")
+				} else {
+					(*v19).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"moved\":"
+		out.RawString(prefix)
+		if in.Moved == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Moved {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if v21 == nil {
+					out.RawString("null")
+				} else {
+					(*v21).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackIdDTO) MarshalJSON() ([]byte, error) {
+func (v PlaylistVersionDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackIdDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(w, v)
+func (v PlaylistVersionDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackIdDTO) UnmarshalJSON(data []byte) error {
+func (v *PlaylistVersionDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackIdDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto(l, v)
+func (v *PlaylistVersionDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto8(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(in *jlexer.Lexer, out *PlaylistTrackDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(in *jlexer.Lexer, out *PlaylistTrackDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -100,17 +1092,17 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v1 *PlaylistTrackDTO
+			var v22 *PlaylistTrackDTO
 			if in.IsNull() {
 				in.Skip()
-				v1 = nil
+				v22 = nil
 			} else {
-				if v1 == nil {
-					v1 = new(PlaylistTrackDTO)
+				if v22 == nil {
+					v22 = new(PlaylistTrackDTO)
 				}
-				(*v1).UnmarshalEasyJSON(in)
+				(*v22).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v1)
+			*out = append(*out, v22)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -119,19 +1111,19 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(out *jwriter.Writer, in PlaylistTrackDTOs) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(out *jwriter.Writer, in PlaylistTrackDTOs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v2, v3 := range in {
-			if v2 > 0 {
+		for v23, v24 := range in {
+			if v23 > 0 {
 				out.RawByte(',')
 			}
-			if v3 == nil {
+			if v24 == nil {
 				out.RawString("null")
 			} else {
-				(*v3).MarshalEasyJSON(out)
+				(*v24).MarshalEasyJSON(out)
 			}
 		}
 		out.RawByte(']')
@@ -141,27 +1133,27 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 // MarshalJSON supports json.Marshaler interface
 func (v PlaylistTrackDTOs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PlaylistTrackDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PlaylistTrackDTOs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PlaylistTrackDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto1(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto9(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(in *jlexer.Lexer, out *PlaylistTrackDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(in *jlexer.Lexer, out *PlaylistTrackDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -194,7 +1186,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(out *jwriter.Writer, in PlaylistTrackDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(out *jwriter.Writer, in PlaylistTrackDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -214,27 +1206,93 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 // MarshalJSON supports json.Marshaler interface
 func (v PlaylistTrackDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PlaylistTrackDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PlaylistTrackDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PlaylistTrackDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto2(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto10(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(in *jlexer.Lexer, out *PlaylistNameDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(out *jwriter.Writer, in PlaylistNameDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistNameDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistNameDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistNameDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistNameDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto11(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(in *jlexer.Lexer, out *PlaylistDTOs) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(in *jlexer.Lexer, out *PlaylistDTOs) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		in.Skip()
@@ -251,17 +1309,17 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 			*out = (*out)[:0]
 		}
 		for !in.IsDelim(']') {
-			var v4 *PlaylistDTO
+			var v25 *PlaylistDTO
 			if in.IsNull() {
 				in.Skip()
-				v4 = nil
+				v25 = nil
 			} else {
-				if v4 == nil {
-					v4 = new(PlaylistDTO)
+				if v25 == nil {
+					v25 = new(PlaylistDTO)
 				}
-				(*v4).UnmarshalEasyJSON(in)
+				(*v25).UnmarshalEasyJSON(in)
 			}
-			*out = append(*out, v4)
+			*out = append(*out, v25)
 			in.WantComma()
 		}
 		in.Delim(']')
@@ -270,19 +1328,19 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(out *jwriter.Writer, in PlaylistDTOs) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(out *jwriter.Writer, in PlaylistDTOs) {
 	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 		out.RawString("null")
 	} else {
 		out.RawByte('[')
-		for v5, v6 := range in {
-			if v5 > 0 {
+		for v26, v27 := range in {
+			if v26 > 0 {
 				out.RawByte(',')
 			}
-			if v6 == nil {
+			if v27 == nil {
 				out.RawString("null")
 			} else {
-				(*v6).MarshalEasyJSON(out)
+				(*v27).MarshalEasyJSON(out)
 			}
 		}
 		out.RawByte(']')
@@ -292,27 +1350,27 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 // MarshalJSON supports json.Marshaler interface
 func (v PlaylistDTOs) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PlaylistDTOs) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PlaylistDTOs) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PlaylistDTOs) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto3(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto12(l, v)
 }
-func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(in *jlexer.Lexer, out *PlaylistDTO) {
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(in *jlexer.Lexer, out *PlaylistDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -343,6 +1401,37 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 			}
 		case "owner_name":
 			out.OwnerName = string(in.String())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]*dto.ImageDTO, 0, 8)
+					} else {
+						out.Images = []*dto.ImageDTO{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 *dto.ImageDTO
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						if v28 == nil {
+							v28 = new(dto.ImageDTO)
+						}
+						(*v28).UnmarshalEasyJSON(in)
+					}
+					out.Images = append(out.Images, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -353,7 +1442,7 @@ func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		in.Consumed()
 	}
 }
-func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(out *jwriter.Writer, in PlaylistDTO) {
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(out *jwriter.Writer, in PlaylistDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -388,29 +1477,183 @@ func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlayli
 		out.RawString(prefix)
 		out.String(string(in.OwnerName))
 	}
+	if len(in.Images) != 0 {
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v29, v30 := range in.Images {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PlaylistDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(&w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PlaylistDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(w, v)
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PlaylistDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(&r, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PlaylistDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto4(l, v)
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto13(l, v)
+}
+func easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(in *jlexer.Lexer, out *ImportReportDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlist":
+			if in.IsNull() {
+				in.Skip()
+				out.Playlist = nil
+			} else {
+				if out.Playlist == nil {
+					out.Playlist = new(PlaylistDTO)
+				}
+				(*out.Playlist).UnmarshalEasyJSON(in)
+			}
+		case "matched":
+			out.Matched = int(in.Int())
+		case "unmatched":
+			if in.IsNull() {
+				in.Skip()
+				out.Unmatched = nil
+			} else {
+				in.Delim('[')
+				if out.Unmatched == nil {
+					if !in.IsDelim(']') {
+						out.Unmatched = make([]*UnmatchedEntryDTO, 0, 8)
+					} else {
+						out.Unmatched = []*UnmatchedEntryDTO{}
+					}
+				} else {
+					out.Unmatched = (out.Unmatched)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 *UnmatchedEntryDTO
+					if in.IsNull() {
+						in.Skip()
+						v31 = nil
+					} else {
+						if v31 == nil {
+							v31 = new(UnmatchedEntryDTO)
+						}
+						(*v31).UnmarshalEasyJSON(in)
+					}
+					out.Unmatched = append(out.Unmatched, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(out *jwriter.Writer, in ImportReportDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlist\":"
+		out.RawString(prefix[1:])
+		if in.Playlist == nil {
+			out.RawString("null")
+		} else {
+			(*in.Playlist).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"matched\":"
+		out.RawString(prefix)
+		out.Int(int(in.Matched))
+	}
+	{
+		const prefix string = ",\"unmatched\":"
+		out.RawString(prefix)
+		if in.Unmatched == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Unmatched {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				if v33 == nil {
+					out.RawString("null")
+				} else {
+					(*v33).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportReportDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportReportDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson56de76c1EncodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportReportDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportReportDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson56de76c1DecodeGithubComGoParkMailRu20242NovaCodeMicroservicesPlaylistDto14(l, v)
 }
@@ -0,0 +1,13 @@
+package playlist
+
+import "errors"
+
+var (
+	ErrPlaylistNotFound = errors.New("Playlist wasn't found")
+	ErrNotOwner         = errors.New("Playlist belongs to another user")
+	ErrNameTaken        = errors.New("Playlist name is already taken")
+	ErrTrackInPlaylist  = errors.New("Track is already in the playlist")
+	ErrTrackNotFound    = errors.New("Track isn't in the playlist")
+	ErrEmptyImport      = errors.New("Playlist file has no tracks")
+	ErrVersionNotFound  = errors.New("Playlist version wasn't found")
+)
@@ -8,6 +8,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -52,18 +53,18 @@ func (mr *MockRepositoryMockRecorder) AddFavoritePlaylist(ctx, userID, playlistI
 }
 
 // AddToPlaylist mocks base method.
-func (m *MockRepository) AddToPlaylist(ctx context.Context, playlistID, trackOrder, trackID uint64) (*models.PlaylistTrack, error) {
+func (m *MockRepository) AddToPlaylist(ctx context.Context, playlistID, trackOrder, trackID uint64, authorID uuid.UUID) (*models.PlaylistTrack, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddToPlaylist", ctx, playlistID, trackOrder, trackID)
+	ret := m.ctrl.Call(m, "AddToPlaylist", ctx, playlistID, trackOrder, trackID, authorID)
 	ret0, _ := ret[0].(*models.PlaylistTrack)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddToPlaylist indicates an expected call of AddToPlaylist.
-func (mr *MockRepositoryMockRecorder) AddToPlaylist(ctx, playlistID, trackOrder, trackID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) AddToPlaylist(ctx, playlistID, trackOrder, trackID, authorID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToPlaylist", reflect.TypeOf((*MockRepository)(nil).AddToPlaylist), ctx, playlistID, trackOrder, trackID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToPlaylist", reflect.TypeOf((*MockRepository)(nil).AddToPlaylist), ctx, playlistID, trackOrder, trackID, authorID)
 }
 
 // CreatePlaylist mocks base method.
@@ -81,6 +82,21 @@ func (mr *MockRepositoryMockRecorder) CreatePlaylist(ctx, playlist interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockRepository)(nil).CreatePlaylist), ctx, playlist)
 }
 
+// CreatePlaylistWithTracks mocks base method.
+func (m *MockRepository) CreatePlaylistWithTracks(ctx context.Context, playlist *models.Playlist, trackIDs []uint64) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylistWithTracks", ctx, playlist, trackIDs)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlaylistWithTracks indicates an expected call of CreatePlaylistWithTracks.
+func (mr *MockRepositoryMockRecorder) CreatePlaylistWithTracks(ctx, playlist, trackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylistWithTracks", reflect.TypeOf((*MockRepository)(nil).CreatePlaylistWithTracks), ctx, playlist, trackIDs)
+}
+
 // DeleteFavoritePlaylist mocks base method.
 func (m *MockRepository) DeleteFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error {
 	m.ctrl.T.Helper()
@@ -110,6 +126,21 @@ func (mr *MockRepositoryMockRecorder) DeletePlaylist(ctx, playlistID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockRepository)(nil).DeletePlaylist), ctx, playlistID)
 }
 
+// FindCatalogEntries mocks base method.
+func (m *MockRepository) FindCatalogEntries(ctx context.Context, names, artists []string, limit int) ([]*models.PlaylistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCatalogEntries", ctx, names, artists, limit)
+	ret0, _ := ret[0].([]*models.PlaylistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCatalogEntries indicates an expected call of FindCatalogEntries.
+func (mr *MockRepositoryMockRecorder) FindCatalogEntries(ctx, names, artists, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCatalogEntries", reflect.TypeOf((*MockRepository)(nil).FindCatalogEntries), ctx, names, artists, limit)
+}
+
 // GetAllPlaylists mocks base method.
 func (m *MockRepository) GetAllPlaylists(ctx context.Context) ([]*models.Playlist, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +186,21 @@ func (mr *MockRepositoryMockRecorder) GetLengthPlaylist(ctx, playlistID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLengthPlaylist", reflect.TypeOf((*MockRepository)(nil).GetLengthPlaylist), ctx, playlistID)
 }
 
+// GetMosaicCovers mocks base method.
+func (m *MockRepository) GetMosaicCovers(ctx context.Context, playlistID uint64, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMosaicCovers", ctx, playlistID, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMosaicCovers indicates an expected call of GetMosaicCovers.
+func (mr *MockRepositoryMockRecorder) GetMosaicCovers(ctx, playlistID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMosaicCovers", reflect.TypeOf((*MockRepository)(nil).GetMosaicCovers), ctx, playlistID, limit)
+}
+
 // GetPlaylist mocks base method.
 func (m *MockRepository) GetPlaylist(ctx context.Context, playlistID uint64) (*models.Playlist, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +216,66 @@ func (mr *MockRepositoryMockRecorder) GetPlaylist(ctx, playlistID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylist", reflect.TypeOf((*MockRepository)(nil).GetPlaylist), ctx, playlistID)
 }
 
+// GetPlaylistEntries mocks base method.
+func (m *MockRepository) GetPlaylistEntries(ctx context.Context, playlistID uint64) ([]*models.PlaylistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistEntries", ctx, playlistID)
+	ret0, _ := ret[0].([]*models.PlaylistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistEntries indicates an expected call of GetPlaylistEntries.
+func (mr *MockRepositoryMockRecorder) GetPlaylistEntries(ctx, playlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistEntries", reflect.TypeOf((*MockRepository)(nil).GetPlaylistEntries), ctx, playlistID)
+}
+
+// GetPlaylistTrackIDs mocks base method.
+func (m *MockRepository) GetPlaylistTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistTrackIDs", ctx, playlistID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistTrackIDs indicates an expected call of GetPlaylistTrackIDs.
+func (mr *MockRepositoryMockRecorder) GetPlaylistTrackIDs(ctx, playlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistTrackIDs", reflect.TypeOf((*MockRepository)(nil).GetPlaylistTrackIDs), ctx, playlistID)
+}
+
+// GetPlaylistsByIDs mocks base method.
+func (m *MockRepository) GetPlaylistsByIDs(ctx context.Context, playlistIDs []uint64) ([]*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistsByIDs", ctx, playlistIDs)
+	ret0, _ := ret[0].([]*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistsByIDs indicates an expected call of GetPlaylistsByIDs.
+func (mr *MockRepositoryMockRecorder) GetPlaylistsByIDs(ctx, playlistIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistsByIDs", reflect.TypeOf((*MockRepository)(nil).GetPlaylistsByIDs), ctx, playlistIDs)
+}
+
+// GetPlaylistsTracks mocks base method.
+func (m *MockRepository) GetPlaylistsTracks(ctx context.Context, playlistIDs []uint64) ([]*models.PlaylistTrack, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistsTracks", ctx, playlistIDs)
+	ret0, _ := ret[0].([]*models.PlaylistTrack)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistsTracks indicates an expected call of GetPlaylistsTracks.
+func (mr *MockRepositoryMockRecorder) GetPlaylistsTracks(ctx, playlistIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistsTracks", reflect.TypeOf((*MockRepository)(nil).GetPlaylistsTracks), ctx, playlistIDs)
+}
+
 // GetPopularPlaylists mocks base method.
 func (m *MockRepository) GetPopularPlaylists(ctx context.Context) ([]*models.Playlist, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +291,21 @@ func (mr *MockRepositoryMockRecorder) GetPopularPlaylists(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularPlaylists", reflect.TypeOf((*MockRepository)(nil).GetPopularPlaylists), ctx)
 }
 
+// GetTrashedPlaylists mocks base method.
+func (m *MockRepository) GetTrashedPlaylists(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashedPlaylists", ctx, userID, retention)
+	ret0, _ := ret[0].([]*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashedPlaylists indicates an expected call of GetTrashedPlaylists.
+func (mr *MockRepositoryMockRecorder) GetTrashedPlaylists(ctx, userID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashedPlaylists", reflect.TypeOf((*MockRepository)(nil).GetTrashedPlaylists), ctx, userID, retention)
+}
+
 // GetUserPlaylists mocks base method.
 func (m *MockRepository) GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]*models.Playlist, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +321,36 @@ func (mr *MockRepositoryMockRecorder) GetUserPlaylists(ctx, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylists", reflect.TypeOf((*MockRepository)(nil).GetUserPlaylists), ctx, userID)
 }
 
+// GetVersion mocks base method.
+func (m *MockRepository) GetVersion(ctx context.Context, playlistID, version uint64) (*models.PlaylistVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", ctx, playlistID, version)
+	ret0, _ := ret[0].(*models.PlaylistVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockRepositoryMockRecorder) GetVersion(ctx, playlistID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockRepository)(nil).GetVersion), ctx, playlistID, version)
+}
+
+// GetVersions mocks base method.
+func (m *MockRepository) GetVersions(ctx context.Context, playlistID, before, limit uint64) ([]*models.PlaylistVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", ctx, playlistID, before, limit)
+	ret0, _ := ret[0].([]*models.PlaylistVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockRepositoryMockRecorder) GetVersions(ctx, playlistID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockRepository)(nil).GetVersions), ctx, playlistID, before, limit)
+}
+
 // IsFavoritePlaylist mocks base method.
 func (m *MockRepository) IsFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -215,17 +366,121 @@ func (mr *MockRepositoryMockRecorder) IsFavoritePlaylist(ctx, userID, playlistID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoritePlaylist", reflect.TypeOf((*MockRepository)(nil).IsFavoritePlaylist), ctx, userID, playlistID)
 }
 
+// MoveTrack mocks base method.
+func (m *MockRepository) MoveTrack(ctx context.Context, playlistID, trackID, position uint64, authorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTrack", ctx, playlistID, trackID, position, authorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTrack indicates an expected call of MoveTrack.
+func (mr *MockRepositoryMockRecorder) MoveTrack(ctx, playlistID, trackID, position, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTrack", reflect.TypeOf((*MockRepository)(nil).MoveTrack), ctx, playlistID, trackID, position, authorID)
+}
+
+// PurgePlaylists mocks base method.
+func (m *MockRepository) PurgePlaylists(ctx context.Context, retention time.Duration) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePlaylists", ctx, retention)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgePlaylists indicates an expected call of PurgePlaylists.
+func (mr *MockRepositoryMockRecorder) PurgePlaylists(ctx, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePlaylists", reflect.TypeOf((*MockRepository)(nil).PurgePlaylists), ctx, retention)
+}
+
 // RemoveFromPlaylist mocks base method.
-func (m *MockRepository) RemoveFromPlaylist(ctx context.Context, playlistID, trackID uint64) (sql.Result, error) {
+func (m *MockRepository) RemoveFromPlaylist(ctx context.Context, playlistID, trackID uint64, authorID uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveFromPlaylist", ctx, playlistID, trackID)
-	ret0, _ := ret[0].(sql.Result)
+	ret := m.ctrl.Call(m, "RemoveFromPlaylist", ctx, playlistID, trackID, authorID)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveFromPlaylist indicates an expected call of RemoveFromPlaylist.
-func (mr *MockRepositoryMockRecorder) RemoveFromPlaylist(ctx, playlistID, trackID interface{}) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) RemoveFromPlaylist(ctx, playlistID, trackID, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromPlaylist", reflect.TypeOf((*MockRepository)(nil).RemoveFromPlaylist), ctx, playlistID, trackID, authorID)
+}
+
+// RenamePlaylist mocks base method.
+func (m *MockRepository) RenamePlaylist(ctx context.Context, playlistID uint64, name string, authorID uuid.UUID) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenamePlaylist", ctx, playlistID, name, authorID)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenamePlaylist indicates an expected call of RenamePlaylist.
+func (mr *MockRepositoryMockRecorder) RenamePlaylist(ctx, playlistID, name, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenamePlaylist", reflect.TypeOf((*MockRepository)(nil).RenamePlaylist), ctx, playlistID, name, authorID)
+}
+
+// RestorePlaylist mocks base method.
+func (m *MockRepository) RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID, retention time.Duration) (*models.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePlaylist", ctx, playlistID, userID, retention)
+	ret0, _ := ret[0].(*models.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePlaylist indicates an expected call of RestorePlaylist.
+func (mr *MockRepositoryMockRecorder) RestorePlaylist(ctx, playlistID, userID, retention interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePlaylist", reflect.TypeOf((*MockRepository)(nil).RestorePlaylist), ctx, playlistID, userID, retention)
+}
+
+// RevertPlaylist mocks base method.
+func (m *MockRepository) RevertPlaylist(ctx context.Context, playlistID, version uint64, authorID uuid.UUID) (*models.PlaylistVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertPlaylist", ctx, playlistID, version, authorID)
+	ret0, _ := ret[0].(*models.PlaylistVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPlaylist indicates an expected call of RevertPlaylist.
+func (mr *MockRepositoryMockRecorder) RevertPlaylist(ctx, playlistID, version, authorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPlaylist", reflect.TypeOf((*MockRepository)(nil).RevertPlaylist), ctx, playlistID, version, authorID)
+}
+
+// UpdateImage mocks base method.
+func (m *MockRepository) UpdateImage(ctx context.Context, playlistID uint64, image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImage", ctx, playlistID, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImage indicates an expected call of UpdateImage.
+func (mr *MockRepositoryMockRecorder) UpdateImage(ctx, playlistID, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImage", reflect.TypeOf((*MockRepository)(nil).UpdateImage), ctx, playlistID, image)
+}
+
+// UpdateMosaic mocks base method.
+func (m *MockRepository) UpdateMosaic(ctx context.Context, playlistID uint64, image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMosaic", ctx, playlistID, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMosaic indicates an expected call of UpdateMosaic.
+func (mr *MockRepositoryMockRecorder) UpdateMosaic(ctx, playlistID, image interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromPlaylist", reflect.TypeOf((*MockRepository)(nil).RemoveFromPlaylist), ctx, playlistID, trackID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMosaic", reflect.TypeOf((*MockRepository)(nil).UpdateMosaic), ctx, playlistID, image)
 }
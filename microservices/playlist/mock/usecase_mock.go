@@ -10,6 +10,8 @@ import (
 
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	imaging "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	playlistfile "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/playlistfile"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -109,6 +111,21 @@ func (mr *MockUsecaseMockRecorder) DeletePlaylist(ctx, playlistID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockUsecase)(nil).DeletePlaylist), ctx, playlistID)
 }
 
+// ExportPlaylist mocks base method.
+func (m *MockUsecase) ExportPlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*playlistfile.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPlaylist", ctx, playlistID, userID)
+	ret0, _ := ret[0].(*playlistfile.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPlaylist indicates an expected call of ExportPlaylist.
+func (mr *MockUsecaseMockRecorder) ExportPlaylist(ctx, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPlaylist", reflect.TypeOf((*MockUsecase)(nil).ExportPlaylist), ctx, playlistID, userID)
+}
+
 // GetAllPlaylists mocks base method.
 func (m *MockUsecase) GetAllPlaylists(ctx context.Context) ([]*dto.PlaylistDTO, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +171,21 @@ func (mr *MockUsecaseMockRecorder) GetPlaylist(ctx, playlistID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylist", reflect.TypeOf((*MockUsecase)(nil).GetPlaylist), ctx, playlistID)
 }
 
+// GetPlaylists mocks base method.
+func (m *MockUsecase) GetPlaylists(ctx context.Context, playlistIDs []uint64) ([]*dto.PlaylistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylists", ctx, playlistIDs)
+	ret0, _ := ret[0].([]*dto.PlaylistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylists indicates an expected call of GetPlaylists.
+func (mr *MockUsecaseMockRecorder) GetPlaylists(ctx, playlistIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylists", reflect.TypeOf((*MockUsecase)(nil).GetPlaylists), ctx, playlistIDs)
+}
+
 // GetPopularPlaylists mocks base method.
 func (m *MockUsecase) GetPopularPlaylists(ctx context.Context) ([]*dto.PlaylistDTO, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +201,36 @@ func (mr *MockUsecaseMockRecorder) GetPopularPlaylists(ctx interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularPlaylists", reflect.TypeOf((*MockUsecase)(nil).GetPopularPlaylists), ctx)
 }
 
+// GetTrackIDs mocks base method.
+func (m *MockUsecase) GetTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackIDs", ctx, playlistID)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackIDs indicates an expected call of GetTrackIDs.
+func (mr *MockUsecaseMockRecorder) GetTrackIDs(ctx, playlistID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackIDs", reflect.TypeOf((*MockUsecase)(nil).GetTrackIDs), ctx, playlistID)
+}
+
+// GetTrash mocks base method.
+func (m *MockUsecase) GetTrash(ctx context.Context, userID uuid.UUID) ([]*dto.TrashedPlaylistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx, userID)
+	ret0, _ := ret[0].([]*dto.TrashedPlaylistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockUsecaseMockRecorder) GetTrash(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockUsecase)(nil).GetTrash), ctx, userID)
+}
+
 // GetUserPlaylists mocks base method.
 func (m *MockUsecase) GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]*dto.PlaylistDTO, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +246,36 @@ func (mr *MockUsecaseMockRecorder) GetUserPlaylists(ctx, userID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPlaylists", reflect.TypeOf((*MockUsecase)(nil).GetUserPlaylists), ctx, userID)
 }
 
+// GetVersions mocks base method.
+func (m *MockUsecase) GetVersions(ctx context.Context, playlistID uint64, userID uuid.UUID, before, limit uint64) (*dto.PlaylistVersionsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersions", ctx, playlistID, userID, before, limit)
+	ret0, _ := ret[0].(*dto.PlaylistVersionsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersions indicates an expected call of GetVersions.
+func (mr *MockUsecaseMockRecorder) GetVersions(ctx, playlistID, userID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersions", reflect.TypeOf((*MockUsecase)(nil).GetVersions), ctx, playlistID, userID, before, limit)
+}
+
+// ImportPlaylist mocks base method.
+func (m *MockUsecase) ImportPlaylist(ctx context.Context, userID uuid.UUID, name string, file *playlistfile.Playlist) (*dto.ImportReportDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPlaylist", ctx, userID, name, file)
+	ret0, _ := ret[0].(*dto.ImportReportDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPlaylist indicates an expected call of ImportPlaylist.
+func (mr *MockUsecaseMockRecorder) ImportPlaylist(ctx, userID, name, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPlaylist", reflect.TypeOf((*MockUsecase)(nil).ImportPlaylist), ctx, userID, name, file)
+}
+
 // IsFavoritePlaylist mocks base method.
 func (m *MockUsecase) IsFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +291,34 @@ func (mr *MockUsecaseMockRecorder) IsFavoritePlaylist(ctx, userID, playlistID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoritePlaylist", reflect.TypeOf((*MockUsecase)(nil).IsFavoritePlaylist), ctx, userID, playlistID)
 }
 
+// MoveTrack mocks base method.
+func (m *MockUsecase) MoveTrack(ctx context.Context, playlistID, trackID, position uint64, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTrack", ctx, playlistID, trackID, position, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveTrack indicates an expected call of MoveTrack.
+func (mr *MockUsecaseMockRecorder) MoveTrack(ctx, playlistID, trackID, position, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTrack", reflect.TypeOf((*MockUsecase)(nil).MoveTrack), ctx, playlistID, trackID, position, userID)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockUsecase) PurgeTrash(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockUsecaseMockRecorder) PurgeTrash(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockUsecase)(nil).PurgeTrash), ctx)
+}
+
 // RemoveFromPlaylist mocks base method.
 func (m *MockUsecase) RemoveFromPlaylist(ctx context.Context, playlistTrackDTO *dto.PlaylistTrackDTO) error {
 	m.ctrl.T.Helper()
@@ -212,3 +332,63 @@ func (mr *MockUsecaseMockRecorder) RemoveFromPlaylist(ctx, playlistTrackDTO inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromPlaylist", reflect.TypeOf((*MockUsecase)(nil).RemoveFromPlaylist), ctx, playlistTrackDTO)
 }
+
+// RenamePlaylist mocks base method.
+func (m *MockUsecase) RenamePlaylist(ctx context.Context, playlistID uint64, name string, userID uuid.UUID) (*dto.PlaylistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenamePlaylist", ctx, playlistID, name, userID)
+	ret0, _ := ret[0].(*dto.PlaylistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenamePlaylist indicates an expected call of RenamePlaylist.
+func (mr *MockUsecaseMockRecorder) RenamePlaylist(ctx, playlistID, name, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenamePlaylist", reflect.TypeOf((*MockUsecase)(nil).RenamePlaylist), ctx, playlistID, name, userID)
+}
+
+// RestorePlaylist mocks base method.
+func (m *MockUsecase) RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*dto.PlaylistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestorePlaylist", ctx, playlistID, userID)
+	ret0, _ := ret[0].(*dto.PlaylistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestorePlaylist indicates an expected call of RestorePlaylist.
+func (mr *MockUsecaseMockRecorder) RestorePlaylist(ctx, playlistID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestorePlaylist", reflect.TypeOf((*MockUsecase)(nil).RestorePlaylist), ctx, playlistID, userID)
+}
+
+// RevertPlaylist mocks base method.
+func (m *MockUsecase) RevertPlaylist(ctx context.Context, playlistID, version uint64, userID uuid.UUID) (*dto.PlaylistVersionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertPlaylist", ctx, playlistID, version, userID)
+	ret0, _ := ret[0].(*dto.PlaylistVersionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPlaylist indicates an expected call of RevertPlaylist.
+func (mr *MockUsecaseMockRecorder) RevertPlaylist(ctx, playlistID, version, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPlaylist", reflect.TypeOf((*MockUsecase)(nil).RevertPlaylist), ctx, playlistID, version, userID)
+}
+
+// UploadImage mocks base method.
+func (m *MockUsecase) UploadImage(ctx context.Context, playlistID uint64, userID uuid.UUID, renditions []*imaging.Rendition) (*dto.PlaylistDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadImage", ctx, playlistID, userID, renditions)
+	ret0, _ := ret[0].(*dto.PlaylistDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadImage indicates an expected call of UploadImage.
+func (mr *MockUsecaseMockRecorder) UploadImage(ctx, playlistID, userID, renditions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUsecase)(nil).UploadImage), ctx, playlistID, userID, renditions)
+}
@@ -0,0 +1,8 @@
+package playlist
+
+const (
+	// ImportedName names the imported playlists that come without a name.
+	ImportedName = "Imported playlist"
+	// MaxNameLength follows the playlist_name_length constraint.
+	MaxNameLength = 31
+)
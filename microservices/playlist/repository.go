@@ -3,20 +3,76 @@ package playlist
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/google/uuid"
 )
 
 type Repository interface {
+	// CreatePlaylist creates the playlist along with its first version.
 	CreatePlaylist(ctx context.Context, playlist *models.Playlist) (*models.Playlist, error)
 	GetAllPlaylists(ctx context.Context) ([]*models.Playlist, error)
 	GetPlaylist(ctx context.Context, playlistID uint64) (*models.Playlist, error)
+	GetPlaylistsByIDs(ctx context.Context, playlistIDs []uint64) ([]*models.Playlist, error)
+	GetPlaylistTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error)
+	GetPlaylistsTracks(ctx context.Context, playlistIDs []uint64) ([]*models.PlaylistTrack, error)
 	GetLengthPlaylist(ctx context.Context, playlistID uint64) (uint64, error)
 	GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]*models.Playlist, error)
-	AddToPlaylist(ctx context.Context, playlistID uint64, trackOrder uint64, trackID uint64) (*models.PlaylistTrack, error)
-	RemoveFromPlaylist(ctx context.Context, playlistID uint64, trackID uint64) (sql.Result, error)
+	// AddToPlaylist records the change as a version of the playlist by the
+	// author. It returns ErrPlaylistNotFound when the playlist is missing or
+	// trashed.
+	AddToPlaylist(ctx context.Context, playlistID uint64, trackOrder uint64, trackID uint64, authorID uuid.UUID) (*models.PlaylistTrack, error)
+	// RemoveFromPlaylist returns how many tracks were removed and records the
+	// removal as a version of the playlist by the author. It returns
+	// ErrPlaylistNotFound when the playlist is missing or trashed.
+	RemoveFromPlaylist(ctx context.Context, playlistID uint64, trackID uint64, authorID uuid.UUID) (int64, error)
+	// RenamePlaylist records the new name as a version of the playlist by the
+	// author, unless the name stays the same. It returns ErrPlaylistNotFound
+	// when the playlist is missing or trashed and ErrNameTaken when another
+	// playlist has the name.
+	RenamePlaylist(ctx context.Context, playlistID uint64, name string, authorID uuid.UUID) (*models.Playlist, error)
+	// MoveTrack puts the track at the 1-based position, past the end meaning
+	// the last one, and records the move as a version of the playlist by the
+	// author unless the track stays in place. It returns ErrPlaylistNotFound
+	// when the playlist is missing or trashed and ErrTrackNotFound when the
+	// track isn't in the playlist.
+	MoveTrack(ctx context.Context, playlistID uint64, trackID uint64, position uint64, authorID uuid.UUID) error
+	// DeletePlaylist moves the playlist to the trash.
 	DeletePlaylist(ctx context.Context, playlistID uint64) (sql.Result, error)
+	// GetTrashedPlaylists returns the playlists of the user trashed within
+	// retention, recently trashed first.
+	GetTrashedPlaylists(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]*models.Playlist, error)
+	// RestorePlaylist takes the playlist of the user trashed within
+	// retention out of the trash. It returns sql.ErrNoRows when there is no
+	// such playlist and ErrNameTaken when its name was taken meanwhile.
+	RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID, retention time.Duration) (*models.Playlist, error)
+	// PurgePlaylists deletes the playlists trashed before retention and
+	// returns the covers of the deleted ones.
+	PurgePlaylists(ctx context.Context, retention time.Duration) ([]string, error)
+	// GetVersions returns up to limit versions of the playlist older than
+	// before, latest first. Zero before starts from the latest version.
+	GetVersions(ctx context.Context, playlistID uint64, before uint64, limit uint64) ([]*models.PlaylistVersion, error)
+	GetVersion(ctx context.Context, playlistID uint64, version uint64) (*models.PlaylistVersion, error)
+	// RevertPlaylist restores the name and tracks of the version in one
+	// transaction and records the result as a new version. It returns
+	// sql.ErrNoRows when there is no such version and ErrNameTaken when the
+	// name of the version was taken meanwhile.
+	RevertPlaylist(ctx context.Context, playlistID uint64, version uint64, authorID uuid.UUID) (*models.PlaylistVersion, error)
+	// UpdateImage sets a custom cover and returns the previous image.
+	UpdateImage(ctx context.Context, playlistID uint64, image string) (string, error)
+	// UpdateMosaic sets a generated cover and returns the previous image. It
+	// returns sql.ErrNoRows when the playlist has a custom cover.
+	UpdateMosaic(ctx context.Context, playlistID uint64, image string) (string, error)
+	// GetMosaicCovers returns the covers of the first distinct albums in the
+	// playlist, in the order of their first tracks. It returns sql.ErrNoRows
+	// when the playlist has a custom cover.
+	GetMosaicCovers(ctx context.Context, playlistID uint64, limit int) ([]string, error)
+	CreatePlaylistWithTracks(ctx context.Context, playlist *models.Playlist, trackIDs []uint64) (*models.Playlist, error)
+	GetPlaylistEntries(ctx context.Context, playlistID uint64) ([]*models.PlaylistEntry, error)
+	// FindCatalogEntries returns the tracks named or performed by any of the
+	// given names normalized by matching.Normalize.
+	FindCatalogEntries(ctx context.Context, names []string, artists []string, limit int) ([]*models.PlaylistEntry, error)
 	AddFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error
 	DeleteFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error
 	IsFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) (bool, error)
@@ -3,10 +3,13 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"slices"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -19,8 +22,14 @@ func NewPlaylistRepository(db *sql.DB) playlist.Repository {
 }
 
 func (r *PlaylistRepository) CreatePlaylist(ctx context.Context, playlist *models.Playlist) (*models.Playlist, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Begin")
+	}
+	defer tx.Rollback()
+
 	insertedPlaylist := &models.Playlist{}
-	row := r.db.QueryRowContext(ctx,
+	row := tx.QueryRowContext(ctx,
 		CreatePlaylistQuery,
 		playlist.Name,
 		playlist.Image,
@@ -38,6 +47,14 @@ func (r *PlaylistRepository) CreatePlaylist(ctx context.Context, playlist *model
 	); err != nil {
 		return nil, err
 	}
+
+	if _, err := recordVersion(ctx, tx, insertedPlaylist.ID, insertedPlaylist.OwnerID, models.PlaylistChangeCreate, nil); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Record")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylist.Commit")
+	}
 	return insertedPlaylist, nil
 }
 
@@ -90,6 +107,91 @@ func (r *PlaylistRepository) GetPlaylist(ctx context.Context, playlistID uint64)
 	return playlist, nil
 }
 
+// GetPlaylistsByIDs returns the playlists in the order of ids, missing ones are skipped.
+func (r *PlaylistRepository) GetPlaylistsByIDs(ctx context.Context, playlistIDs []uint64) ([]*models.Playlist, error) {
+	ids := make([]int64, 0, len(playlistIDs))
+	for _, id := range playlistIDs {
+		ids = append(ids, int64(id))
+	}
+
+	playlists := []*models.Playlist{}
+	rows, err := r.db.QueryContext(ctx, GetPlaylistsByIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPlaylistsByIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		playlist := &models.Playlist{}
+		if err := rows.Scan(
+			&playlist.ID,
+			&playlist.Name,
+			&playlist.Image,
+			&playlist.OwnerID,
+			&playlist.IsPrivate,
+			&playlist.CreatedAt,
+			&playlist.UpdatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetPlaylistsByIDs.Scan")
+		}
+		playlists = append(playlists, playlist)
+	}
+
+	return playlists, nil
+}
+
+// GetPlaylistTrackIDs returns the tracks of the playlist, recently added first.
+func (r *PlaylistRepository) GetPlaylistTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error) {
+	trackIDs := []uint64{}
+	rows, err := r.db.QueryContext(ctx, GetPlaylistTrackIDsQuery, playlistID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPlaylistTrackIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var trackID uint64
+		if err := rows.Scan(&trackID); err != nil {
+			return nil, errors.Wrap(err, "GetPlaylistTrackIDs.Scan")
+		}
+		trackIDs = append(trackIDs, trackID)
+	}
+
+	return trackIDs, nil
+}
+
+// GetPlaylistsTracks returns the tracks of all the playlists, each playlist
+// ordered as in GetPlaylistTrackIDs.
+func (r *PlaylistRepository) GetPlaylistsTracks(ctx context.Context, playlistIDs []uint64) ([]*models.PlaylistTrack, error) {
+	ids := make([]int64, 0, len(playlistIDs))
+	for _, id := range playlistIDs {
+		ids = append(ids, int64(id))
+	}
+
+	playlistTracks := []*models.PlaylistTrack{}
+	rows, err := r.db.QueryContext(ctx, GetPlaylistsTracksQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPlaylistsTracks.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		playlistTrack := &models.PlaylistTrack{}
+		if err := rows.Scan(
+			&playlistTrack.ID,
+			&playlistTrack.PlaylistID,
+			&playlistTrack.TrackOrderInPlaylist,
+			&playlistTrack.TrackID,
+			&playlistTrack.CreatedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetPlaylistsTracks.Scan")
+		}
+		playlistTracks = append(playlistTracks, playlistTrack)
+	}
+
+	return playlistTracks, nil
+}
+
 func (r *PlaylistRepository) GetLengthPlaylist(ctx context.Context, playlistID uint64) (uint64, error) {
 	var length uint64
 	row := r.db.QueryRowContext(ctx,
@@ -135,9 +237,15 @@ func (r *PlaylistRepository) GetUserPlaylists(ctx context.Context, userID uuid.U
 	return playlists, nil
 }
 
-func (r *PlaylistRepository) AddToPlaylist(ctx context.Context, playlistID uint64, trackOrder uint64, trackID uint64) (*models.PlaylistTrack, error) {
+func (r *PlaylistRepository) AddToPlaylist(ctx context.Context, playlistID uint64, trackOrder uint64, trackID uint64, authorID uuid.UUID) (*models.PlaylistTrack, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "AddToPlaylist.Begin")
+	}
+	defer tx.Rollback()
+
 	insertedTrack := &models.PlaylistTrack{}
-	row := r.db.QueryRowContext(ctx,
+	row := tx.QueryRowContext(ctx,
 		AddToPlaylistQuery,
 		playlistID,
 		trackOrder,
@@ -151,22 +259,151 @@ func (r *PlaylistRepository) AddToPlaylist(ctx context.Context, playlistID uint6
 		&insertedTrack.TrackID,
 		&insertedTrack.CreatedAt,
 	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, playlist.ErrTrackInPlaylist
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, playlist.ErrPlaylistNotFound
+		}
 		return nil, err
 	}
+
+	if _, err := recordVersion(ctx, tx, playlistID, authorID, models.PlaylistChangeAdd, nil); err != nil {
+		return nil, errors.Wrap(err, "AddToPlaylist.Record")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "AddToPlaylist.Commit")
+	}
 	return insertedTrack, nil
 }
 
-func (r *PlaylistRepository) RemoveFromPlaylist(ctx context.Context, playlistID uint64, trackID uint64) (sql.Result, error) {
-	res, err := r.db.ExecContext(ctx,
-		RemoveFromPlaylistQuery,
-		playlistID,
-		trackID,
-	)
+func (r *PlaylistRepository) RemoveFromPlaylist(ctx context.Context, playlistID uint64, trackID uint64, authorID uuid.UUID) (int64, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return 0, errors.Wrap(err, "RemoveFromPlaylist.Begin")
 	}
+	defer tx.Rollback()
 
-	return res, nil
+	var removed int64
+	if err := tx.QueryRowContext(ctx, RemoveFromPlaylistQuery, playlistID, trackID).Scan(&removed); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, playlist.ErrPlaylistNotFound
+		}
+		return 0, errors.Wrap(err, "RemoveFromPlaylist.Query")
+	}
+
+	if removed > 0 {
+		if _, err := recordVersion(ctx, tx, playlistID, authorID, models.PlaylistChangeRemove, nil); err != nil {
+			return 0, errors.Wrap(err, "RemoveFromPlaylist.Record")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "RemoveFromPlaylist.Commit")
+	}
+	return removed, nil
+}
+
+func (r *PlaylistRepository) RenamePlaylist(ctx context.Context, playlistID uint64, name string, authorID uuid.UUID) (*models.Playlist, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "RenamePlaylist.Begin")
+	}
+	defer tx.Rollback()
+
+	renamed := &models.Playlist{}
+	var previousName string
+	if err := tx.QueryRowContext(ctx, updateNameQuery, playlistID, name).Scan(
+		&renamed.ID,
+		&renamed.Name,
+		&renamed.Image,
+		&renamed.OwnerID,
+		&renamed.IsPrivate,
+		&renamed.CreatedAt,
+		&renamed.UpdatedAt,
+		&previousName,
+	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, playlist.ErrNameTaken
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, playlist.ErrPlaylistNotFound
+		}
+		return nil, errors.Wrap(err, "RenamePlaylist.Query")
+	}
+
+	if previousName != renamed.Name {
+		if _, err := recordVersion(ctx, tx, playlistID, authorID, models.PlaylistChangeRenamed, nil); err != nil {
+			return nil, errors.Wrap(err, "RenamePlaylist.Record")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "RenamePlaylist.Commit")
+	}
+	return renamed, nil
+}
+
+func (r *PlaylistRepository) MoveTrack(ctx context.Context, playlistID uint64, trackID uint64, position uint64, authorID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "MoveTrack.Begin")
+	}
+	defer tx.Rollback()
+
+	var lockedID uint64
+	if err := tx.QueryRowContext(ctx, lockPlaylistQuery, playlistID).Scan(&lockedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return playlist.ErrPlaylistNotFound
+		}
+		return errors.Wrap(err, "MoveTrack.Lock")
+	}
+
+	rows, err := tx.QueryContext(ctx, GetPlaylistTrackIDsQuery, playlistID)
+	if err != nil {
+		return errors.Wrap(err, "MoveTrack.Tracks")
+	}
+	var trackIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return errors.Wrap(err, "MoveTrack.Scan")
+		}
+		trackIDs = append(trackIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "MoveTrack.Rows")
+	}
+
+	from := slices.Index(trackIDs, int64(trackID))
+	if from < 0 {
+		return playlist.ErrTrackNotFound
+	}
+	to := len(trackIDs) - 1
+	if position > 0 && position <= uint64(len(trackIDs)) {
+		to = int(position) - 1
+	}
+	if from == to {
+		return nil
+	}
+	trackIDs = slices.Insert(slices.Delete(trackIDs, from, from+1), to, int64(trackID))
+
+	if _, err := tx.ExecContext(ctx, reorderPlaylistQuery, playlistID, pq.Array(trackIDs)); err != nil {
+		return errors.Wrap(err, "MoveTrack.Reorder")
+	}
+	if _, err := recordVersion(ctx, tx, playlistID, authorID, models.PlaylistChangeMoved, nil); err != nil {
+		return errors.Wrap(err, "MoveTrack.Record")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "MoveTrack.Commit")
+	}
+	return nil
 }
 
 func (r *PlaylistRepository) DeletePlaylist(ctx context.Context, playlistID uint64) (sql.Result, error) {
@@ -180,6 +417,233 @@ func (r *PlaylistRepository) DeletePlaylist(ctx context.Context, playlistID uint
 	return res, nil
 }
 
+func (r *PlaylistRepository) GetTrashedPlaylists(ctx context.Context, userID uuid.UUID, retention time.Duration) ([]*models.Playlist, error) {
+	rows, err := r.db.QueryContext(ctx, getTrashedPlaylistsQuery, userID, retention.Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "GetTrashedPlaylists.Query")
+	}
+	defer rows.Close()
+
+	playlists := []*models.Playlist{}
+	for rows.Next() {
+		playlist := &models.Playlist{}
+		if err := rows.Scan(
+			&playlist.ID,
+			&playlist.Name,
+			&playlist.Image,
+			&playlist.OwnerID,
+			&playlist.IsPrivate,
+			&playlist.CreatedAt,
+			&playlist.UpdatedAt,
+			&playlist.DeletedAt,
+		); err != nil {
+			return nil, errors.Wrap(err, "GetTrashedPlaylists.Scan")
+		}
+		playlists = append(playlists, playlist)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetTrashedPlaylists.Query")
+	}
+
+	return playlists, nil
+}
+
+func (r *PlaylistRepository) RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID, retention time.Duration) (*models.Playlist, error) {
+	restored := &models.Playlist{}
+	if err := r.db.QueryRowContext(ctx, restorePlaylistQuery, playlistID, userID, retention.Seconds()).Scan(
+		&restored.ID,
+		&restored.Name,
+		&restored.Image,
+		&restored.OwnerID,
+		&restored.IsPrivate,
+		&restored.CreatedAt,
+		&restored.UpdatedAt,
+	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, playlist.ErrNameTaken
+		}
+		return nil, errors.Wrap(err, "RestorePlaylist.Query")
+	}
+
+	return restored, nil
+}
+
+func (r *PlaylistRepository) PurgePlaylists(ctx context.Context, retention time.Duration) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, purgePlaylistsQuery, retention.Seconds())
+	if err != nil {
+		return nil, errors.Wrap(err, "PurgePlaylists.Query")
+	}
+	defer rows.Close()
+
+	images := []string{}
+	for rows.Next() {
+		var image string
+		if err := rows.Scan(&image); err != nil {
+			return nil, errors.Wrap(err, "PurgePlaylists.Scan")
+		}
+		images = append(images, image)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "PurgePlaylists.Rows")
+	}
+
+	return images, nil
+}
+
+func (r *PlaylistRepository) UpdateImage(ctx context.Context, playlistID uint64, image string) (string, error) {
+	var previousImage string
+	if err := r.db.QueryRowContext(ctx, updateImageQuery, playlistID, image).Scan(&previousImage); err != nil {
+		return "", errors.Wrap(err, "UpdateImage.Query")
+	}
+
+	return previousImage, nil
+}
+
+func (r *PlaylistRepository) UpdateMosaic(ctx context.Context, playlistID uint64, image string) (string, error) {
+	var previousImage string
+	if err := r.db.QueryRowContext(ctx, updateMosaicQuery, playlistID, image).Scan(&previousImage); err != nil {
+		return "", errors.Wrap(err, "UpdateMosaic.Query")
+	}
+
+	return previousImage, nil
+}
+
+func (r *PlaylistRepository) GetMosaicCovers(ctx context.Context, playlistID uint64, limit int) ([]string, error) {
+	var hasCustomImage bool
+	if err := r.db.QueryRowContext(ctx, hasCustomImageQuery, playlistID).Scan(&hasCustomImage); err != nil {
+		return nil, errors.Wrap(err, "GetMosaicCovers.CustomImage")
+	}
+	if hasCustomImage {
+		return nil, errors.Wrap(sql.ErrNoRows, "GetMosaicCovers.CustomImage")
+	}
+
+	rows, err := r.db.QueryContext(ctx, getMosaicCoversQuery, playlistID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetMosaicCovers.Query")
+	}
+	defer rows.Close()
+
+	var covers []string
+	for rows.Next() {
+		var cover string
+		if err := rows.Scan(&cover); err != nil {
+			return nil, errors.Wrap(err, "GetMosaicCovers.Query")
+		}
+		covers = append(covers, cover)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetMosaicCovers.Query")
+	}
+
+	return covers, nil
+}
+
+// uniqueViolation is the code of the error raised for a duplicate playlist
+// name or a track added twice.
+const uniqueViolation = "23505"
+
+func (r *PlaylistRepository) CreatePlaylistWithTracks(ctx context.Context, newPlaylist *models.Playlist, trackIDs []uint64) (*models.Playlist, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylistWithTracks.Begin")
+	}
+	defer tx.Rollback()
+
+	created, err := InsertPlaylistWithTracks(ctx, tx, newPlaylist, trackIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylistWithTracks.Commit")
+	}
+
+	return created, nil
+}
+
+// InsertPlaylistWithTracks creates the playlist with the tracks and its first
+// version within tx, so other services can store their records of it in the
+// same transaction. It returns ErrNameTaken when the name is taken.
+func InsertPlaylistWithTracks(ctx context.Context, tx *sql.Tx, newPlaylist *models.Playlist, trackIDs []uint64) (*models.Playlist, error) {
+	created := &models.Playlist{}
+	if err := tx.QueryRowContext(ctx, CreatePlaylistQuery, newPlaylist.Name, newPlaylist.Image, newPlaylist.OwnerID).Scan(
+		&created.ID,
+		&created.Name,
+		&created.Image,
+		&created.OwnerID,
+		&created.IsPrivate,
+		&created.CreatedAt,
+		&created.UpdatedAt,
+	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, playlist.ErrNameTaken
+		}
+		return nil, errors.Wrap(err, "CreatePlaylistWithTracks.Query")
+	}
+
+	for i, trackID := range trackIDs {
+		if _, err := tx.ExecContext(ctx, AddToPlaylistQuery, created.ID, uint64(i+1), trackID); err != nil {
+			return nil, errors.Wrap(err, "CreatePlaylistWithTracks.Exec")
+		}
+	}
+
+	if _, err := recordVersion(ctx, tx, created.ID, created.OwnerID, models.PlaylistChangeCreate, nil); err != nil {
+		return nil, errors.Wrap(err, "CreatePlaylistWithTracks.Record")
+	}
+
+	return created, nil
+}
+
+func (r *PlaylistRepository) GetPlaylistEntries(ctx context.Context, playlistID uint64) ([]*models.PlaylistEntry, error) {
+	rows, err := r.db.QueryContext(ctx, getPlaylistEntriesQuery, playlistID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPlaylistEntries.Query")
+	}
+
+	entries, err := scanEntries(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPlaylistEntries.Query")
+	}
+	return entries, nil
+}
+
+func (r *PlaylistRepository) FindCatalogEntries(ctx context.Context, names []string, artists []string, limit int) ([]*models.PlaylistEntry, error) {
+	rows, err := r.db.QueryContext(ctx, findCatalogEntriesQuery, pq.Array(names), pq.Array(artists), limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindCatalogEntries.Query")
+	}
+
+	entries, err := scanEntries(rows)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindCatalogEntries.Query")
+	}
+	return entries, nil
+}
+
+func scanEntries(rows *sql.Rows) ([]*models.PlaylistEntry, error) {
+	defer rows.Close()
+
+	var entries []*models.PlaylistEntry
+	for rows.Next() {
+		entry := &models.PlaylistEntry{}
+		if err := rows.Scan(
+			&entry.TrackID,
+			&entry.Name,
+			&entry.Artist,
+			&entry.Album,
+			&entry.Duration,
+			&entry.FilePath,
+		); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
 func (r *PlaylistRepository) AddFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error {
 	_, err := r.db.ExecContext(ctx, addFavoritePlaylistQuery, userID, playlistID)
 	if err != nil {
@@ -263,3 +727,125 @@ func (r *PlaylistRepository) GetPopularPlaylists(ctx context.Context) ([]*models
 
 	return playlists, nil
 }
+
+func (r *PlaylistRepository) GetVersions(ctx context.Context, playlistID uint64, before uint64, limit uint64) ([]*models.PlaylistVersion, error) {
+	rows, err := r.db.QueryContext(ctx, getVersionsQuery, playlistID, before, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetVersions.Query")
+	}
+	defer rows.Close()
+
+	versions := []*models.PlaylistVersion{}
+	for rows.Next() {
+		version, err := scanVersion(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetVersions.Scan")
+		}
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "GetVersions.Rows")
+	}
+
+	return versions, nil
+}
+
+func (r *PlaylistRepository) GetVersion(ctx context.Context, playlistID uint64, version uint64) (*models.PlaylistVersion, error) {
+	found, err := scanVersion(r.db.QueryRowContext(ctx, getVersionQuery, playlistID, version))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetVersion.Query")
+	}
+	return found, nil
+}
+
+func (r *PlaylistRepository) RevertPlaylist(ctx context.Context, playlistID uint64, version uint64, authorID uuid.UUID) (*models.PlaylistVersion, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Begin")
+	}
+	defer tx.Rollback()
+
+	target, err := scanVersion(tx.QueryRowContext(ctx, getVersionQuery, playlistID, version))
+	if err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Version")
+	}
+
+	// renaming locks the playlist until the revert is recorded
+	result, err := tx.ExecContext(ctx, renamePlaylistQuery, playlistID, target.Name)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+			return nil, playlist.ErrNameTaken
+		}
+		return nil, errors.Wrap(err, "RevertPlaylist.Rename")
+	}
+	if renamed, err := result.RowsAffected(); err == nil && renamed == 0 {
+		return nil, errors.Wrap(sql.ErrNoRows, "RevertPlaylist.Rename")
+	}
+
+	trackIDs := make([]int64, 0, len(target.TrackIDs))
+	for _, trackID := range target.TrackIDs {
+		trackIDs = append(trackIDs, int64(trackID))
+	}
+	if _, err := tx.ExecContext(ctx, clearPlaylistQuery, playlistID); err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Clear")
+	}
+	if _, err := tx.ExecContext(ctx, fillPlaylistQuery, playlistID, pq.Array(trackIDs)); err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Fill")
+	}
+
+	reverted, err := recordVersion(ctx, tx, playlistID, authorID, models.PlaylistChangeRevert, version)
+	if err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Record")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "RevertPlaylist.Commit")
+	}
+
+	return reverted, nil
+}
+
+// recordVersion snapshots the playlist as its next version within the
+// transaction of the change, so a change is never left without its version.
+func recordVersion(ctx context.Context, tx *sql.Tx, playlistID uint64, authorID uuid.UUID, change string, revertedTo any) (*models.PlaylistVersion, error) {
+	return scanVersion(tx.QueryRowContext(ctx, RecordVersionQuery, playlistID, nullAuthor(authorID), change, revertedTo))
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanVersion(row scanner) (*models.PlaylistVersion, error) {
+	version := &models.PlaylistVersion{}
+	var authorID uuid.NullUUID
+	var revertedTo sql.NullInt64
+	var trackIDs []int64
+	if err := row.Scan(
+		&version.PlaylistID,
+		&version.Version,
+		&authorID,
+		&version.Change,
+		&revertedTo,
+		&version.Name,
+		pq.Array(&trackIDs),
+		&version.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	version.AuthorID = authorID.UUID
+	version.RevertedTo = uint64(revertedTo.Int64)
+	version.TrackIDs = make([]uint64, 0, len(trackIDs))
+	for _, trackID := range trackIDs {
+		version.TrackIDs = append(version.TrackIDs, uint64(trackID))
+	}
+	return version, nil
+}
+
+func nullAuthor(authorID uuid.UUID) any {
+	if authorID == uuid.Nil {
+		return nil
+	}
+	return authorID
+}
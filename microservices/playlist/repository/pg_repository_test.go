@@ -2,12 +2,15 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,15 +43,22 @@ func TestPlaylistRepositoryCreatePlaylist(t *testing.T) {
 		time.Now(),
 	)
 
+	versionColumns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
+
+	mock.ExpectBegin()
 	mock.ExpectQuery(CreatePlaylistQuery).WithArgs(
 		mockPlaylist.Name,
 		mockPlaylist.Image,
 		mockPlaylist.OwnerID,
 	).WillReturnRows(rows)
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(mockPlaylist.ID, mockPlaylist.OwnerID, models.PlaylistChangeCreate, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(1, 1, mockPlaylist.OwnerID, "create", nil, mockPlaylist.Name, "{}", time.Now()))
+	mock.ExpectCommit()
 
 	createdPlaylist, err := playlistRepository.CreatePlaylist(context.Background(), mockPlaylist)
 	require.NoError(t, err)
 	require.NotNil(t, createdPlaylist)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestPlaylistRepositoryGetAllPlaylists(t *testing.T) {
@@ -127,6 +137,47 @@ func TestPlaylistRepositoryGetLengthPlaylist(t *testing.T) {
 	require.Equal(t, mockLength, length)
 }
 
+func TestPlaylistRepositoryGetPlaylistTrackIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+
+	mock.ExpectQuery(GetPlaylistTrackIDsQuery).WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"track_id"}).AddRow(3).AddRow(1))
+
+	trackIDs, err := playlistRepository.GetPlaylistTrackIDs(context.Background(), uint64(1))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1}, trackIDs)
+}
+
+func TestPlaylistRepositoryGetPlaylistsTracks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	createdAt := time.Now()
+
+	columns := []string{"id", "playlist_id", "track_order_in_playlist", "track_id", "created_at"}
+	mock.ExpectQuery(GetPlaylistsTracksQuery).WithArgs(pq.Array([]int64{1, 2})).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 1, 1, 3, createdAt).
+			AddRow(2, 2, 1, 5, createdAt))
+
+	playlistTracks, err := playlistRepository.GetPlaylistsTracks(context.Background(), []uint64{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []*models.PlaylistTrack{
+		{ID: 1, PlaylistID: 1, TrackOrderInPlaylist: 1, TrackID: 3, CreatedAt: createdAt},
+		{ID: 2, PlaylistID: 2, TrackOrderInPlaylist: 1, TrackID: 5, CreatedAt: createdAt},
+	}, playlistTracks)
+}
+
 func TestPlaylistRepositoryGetUserPlaylists(t *testing.T) {
 	t.Parallel()
 
@@ -160,17 +211,40 @@ func TestPlaylistRepositoryAddToPlaylist(t *testing.T) {
 	mockPlaylistID := uint64(1)
 	mockTrackID := uint64(42)
 	mockTrackOrder := uint64(1)
+	authorID := uuid.New()
 
 	columns := []string{"id", "playlist_id", "track_order_in_playlist", "track_id", "created_at"}
+	versionColumns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
 	row := sqlmock.NewRows(columns).AddRow(1, mockPlaylistID, mockTrackOrder, mockTrackID, time.Now())
 
+	mock.ExpectBegin()
 	mock.ExpectQuery(AddToPlaylistQuery).WithArgs(mockPlaylistID, mockTrackOrder, mockTrackID).WillReturnRows(row)
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(mockPlaylistID, authorID, models.PlaylistChangeAdd, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(mockPlaylistID, 2, authorID, "add", nil, "Road trip", "{42}", time.Now()))
+	mock.ExpectCommit()
 
-	track, err := playlistRepository.AddToPlaylist(context.Background(), mockPlaylistID, mockTrackOrder, mockTrackID)
+	track, err := playlistRepository.AddToPlaylist(context.Background(), mockPlaylistID, mockTrackOrder, mockTrackID, authorID)
 	require.NoError(t, err)
 	require.NotNil(t, track)
 	require.Equal(t, mockPlaylistID, track.PlaylistID)
 	require.Equal(t, mockTrackID, track.TrackID)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(AddToPlaylistQuery).WithArgs(mockPlaylistID, mockTrackOrder, mockTrackID).
+		WillReturnError(&pq.Error{Code: uniqueViolation})
+	mock.ExpectRollback()
+
+	_, err = playlistRepository.AddToPlaylist(context.Background(), mockPlaylistID, mockTrackOrder, mockTrackID, authorID)
+	require.ErrorIs(t, err, playlist.ErrTrackInPlaylist)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(AddToPlaylistQuery).WithArgs(mockPlaylistID, mockTrackOrder, mockTrackID).
+		WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectRollback()
+
+	_, err = playlistRepository.AddToPlaylist(context.Background(), mockPlaylistID, mockTrackOrder, mockTrackID, authorID)
+	require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestPlaylistRepositoryRemoveFromPlaylist(t *testing.T) {
@@ -183,14 +257,37 @@ func TestPlaylistRepositoryRemoveFromPlaylist(t *testing.T) {
 	playlistRepository := NewPlaylistRepository(db)
 	mockPlaylistID := uint64(1)
 	mockTrackID := uint64(42)
+	authorID := uuid.New()
+	versionColumns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
 
-	mock.ExpectExec(RemoveFromPlaylistQuery).WithArgs(mockPlaylistID, mockTrackID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery(RemoveFromPlaylistQuery).WithArgs(mockPlaylistID, mockTrackID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(mockPlaylistID, authorID, models.PlaylistChangeRemove, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(mockPlaylistID, 3, authorID, "remove", nil, "Road trip", "{}", time.Now()))
+	mock.ExpectCommit()
 
-	res, err := playlistRepository.RemoveFromPlaylist(context.Background(), mockPlaylistID, mockTrackID)
+	removed, err := playlistRepository.RemoveFromPlaylist(context.Background(), mockPlaylistID, mockTrackID, authorID)
 	require.NoError(t, err)
-	rowsAffected, _ := res.RowsAffected()
-	require.Equal(t, int64(1), rowsAffected)
+	require.Equal(t, int64(1), removed)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(RemoveFromPlaylistQuery).WithArgs(mockPlaylistID, mockTrackID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectCommit()
+
+	removed, err = playlistRepository.RemoveFromPlaylist(context.Background(), mockPlaylistID, mockTrackID, authorID)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(RemoveFromPlaylistQuery).WithArgs(mockPlaylistID, mockTrackID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}))
+	mock.ExpectRollback()
+
+	_, err = playlistRepository.RemoveFromPlaylist(context.Background(), mockPlaylistID, mockTrackID, authorID)
+	require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestPlaylistRepositoryDeletePlaylist(t *testing.T) {
@@ -356,3 +453,357 @@ func TestPlaylistRepositoryGetPopularPlaylists(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	require.NoError(t, err)
 }
+
+func TestPlaylistRepositoryUpdateImage(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	mock.ExpectQuery(updateImageQuery).WithArgs(uint64(1), "covers/640.jpg").
+		WillReturnRows(sqlmock.NewRows([]string{"image"}).AddRow("default.webp"))
+
+	previousImage, err := playlistRepository.UpdateImage(context.Background(), 1, "covers/640.jpg")
+	require.NoError(t, err)
+	require.Equal(t, "default.webp", previousImage)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryUpdateMosaic(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	mock.ExpectQuery(updateMosaicQuery).WithArgs(uint64(1), "mosaic/640.jpg").
+		WillReturnRows(sqlmock.NewRows([]string{"image"}).AddRow("default.webp"))
+	mock.ExpectQuery(updateMosaicQuery).WithArgs(uint64(2), "mosaic/640.jpg").
+		WillReturnRows(sqlmock.NewRows([]string{"image"}))
+
+	previousImage, err := playlistRepository.UpdateMosaic(context.Background(), 1, "mosaic/640.jpg")
+	require.NoError(t, err)
+	require.Equal(t, "default.webp", previousImage)
+
+	_, err = playlistRepository.UpdateMosaic(context.Background(), 2, "mosaic/640.jpg")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryGetMosaicCovers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	rows := sqlmock.NewRows([]string{"image"}).AddRow("misery.webp").AddRow("fvck.webp")
+	mock.ExpectQuery(hasCustomImageQuery).WithArgs(uint64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"has_custom_image"}).AddRow(false))
+	mock.ExpectQuery(getMosaicCoversQuery).WithArgs(uint64(1), 4).WillReturnRows(rows)
+
+	covers, err := playlistRepository.GetMosaicCovers(context.Background(), 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []string{"misery.webp", "fvck.webp"}, covers)
+
+	mock.ExpectQuery(hasCustomImageQuery).WithArgs(uint64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"has_custom_image"}).AddRow(true))
+	_, err = playlistRepository.GetMosaicCovers(context.Background(), 2, 4)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryCreatePlaylistWithTracks(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	ownerID := uuid.New()
+	columns := []string{"id", "name", "image", "owner_id", "is_private", "created_at", "updated_at"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(CreatePlaylistQuery).WithArgs("Imported", "default.webp", ownerID).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "Imported", "default.webp", ownerID, false, time.Now(), time.Now()))
+	mock.ExpectExec(AddToPlaylistQuery).WithArgs(uint64(3), uint64(1), uint64(7)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(AddToPlaylistQuery).WithArgs(uint64(3), uint64(2), uint64(5)).WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(uint64(3), ownerID, models.PlaylistChangeCreate, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}).
+			AddRow(3, 1, ownerID, "create", nil, "Imported", "{7,5}", time.Now()))
+	mock.ExpectCommit()
+
+	created, err := playlistRepository.CreatePlaylistWithTracks(context.Background(), &models.Playlist{
+		Name:    "Imported",
+		Image:   "default.webp",
+		OwnerID: ownerID,
+	}, []uint64{7, 5})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), created.ID)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(CreatePlaylistQuery).WithArgs("Imported", "default.webp", ownerID).
+		WillReturnError(&pq.Error{Code: uniqueViolation})
+	mock.ExpectRollback()
+
+	_, err = playlistRepository.CreatePlaylistWithTracks(context.Background(), &models.Playlist{
+		Name:    "Imported",
+		Image:   "default.webp",
+		OwnerID: ownerID,
+	}, []uint64{7, 5})
+	require.ErrorIs(t, err, playlist.ErrNameTaken)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryGetPlaylistEntries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	columns := []string{"id", "name", "name", "name", "duration", "filepath"}
+	rows := sqlmock.NewRows(columns).AddRow(1, "im so happy", "EKKSTACY", "misery", 139, "ekkstacy_misery_2.mp3")
+	mock.ExpectQuery(getPlaylistEntriesQuery).WithArgs(uint64(1)).WillReturnRows(rows)
+
+	entries, err := playlistRepository.GetPlaylistEntries(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []*models.PlaylistEntry{
+		{TrackID: 1, Name: "im so happy", Artist: "EKKSTACY", Album: "misery", Duration: 139, FilePath: "ekkstacy_misery_2.mp3"},
+	}, entries)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryFindCatalogEntries(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	columns := []string{"id", "name", "name", "name", "duration", "filepath"}
+	rows := sqlmock.NewRows(columns).AddRow(1, "im so happy", "EKKSTACY", "misery", 139, "ekkstacy_misery_2.mp3")
+	mock.ExpectQuery(findCatalogEntriesQuery).
+		WithArgs(pq.Array([]string{"im so happy"}), pq.Array([]string{"ekkstacy"}), 10).
+		WillReturnRows(rows)
+
+	entries, err := playlistRepository.FindCatalogEntries(context.Background(), []string{"im so happy"}, []string{"ekkstacy"}, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryTrash(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	ownerID := uuid.New()
+	retention := 30 * 24 * time.Hour
+	createdAt := time.Now().Add(-time.Hour)
+	deletedAt := time.Now()
+
+	mock.ExpectQuery(getTrashedPlaylistsQuery).WithArgs(ownerID, retention.Seconds()).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "image", "owner_id", "is_private", "created_at", "updated_at", "deleted_at"}).
+				AddRow(1, "Road trip", "default.webp", ownerID, false, createdAt, createdAt, deletedAt),
+		)
+	trashed, err := playlistRepository.GetTrashedPlaylists(context.Background(), ownerID, retention)
+	require.NoError(t, err)
+	require.Equal(t, []*models.Playlist{{
+		ID:        1,
+		Name:      "Road trip",
+		Image:     "default.webp",
+		OwnerID:   ownerID,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		DeletedAt: &deletedAt,
+	}}, trashed)
+
+	mock.ExpectQuery(restorePlaylistQuery).WithArgs(uint64(1), ownerID, retention.Seconds()).
+		WillReturnError(&pq.Error{Code: uniqueViolation})
+	_, err = playlistRepository.RestorePlaylist(context.Background(), 1, ownerID, retention)
+	require.ErrorIs(t, err, playlist.ErrNameTaken)
+
+	mock.ExpectQuery(restorePlaylistQuery).WithArgs(uint64(2), ownerID, retention.Seconds()).
+		WillReturnError(sql.ErrNoRows)
+	_, err = playlistRepository.RestorePlaylist(context.Background(), 2, ownerID, retention)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	mock.ExpectQuery(purgePlaylistsQuery).WithArgs(retention.Seconds()).
+		WillReturnRows(sqlmock.NewRows([]string{"image"}).AddRow("default.webp").AddRow("covers/1_1200.webp"))
+	images, err := playlistRepository.PurgePlaylists(context.Background(), retention)
+	require.NoError(t, err)
+	require.Equal(t, []string{"default.webp", "covers/1_1200.webp"}, images)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryVersions(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	authorID := uuid.New()
+	createdAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	columns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
+
+	mock.ExpectQuery(getVersionsQuery).WithArgs(uint64(1), uint64(3), uint64(2)).
+		WillReturnRows(
+			sqlmock.NewRows(columns).
+				AddRow(1, 2, authorID, "add", nil, "Road trip", "{4,5,6}", createdAt).
+				AddRow(1, 1, nil, "create", nil, "Road trip", "{}", createdAt),
+		)
+	versions, err := playlistRepository.GetVersions(context.Background(), 1, 3, 2)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, uuid.Nil, versions[1].AuthorID)
+	require.Empty(t, versions[1].TrackIDs)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getVersionQuery).WithArgs(uint64(1), uint64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, nil, "create", nil, "Road trip", "{4,5}", createdAt))
+	mock.ExpectExec(renamePlaylistQuery).WithArgs(uint64(1), "Road trip").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(clearPlaylistQuery).WithArgs(uint64(1)).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(fillPlaylistQuery).WithArgs(uint64(1), pq.Array([]int64{4, 5})).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(uint64(1), authorID, models.PlaylistChangeRevert, uint64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 3, authorID, "revert", 1, "Road trip", "{4,5}", createdAt))
+	mock.ExpectCommit()
+	reverted, err := playlistRepository.RevertPlaylist(context.Background(), 1, 1, authorID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), reverted.Version)
+	require.Equal(t, uint64(1), reverted.RevertedTo)
+	require.Equal(t, []uint64{4, 5}, reverted.TrackIDs)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getVersionQuery).WithArgs(uint64(1), uint64(1)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 1, nil, "create", nil, "Taken", "{}", createdAt))
+	mock.ExpectExec(renamePlaylistQuery).WithArgs(uint64(1), "Taken").WillReturnError(&pq.Error{Code: uniqueViolation})
+	mock.ExpectRollback()
+	_, err = playlistRepository.RevertPlaylist(context.Background(), 1, 1, authorID)
+	require.ErrorIs(t, err, playlist.ErrNameTaken)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(getVersionQuery).WithArgs(uint64(1), uint64(9)).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	_, err = playlistRepository.RevertPlaylist(context.Background(), 1, 9, authorID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryRenamePlaylist(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	ownerID := uuid.New()
+	now := time.Now()
+	columns := []string{"id", "name", "image", "owner_id", "is_private", "created_at", "updated_at", "old_name"}
+	versionColumns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateNameQuery).WithArgs(uint64(1), "Night drive").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Night drive", "default.webp", ownerID, false, now, now, "Road trip"))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(uint64(1), ownerID, models.PlaylistChangeRenamed, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(1, 2, ownerID, "renamed", nil, "Night drive", "{}", now))
+	mock.ExpectCommit()
+	renamed, err := playlistRepository.RenamePlaylist(context.Background(), 1, "Night drive", ownerID)
+	require.NoError(t, err)
+	require.Equal(t, "Night drive", renamed.Name)
+
+	// the same name records no version
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateNameQuery).WithArgs(uint64(1), "Night drive").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "Night drive", "default.webp", ownerID, false, now, now, "Night drive"))
+	mock.ExpectCommit()
+	_, err = playlistRepository.RenamePlaylist(context.Background(), 1, "Night drive", ownerID)
+	require.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateNameQuery).WithArgs(uint64(1), "Taken").WillReturnError(&pq.Error{Code: uniqueViolation})
+	mock.ExpectRollback()
+	_, err = playlistRepository.RenamePlaylist(context.Background(), 1, "Taken", ownerID)
+	require.ErrorIs(t, err, playlist.ErrNameTaken)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(updateNameQuery).WithArgs(uint64(2), "Taken").WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	_, err = playlistRepository.RenamePlaylist(context.Background(), 2, "Taken", ownerID)
+	require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPlaylistRepositoryMoveTrack(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	playlistRepository := NewPlaylistRepository(db)
+	authorID := uuid.New()
+	now := time.Now()
+	versionColumns := []string{"playlist_id", "version", "author_id", "change", "reverted_to", "name", "track_ids", "created_at"}
+	tracks := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"track_id"}).AddRow(4).AddRow(5).AddRow(6)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockPlaylistQuery).WithArgs(uint64(1)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(GetPlaylistTrackIDsQuery).WithArgs(uint64(1)).WillReturnRows(tracks())
+	mock.ExpectExec(reorderPlaylistQuery).WithArgs(uint64(1), pq.Array([]int64{6, 4, 5})).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(uint64(1), authorID, models.PlaylistChangeMoved, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(1, 3, authorID, "moved", nil, "Road trip", "{6,4,5}", now))
+	mock.ExpectCommit()
+	require.NoError(t, playlistRepository.MoveTrack(context.Background(), 1, 6, 1, authorID))
+
+	// positions past the end move the track last
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockPlaylistQuery).WithArgs(uint64(1)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(GetPlaylistTrackIDsQuery).WithArgs(uint64(1)).WillReturnRows(tracks())
+	mock.ExpectExec(reorderPlaylistQuery).WithArgs(uint64(1), pq.Array([]int64{5, 6, 4})).WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectQuery(RecordVersionQuery).WithArgs(uint64(1), authorID, models.PlaylistChangeMoved, nil).
+		WillReturnRows(sqlmock.NewRows(versionColumns).AddRow(1, 4, authorID, "moved", nil, "Road trip", "{5,6,4}", now))
+	mock.ExpectCommit()
+	require.NoError(t, playlistRepository.MoveTrack(context.Background(), 1, 4, 10, authorID))
+
+	// a track staying in place records no version
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockPlaylistQuery).WithArgs(uint64(1)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(GetPlaylistTrackIDsQuery).WithArgs(uint64(1)).WillReturnRows(tracks())
+	mock.ExpectRollback()
+	require.NoError(t, playlistRepository.MoveTrack(context.Background(), 1, 5, 2, authorID))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockPlaylistQuery).WithArgs(uint64(1)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(GetPlaylistTrackIDsQuery).WithArgs(uint64(1)).WillReturnRows(tracks())
+	mock.ExpectRollback()
+	err = playlistRepository.MoveTrack(context.Background(), 1, 9, 1, authorID)
+	require.ErrorIs(t, err, playlist.ErrTrackNotFound)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockPlaylistQuery).WithArgs(uint64(2)).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+	err = playlistRepository.MoveTrack(context.Background(), 2, 4, 1, authorID)
+	require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -5,25 +5,165 @@ const (
 VALUES ($1, $2, $3)
 RETURNING id, name, image, owner_id, is_private, created_at, updated_at`
 
-	GetAllPlaylistsQuery = `SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist`
+	GetAllPlaylistsQuery = `SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE deleted_at IS NULL`
 
-	GetPlaylistQuery = `SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE id = $1`
+	GetPlaylistQuery = `SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE id = $1 AND deleted_at IS NULL`
 
-	GetLengthPlaylistsQuery = `SELECT COUNT(id) FROM playlist_track WHERE id = $1`
+	GetPlaylistsByIDsQuery = `SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE id = ANY($1::INT[]) AND deleted_at IS NULL ORDER BY array_position($1::INT[], id)`
 
-	GetUserPlaylistsQuery = "SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE owner_id = $1 ORDER BY created_at DESC"
+	// The tracks of a playlist are listed, snapshotted and restored by
+	// track_order_in_playlist, the id breaks the ties left by removals
+	GetPlaylistTrackIDsQuery = `
+    SELECT pt.track_id
+    FROM playlist_track AS pt
+      JOIN playlist AS p ON p.id = pt.playlist_id
+    WHERE pt.playlist_id = $1 AND p.deleted_at IS NULL
+    ORDER BY pt.track_order_in_playlist, pt.id`
+
+	GetPlaylistsTracksQuery = `
+    SELECT pt.id, pt.playlist_id, pt.track_order_in_playlist, pt.track_id, pt.created_at
+    FROM playlist_track AS pt
+      JOIN playlist AS p ON p.id = pt.playlist_id
+    WHERE pt.playlist_id = ANY($1::INT[]) AND p.deleted_at IS NULL
+    ORDER BY pt.playlist_id, pt.track_order_in_playlist, pt.id`
+
+	GetLengthPlaylistsQuery = `SELECT COUNT(id) FROM playlist_track WHERE playlist_id = $1`
+
+	GetUserPlaylistsQuery = "SELECT id, name, image, owner_id, is_private, created_at, updated_at FROM playlist WHERE owner_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC"
 
 	AddToPlaylistQuery = `INSERT INTO playlist_track (playlist_id, track_order_in_playlist, track_id)
-VALUES ($1, $2, $3)
+SELECT id, $2, $3 FROM playlist WHERE id = $1 AND deleted_at IS NULL
 RETURNING id, playlist_id, track_order_in_playlist, track_id, created_at`
 
-	RemoveFromPlaylistQuery = `DELETE FROM playlist_track WHERE playlist_id = $1 AND track_id = $2`
+	// RemoveFromPlaylistQuery returns how many tracks were removed, or no row
+	// when the playlist is missing or trashed
+	RemoveFromPlaylistQuery = `
+    WITH live AS (
+      SELECT id FROM playlist WHERE id = $1 AND deleted_at IS NULL
+    ), removed AS (
+      DELETE FROM playlist_track AS pt
+      USING live
+      WHERE pt.playlist_id = live.id AND pt.track_id = $2
+      RETURNING pt.id
+    )
+    SELECT (SELECT COUNT(*) FROM removed) FROM live`
+
+	// updateNameQuery returns the renamed playlist and its previous name
+	updateNameQuery = `
+    UPDATE playlist AS p
+    SET name = $2, updated_at = NOW()
+    FROM playlist AS old
+    WHERE p.id = $1 AND old.id = p.id AND p.deleted_at IS NULL
+    RETURNING p.id, p.name, p.image, p.owner_id, p.is_private, p.created_at, p.updated_at, old.name`
+
+	// lockPlaylistQuery keeps tracks from being added to the playlist until
+	// the transaction ends
+	lockPlaylistQuery = `SELECT id FROM playlist WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`
+
+	// reorderPlaylistQuery numbers the tracks of the playlist in the given
+	// order
+	reorderPlaylistQuery = `
+    UPDATE playlist_track AS pt
+    SET track_order_in_playlist = entries.position
+    FROM unnest($2::INT[]) WITH ORDINALITY AS entries (track_id, position)
+    WHERE pt.playlist_id = $1 AND pt.track_id = entries.track_id`
+
+	// DeletePlaylistQuery moves the playlist to the trash, it is purged once
+	// the retention is over
+	DeletePlaylistQuery = `UPDATE playlist SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	getTrashedPlaylistsQuery = `
+    SELECT id, name, image, owner_id, is_private, created_at, updated_at, deleted_at
+    FROM playlist
+    WHERE owner_id = $1 AND deleted_at > NOW() - make_interval(secs => $2)
+    ORDER BY deleted_at DESC`
+
+	restorePlaylistQuery = `
+    UPDATE playlist
+    SET deleted_at = NULL, updated_at = NOW()
+    WHERE id = $1 AND owner_id = $2 AND deleted_at > NOW() - make_interval(secs => $3)
+    RETURNING id, name, image, owner_id, is_private, created_at, updated_at`
+
+	purgePlaylistsQuery = `DELETE FROM playlist WHERE deleted_at <= NOW() - make_interval(secs => $1) RETURNING image`
 
-	DeletePlaylistQuery = `DELETE FROM playlist WHERE id = $1`
+	// RecordVersionQuery snapshots the playlist as its next version
+	RecordVersionQuery = `
+    WITH bumped AS (
+      UPDATE playlist SET version = version + 1 WHERE id = $1
+      RETURNING id, name, version
+    )
+    INSERT INTO playlist_version (playlist_id, version, author_id, change, reverted_to, name, track_ids)
+    SELECT
+      bumped.id, bumped.version, $2, $3, $4, bumped.name,
+      ARRAY(
+        SELECT pt.track_id
+        FROM playlist_track AS pt
+        WHERE pt.playlist_id = bumped.id
+        ORDER BY pt.track_order_in_playlist, pt.id
+      )
+    FROM bumped
+    RETURNING playlist_id, version, author_id, change, reverted_to, name, track_ids, created_at`
+
+	// getVersionsQuery continues the list after the version $2, or starts from
+	// the latest one when it is zero
+	getVersionsQuery = `
+    SELECT playlist_id, version, author_id, change, reverted_to, name, track_ids, created_at
+    FROM playlist_version
+    WHERE playlist_id = $1 AND ($2 = 0 OR version < $2)
+    ORDER BY version DESC
+    LIMIT $3`
+
+	getVersionQuery = `
+    SELECT playlist_id, version, author_id, change, reverted_to, name, track_ids, created_at
+    FROM playlist_version
+    WHERE playlist_id = $1 AND version = $2`
+
+	renamePlaylistQuery = `UPDATE playlist SET name = $2, updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+
+	clearPlaylistQuery = `DELETE FROM playlist_track WHERE playlist_id = $1`
+
+	// fillPlaylistQuery adds the tracks in the given order, skipping the ones
+	// removed from the catalog
+	fillPlaylistQuery = `
+    INSERT INTO playlist_track (playlist_id, track_order_in_playlist, track_id)
+    SELECT $1, entries.position, t.id
+    FROM unnest($2::INT[]) WITH ORDINALITY AS entries (track_id, position)
+      JOIN track AS t ON t.id = entries.track_id
+    ORDER BY entries.position`
+
+	updateImageQuery = `
+    UPDATE playlist AS p
+    SET image = $2, has_custom_image = true, updated_at = NOW()
+    FROM playlist AS old
+    WHERE p.id = $1 AND old.id = p.id
+    RETURNING old.image`
+
+	updateMosaicQuery = `
+    UPDATE playlist AS p
+    SET image = $2, updated_at = NOW()
+    FROM playlist AS old
+    WHERE p.id = $1 AND old.id = p.id AND NOT p.has_custom_image
+    RETURNING old.image`
+
+	hasCustomImageQuery = `SELECT has_custom_image FROM playlist WHERE id = $1`
+
+	getMosaicCoversQuery = `
+    SELECT a.image
+    FROM (
+      SELECT DISTINCT ON (t.album_id) t.album_id, pt.track_order_in_playlist
+      FROM playlist_track AS pt
+        JOIN track AS t ON t.id = pt.track_id
+      WHERE pt.playlist_id = $1
+      ORDER BY t.album_id, pt.track_order_in_playlist
+    ) AS first_tracks
+      JOIN album AS a ON a.id = first_tracks.album_id
+    WHERE a.image IS NOT NULL
+    ORDER BY first_tracks.track_order_in_playlist
+    LIMIT $2`
 
 	addFavoritePlaylistQuery = `
-    INSERT INTO favorite_playlist (user_id, playlist_id) 
-    VALUES ($1, $2)
+    INSERT INTO favorite_playlist (user_id, playlist_id)
+    SELECT $1, id FROM playlist WHERE id = $2 AND deleted_at IS NULL
     ON CONFLICT (user_id, playlist_id) DO NOTHING`
 
 	deleteFavoritePlaylistQuery = `
@@ -40,7 +180,7 @@ RETURNING id, playlist_id, track_order_in_playlist, track_id, created_at`
     FROM playlist AS p
       JOIN favorite_playlist AS fp
       ON p.id = fp.playlist_id
-    WHERE fp.user_id = $1`
+    WHERE fp.user_id = $1 AND p.deleted_at IS NULL`
 
 	getPopularPlaylistsQuery = `SELECT 
     p.id, 
@@ -54,10 +194,31 @@ RETURNING id, playlist_id, track_order_in_playlist, track_id, created_at`
         playlist p
     LEFT JOIN 
         favorite_playlist fp ON p.id = fp.playlist_id
+    WHERE 
+        p.deleted_at IS NULL
     GROUP BY 
         p.id
     ORDER BY 
         COUNT(fp.playlist_id) DESC
     LIMIT 50;
     `
+
+	getPlaylistEntriesQuery = `
+    SELECT t.id, t.name, ar.name, al.name, COALESCE(t.duration, 0), COALESCE(t.filepath, '')
+    FROM playlist_track AS pt
+      JOIN track AS t ON t.id = pt.track_id
+      JOIN artist AS ar ON ar.id = t.artist_id
+      JOIN album AS al ON al.id = t.album_id
+    WHERE pt.playlist_id = $1
+    ORDER BY pt.track_order_in_playlist, pt.id`
+
+	// findCatalogEntriesQuery compares the names normalized like
+	// matching.Normalize does
+	findCatalogEntriesQuery = `
+    SELECT t.id, t.name, ar.name, al.name, COALESCE(t.duration, 0), COALESCE(t.filepath, '')
+    FROM track AS t
+      JOIN artist AS ar ON ar.id = t.artist_id
+      JOIN album AS al ON al.id = t.album_id
+    WHERE normalize_name(t.name) = ANY($1) OR normalize_name(ar.name) = ANY($2)
+    LIMIT $3`
 )
@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	pldto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/playlistfile"
 	"github.com/google/uuid"
 )
 
@@ -12,10 +14,34 @@ type Usecase interface {
 	CreatePlaylist(ctx context.Context, newPlaylistDTO *pldto.PlaylistDTO) (*pldto.PlaylistDTO, error)
 	GetAllPlaylists(ctx context.Context) ([]*pldto.PlaylistDTO, error)
 	GetPlaylist(ctx context.Context, playlistID uint64) (*pldto.PlaylistDTO, error)
+	GetPlaylists(ctx context.Context, playlistIDs []uint64) ([]*pldto.PlaylistDTO, error)
+	GetTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error)
 	GetUserPlaylists(ctx context.Context, userID uuid.UUID) ([]*pldto.PlaylistDTO, error)
 	AddToPlaylist(ctx context.Context, playlistTrackDTO *pldto.PlaylistTrackDTO) (*models.PlaylistTrack, error)
 	RemoveFromPlaylist(ctx context.Context, playlistTrackDTO *pldto.PlaylistTrackDTO) error
+	// RenamePlaylist renames the playlist of the user, recording the change
+	// as the latest version.
+	RenamePlaylist(ctx context.Context, playlistID uint64, name string, userID uuid.UUID) (*pldto.PlaylistDTO, error)
+	// MoveTrack puts the track of the playlist of the user at the 1-based
+	// position, recording the change as the latest version.
+	MoveTrack(ctx context.Context, playlistID uint64, trackID uint64, position uint64, userID uuid.UUID) error
+	ExportPlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*playlistfile.Playlist, error)
+	ImportPlaylist(ctx context.Context, userID uuid.UUID, name string, file *playlistfile.Playlist) (*pldto.ImportReportDTO, error)
+	UploadImage(ctx context.Context, playlistID uint64, userID uuid.UUID, renditions []*imaging.Rendition) (*pldto.PlaylistDTO, error)
+	// DeletePlaylist moves the playlist to the trash.
 	DeletePlaylist(ctx context.Context, playlistID uint64) error
+	GetTrash(ctx context.Context, userID uuid.UUID) ([]*pldto.TrashedPlaylistDTO, error)
+	RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*pldto.PlaylistDTO, error)
+	// PurgeTrash deletes the playlists kept in the trash longer than the
+	// retention.
+	PurgeTrash(ctx context.Context) error
+	// GetVersions returns a page of up to limit versions of the playlist
+	// older than before, latest first, each with the changes since the
+	// version before it. Zero before starts from the latest version.
+	GetVersions(ctx context.Context, playlistID uint64, userID uuid.UUID, before uint64, limit uint64) (*pldto.PlaylistVersionsDTO, error)
+	// RevertPlaylist restores the name and tracks of the version, recording
+	// the revert as the latest version.
+	RevertPlaylist(ctx context.Context, playlistID uint64, version uint64, userID uuid.UUID) (*pldto.PlaylistVersionDTO, error)
 	AddFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error
 	DeleteFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) error
 	IsFavoritePlaylist(ctx context.Context, userID uuid.UUID, playlistID uint64) (bool, error)
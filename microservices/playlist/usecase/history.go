@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	"github.com/google/uuid"
+)
+
+func (u *PlaylistUsecase) GetVersions(ctx context.Context, playlistID uint64, userID uuid.UUID, before uint64, limit uint64) (*dto.PlaylistVersionsDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.IsPrivate && playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't see history of private playlist %d", userID, playlistID), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+
+	// one more version tells whether there is a next page and is the one the
+	// last version of the page is compared with
+	versions, err := u.playlistRepo.GetVersions(ctx, playlistID, before, limit+1)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't get versions of playlist %d: %v", playlistID, err), requestID)
+		return nil, fmt.Errorf("Can't get versions of playlist %d", playlistID)
+	}
+
+	var next *models.PlaylistVersion
+	page := &dto.PlaylistVersionsDTO{}
+	if limit > 0 && uint64(len(versions)) > limit {
+		next = versions[limit]
+		versions = versions[:limit]
+		page.NextCursor = versions[len(versions)-1].Version
+	}
+
+	page.Versions = make([]*dto.PlaylistVersionDTO, 0, len(versions))
+	for i, version := range versions {
+		previous := next
+		if i+1 < len(versions) {
+			previous = versions[i+1]
+		}
+		page.Versions = append(page.Versions, newVersionDTO(previous, version))
+	}
+
+	return page, nil
+}
+
+func (u *PlaylistUsecase) RevertPlaylist(ctx context.Context, playlistID uint64, version uint64, userID uuid.UUID) (*dto.PlaylistVersionDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't revert playlist %d", userID, playlistID), requestID)
+		return nil, playlist.ErrNotOwner
+	}
+
+	reverted, err := u.playlistRepo.RevertPlaylist(ctx, playlistID, version, userID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't revert playlist %d to version %d: %v", playlistID, version, err), requestID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, playlist.ErrVersionNotFound
+		case errors.Is(err, playlist.ErrNameTaken):
+			return nil, err
+		}
+		return nil, fmt.Errorf("Can't revert playlist %d", playlistID)
+	}
+	u.logger.Info(fmt.Sprintf("Reverted playlist %d to version %d", playlistID, version), requestID)
+	u.mosaics.schedule(playlistID)
+
+	previous, err := u.playlistRepo.GetVersion(ctx, playlistID, reverted.Version-1)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't get version before revert of playlist %d: %v", playlistID, err), requestID)
+	}
+
+	return newVersionDTO(previous, reverted), nil
+}
+
+// author returns the user making the request, uuid.Nil when it is unknown.
+func author(ctx context.Context) uuid.UUID {
+	userID, _ := ctx.Value(utils.UserIDKey{}).(uuid.UUID)
+	return userID
+}
+
+func newVersionDTO(previous, version *models.PlaylistVersion) *dto.PlaylistVersionDTO {
+	versionDTO := dto.NewPlaylistVersionDTO(version)
+
+	var before []uint64
+	if previous != nil {
+		before = previous.TrackIDs
+		if previous.Name != version.Name {
+			versionDTO.PreviousName = previous.Name
+		}
+	}
+	diffTracks(versionDTO, before, version.TrackIDs)
+
+	return versionDTO
+}
+
+// diffTracks fills the tracks added, removed and moved between before and
+// after. The kept tracks that stay in order are the longest increasing run of
+// their new positions, only the rest count as moved, so shifts caused by
+// other tracks coming and going don't.
+func diffTracks(versionDTO *dto.PlaylistVersionDTO, before, after []uint64) {
+	beforePositions := positions(before)
+	afterPositions := positions(after)
+
+	var kept []uint64
+	for i, trackID := range before {
+		if _, ok := afterPositions[trackID]; !ok {
+			versionDTO.Removed = append(versionDTO.Removed, &dto.TrackChangeDTO{TrackID: trackID, Position: i + 1})
+			continue
+		}
+		kept = append(kept, trackID)
+	}
+	for i, trackID := range after {
+		if _, ok := beforePositions[trackID]; !ok {
+			versionDTO.Added = append(versionDTO.Added, &dto.TrackChangeDTO{TrackID: trackID, Position: i + 1})
+		}
+	}
+
+	inOrder := longestInOrder(kept, afterPositions)
+	for _, trackID := range kept {
+		if !inOrder[trackID] {
+			versionDTO.Moved = append(versionDTO.Moved, &dto.TrackMoveDTO{
+				TrackID: trackID,
+				From:    beforePositions[trackID],
+				To:      afterPositions[trackID],
+			})
+		}
+	}
+	sort.Slice(versionDTO.Moved, func(i, j int) bool {
+		return versionDTO.Moved[i].To < versionDTO.Moved[j].To
+	})
+}
+
+func positions(trackIDs []uint64) map[uint64]int {
+	result := make(map[uint64]int, len(trackIDs))
+	for i, trackID := range trackIDs {
+		result[trackID] = i + 1
+	}
+	return result
+}
+
+// longestInOrder returns the longest subsequence of trackIDs whose positions
+// increase.
+func longestInOrder(trackIDs []uint64, positions map[uint64]int) map[uint64]bool {
+	// tails[k] is the index of the smallest tail of a run of length k+1
+	var tails []int
+	parents := make([]int, len(trackIDs))
+	for i, trackID := range trackIDs {
+		k := sort.Search(len(tails), func(k int) bool {
+			return positions[trackIDs[tails[k]]] >= positions[trackID]
+		})
+		parents[i] = -1
+		if k > 0 {
+			parents[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	result := make(map[uint64]bool, len(tails))
+	if len(tails) == 0 {
+		return result
+	}
+	for i := tails[len(tails)-1]; i >= 0; i = parents[i] {
+		result[trackIDs[i]] = true
+	}
+	return result
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	"github.com/google/uuid"
+)
+
+const (
+	coversBucket      = "playlists"
+	albumCoversBucket = "images"
+	defaultImage      = "default.webp"
+
+	mosaicTiles   = 4
+	mosaicTimeout = time.Minute
+)
+
+// mosaicScheduler runs the generation of playlist covers in the background.
+// Changes of a playlist whose cover is being generated are coalesced into a
+// single run after the current one, so a burst of edits doesn't spawn a
+// goroutine each and the last run always sees the final tracks.
+type mosaicScheduler struct {
+	generate func(ctx context.Context, playlistID uint64)
+
+	mu      sync.Mutex
+	pending map[uint64]bool
+	wg      sync.WaitGroup
+}
+
+func newMosaicScheduler(generate func(ctx context.Context, playlistID uint64)) *mosaicScheduler {
+	return &mosaicScheduler{generate: generate, pending: make(map[uint64]bool)}
+}
+
+func (s *mosaicScheduler) schedule(playlistID uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, running := s.pending[playlistID]; running {
+		s.pending[playlistID] = true
+		return
+	}
+	s.pending[playlistID] = false
+	s.wg.Add(1)
+	go s.run(playlistID)
+}
+
+func (s *mosaicScheduler) run(playlistID uint64) {
+	defer s.wg.Done()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), mosaicTimeout)
+		s.generate(ctx, playlistID)
+		cancel()
+
+		s.mu.Lock()
+		if !s.pending[playlistID] {
+			delete(s.pending, playlistID)
+			s.mu.Unlock()
+			return
+		}
+		s.pending[playlistID] = false
+		s.mu.Unlock()
+	}
+}
+
+// wait blocks until every scheduled generation is done.
+func (s *mosaicScheduler) wait() {
+	s.wg.Wait()
+}
+
+// generateMosaic replaces the cover of a playlist without a custom one with a
+// mosaic of the covers of its first albums, or with the default image when
+// there are none.
+func (u *PlaylistUsecase) generateMosaic(ctx context.Context, playlistID uint64) {
+	covers, err := u.playlistRepo.GetMosaicCovers(ctx, playlistID, mosaicTiles)
+	if err != nil {
+		// A custom cover is kept, so there is nothing to render.
+		if !errors.Is(err, sql.ErrNoRows) {
+			u.logger.Warnf("Can't load album covers of playlist %d: %v", playlistID, err)
+		}
+		return
+	}
+
+	var pictures [][]byte
+	for _, cover := range covers {
+		picture, err := u.loadCover(ctx, cover)
+		if err != nil {
+			u.logger.Warnf("Can't load album cover '%s' of playlist %d: %v", cover, playlistID, err)
+			continue
+		}
+		pictures = append(pictures, picture)
+	}
+
+	image := defaultImage
+	var keys []string
+	if len(pictures) > 0 {
+		renditions, err := imaging.Mosaic(pictures)
+		if err != nil {
+			u.logger.Warnf("Can't compose cover of playlist %d: %v", playlistID, err)
+			return
+		}
+
+		base := uuid.New().String()
+		if keys, err = u.uploadRenditions(ctx, base, renditions); err != nil {
+			u.logger.Warnf("Can't save cover of playlist %d: %v", playlistID, err)
+			u.removeKeys(ctx, keys)
+			return
+		}
+		image = imaging.Largest(base)
+	}
+
+	previousImage, err := u.playlistRepo.UpdateMosaic(ctx, playlistID, image)
+	if err != nil {
+		// A custom cover was uploaded or the playlist was deleted meanwhile.
+		if !errors.Is(err, sql.ErrNoRows) {
+			u.logger.Warnf("Can't update cover of playlist %d: %v", playlistID, err)
+		}
+		u.removeKeys(ctx, keys)
+		return
+	}
+
+	u.removeKeys(ctx, imaging.Keys(previousImage))
+}
+
+func (u *PlaylistUsecase) loadCover(ctx context.Context, cover string) ([]byte, error) {
+	object, err := u.s3Repo.Get(ctx, albumCoversBucket, cover)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	return io.ReadAll(io.LimitReader(object, imaging.MaxFileSize+1))
+}
+
+// uploadRenditions stores the renditions under base and returns the keys of
+// the stored ones, even when it fails partway.
+func (u *PlaylistUsecase) uploadRenditions(ctx context.Context, base string, renditions []*imaging.Rendition) ([]string, error) {
+	keys := make([]string, 0, len(renditions))
+	for _, rendition := range renditions {
+		upload := s3.Upload{
+			Bucket:      coversBucket,
+			Key:         rendition.Key(base),
+			File:        bytes.NewReader(rendition.Data),
+			Size:        int64(len(rendition.Data)),
+			ContentType: rendition.Format.ContentType(),
+		}
+		if _, err := u.s3Repo.Put(ctx, upload); err != nil {
+			return keys, err
+		}
+		keys = append(keys, upload.Key)
+	}
+
+	return keys, nil
+}
+
+func (u *PlaylistUsecase) removeKeys(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := u.s3Repo.Remove(ctx, coversBucket, key); err != nil {
+			u.logger.Warnf("Can't remove playlist cover '%s': %v", key, err)
+		}
+	}
+}
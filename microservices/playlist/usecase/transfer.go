@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/matching"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/playlistfile"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	"github.com/google/uuid"
+)
+
+const (
+	tracksBucket = "tracks"
+	// maxCatalogCandidates bounds the tracks loaded to match an import.
+	maxCatalogCandidates = 5000
+)
+
+func (u *PlaylistUsecase) ExportPlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*playlistfile.Playlist, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.IsPrivate && playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't export private playlist %d", userID, playlistID), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+
+	entries, err := u.playlistRepo.GetPlaylistEntries(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't load tracks of playlist %d: %v", playlistID, err), requestID)
+		return nil, fmt.Errorf("Can't load tracks of playlist %d", playlistID)
+	}
+
+	file := &playlistfile.Playlist{Title: playlistModel.Name}
+	for _, entry := range entries {
+		file.Entries = append(file.Entries, &playlistfile.Entry{
+			Title:    entry.Name,
+			Artist:   entry.Artist,
+			Album:    entry.Album,
+			Duration: entry.Duration,
+			URL:      u.streamURL(entry.FilePath),
+		})
+	}
+
+	return file, nil
+}
+
+func (u *PlaylistUsecase) ImportPlaylist(ctx context.Context, userID uuid.UUID, name string, file *playlistfile.Playlist) (*dto.ImportReportDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if len(file.Entries) == 0 {
+		return nil, playlist.ErrEmptyImport
+	}
+
+	names, artists := lookupKeys(file.Entries)
+	candidates, err := u.playlistRepo.FindCatalogEntries(ctx, names, artists, maxCatalogCandidates)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't load catalog tracks to import: %v", err), requestID)
+		return nil, fmt.Errorf("Can't load catalog tracks to import")
+	}
+
+	tracks := make([]*matching.Track, 0, len(candidates))
+	for _, candidate := range candidates {
+		tracks = append(tracks, &matching.Track{
+			ID:       candidate.TrackID,
+			Title:    candidate.Name,
+			Artist:   candidate.Artist,
+			Duration: candidate.Duration,
+		})
+	}
+	index := matching.NewIndex(tracks)
+
+	report := &dto.ImportReportDTO{Unmatched: []*dto.UnmatchedEntryDTO{}}
+	var trackIDs []uint64
+	added := make(map[uint64]bool)
+	for i, entry := range file.Entries {
+		track, ok := index.Match(entry.Title, entry.Artist, entry.Duration)
+		if !ok {
+			report.Unmatched = append(report.Unmatched, &dto.UnmatchedEntryDTO{
+				Position: i + 1,
+				Title:    entry.Title,
+				Artist:   entry.Artist,
+				Album:    entry.Album,
+				Duration: entry.Duration,
+			})
+			continue
+		}
+
+		report.Matched++
+		if !added[track.ID] {
+			added[track.ID] = true
+			trackIDs = append(trackIDs, track.ID)
+		}
+	}
+
+	newPlaylist := &models.Playlist{Name: importName(name, file.Title), Image: defaultImage, OwnerID: userID}
+	created, err := u.playlistRepo.CreatePlaylistWithTracks(ctx, newPlaylist, trackIDs)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't create imported playlist '%s': %v", newPlaylist.Name, err), requestID)
+		if errors.Is(err, playlist.ErrNameTaken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Can't create imported playlist '%s'", newPlaylist.Name)
+	}
+	u.logger.Infof("Imported playlist %d with %d of %d tracks matched", created.ID, report.Matched, len(file.Entries))
+	if len(trackIDs) > 0 {
+		u.mosaics.schedule(created.ID)
+	}
+
+	report.Playlist = dto.NewPlaylistToPlaylistDTO(created)
+	owner, err := u.userClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: userID.String()})
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find owner of playlist %d: %v", created.ID, err), requestID)
+	} else {
+		report.Playlist.OwnerName = owner.User.Username
+	}
+
+	return report, nil
+}
+
+func (u *PlaylistUsecase) streamURL(filePath string) string {
+	if filePath == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", strings.TrimRight(u.minioCfg.PublicURL, "/"), tracksBucket, url.PathEscape(filePath))
+}
+
+// lookupKeys returns the normalized titles and artist names of the entries,
+// the artists both as credited and one by one, to load the catalog tracks
+// they may match.
+func lookupKeys(entries []*playlistfile.Entry) ([]string, []string) {
+	names := make(map[string]bool)
+	artists := make(map[string]bool)
+	for _, entry := range entries {
+		names[matching.Normalize(entry.Title)] = true
+		if entry.Artist != "" {
+			artists[matching.Normalize(entry.Artist)] = true
+			for _, artist := range matching.Artists(entry.Artist) {
+				artists[artist] = true
+			}
+		}
+	}
+
+	return keys(names), keys(artists)
+}
+
+func keys(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for key := range set {
+		if key != "" {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// importName picks the name of an imported playlist, cut to fit the column.
+func importName(name, title string) string {
+	for _, candidate := range []string{name, title, playlist.ImportedName} {
+		if candidate = strings.TrimSpace(candidate); candidate != "" {
+			name = candidate
+			break
+		}
+	}
+
+	if runes := []rune(name); len(runes) > playlist.MaxNameLength {
+		name = strings.TrimSpace(string(runes[:playlist.MaxNameLength]))
+	}
+	return name
+}
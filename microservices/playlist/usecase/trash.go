@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
+	"github.com/google/uuid"
+)
+
+// defaultRetention keeps the trash when the retention isn't configured,
+// rather than purging every deleted playlist right away.
+const defaultRetention = 30 * 24 * time.Hour
+
+func (u *PlaylistUsecase) GetTrash(ctx context.Context, userID uuid.UUID) ([]*dto.TrashedPlaylistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlists, err := u.playlistRepo.GetTrashedPlaylists(ctx, userID, u.retention())
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't get trash of user %v: %v", userID, err), requestID)
+		return nil, fmt.Errorf("Can't get trash")
+	}
+
+	trashedDTOs := []*dto.TrashedPlaylistDTO{}
+	for _, trashed := range playlists {
+		trashedDTOs = append(trashedDTOs, dto.NewTrashedPlaylistDTO(trashed, u.retention()))
+	}
+
+	return trashedDTOs, nil
+}
+
+func (u *PlaylistUsecase) RestorePlaylist(ctx context.Context, playlistID uint64, userID uuid.UUID) (*dto.PlaylistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	restored, err := u.playlistRepo.RestorePlaylist(ctx, playlistID, userID, u.retention())
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't restore playlist %d of user %v: %v", playlistID, userID, err), requestID)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, playlist.ErrPlaylistNotFound
+		case errors.Is(err, playlist.ErrNameTaken):
+			return nil, err
+		}
+		return nil, fmt.Errorf("Can't restore playlist")
+	}
+	u.logger.Info(fmt.Sprintf("Restored playlist %d from trash", playlistID), requestID)
+
+	playlistDTO := dto.NewPlaylistToPlaylistDTO(restored)
+	owner, err := u.userClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: userID.String()})
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find owner of playlist %d: %v", playlistID, err), requestID)
+		return playlistDTO, nil
+	}
+	playlistDTO.OwnerName = owner.User.Username
+
+	return playlistDTO, nil
+}
+
+func (u *PlaylistUsecase) PurgeTrash(ctx context.Context) error {
+	images, err := u.playlistRepo.PurgePlaylists(ctx, u.retention())
+	if err != nil {
+		return err
+	}
+	// only renditions of uploaded covers and mosaics belong to the playlist,
+	// the other images may be shared with the catalog
+	for _, image := range images {
+		u.removeKeys(ctx, imaging.Keys(image))
+	}
+	if len(images) > 0 {
+		u.logger.Infof("Purged %d playlists from trash", len(images))
+	}
+
+	return nil
+}
+
+func (u *PlaylistUsecase) retention() time.Duration {
+	if u.trashCfg == nil || u.trashCfg.Retention <= 0 {
+		return defaultRetention
+	}
+	return u.trashCfg.Retention * time.Second
+}
@@ -2,30 +2,54 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
 	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/google/uuid"
 )
 
 type PlaylistUsecase struct {
-	playlistRepo playlist.Repository
-	userClient   userService.UserServiceClient
-	logger       logger.Logger
+	minioCfg             *config.MinioConfig
+	trashCfg             *config.TrashConfig
+	playlistRepo         playlist.Repository
+	s3Repo               s3.S3Repo
+	userClient           userService.UserServiceClient
+	notificationProducer notification.Producer
+	logger               logger.Logger
+	mosaics              *mosaicScheduler
 }
 
 func NewPlaylistUsecase(
+	minioCfg *config.MinioConfig,
+	trashCfg *config.TrashConfig,
 	playlistRepo playlist.Repository,
+	s3Repo s3.S3Repo,
 	userClient userService.UserServiceClient,
+	notificationProducer notification.Producer,
 	logger logger.Logger,
 ) playlist.Usecase {
-	return &PlaylistUsecase{playlistRepo, userClient, logger}
+	usecase := &PlaylistUsecase{
+		minioCfg:             minioCfg,
+		trashCfg:             trashCfg,
+		playlistRepo:         playlistRepo,
+		s3Repo:               s3Repo,
+		userClient:           userClient,
+		notificationProducer: notificationProducer,
+		logger:               logger,
+	}
+	usecase.mosaics = newMosaicScheduler(usecase.generateMosaic)
+	return usecase
 }
 
 func (u *PlaylistUsecase) CreatePlaylist(ctx context.Context, newPlaylistDTO *dto.PlaylistDTO) (*dto.PlaylistDTO, error) {
@@ -65,6 +89,45 @@ func (u *PlaylistUsecase) GetPlaylist(ctx context.Context, playlistID uint64) (*
 	return playlistDTO, nil
 }
 
+func (u *PlaylistUsecase) GetPlaylists(ctx context.Context, playlistIDs []uint64) ([]*dto.PlaylistDTO, error) {
+	playlists, err := u.playlistRepo.GetPlaylistsByIDs(ctx, playlistIDs)
+	if err != nil {
+		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
+		return nil, err
+	}
+
+	owners := make(map[uuid.UUID]string)
+	playlistsDTO := []*dto.PlaylistDTO{}
+	for _, playlist := range playlists {
+		ownerName, ok := owners[playlist.OwnerID]
+		if !ok {
+			owner, err := u.userClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: playlist.OwnerID.String()})
+			if err != nil {
+				u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
+				return nil, err
+			}
+			ownerName = owner.User.Username
+			owners[playlist.OwnerID] = ownerName
+		}
+
+		playlistDTO := dto.NewPlaylistToPlaylistDTO(playlist)
+		playlistDTO.OwnerName = ownerName
+		playlistsDTO = append(playlistsDTO, playlistDTO)
+	}
+
+	return playlistsDTO, nil
+}
+
+func (u *PlaylistUsecase) GetTrackIDs(ctx context.Context, playlistID uint64) ([]uint64, error) {
+	trackIDs, err := u.playlistRepo.GetPlaylistTrackIDs(ctx, playlistID)
+	if err != nil {
+		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
+		return nil, err
+	}
+
+	return trackIDs, nil
+}
+
 func (u *PlaylistUsecase) GetAllPlaylists(ctx context.Context) ([]*dto.PlaylistDTO, error) {
 	playlists, err := u.playlistRepo.GetAllPlaylists(ctx)
 	if err != nil {
@@ -116,30 +179,134 @@ func (u *PlaylistUsecase) AddToPlaylist(ctx context.Context, playlistTrackDTO *d
 		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
 		return nil, err
 	}
-	playlistTrack, err := u.playlistRepo.AddToPlaylist(ctx, playlistTrackDTO.PlaylistID, length+1, playlistTrackDTO.TrackID)
+	playlistTrack, err := u.playlistRepo.AddToPlaylist(ctx, playlistTrackDTO.PlaylistID, length+1, playlistTrackDTO.TrackID, author(ctx))
 	if err != nil {
 		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
 		return nil, err
 	}
+	u.mosaics.schedule(playlistTrackDTO.PlaylistID)
 
 	return playlistTrack, nil
 }
 
 func (u *PlaylistUsecase) RemoveFromPlaylist(ctx context.Context, playlistTrackDTO *dto.PlaylistTrackDTO) error {
-	_, err := u.playlistRepo.RemoveFromPlaylist(ctx, playlistTrackDTO.PlaylistID, playlistTrackDTO.TrackID)
+	_, err := u.playlistRepo.RemoveFromPlaylist(ctx, playlistTrackDTO.PlaylistID, playlistTrackDTO.TrackID, author(ctx))
 	if err != nil {
 		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
 		return err
 	}
+	u.mosaics.schedule(playlistTrackDTO.PlaylistID)
+
 	return nil
 }
 
+func (u *PlaylistUsecase) RenamePlaylist(ctx context.Context, playlistID uint64, name string, userID uuid.UUID) (*dto.PlaylistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't rename playlist %d", userID, playlistID), requestID)
+		return nil, playlist.ErrNotOwner
+	}
+
+	renamed, err := u.playlistRepo.RenamePlaylist(ctx, playlistID, name, userID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't rename playlist %d: %v", playlistID, err), requestID)
+		if errors.Is(err, playlist.ErrPlaylistNotFound) || errors.Is(err, playlist.ErrNameTaken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("Can't rename playlist %d", playlistID)
+	}
+	u.logger.Info(fmt.Sprintf("Renamed playlist %d", playlistID), requestID)
+
+	playlistDTO := dto.NewPlaylistToPlaylistDTO(renamed)
+	owner, err := u.userClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: userID.String()})
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find owner of playlist %d: %v", playlistID, err), requestID)
+		return playlistDTO, nil
+	}
+	playlistDTO.OwnerName = owner.User.Username
+
+	return playlistDTO, nil
+}
+
+func (u *PlaylistUsecase) MoveTrack(ctx context.Context, playlistID uint64, trackID uint64, position uint64, userID uuid.UUID) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't reorder playlist %d", userID, playlistID), requestID)
+		return playlist.ErrNotOwner
+	}
+
+	if err := u.playlistRepo.MoveTrack(ctx, playlistID, trackID, position, userID); err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't move track %d of playlist %d: %v", trackID, playlistID, err), requestID)
+		if errors.Is(err, playlist.ErrPlaylistNotFound) || errors.Is(err, playlist.ErrTrackNotFound) {
+			return err
+		}
+		return fmt.Errorf("Can't move track of playlist %d", playlistID)
+	}
+	u.mosaics.schedule(playlistID)
+
+	return nil
+}
+
+func (u *PlaylistUsecase) UploadImage(ctx context.Context, playlistID uint64, userID uuid.UUID, renditions []*imaging.Rendition) (*dto.PlaylistDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	playlistModel, err := u.playlistRepo.GetPlaylist(ctx, playlistID)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find playlist %d: %v", playlistID, err), requestID)
+		return nil, playlist.ErrPlaylistNotFound
+	}
+	if playlistModel.OwnerID != userID {
+		u.logger.Warn(fmt.Sprintf("User %v can't change cover of playlist %d", userID, playlistID), requestID)
+		return nil, playlist.ErrNotOwner
+	}
+
+	base := uuid.New().String()
+	keys, err := u.uploadRenditions(ctx, base, renditions)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't save cover of playlist %d: %v", playlistID, err), requestID)
+		u.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't save cover of playlist %d", playlistID)
+	}
+
+	image := imaging.Largest(base)
+	previousImage, err := u.playlistRepo.UpdateImage(ctx, playlistID, image)
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't update cover of playlist %d: %v", playlistID, err), requestID)
+		u.removeKeys(ctx, keys)
+		return nil, fmt.Errorf("Can't update cover of playlist %d", playlistID)
+	}
+	u.removeKeys(ctx, imaging.Keys(previousImage))
+
+	playlistModel.Image = image
+	playlistDTO := dto.NewPlaylistToPlaylistDTO(playlistModel)
+	owner, err := u.userClient.FindByID(ctx, &userService.FindByIDRequest{Uuid: playlistModel.OwnerID.String()})
+	if err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't find owner of playlist %d: %v", playlistID, err), requestID)
+	} else {
+		playlistDTO.OwnerName = owner.User.Username
+	}
+
+	return playlistDTO, nil
+}
+
 func (u *PlaylistUsecase) DeletePlaylist(ctx context.Context, playlistID uint64) error {
-	_, err := u.playlistRepo.DeletePlaylist(ctx, playlistID)
+	result, err := u.playlistRepo.DeletePlaylist(ctx, playlistID)
 	if err != nil {
 		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
 		return err
 	}
+	if trashed, err := result.RowsAffected(); err == nil && trashed == 0 {
+		return playlist.ErrPlaylistNotFound
+	}
 	return nil
 }
 
@@ -150,6 +317,10 @@ func (u *PlaylistUsecase) AddFavoritePlaylist(ctx context.Context, userID uuid.U
 		return fmt.Errorf("Can't add playlist %d to favorite for user %v: %v", playlistID, userID, err)
 	}
 
+	if err := u.notificationProducer.PlaylistFavorited(ctx, playlistID, userID); err != nil {
+		u.logger.Warn(fmt.Sprintf("Can't notify owner of playlist %d: %v", playlistID, err), requestID)
+	}
+
 	return nil
 }
 
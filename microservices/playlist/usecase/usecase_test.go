@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,9 +12,13 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	mockNotification "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/notification/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/playlist/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/playlistfile"
 	userService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/user"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -38,7 +43,7 @@ func TestPlaylistUsecaseCreatePlaylist_Success(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ownerId := uuid.New()
 
@@ -47,7 +52,6 @@ func TestPlaylistUsecaseCreatePlaylist_Success(t *testing.T) {
 			Uuid:     ownerId.String(),
 			Username: "user",
 			Email:    "email@example.com",
-			Password: "new_password",
 		},
 	}
 
@@ -96,7 +100,7 @@ func TestPlaylistUsecaseGetPlaylist_Success(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	playlistID := uint64(1)
 	ownerID := uuid.New()
@@ -146,7 +150,7 @@ func TestPlaylistUsecaseGetPlaylist_NotFound(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	playlistID := uint64(1)
 	ctx := context.Background()
@@ -176,7 +180,7 @@ func TestPlaylistUsecaseGetAllPlaylists_Success(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ownerID := uuid.New()
 	playlists := []*models.Playlist{
@@ -202,6 +206,49 @@ func TestPlaylistUsecaseGetAllPlaylists_Success(t *testing.T) {
 	require.Equal(t, playlists[0].Name, dtoPlaylists[0].Name)
 }
 
+func TestPlaylistUsecaseGetPlaylists_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	userClientMock := mock.NewMockUserServiceClient(ctrl)
+	playlistRepoMock := mock.NewMockRepository(ctrl)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
+
+	ownerID := uuid.New()
+	playlists := []*models.Playlist{
+		{ID: 2, Name: "workout beats", Image: "/images/playlist_2.jpg", OwnerID: ownerID, IsPrivate: false},
+		{ID: 1, Name: "chill vibes", Image: "/images/playlist_1.jpg", OwnerID: ownerID, IsPrivate: false},
+	}
+
+	findByIDResponseUser := &userService.FindByIDResponse{
+		User: &userService.User{
+			Uuid:     ownerID.String(),
+			Username: "user2",
+		},
+	}
+
+	ctx := context.Background()
+	playlistRepoMock.EXPECT().GetPlaylistsByIDs(ctx, []uint64{2, 1, 5}).Return(playlists, nil)
+	userClientMock.EXPECT().FindByID(ctx, &userService.FindByIDRequest{Uuid: ownerID.String()}).Return(findByIDResponseUser, nil).Times(1)
+
+	dtoPlaylists, err := playlistUsecase.GetPlaylists(ctx, []uint64{2, 1, 5})
+
+	require.NoError(t, err)
+	require.Len(t, dtoPlaylists, len(playlists))
+	require.Equal(t, uint64(2), dtoPlaylists[0].Id)
+	require.Equal(t, "user2", dtoPlaylists[1].OwnerName)
+}
+
 func TestPlaylistUsecaseGetAllPlaylists_ConnDone(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -218,7 +265,7 @@ func TestPlaylistUsecaseGetAllPlaylists_ConnDone(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ctx := context.Background()
 	playlistRepoMock.EXPECT().GetAllPlaylists(ctx).Return(nil, sql.ErrConnDone)
@@ -244,7 +291,7 @@ func TestPlaylistUsecaseAddToPlaylist_Success(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 	trackID := uint64(42)
@@ -258,12 +305,15 @@ func TestPlaylistUsecaseAddToPlaylist_Success(t *testing.T) {
 	}
 
 	playlistRepoMock.EXPECT().GetLengthPlaylist(context.Background(), playlistID).Return(length, nil)
-	playlistRepoMock.EXPECT().AddToPlaylist(context.Background(), playlistID, length+1, trackID).Return(mockPlaylistTrack, nil)
+	playlistRepoMock.EXPECT().AddToPlaylist(context.Background(), playlistID, length+1, trackID, uuid.Nil).Return(mockPlaylistTrack, nil)
+	playlistRepoMock.EXPECT().GetMosaicCovers(gomock.Any(), playlistID, mosaicTiles).Return(nil, nil)
+	playlistRepoMock.EXPECT().UpdateMosaic(gomock.Any(), playlistID, defaultImage).Return(defaultImage, nil)
 
 	result, err := playlistUsecase.AddToPlaylist(context.Background(), &dto.PlaylistTrackDTO{
 		PlaylistID: playlistID,
 		TrackID:    trackID,
 	})
+	playlistUsecase.(*PlaylistUsecase).mosaics.wait()
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
@@ -285,7 +335,7 @@ func TestPlaylistUsecaseAddToPlaylist_NotFound(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 	trackID := uint64(42)
@@ -317,7 +367,7 @@ func TestPlaylistUsecaseGetUserPlaylists_Success(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	userID := uuid.New()
 	playlists := []*models.Playlist{
@@ -359,7 +409,7 @@ func TestPlaylistUsecaseGetUserPlaylists_ConnDone(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	userID := uuid.New()
 	ctx := context.Background()
@@ -387,18 +437,21 @@ func TestPlaylistUsecaseRemoveFromPlaylist_Success(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 	trackID := uint64(42)
 
 	ctx := context.Background()
-	playlistRepoMock.EXPECT().RemoveFromPlaylist(ctx, playlistID, trackID).Return(sqlmock.NewResult(1, 1), nil)
+	playlistRepoMock.EXPECT().RemoveFromPlaylist(ctx, playlistID, trackID, uuid.Nil).Return(int64(1), nil)
+	playlistRepoMock.EXPECT().GetMosaicCovers(gomock.Any(), playlistID, mosaicTiles).Return(nil, nil)
+	playlistRepoMock.EXPECT().UpdateMosaic(gomock.Any(), playlistID, defaultImage).Return(defaultImage, nil)
 
 	err := playlistUsecase.RemoveFromPlaylist(ctx, &dto.PlaylistTrackDTO{
 		PlaylistID: playlistID,
 		TrackID:    trackID,
 	})
+	playlistUsecase.(*PlaylistUsecase).mosaics.wait()
 
 	require.NoError(t, err)
 }
@@ -418,20 +471,20 @@ func TestPlaylistUsecaseRemoveFromPlaylist_NotFound(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 	trackID := uint64(42)
 
 	ctx := context.Background()
-	playlistRepoMock.EXPECT().RemoveFromPlaylist(ctx, playlistID, trackID).Return(nil, sql.ErrNoRows)
+	playlistRepoMock.EXPECT().RemoveFromPlaylist(ctx, playlistID, trackID, uuid.Nil).Return(int64(0), playlist.ErrPlaylistNotFound)
 
 	err := playlistUsecase.RemoveFromPlaylist(ctx, &dto.PlaylistTrackDTO{
 		PlaylistID: playlistID,
 		TrackID:    trackID,
 	})
 
-	require.Error(t, err)
+	require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
 }
 
 func TestPlaylistUsecaseDeletePlaylist_Success(t *testing.T) {
@@ -449,7 +502,7 @@ func TestPlaylistUsecaseDeletePlaylist_Success(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 
@@ -476,7 +529,7 @@ func TestPlaylistUsecaseDeletePlaylist_ConnDone(t *testing.T) {
 
 	logger := logger.New(&cfg.Service.Logger)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, nil, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
 
 	playlistID := uint64(1)
 
@@ -504,11 +557,13 @@ func TestPlaylistUsecase_AddFavoritePlaylist(t *testing.T) {
 	}
 
 	mockPlaylistRepo := mock.NewMockRepository(ctrl)
+	mockProducer := mockNotification.NewMockProducer(ctrl)
 	logger := logger.New(&cfg.Service.Logger)
 
 	playlistUsecase := &PlaylistUsecase{
-		playlistRepo: mockPlaylistRepo,
-		logger:       logger,
+		playlistRepo:         mockPlaylistRepo,
+		notificationProducer: mockProducer,
+		logger:               logger,
 	}
 
 	userID := uuid.New()
@@ -518,6 +573,14 @@ func TestPlaylistUsecase_AddFavoritePlaylist(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockPlaylistRepo.EXPECT().AddFavoritePlaylist(ctx, userID, playlistID).Return(nil)
+		mockProducer.EXPECT().PlaylistFavorited(ctx, playlistID, userID).Return(nil)
+		err := playlistUsecase.AddFavoritePlaylist(ctx, userID, playlistID)
+		require.NoError(t, err)
+	})
+
+	t.Run("notification error is not fatal", func(t *testing.T) {
+		mockPlaylistRepo.EXPECT().AddFavoritePlaylist(ctx, userID, playlistID).Return(nil)
+		mockProducer.EXPECT().PlaylistFavorited(ctx, playlistID, userID).Return(fmt.Errorf("producer error"))
 		err := playlistUsecase.AddFavoritePlaylist(ctx, userID, playlistID)
 		require.NoError(t, err)
 	})
@@ -740,7 +803,7 @@ func TestPlaylistUsecaseGetPopularPlaylists_Success(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ownerID := uuid.New()
 	mockPlaylists := []*models.Playlist{
@@ -800,7 +863,7 @@ func TestPlaylistUsecaseGetPopularPlaylists_RepoError(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ctx := context.Background()
 	mockError := fmt.Errorf("repository error")
@@ -828,7 +891,7 @@ func TestPlaylistUsecaseGetPopularPlaylists_UserClientError(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	userClientMock := mock.NewMockUserServiceClient(ctrl)
 	playlistRepoMock := mock.NewMockRepository(ctrl)
-	playlistUsecase := NewPlaylistUsecase(playlistRepoMock, userClientMock, logger)
+	playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
 
 	ownerID := uuid.New()
 	mockPlaylists := []*models.Playlist{
@@ -852,3 +915,633 @@ func TestPlaylistUsecaseGetPopularPlaylists_UserClientError(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, playlistsDTO)
 }
+
+func TestPlaylistUsecase_UploadImage(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+
+	ownerID := uuid.New()
+	playlistID := uint64(1)
+	renditions := []*imaging.Rendition{
+		{Size: 640, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+		{Size: 640, Format: imaging.FormatWebP, Data: []byte("webp")},
+	}
+	previousImage := imaging.Largest("5f0e1cda-3b4e-4b7a-9d51-0c8f1c3e2a10")
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		userClientMock := mock.NewMockUserServiceClient(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, s3RepoMock, userClientMock, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, playlistID).Return(&models.Playlist{ID: playlistID, OwnerID: ownerID, Image: previousImage}, nil)
+		s3RepoMock.EXPECT().Put(ctx, gomock.Any()).Return(nil, nil).Times(len(renditions))
+		playlistRepoMock.EXPECT().UpdateImage(ctx, playlistID, gomock.Any()).Return(previousImage, nil)
+		for _, key := range imaging.Keys(previousImage) {
+			s3RepoMock.EXPECT().Remove(ctx, coversBucket, key).Return(nil)
+		}
+		userClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(&userService.FindByIDResponse{User: &userService.User{Username: "owner"}}, nil)
+
+		playlistDTO, err := playlistUsecase.UploadImage(ctx, playlistID, ownerID, renditions)
+		require.NoError(t, err)
+		require.Equal(t, "owner", playlistDTO.OwnerName)
+		require.NotEqual(t, previousImage, playlistDTO.Image)
+		require.Len(t, imaging.Variants(playlistDTO.Image), len(imaging.Sizes))
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, playlistID).Return(&models.Playlist{ID: playlistID, OwnerID: ownerID}, nil)
+
+		_, err := playlistUsecase.UploadImage(ctx, playlistID, uuid.New(), renditions)
+		require.ErrorIs(t, err, playlist.ErrNotOwner)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, playlistID).Return(nil, sql.ErrNoRows)
+
+		_, err := playlistUsecase.UploadImage(ctx, playlistID, ownerID, renditions)
+		require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	})
+
+	t.Run("update failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, s3RepoMock, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, playlistID).Return(&models.Playlist{ID: playlistID, OwnerID: ownerID}, nil)
+		s3RepoMock.EXPECT().Put(ctx, gomock.Any()).Return(nil, nil).Times(len(renditions))
+		playlistRepoMock.EXPECT().UpdateImage(ctx, playlistID, gomock.Any()).Return("", sql.ErrConnDone)
+		s3RepoMock.EXPECT().Remove(ctx, coversBucket, gomock.Any()).Return(nil).Times(len(renditions))
+
+		_, err := playlistUsecase.UploadImage(ctx, playlistID, ownerID, renditions)
+		require.Error(t, err)
+	})
+}
+
+func TestPlaylistUsecase_GenerateMosaic(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+	playlistID := uint64(1)
+	previousImage := imaging.Largest("5f0e1cda-3b4e-4b7a-9d51-0c8f1c3e2a10")
+
+	t.Run("unavailable covers reset to default", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, s3RepoMock, nil, nil, logger).(*PlaylistUsecase)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetMosaicCovers(ctx, playlistID, mosaicTiles).Return([]string{"misery.webp"}, nil)
+		s3RepoMock.EXPECT().Get(ctx, albumCoversBucket, "misery.webp").Return(nil, fmt.Errorf("no such key"))
+		playlistRepoMock.EXPECT().UpdateMosaic(ctx, playlistID, defaultImage).Return(previousImage, nil)
+		s3RepoMock.EXPECT().Remove(ctx, coversBucket, gomock.Any()).Return(nil).Times(2 * len(imaging.Sizes))
+
+		playlistUsecase.generateMosaic(ctx, playlistID)
+	})
+
+	t.Run("custom cover isn't rendered", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger).(*PlaylistUsecase)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetMosaicCovers(ctx, playlistID, mosaicTiles).Return(nil, sql.ErrNoRows)
+
+		playlistUsecase.generateMosaic(ctx, playlistID)
+	})
+
+	t.Run("custom cover uploaded meanwhile is kept", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger).(*PlaylistUsecase)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetMosaicCovers(ctx, playlistID, mosaicTiles).Return(nil, nil)
+		playlistRepoMock.EXPECT().UpdateMosaic(ctx, playlistID, defaultImage).Return("", sql.ErrNoRows)
+
+		playlistUsecase.generateMosaic(ctx, playlistID)
+	})
+}
+
+func TestMosaicScheduler(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	runs := map[uint64]int{}
+	release := make(chan struct{})
+	scheduler := newMosaicScheduler(func(ctx context.Context, playlistID uint64) {
+		<-release
+		mu.Lock()
+		runs[playlistID]++
+		mu.Unlock()
+	})
+
+	// Changes made while a cover is generated are coalesced into one more run.
+	for i := 0; i < 5; i++ {
+		scheduler.schedule(1)
+	}
+	scheduler.schedule(2)
+	close(release)
+	scheduler.wait()
+
+	require.Equal(t, map[uint64]int{1: 2, 2: 1}, runs)
+	require.Empty(t, scheduler.pending)
+}
+
+func TestPlaylistUsecase_ExportPlaylist(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+		Minio: config.MinioConfig{PublicURL: "https://nova-music.ru/storage/"},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+	ownerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, Name: "Road trip", OwnerID: ownerID}, nil)
+		playlistRepoMock.EXPECT().GetPlaylistEntries(ctx, uint64(1)).Return([]*models.PlaylistEntry{
+			{TrackID: 7, Name: "im so happy", Artist: "EKKSTACY", Album: "misery", Duration: 139, FilePath: "ekkstacy misery 2.mp3"},
+			{TrackID: 8, Name: "no file", Artist: "EKKSTACY", Album: "misery"},
+		}, nil)
+
+		file, err := playlistUsecase.ExportPlaylist(ctx, 1, uuid.New())
+		require.NoError(t, err)
+		require.Equal(t, &playlistfile.Playlist{
+			Title: "Road trip",
+			Entries: []*playlistfile.Entry{
+				{Title: "im so happy", Artist: "EKKSTACY", Album: "misery", Duration: 139, URL: "https://nova-music.ru/storage/tracks/ekkstacy%20misery%202.mp3"},
+				{Title: "no file", Artist: "EKKSTACY", Album: "misery"},
+			},
+		}, file)
+	})
+
+	t.Run("private playlist of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID, IsPrivate: true}, nil)
+
+		_, err := playlistUsecase.ExportPlaylist(ctx, 1, uuid.New())
+		require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	})
+}
+
+func TestPlaylistUsecase_ImportPlaylist(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+	userID := uuid.New()
+	file := &playlistfile.Playlist{
+		Title: "A very long title of an imported playlist",
+		Entries: []*playlistfile.Entry{
+			{Title: "Im So Happy (Remastered)", Artist: "Ekkstacy", Duration: 140},
+			{Title: "Unknown song", Artist: "Nobody", Duration: 200},
+			{Title: "im so happy", Artist: "EKKSTACY"},
+			{Title: "Intro", Duration: 60},
+		},
+	}
+	catalog := []*models.PlaylistEntry{
+		{TrackID: 2, Name: "im so happy", Artist: "EKKSTACY", Duration: 139},
+		{TrackID: 5, Name: "intro", Artist: "The xx", Duration: 127},
+		{TrackID: 9, Name: "Intro", Artist: "EKKSTACY", Duration: 61},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		userClientMock := mock.NewMockUserServiceClient(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().FindCatalogEntries(ctx,
+			[]string{"im so happy", "intro", "unknown song"},
+			[]string{"ekkstacy", "nobody"},
+			maxCatalogCandidates,
+		).Return(catalog, nil)
+		created := &models.Playlist{ID: 3, Name: "A very long title of an importe", Image: defaultImage, OwnerID: userID}
+		playlistRepoMock.EXPECT().CreatePlaylistWithTracks(ctx, &models.Playlist{
+			Name:    "A very long title of an importe",
+			Image:   defaultImage,
+			OwnerID: userID,
+		}, []uint64{2, 9}).Return(created, nil)
+		userClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(&userService.FindByIDResponse{User: &userService.User{Username: "owner"}}, nil)
+		playlistRepoMock.EXPECT().GetMosaicCovers(gomock.Any(), created.ID, mosaicTiles).Return(nil, nil)
+		playlistRepoMock.EXPECT().UpdateMosaic(gomock.Any(), created.ID, defaultImage).Return(defaultImage, nil)
+
+		report, err := playlistUsecase.ImportPlaylist(ctx, userID, " ", file)
+		playlistUsecase.(*PlaylistUsecase).mosaics.wait()
+		require.NoError(t, err)
+		require.Equal(t, 3, report.Matched)
+		require.Equal(t, "owner", report.Playlist.OwnerName)
+		require.Equal(t, []*dto.UnmatchedEntryDTO{{Position: 2, Title: "Unknown song", Artist: "Nobody", Duration: 200}}, report.Unmatched)
+	})
+
+	t.Run("name taken", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().FindCatalogEntries(ctx, gomock.Any(), gomock.Any(), maxCatalogCandidates).Return(nil, nil)
+		playlistRepoMock.EXPECT().CreatePlaylistWithTracks(ctx, gomock.Any(), nil).Return(nil, playlist.ErrNameTaken)
+
+		_, err := playlistUsecase.ImportPlaylist(ctx, userID, "Taken", file)
+		require.ErrorIs(t, err, playlist.ErrNameTaken)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, nil, nil, nil, nil, logger)
+
+		_, err := playlistUsecase.ImportPlaylist(context.Background(), userID, "", &playlistfile.Playlist{})
+		require.ErrorIs(t, err, playlist.ErrEmptyImport)
+	})
+}
+
+func TestPlaylistUsecase_Trash(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{Level: "info", Format: "json"},
+			Trash:  config.TrashConfig{Retention: 3600},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+	userID := uuid.New()
+
+	t.Run("delete missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().DeletePlaylist(ctx, uint64(1)).Return(sqlmock.NewResult(0, 0), nil)
+
+		err := playlistUsecase.DeletePlaylist(ctx, 1)
+		require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	})
+
+	t.Run("get trash", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		deletedAt := time.Now()
+		playlistRepoMock.EXPECT().GetTrashedPlaylists(ctx, userID, time.Hour).
+			Return([]*models.Playlist{{ID: 1, Name: "Road trip", OwnerID: userID, DeletedAt: &deletedAt}}, nil)
+
+		trash, err := playlistUsecase.GetTrash(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, trash, 1)
+		require.Equal(t, "Road trip", trash[0].Name)
+		require.Equal(t, deletedAt.Add(time.Hour), trash[0].PurgeAt)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		userClientMock := mock.NewMockUserServiceClient(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, &cfg.Service.Trash, playlistRepoMock, nil, userClientMock, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().RestorePlaylist(ctx, uint64(1), userID, time.Hour).
+			Return(&models.Playlist{ID: 1, Name: "Road trip", OwnerID: userID}, nil)
+		userClientMock.EXPECT().FindByID(ctx, &userService.FindByIDRequest{Uuid: userID.String()}).
+			Return(&userService.FindByIDResponse{User: &userService.User{Username: "owner"}}, nil)
+
+		playlistDTO, err := playlistUsecase.RestorePlaylist(ctx, 1, userID)
+		require.NoError(t, err)
+		require.Equal(t, "owner", playlistDTO.OwnerName)
+
+		playlistRepoMock.EXPECT().RestorePlaylist(ctx, uint64(2), userID, time.Hour).Return(nil, sql.ErrNoRows)
+		_, err = playlistUsecase.RestorePlaylist(ctx, 2, userID)
+		require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, s3RepoMock, nil, nil, logger)
+
+		ctx := context.Background()
+		cover := imaging.Largest("5f0e1cda-3b4e-4b7a-9d51-0c8f1c3e2a10")
+		playlistRepoMock.EXPECT().PurgePlaylists(ctx, defaultRetention).Return([]string{defaultImage, cover}, nil)
+		for _, key := range imaging.Keys(cover) {
+			s3RepoMock.EXPECT().Remove(ctx, coversBucket, key).Return(nil)
+		}
+
+		require.NoError(t, playlistUsecase.PurgeTrash(ctx))
+	})
+}
+
+func TestPlaylistUsecase_Versions(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{Level: "info", Format: "json"},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+	ownerID := uuid.New()
+	createdAt := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("get versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil)
+		playlistRepoMock.EXPECT().GetVersions(ctx, uint64(1), uint64(0), uint64(21)).Return([]*models.PlaylistVersion{
+			{PlaylistID: 1, Version: 2, AuthorID: ownerID, Change: models.PlaylistChangeAdd, Name: "Road trip", TrackIDs: []uint64{4, 5, 6}, CreatedAt: createdAt},
+			{PlaylistID: 1, Version: 1, Change: models.PlaylistChangeCreate, Name: "Road trip", TrackIDs: []uint64{4, 5}, CreatedAt: createdAt},
+		}, nil)
+
+		page, err := playlistUsecase.GetVersions(ctx, 1, uuid.New(), 0, 20)
+		require.NoError(t, err)
+		require.Zero(t, page.NextCursor)
+		versions := page.Versions
+		require.Len(t, versions, 2)
+		require.Equal(t, &ownerID, versions[0].AuthorID)
+		require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 6, Position: 3}}, versions[0].Added)
+		require.Empty(t, versions[0].Removed)
+		require.Nil(t, versions[1].AuthorID)
+		require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 4, Position: 1}, {TrackID: 5, Position: 2}}, versions[1].Added)
+	})
+
+	t.Run("get page of versions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil)
+		playlistRepoMock.EXPECT().GetVersions(ctx, uint64(1), uint64(5), uint64(2)).Return([]*models.PlaylistVersion{
+			{PlaylistID: 1, Version: 4, Change: models.PlaylistChangeRemove, Name: "Road trip", TrackIDs: []uint64{4}, CreatedAt: createdAt},
+			{PlaylistID: 1, Version: 3, Change: models.PlaylistChangeAdd, Name: "Road trip", TrackIDs: []uint64{4, 5}, CreatedAt: createdAt},
+		}, nil)
+
+		page, err := playlistUsecase.GetVersions(ctx, 1, uuid.New(), 5, 1)
+		require.NoError(t, err)
+		require.Len(t, page.Versions, 1)
+		require.Equal(t, uint64(4), page.NextCursor)
+		// the version past the page is only used for the changes
+		require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 5, Position: 2}}, page.Versions[0].Removed)
+		require.Empty(t, page.Versions[0].Added)
+	})
+
+	t.Run("private playlist", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID, IsPrivate: true}, nil)
+
+		_, err := playlistUsecase.GetVersions(ctx, 1, uuid.New(), 0, 20)
+		require.ErrorIs(t, err, playlist.ErrPlaylistNotFound)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil)
+		playlistRepoMock.EXPECT().RevertPlaylist(ctx, uint64(1), uint64(1), ownerID).Return(&models.PlaylistVersion{
+			PlaylistID: 1, Version: 3, AuthorID: ownerID, Change: models.PlaylistChangeRevert, RevertedTo: 1,
+			Name: "Road trip", TrackIDs: []uint64{4, 5}, CreatedAt: createdAt,
+		}, nil)
+		playlistRepoMock.EXPECT().GetVersion(ctx, uint64(1), uint64(2)).Return(&models.PlaylistVersion{
+			PlaylistID: 1, Version: 2, Name: "Trip", TrackIDs: []uint64{5, 4, 6}, CreatedAt: createdAt,
+		}, nil)
+		playlistRepoMock.EXPECT().GetMosaicCovers(gomock.Any(), uint64(1), mosaicTiles).Return(nil, nil)
+		playlistRepoMock.EXPECT().UpdateMosaic(gomock.Any(), uint64(1), defaultImage).Return(defaultImage, nil)
+
+		versionDTO, err := playlistUsecase.RevertPlaylist(ctx, 1, 1, ownerID)
+		playlistUsecase.(*PlaylistUsecase).mosaics.wait()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), versionDTO.RevertedTo)
+		require.Equal(t, "Trip", versionDTO.PreviousName)
+		require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 6, Position: 3}}, versionDTO.Removed)
+		require.Equal(t, []*dto.TrackMoveDTO{{TrackID: 5, From: 1, To: 2}}, versionDTO.Moved)
+	})
+
+	t.Run("revert errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(&cfg.Minio, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil).Times(3)
+
+		_, err := playlistUsecase.RevertPlaylist(ctx, 1, 1, uuid.New())
+		require.ErrorIs(t, err, playlist.ErrNotOwner)
+
+		playlistRepoMock.EXPECT().RevertPlaylist(ctx, uint64(1), uint64(7), ownerID).Return(nil, sql.ErrNoRows)
+		_, err = playlistUsecase.RevertPlaylist(ctx, 1, 7, ownerID)
+		require.ErrorIs(t, err, playlist.ErrVersionNotFound)
+
+		playlistRepoMock.EXPECT().RevertPlaylist(ctx, uint64(1), uint64(1), ownerID).Return(nil, playlist.ErrNameTaken)
+		_, err = playlistUsecase.RevertPlaylist(ctx, 1, 1, ownerID)
+		require.ErrorIs(t, err, playlist.ErrNameTaken)
+	})
+}
+
+func TestPlaylistUsecase_RenameAndMove(t *testing.T) {
+	t.Parallel()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	ownerID := uuid.New()
+
+	t.Run("rename", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		userClientMock := mock.NewMockUserServiceClient(ctrl)
+		playlistUsecase := NewPlaylistUsecase(nil, nil, playlistRepoMock, nil, userClientMock, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, Name: "Road trip", OwnerID: ownerID}, nil)
+		playlistRepoMock.EXPECT().RenamePlaylist(ctx, uint64(1), "Night drive", ownerID).
+			Return(&models.Playlist{ID: 1, Name: "Night drive", OwnerID: ownerID}, nil)
+		userClientMock.EXPECT().FindByID(ctx, &userService.FindByIDRequest{Uuid: ownerID.String()}).
+			Return(&userService.FindByIDResponse{User: &userService.User{Username: "quinn"}}, nil)
+
+		playlistDTO, err := playlistUsecase.RenamePlaylist(ctx, 1, "Night drive", ownerID)
+		require.NoError(t, err)
+		require.Equal(t, "Night drive", playlistDTO.Name)
+		require.Equal(t, "quinn", playlistDTO.OwnerName)
+	})
+
+	t.Run("rename errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(nil, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil).Times(2)
+
+		_, err := playlistUsecase.RenamePlaylist(ctx, 1, "Night drive", uuid.New())
+		require.ErrorIs(t, err, playlist.ErrNotOwner)
+
+		playlistRepoMock.EXPECT().RenamePlaylist(ctx, uint64(1), "Taken", ownerID).Return(nil, playlist.ErrNameTaken)
+		_, err = playlistUsecase.RenamePlaylist(ctx, 1, "Taken", ownerID)
+		require.ErrorIs(t, err, playlist.ErrNameTaken)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		playlistRepoMock := mock.NewMockRepository(ctrl)
+		playlistUsecase := NewPlaylistUsecase(nil, nil, playlistRepoMock, nil, nil, nil, logger)
+
+		ctx := context.Background()
+		playlistRepoMock.EXPECT().GetPlaylist(ctx, uint64(1)).Return(&models.Playlist{ID: 1, OwnerID: ownerID}, nil).Times(3)
+		playlistRepoMock.EXPECT().MoveTrack(ctx, uint64(1), uint64(6), uint64(1), ownerID).Return(nil)
+		// the first track may change, so the mosaic is generated again
+		playlistRepoMock.EXPECT().GetMosaicCovers(gomock.Any(), uint64(1), mosaicTiles).Return(nil, nil)
+		playlistRepoMock.EXPECT().UpdateMosaic(gomock.Any(), uint64(1), defaultImage).Return(defaultImage, nil)
+
+		err := playlistUsecase.MoveTrack(ctx, 1, 6, 1, ownerID)
+		playlistUsecase.(*PlaylistUsecase).mosaics.wait()
+		require.NoError(t, err)
+
+		err = playlistUsecase.MoveTrack(ctx, 1, 6, 1, uuid.New())
+		require.ErrorIs(t, err, playlist.ErrNotOwner)
+
+		playlistRepoMock.EXPECT().MoveTrack(ctx, uint64(1), uint64(9), uint64(1), ownerID).Return(playlist.ErrTrackNotFound)
+		err = playlistUsecase.MoveTrack(ctx, 1, 9, 1, ownerID)
+		require.ErrorIs(t, err, playlist.ErrTrackNotFound)
+	})
+}
+
+func TestDiffTracks(t *testing.T) {
+	t.Parallel()
+
+	versionDTO := &dto.PlaylistVersionDTO{}
+	// 1 is removed, 9 is added, 5 moves to the front, the others only shift
+	diffTracks(versionDTO, []uint64{1, 2, 3, 4, 5}, []uint64{5, 2, 3, 9, 4})
+
+	require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 1, Position: 1}}, versionDTO.Removed)
+	require.Equal(t, []*dto.TrackChangeDTO{{TrackID: 9, Position: 4}}, versionDTO.Added)
+	require.Equal(t, []*dto.TrackMoveDTO{{TrackID: 5, From: 5, To: 1}}, versionDTO.Moved)
+
+	versionDTO = &dto.PlaylistVersionDTO{}
+	diffTracks(versionDTO, []uint64{1, 2, 3}, []uint64{1, 2, 3})
+	require.Empty(t, versionDTO.Added)
+	require.Empty(t, versionDTO.Removed)
+	require.Empty(t, versionDTO.Moved)
+}
+
+func TestLookupKeys(t *testing.T) {
+	t.Parallel()
+
+	names, artists := lookupKeys([]*playlistfile.Entry{
+		{Title: "Don't Stop (Remastered)", Artist: "Simon & Garfunkel"},
+		{Title: "Intro"},
+	})
+	require.Equal(t, []string{"dont stop", "intro"}, names)
+	require.Equal(t, []string{"garfunkel", "simon", "simon garfunkel"}, artists)
+}
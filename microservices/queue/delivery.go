@@ -0,0 +1,15 @@
+package queue
+
+import "net/http"
+
+type Handlers interface {
+	GetQueue(response http.ResponseWriter, request *http.Request)
+	SetQueue(response http.ResponseWriter, request *http.Request)
+	AddNext(response http.ResponseWriter, request *http.Request)
+	Remove(response http.ResponseWriter, request *http.Request)
+	SetShuffle(response http.ResponseWriter, request *http.Request)
+	SetRepeat(response http.ResponseWriter, request *http.Request)
+	UpdatePlayback(response http.ResponseWriter, request *http.Request)
+	TakeControl(response http.ResponseWriter, request *http.Request)
+	Socket(response http.ResponseWriter, request *http.Request)
+}
@@ -0,0 +1,482 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/mailru/easyjson"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = pongWait * 9 / 10
+	maxMessageSize = 4096
+	maxDeviceID    = 64
+)
+
+type queueHandlers struct {
+	usecase  queue.Usecase
+	upgrader websocket.Upgrader
+	logger   logger.Logger
+}
+
+func NewQueueHandlers(usecase queue.Usecase, allowOrigin string, logger logger.Logger) queue.Handlers {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin: func(request *http.Request) bool {
+			origin := request.Header.Get("Origin")
+			return origin == "" || allowOrigin == "*" || origin == allowOrigin
+		},
+	}
+
+	return &queueHandlers{usecase, upgrader, logger}
+}
+
+// GetQueue godoc
+// @Summary Get play queue
+// @Description Returns the play queue of the authenticated user, empty if it was never set.
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "User id not found"
+// @Failure 500 {object} utils.ErrorResponse "Can't load queue"
+// @Router /api/v1/queue [get]
+func (handlers *queueHandlers) GetQueue(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	foundQueue, err := handlers.usecase.GetQueue(request.Context(), userID)
+	handlers.respond(response, requestID, foundQueue, err)
+}
+
+// SetQueue godoc
+// @Summary Set play queue
+// @Description Replaces the play queue with the tracks of an album, a playlist, a radio started from a track or an explicit list of tracks. The device setting the queue takes control of playback.
+// @Param queue body dto.SetQueueDTO true "Queue source"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Invalid queue source"
+// @Failure 404 {object} utils.ErrorResponse "Track not found"
+// @Failure 409 {object} utils.ErrorResponse "Queue was changed concurrently"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue [put]
+func (handlers *queueHandlers) SetQueue(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	setQueueDTO := &dto.SetQueueDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, setQueueDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !validDeviceID(setQueueDTO.DeviceID) {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid device ID")
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.SetQueue(request.Context(), userID, setQueueDTO)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// AddNext godoc
+// @Summary Play track next
+// @Description Inserts a track right after the current one.
+// @Param track body dto.AddTrackDTO true "Track to add"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Queue is full"
+// @Failure 404 {object} utils.ErrorResponse "Track not found"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/next [post]
+func (handlers *queueHandlers) AddNext(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	addTrackDTO := &dto.AddTrackDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, addTrackDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.AddNext(request.Context(), userID, addTrackDTO.TrackID)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// Remove godoc
+// @Summary Remove track from queue
+// @Description Removes the track at the given position of the queue.
+// @Param index path int true "Position in queue"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Index is out of queue"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/tracks/{index} [delete]
+func (handlers *queueHandlers) Remove(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	index, err := strconv.ParseUint(vars["index"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Invalid queue index: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, fmt.Sprintf("Invalid queue index: %v", err))
+		return
+	}
+
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.Remove(request.Context(), userID, index)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// SetShuffle godoc
+// @Summary Toggle shuffle
+// @Description Shuffles the tracks after the current one or restores their original order.
+// @Param shuffle body dto.ShuffleDTO true "Shuffle mode"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/shuffle [put]
+func (handlers *queueHandlers) SetShuffle(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	shuffleDTO := &dto.ShuffleDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, shuffleDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.SetShuffle(request.Context(), userID, shuffleDTO.Enabled)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// SetRepeat godoc
+// @Summary Set repeat mode
+// @Description Sets the repeat mode of the queue: "off", "all" or "one".
+// @Param repeat body dto.RepeatDTO true "Repeat mode"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Unknown repeat mode"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/repeat [put]
+func (handlers *queueHandlers) SetRepeat(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	repeatDTO := &dto.RepeatDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, repeatDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.SetRepeat(request.Context(), userID, repeatDTO.Mode)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// UpdatePlayback godoc
+// @Summary Update playback
+// @Description Stores the current index, position and play state reported by the device in control of playback.
+// @Param playback body dto.PlaybackDTO true "Playback state"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Index is out of queue"
+// @Failure 409 {object} utils.ErrorResponse "Device doesn't control playback"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/playback [put]
+func (handlers *queueHandlers) UpdatePlayback(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	playbackDTO := &dto.PlaybackDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, playbackDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !validDeviceID(playbackDTO.DeviceID) {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid device ID")
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.UpdatePlayback(request.Context(), userID, playbackDTO)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// TakeControl godoc
+// @Summary Take control of playback
+// @Description Makes the device the one playing the queue, the other devices are told to stop.
+// @Param control body dto.ControlDTO true "Device"
+// @Success 200 {object} dto.QueueDTO "Play queue"
+// @Failure 400 {object} utils.ErrorResponse "Invalid device ID"
+// @Failure 500 {object} utils.ErrorResponse "Can't save queue"
+// @Router /api/v1/queue/control [post]
+func (handlers *queueHandlers) TakeControl(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	controlDTO := &dto.ControlDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, controlDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !validDeviceID(controlDTO.DeviceID) {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid device ID")
+		return
+	}
+
+	updatedQueue, err := handlers.usecase.TakeControl(request.Context(), userID, controlDTO.DeviceID)
+	handlers.respond(response, requestID, updatedQueue, err)
+}
+
+// Socket godoc
+// @Summary Sync queue between devices
+// @Description Upgrades to a WebSocket. The server sends a "state" message with the queue on connect and on every change. The device may send "playback" messages while it controls playback and a "control" message to take control.
+// @Param device query string true "Device ID"
+// @Success 101
+// @Failure 400 {object} utils.ErrorResponse "Invalid device ID"
+// @Router /api/v1/queue/ws [get]
+func (handlers *queueHandlers) Socket(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("User id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "User id not found")
+		return
+	}
+
+	deviceID := request.URL.Query().Get("device")
+	if !validDeviceID(deviceID) {
+		utils.JSONError(response, http.StatusBadRequest, "Invalid device ID")
+		return
+	}
+
+	// subscribe before reading the queue so no change slips in between
+	states, unsubscribe := handlers.usecase.Subscribe(userID)
+	defer unsubscribe()
+
+	currentQueue, err := handlers.usecase.GetQueue(request.Context(), userID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Can't load queue: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Can't load queue")
+		return
+	}
+
+	conn, err := handlers.upgrader.Upgrade(response, request, nil)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to upgrade connection: %v", err), requestID)
+		return
+	}
+	defer conn.Close()
+
+	replies := make(chan *dto.SocketMessageDTO, 1)
+	done := make(chan struct{})
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		defer close(done)
+		handlers.readMessages(conn, request, userID, deviceID, replies, stop)
+	}()
+
+	if err := writeMessage(conn, &dto.SocketMessageDTO{Type: dto.MessageState, Queue: currentQueue}); err != nil {
+		return
+	}
+
+	ping := time.NewTicker(pingPeriod)
+	defer ping.Stop()
+
+	for {
+		var message *dto.SocketMessageDTO
+		select {
+		case <-done:
+			return
+		case state, ok := <-states:
+			if !ok {
+				// the hub is closed when the server shuts down
+				closeMessage := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server is shutting down")
+				conn.WriteControl(websocket.CloseMessage, closeMessage, time.Now().Add(writeWait))
+				return
+			}
+			message = &dto.SocketMessageDTO{Type: dto.MessageState, Queue: state}
+		case message = <-replies:
+		case <-ping.C:
+			conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+			continue
+		}
+
+		if err := writeMessage(conn, message); err != nil {
+			handlers.logger.Warn(fmt.Sprintf("Failed to write to device %s: %v", deviceID, err), requestID)
+			return
+		}
+	}
+}
+
+// readMessages applies the commands of a device until it disconnects. The
+// resulting states reach every device through the usecase subscription.
+func (handlers *queueHandlers) readMessages(
+	conn *websocket.Conn,
+	request *http.Request,
+	userID uuid.UUID,
+	deviceID string,
+	replies chan<- *dto.SocketMessageDTO,
+	stop <-chan struct{},
+) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	reply := func(message *dto.SocketMessageDTO) bool {
+		select {
+		case replies <- message:
+			return true
+		case <-stop:
+			return false
+		}
+	}
+
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, rawBytes, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				handlers.logger.Warn(fmt.Sprintf("Device %s disconnected: %v", deviceID, err), requestID)
+			}
+			return
+		}
+
+		message := &dto.SocketMessageDTO{}
+		if err := easyjson.Unmarshal(rawBytes, message); err != nil {
+			if !reply(&dto.SocketMessageDTO{Type: dto.MessageError, Error: "Invalid message"}) {
+				return
+			}
+			continue
+		}
+
+		switch message.Type {
+		case dto.MessagePlayback:
+			if message.Playback == nil {
+				err = errors.New("Playback is missing")
+				break
+			}
+			message.Playback.DeviceID = deviceID
+			_, err = handlers.usecase.UpdatePlayback(request.Context(), userID, message.Playback)
+		case dto.MessageControl:
+			_, err = handlers.usecase.TakeControl(request.Context(), userID, deviceID)
+		default:
+			err = fmt.Errorf("Unknown message type %q", message.Type)
+		}
+
+		if err != nil && !reply(&dto.SocketMessageDTO{Type: dto.MessageError, Error: err.Error()}) {
+			return
+		}
+	}
+}
+
+func (handlers *queueHandlers) respond(response http.ResponseWriter, requestID interface{}, queueDTO *dto.QueueDTO, err error) {
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Queue request failed: %v", err), requestID)
+		switch {
+		case errors.Is(err, queue.ErrInvalidSource),
+			errors.Is(err, queue.ErrInvalidRepeatMode),
+			errors.Is(err, queue.ErrInvalidIndex),
+			errors.Is(err, queue.ErrEmptySource),
+			errors.Is(err, queue.ErrQueueFull):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		case errors.Is(err, queue.ErrTrackNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		case errors.Is(err, queue.ErrNotActiveDevice),
+			errors.Is(err, queue.ErrQueueConflict):
+			utils.JSONError(response, http.StatusConflict, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(queueDTO)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode queue: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode queue: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func writeMessage(conn *websocket.Conn, message *dto.SocketMessageDTO) error {
+	rawBytes, err := easyjson.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return conn.WriteMessage(websocket.TextMessage, rawBytes)
+}
+
+func validDeviceID(deviceID string) bool {
+	return deviceID != "" && len(deviceID) <= maxDeviceID
+}
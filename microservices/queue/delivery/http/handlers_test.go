@@ -0,0 +1,216 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withUser(userID uuid.UUID, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		next(w, r.WithContext(context.WithValue(r.Context(), utils.UserIDKey{}, userID)))
+	}
+}
+
+func TestQueueHandlers_SetQueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	queueHandlers := NewQueueHandlers(usecaseMock, "*", logger)
+
+	userID := uuid.New()
+	handler := withUser(userID, queueHandlers.SetQueue)
+
+	t.Run("Success", func(t *testing.T) {
+		setQueueDTO := &dto.SetQueueDTO{Source: "album", SourceID: 3, DeviceID: "laptop"}
+		expected := &dto.QueueDTO{TrackIDs: []uint64{1, 2}, SourceType: "album", SourceID: 3, ActiveDevice: "laptop", Version: 1}
+		usecaseMock.EXPECT().SetQueue(gomock.Any(), userID, setQueueDTO).Return(expected, nil)
+
+		body := `{"source":"album","sourceID":3,"deviceID":"laptop"}`
+		request := httptest.NewRequest(http.MethodPut, "/api/v1/queue", strings.NewReader(body))
+		response := httptest.NewRecorder()
+		handler(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		var updatedQueue dto.QueueDTO
+		assert.NoError(t, json.NewDecoder(response.Body).Decode(&updatedQueue))
+		assert.Equal(t, expected.TrackIDs, updatedQueue.TrackIDs)
+		assert.Equal(t, expected.ActiveDevice, updatedQueue.ActiveDevice)
+	})
+
+	t.Run("Missing device", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodPut, "/api/v1/queue", strings.NewReader(`{"source":"album","sourceID":3}`))
+		response := httptest.NewRecorder()
+		handler(response, request)
+
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		testCases := []struct {
+			err    error
+			status int
+		}{
+			{queue.ErrInvalidSource, http.StatusBadRequest},
+			{queue.ErrTrackNotFound, http.StatusNotFound},
+			{queue.ErrQueueConflict, http.StatusConflict},
+		}
+
+		for _, tc := range testCases {
+			usecaseMock.EXPECT().SetQueue(gomock.Any(), userID, gomock.Any()).Return(nil, tc.err)
+
+			body := `{"source":"radio","sourceID":3,"deviceID":"laptop"}`
+			request := httptest.NewRequest(http.MethodPut, "/api/v1/queue", strings.NewReader(body))
+			response := httptest.NewRecorder()
+			handler(response, request)
+
+			assert.Equal(t, tc.status, response.Code, tc.err.Error())
+		}
+	})
+}
+
+func TestQueueHandlers_Remove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	queueHandlers := NewQueueHandlers(usecaseMock, "*", logger)
+
+	userID := uuid.New()
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/queue/tracks/{index:[0-9]+}", withUser(userID, queueHandlers.Remove)).Methods(http.MethodDelete)
+
+	usecaseMock.EXPECT().Remove(gomock.Any(), userID, uint64(2)).Return(&dto.QueueDTO{TrackIDs: []uint64{1, 2}}, nil)
+
+	request := httptest.NewRequest(http.MethodDelete, "/api/v1/queue/tracks/2", nil)
+	response := httptest.NewRecorder()
+	router.ServeHTTP(response, request)
+
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestQueueHandlers_Socket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	queueHandlers := NewQueueHandlers(usecaseMock, "*", logger)
+
+	userID := uuid.New()
+	states := make(chan *dto.QueueDTO, 1)
+	unsubscribed := make(chan struct{})
+
+	current := &dto.QueueDTO{TrackIDs: []uint64{1, 2}, ActiveDevice: "laptop", Version: 1}
+	changed := &dto.QueueDTO{TrackIDs: []uint64{1, 2}, ActiveDevice: "phone", Version: 2}
+
+	usecaseMock.EXPECT().Subscribe(userID).Return((<-chan *dto.QueueDTO)(states), func() { close(unsubscribed) })
+	usecaseMock.EXPECT().GetQueue(gomock.Any(), userID).Return(current, nil)
+	usecaseMock.EXPECT().TakeControl(gomock.Any(), userID, "phone").DoAndReturn(
+		func(context.Context, uuid.UUID, string) (*dto.QueueDTO, error) {
+			states <- changed
+			return changed, nil
+		},
+	)
+	usecaseMock.EXPECT().UpdatePlayback(gomock.Any(), userID, &dto.PlaybackDTO{DeviceID: "phone", CurrentIndex: 5}).
+		Return(nil, queue.ErrInvalidIndex)
+
+	server := httptest.NewServer(withUser(userID, queueHandlers.Socket))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/queue/ws?device=phone"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	readMessage := func() *dto.SocketMessageDTO {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+		_, rawBytes, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		message := &dto.SocketMessageDTO{}
+		require.NoError(t, json.Unmarshal(rawBytes, message))
+		return message
+	}
+
+	message := readMessage()
+	assert.Equal(t, dto.MessageState, message.Type)
+	assert.Equal(t, "laptop", message.Queue.ActiveDevice)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"control"}`)))
+	message = readMessage()
+	assert.Equal(t, dto.MessageState, message.Type)
+	assert.Equal(t, "phone", message.Queue.ActiveDevice)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"playback","playback":{"currentIndex":5}}`)))
+	message = readMessage()
+	assert.Equal(t, dto.MessageError, message.Type)
+	assert.Equal(t, queue.ErrInvalidIndex.Error(), message.Error)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"seek"}`)))
+	message = readMessage()
+	assert.Equal(t, dto.MessageError, message.Type)
+
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+	select {
+	case <-unsubscribed:
+	case <-time.After(time.Second):
+		t.Fatal("device wasn't unsubscribed")
+	}
+}
+
+func TestQueueHandlers_SocketShutdown(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	queueHandlers := NewQueueHandlers(usecaseMock, "*", logger)
+
+	userID := uuid.New()
+	states := make(chan *dto.QueueDTO)
+	usecaseMock.EXPECT().Subscribe(userID).Return((<-chan *dto.QueueDTO)(states), func() {})
+	usecaseMock.EXPECT().GetQueue(gomock.Any(), userID).Return(&dto.QueueDTO{Version: 1}, nil)
+
+	server := httptest.NewServer(withUser(userID, queueHandlers.Socket))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/queue/ws?device=phone"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = conn.ReadMessage()
+	require.NoError(t, err)
+
+	// the hub closes the subscriptions when the shutdown starts
+	close(states)
+	_, _, err = conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "unexpected error: %v", err)
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
+	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/delivery/listener"
+	queueHub "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/hub"
+	queueRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/repository"
+	queueUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/usecase"
+	trackRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/repository"
+	trackUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/usecase"
+	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
+	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func BindRoutes(s *httpServer.Server, artistClient artistService.ArtistServiceClient, albumClient albumService.AlbumServiceClient, playlistClient playlistService.PlaylistServiceClient, pgListener *pq.Listener) {
+	s.MUX.Handle("/metrics", promhttp.Handler())
+
+	trackRepo := trackRepo.NewTrackPGRepository(s.PG)
+	trackUsecase := trackUsecase.NewTrackUsecase(trackRepo, artistClient, albumClient, playlistClient, s.Logger)
+
+	queueRepo := queueRepo.NewQueuePGRepository(s.PG)
+	queueHub := queueHub.New()
+	queueUsecase := queueUsecase.NewQueueUsecase(queueRepo, trackUsecase, queueHub, s.Logger)
+	queueHandlers := NewQueueHandlers(queueUsecase, s.CFG.Service.CORS.AllowOrigin, s.Logger)
+
+	s.Lifecycle.Go("queue listener", func(ctx context.Context) error {
+		return listener.Run(ctx, pgListener, queueUsecase, s.Logger)
+	})
+	// hijacked sockets are not closed by the server shutdown, closing the hub
+	// ends them when the shutdown starts
+	s.Lifecycle.Go("queue sockets", func(ctx context.Context) error {
+		<-ctx.Done()
+		queueHub.Close()
+		return nil
+	})
+
+	s.MUX.Handle(
+		"/api/v1/queue",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.GetQueue)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/queue",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.SetQueue)),
+	).Methods("PUT")
+
+	s.MUX.Handle(
+		"/api/v1/queue/next",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.AddNext)),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/queue/tracks/{index:[0-9]+}",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.Remove)),
+	).Methods("DELETE")
+
+	s.MUX.Handle(
+		"/api/v1/queue/shuffle",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.SetShuffle)),
+	).Methods("PUT")
+
+	s.MUX.Handle(
+		"/api/v1/queue/repeat",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.SetRepeat)),
+	).Methods("PUT")
+
+	s.MUX.Handle(
+		"/api/v1/queue/playback",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.UpdatePlayback)),
+	).Methods("PUT")
+
+	s.MUX.Handle(
+		"/api/v1/queue/control",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.TakeControl)),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/queue/ws",
+		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(queueHandlers.Socket)),
+	).Methods("GET")
+}
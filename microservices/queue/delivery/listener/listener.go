@@ -0,0 +1,56 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/lib/pq"
+	"github.com/mailru/easyjson"
+)
+
+// Channel is the postgres channel queue changes are announced on, it is
+// filled by the trigger on the play_queue table.
+const Channel = "play_queue_changed"
+
+// pingInterval makes a dead connection noticed even when nothing happens.
+const pingInterval = 90 * time.Second
+
+// Run dispatches announced queue changes until ctx is cancelled.
+func Run(ctx context.Context, listener *pq.Listener, usecase queue.Usecase, logger logger.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-listener.Notify:
+			if !ok {
+				return nil
+			}
+
+			// nil is sent after the connection was re-established, changes
+			// made meanwhile were missed, devices catch up on the next one
+			if event == nil {
+				logger.Info("queue listener reconnected", nil)
+				continue
+			}
+
+			payload := &dto.QueueEventDTO{}
+			if err := easyjson.Unmarshal([]byte(event.Extra), payload); err != nil {
+				logger.Errorf("failed to decode queue event: %v", err)
+				continue
+			}
+
+			if err := usecase.Dispatch(context.WithoutCancel(ctx), payload); err != nil {
+				logger.Errorf("failed to dispatch queue: %v", err)
+			}
+		case <-time.After(pingInterval):
+			go func() {
+				if err := listener.Ping(); err != nil {
+					logger.Warnf("queue listener ping failed: %v", err)
+				}
+			}()
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+//easyjson:json
+type QueueDTO struct {
+	TrackIDs     []uint64  `json:"trackIDs"`
+	CurrentIndex uint64    `json:"currentIndex"`
+	PositionMs   uint64    `json:"positionMs"`
+	IsPlaying    bool      `json:"isPlaying"`
+	Shuffle      bool      `json:"shuffle"`
+	RepeatMode   string    `json:"repeatMode"`
+	SourceType   string    `json:"sourceType"`
+	SourceID     uint64    `json:"sourceID"`
+	ActiveDevice string    `json:"activeDevice"`
+	Version      uint64    `json:"version"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func NewQueueDTO(queue *models.PlayQueue) *QueueDTO {
+	trackIDs := queue.TrackIDs
+	if trackIDs == nil {
+		trackIDs = []uint64{}
+	}
+
+	return &QueueDTO{
+		TrackIDs:     trackIDs,
+		CurrentIndex: queue.CurrentIndex,
+		PositionMs:   queue.PositionMs,
+		IsPlaying:    queue.IsPlaying,
+		Shuffle:      queue.Shuffle,
+		RepeatMode:   queue.RepeatMode,
+		SourceType:   queue.SourceType,
+		SourceID:     queue.SourceID,
+		ActiveDevice: queue.ActiveDevice,
+		Version:      queue.Version,
+		UpdatedAt:    queue.UpdatedAt,
+	}
+}
+
+// QueueEventDTO is the payload sent by postgres on the play_queue_changed
+// channel.
+//
+//easyjson:json
+type QueueEventDTO struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Version uint64    `json:"version"`
+}
+
+// SetQueueDTO replaces the queue. TrackIDs are only used with the
+// "tracks" source, for the other ones the tracks are taken from SourceID.
+//
+//easyjson:json
+type SetQueueDTO struct {
+	Source     string   `json:"source"`
+	SourceID   uint64   `json:"sourceID"`
+	TrackIDs   []uint64 `json:"trackIDs"`
+	StartIndex uint64   `json:"startIndex"`
+	DeviceID   string   `json:"deviceID"`
+}
+
+//easyjson:json
+type AddTrackDTO struct {
+	TrackID uint64 `json:"trackID"`
+}
+
+//easyjson:json
+type ShuffleDTO struct {
+	Enabled bool `json:"enabled"`
+}
+
+//easyjson:json
+type RepeatDTO struct {
+	Mode string `json:"mode"`
+}
+
+//easyjson:json
+type PlaybackDTO struct {
+	DeviceID     string `json:"deviceID"`
+	CurrentIndex uint64 `json:"currentIndex"`
+	PositionMs   uint64 `json:"positionMs"`
+	IsPlaying    bool   `json:"isPlaying"`
+}
+
+//easyjson:json
+type ControlDTO struct {
+	DeviceID string `json:"deviceID"`
+}
+
+const (
+	MessageState    = "state"
+	MessagePlayback = "playback"
+	MessageControl  = "control"
+	MessageError    = "error"
+)
+
+// SocketMessageDTO is sent both ways over the queue websocket. The server
+// sends "state" and "error" messages, devices send "playback" and "control".
+//
+//easyjson:json
+type SocketMessageDTO struct {
+	Type     string       `json:"type"`
+	Queue    *QueueDTO    `json:"queue,omitempty"`
+	Playback *PlaybackDTO `json:"playback,omitempty"`
+	Error    string       `json:"error,omitempty"`
+}
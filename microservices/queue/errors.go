@@ -0,0 +1,14 @@
+package queue
+
+import "errors"
+
+var (
+	ErrInvalidSource     = errors.New("Unknown queue source")
+	ErrInvalidRepeatMode = errors.New("Unknown repeat mode")
+	ErrInvalidIndex      = errors.New("Index is out of queue")
+	ErrEmptySource       = errors.New("Queue source has no tracks")
+	ErrTrackNotFound     = errors.New("Track wasn't found")
+	ErrQueueFull         = errors.New("Queue is full")
+	ErrNotActiveDevice   = errors.New("Device doesn't control playback")
+	ErrQueueConflict     = errors.New("Queue was changed concurrently")
+)
@@ -0,0 +1,12 @@
+package queue
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	uuid "github.com/google/uuid"
+)
+
+type Hub interface {
+	Subscribe(userID uuid.UUID) (<-chan *dto.QueueDTO, func())
+	HasSubscribers(userID uuid.UUID) bool
+	Publish(userID uuid.UUID, queue *dto.QueueDTO)
+}
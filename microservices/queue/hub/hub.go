@@ -0,0 +1,101 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	uuid "github.com/google/uuid"
+)
+
+// subscriberBuffer is how many states may wait for a slow device.
+const subscriberBuffer = 4
+
+// Hub keeps devices connected to this instance and sends them every new
+// state of their user's queue.
+type Hub struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[chan *dto.QueueDTO]struct{}
+	closed      bool
+}
+
+func New() *Hub {
+	return &Hub{subscribers: make(map[uuid.UUID]map[chan *dto.QueueDTO]struct{})}
+}
+
+func (h *Hub) Subscribe(userID uuid.UUID) (<-chan *dto.QueueDTO, func()) {
+	ch := make(chan *dto.QueueDTO, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	if _, ok := h.subscribers[userID]; !ok {
+		h.subscribers[userID] = make(map[chan *dto.QueueDTO]struct{})
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			// the channel is already closed if the hub was closed
+			if _, ok := h.subscribers[userID][ch]; !ok {
+				return
+			}
+			delete(h.subscribers[userID], ch)
+			if len(h.subscribers[userID]) == 0 {
+				delete(h.subscribers, userID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+func (h *Hub) HasSubscribers(userID uuid.UUID) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subscribers[userID]) > 0
+}
+
+func (h *Hub) Publish(userID uuid.UUID, queue *dto.QueueDTO) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[userID] {
+		select {
+		case ch <- queue:
+		default:
+			// the device is behind, only the latest state matters to it
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- queue:
+			default:
+			}
+		}
+	}
+}
+
+// Close ends every subscription, so that the sockets of connected devices
+// are closed and the server is able to shut down.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for userID, subscribers := range h.subscribers {
+		for ch := range subscribers {
+			close(ch)
+		}
+		delete(h.subscribers, userID)
+	}
+}
@@ -0,0 +1,90 @@
+package hub
+
+import (
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	uuid "github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubPublish(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	laptop, unsubscribeLaptop := h.Subscribe(userID)
+	phone, unsubscribePhone := h.Subscribe(userID)
+	other, unsubscribeOther := h.Subscribe(uuid.New())
+	defer unsubscribeLaptop()
+	defer unsubscribePhone()
+	defer unsubscribeOther()
+
+	queue := &dto.QueueDTO{TrackIDs: []uint64{1, 2}, Version: 1}
+	h.Publish(userID, queue)
+
+	require.Equal(t, queue, <-laptop)
+	require.Equal(t, queue, <-phone)
+	require.Len(t, other, 0)
+}
+
+func TestHubPublishKeepsLatestForSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	defer unsubscribe()
+
+	last := uint64(subscriberBuffer + 5)
+	for version := uint64(1); version <= last; version++ {
+		h.Publish(userID, &dto.QueueDTO{Version: version})
+	}
+
+	require.Len(t, ch, subscriberBuffer)
+
+	var received *dto.QueueDTO
+	for len(ch) > 0 {
+		received = <-ch
+	}
+	require.Equal(t, last, received.Version)
+}
+
+func TestHubUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	unsubscribe()
+	unsubscribe()
+
+	_, ok := <-ch
+	require.False(t, ok)
+
+	h.Publish(userID, &dto.QueueDTO{Version: 1})
+}
+
+func TestHubClose(t *testing.T) {
+	t.Parallel()
+
+	h := New()
+	userID := uuid.New()
+
+	ch, unsubscribe := h.Subscribe(userID)
+	require.True(t, h.HasSubscribers(userID))
+
+	h.Close()
+	_, ok := <-ch
+	require.False(t, ok)
+	require.False(t, h.HasSubscribers(userID))
+	unsubscribe()
+
+	// devices connecting during the shutdown are closed right away
+	late, _ := h.Subscribe(userID)
+	_, ok = <-late
+	require.False(t, ok)
+}
@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: microservices/queue/usecase.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddNext mocks base method.
+func (m *MockUsecase) AddNext(ctx context.Context, userID uuid.UUID, trackID uint64) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNext", ctx, userID, trackID)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNext indicates an expected call of AddNext.
+func (mr *MockUsecaseMockRecorder) AddNext(ctx, userID, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNext", reflect.TypeOf((*MockUsecase)(nil).AddNext), ctx, userID, trackID)
+}
+
+// Dispatch mocks base method.
+func (m *MockUsecase) Dispatch(ctx context.Context, event *dto.QueueEventDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockUsecaseMockRecorder) Dispatch(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockUsecase)(nil).Dispatch), ctx, event)
+}
+
+// GetQueue mocks base method.
+func (m *MockUsecase) GetQueue(ctx context.Context, userID uuid.UUID) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueue", ctx, userID)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueue indicates an expected call of GetQueue.
+func (mr *MockUsecaseMockRecorder) GetQueue(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueue", reflect.TypeOf((*MockUsecase)(nil).GetQueue), ctx, userID)
+}
+
+// Remove mocks base method.
+func (m *MockUsecase) Remove(ctx context.Context, userID uuid.UUID, index uint64) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, userID, index)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockUsecaseMockRecorder) Remove(ctx, userID, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockUsecase)(nil).Remove), ctx, userID, index)
+}
+
+// SetQueue mocks base method.
+func (m *MockUsecase) SetQueue(ctx context.Context, userID uuid.UUID, setQueueDTO *dto.SetQueueDTO) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQueue", ctx, userID, setQueueDTO)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQueue indicates an expected call of SetQueue.
+func (mr *MockUsecaseMockRecorder) SetQueue(ctx, userID, setQueueDTO interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueue", reflect.TypeOf((*MockUsecase)(nil).SetQueue), ctx, userID, setQueueDTO)
+}
+
+// SetRepeat mocks base method.
+func (m *MockUsecase) SetRepeat(ctx context.Context, userID uuid.UUID, mode string) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRepeat", ctx, userID, mode)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRepeat indicates an expected call of SetRepeat.
+func (mr *MockUsecaseMockRecorder) SetRepeat(ctx, userID, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRepeat", reflect.TypeOf((*MockUsecase)(nil).SetRepeat), ctx, userID, mode)
+}
+
+// SetShuffle mocks base method.
+func (m *MockUsecase) SetShuffle(ctx context.Context, userID uuid.UUID, enabled bool) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetShuffle", ctx, userID, enabled)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetShuffle indicates an expected call of SetShuffle.
+func (mr *MockUsecaseMockRecorder) SetShuffle(ctx, userID, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShuffle", reflect.TypeOf((*MockUsecase)(nil).SetShuffle), ctx, userID, enabled)
+}
+
+// Subscribe mocks base method.
+func (m *MockUsecase) Subscribe(userID uuid.UUID) (<-chan *dto.QueueDTO, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", userID)
+	ret0, _ := ret[0].(<-chan *dto.QueueDTO)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockUsecaseMockRecorder) Subscribe(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockUsecase)(nil).Subscribe), userID)
+}
+
+// TakeControl mocks base method.
+func (m *MockUsecase) TakeControl(ctx context.Context, userID uuid.UUID, deviceID string) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeControl", ctx, userID, deviceID)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeControl indicates an expected call of TakeControl.
+func (mr *MockUsecaseMockRecorder) TakeControl(ctx, userID, deviceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeControl", reflect.TypeOf((*MockUsecase)(nil).TakeControl), ctx, userID, deviceID)
+}
+
+// UpdatePlayback mocks base method.
+func (m *MockUsecase) UpdatePlayback(ctx context.Context, userID uuid.UUID, playbackDTO *dto.PlaybackDTO) (*dto.QueueDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlayback", ctx, userID, playbackDTO)
+	ret0, _ := ret[0].(*dto.QueueDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePlayback indicates an expected call of UpdatePlayback.
+func (mr *MockUsecaseMockRecorder) UpdatePlayback(ctx, userID, playbackDTO interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlayback", reflect.TypeOf((*MockUsecase)(nil).UpdatePlayback), ctx, userID, playbackDTO)
+}
@@ -0,0 +1,13 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+)
+
+type Repo interface {
+	FindByUser(ctx context.Context, userID uuid.UUID) (*models.PlayQueue, error)
+	Save(ctx context.Context, queue *models.PlayQueue) (bool, error)
+}
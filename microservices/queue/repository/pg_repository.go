@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+type QueueRepository struct {
+	db *sql.DB
+}
+
+func NewQueuePGRepository(db *sql.DB) *QueueRepository {
+	return &QueueRepository{db: db}
+}
+
+func (r *QueueRepository) FindByUser(ctx context.Context, userID uuid.UUID) (*models.PlayQueue, error) {
+	var trackIDs, originalTrackIDs []int64
+	queue := &models.PlayQueue{}
+	row := r.db.QueryRowContext(ctx, findByUserQuery, userID)
+	if err := row.Scan(
+		&queue.UserID,
+		pq.Array(&trackIDs),
+		pq.Array(&originalTrackIDs),
+		&queue.CurrentIndex,
+		&queue.PositionMs,
+		&queue.IsPlaying,
+		&queue.Shuffle,
+		&queue.RepeatMode,
+		&queue.SourceType,
+		&queue.SourceID,
+		&queue.ActiveDevice,
+		&queue.PlayCount,
+		&queue.Version,
+		&queue.UpdatedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "FindByUser.Query")
+	}
+
+	queue.TrackIDs = toUint64s(trackIDs)
+	queue.OriginalTrackIDs = toUint64s(originalTrackIDs)
+
+	return queue, nil
+}
+
+// Save stores the queue if its version is still the one it was read with
+// and returns false otherwise. On success the version is bumped.
+func (r *QueueRepository) Save(ctx context.Context, queue *models.PlayQueue) (bool, error) {
+	row := r.db.QueryRowContext(
+		ctx,
+		saveQueueQuery,
+		queue.UserID,
+		pq.Array(toInt64s(queue.TrackIDs)),
+		pq.Array(toInt64s(queue.OriginalTrackIDs)),
+		queue.CurrentIndex,
+		queue.PositionMs,
+		queue.IsPlaying,
+		queue.Shuffle,
+		queue.RepeatMode,
+		queue.SourceType,
+		queue.SourceID,
+		queue.ActiveDevice,
+		queue.PlayCount,
+		queue.Version,
+	)
+
+	if err := row.Scan(&queue.Version, &queue.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "Save.Query")
+	}
+
+	return true, nil
+}
+
+func toInt64s(ids []uint64) []int64 {
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int64(id))
+	}
+	return result
+}
+
+func toUint64s(ids []int64) []uint64 {
+	result := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint64(id))
+	}
+	return result
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueueRepositoryFindByUser(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	queuePGRepository := NewQueuePGRepository(db)
+	mockQueue := &models.PlayQueue{
+		UserID:           uuid.New(),
+		TrackIDs:         []uint64{3, 1, 2},
+		OriginalTrackIDs: []uint64{1, 2, 3},
+		CurrentIndex:     1,
+		PositionMs:       42000,
+		IsPlaying:        true,
+		Shuffle:          true,
+		RepeatMode:       models.RepeatAll,
+		SourceType:       models.QueueSourceAlbum,
+		SourceID:         5,
+		ActiveDevice:     "phone",
+		PlayCount:        12,
+		Version:          7,
+		UpdatedAt:        time.Now(),
+	}
+
+	columns := []string{
+		"user_id", "track_ids", "original_track_ids", "current_index", "position_ms", "is_playing",
+		"shuffle", "repeat_mode", "source_type", "source_id", "active_device", "play_count", "version", "updated_at",
+	}
+	rows := sqlmock.NewRows(columns).AddRow(
+		mockQueue.UserID,
+		"{3,1,2}",
+		"{1,2,3}",
+		mockQueue.CurrentIndex,
+		mockQueue.PositionMs,
+		mockQueue.IsPlaying,
+		mockQueue.Shuffle,
+		mockQueue.RepeatMode,
+		mockQueue.SourceType,
+		mockQueue.SourceID,
+		mockQueue.ActiveDevice,
+		mockQueue.PlayCount,
+		mockQueue.Version,
+		mockQueue.UpdatedAt,
+	)
+
+	mock.ExpectQuery(findByUserQuery).WithArgs(mockQueue.UserID).WillReturnRows(rows)
+
+	foundQueue, err := queuePGRepository.FindByUser(context.Background(), mockQueue.UserID)
+	require.NoError(t, err)
+	require.Equal(t, mockQueue, foundQueue)
+}
+
+func TestQueueRepositoryFindByUserNotFound(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	queuePGRepository := NewQueuePGRepository(db)
+	userID := uuid.New()
+
+	mock.ExpectQuery(findByUserQuery).WithArgs(userID).WillReturnError(sql.ErrNoRows)
+
+	foundQueue, err := queuePGRepository.FindByUser(context.Background(), userID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, foundQueue)
+}
+
+func TestQueueRepositorySave(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	queuePGRepository := NewQueuePGRepository(db)
+	playQueue := &models.PlayQueue{
+		UserID:     uuid.New(),
+		TrackIDs:   []uint64{1, 2},
+		RepeatMode: models.RepeatOff,
+		SourceType: models.QueueSourceTracks,
+		Version:    2,
+	}
+
+	args := []driver.Value{
+		playQueue.UserID,
+		pq.Array([]int64{1, 2}),
+		pq.Array([]int64{}),
+		playQueue.CurrentIndex,
+		playQueue.PositionMs,
+		playQueue.IsPlaying,
+		playQueue.Shuffle,
+		playQueue.RepeatMode,
+		playQueue.SourceType,
+		playQueue.SourceID,
+		playQueue.ActiveDevice,
+		playQueue.PlayCount,
+		uint64(2),
+	}
+
+	t.Run("saved", func(t *testing.T) {
+		updatedAt := time.Now()
+		rows := sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(3, updatedAt)
+		mock.ExpectQuery(saveQueueQuery).WithArgs(args...).WillReturnRows(rows)
+
+		saved, err := queuePGRepository.Save(context.Background(), playQueue)
+		require.NoError(t, err)
+		require.True(t, saved)
+		require.Equal(t, uint64(3), playQueue.Version)
+		require.Equal(t, updatedAt, playQueue.UpdatedAt)
+	})
+
+	t.Run("changed concurrently", func(t *testing.T) {
+		playQueue.Version = 2
+		mock.ExpectQuery(saveQueueQuery).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}))
+
+		saved, err := queuePGRepository.Save(context.Background(), playQueue)
+		require.NoError(t, err)
+		require.False(t, saved)
+	})
+}
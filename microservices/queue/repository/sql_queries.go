@@ -0,0 +1,32 @@
+package repository
+
+const (
+	findByUserQuery = `
+    SELECT user_id, track_ids, original_track_ids, current_index, position_ms, is_playing,
+      shuffle, repeat_mode, source_type, source_id, active_device, play_count, version, updated_at
+    FROM play_queue
+    WHERE user_id = $1`
+
+	// saveQueue only overwrites the row if nobody changed it since it was
+	// read, otherwise no row is returned.
+	saveQueueQuery = `
+    INSERT INTO play_queue (user_id, track_ids, original_track_ids, current_index, position_ms, is_playing,
+      shuffle, repeat_mode, source_type, source_id, active_device, play_count, version)
+    VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13 + 1)
+    ON CONFLICT (user_id) DO UPDATE SET
+      track_ids = EXCLUDED.track_ids,
+      original_track_ids = EXCLUDED.original_track_ids,
+      current_index = EXCLUDED.current_index,
+      position_ms = EXCLUDED.position_ms,
+      is_playing = EXCLUDED.is_playing,
+      shuffle = EXCLUDED.shuffle,
+      repeat_mode = EXCLUDED.repeat_mode,
+      source_type = EXCLUDED.source_type,
+      source_id = EXCLUDED.source_id,
+      active_device = EXCLUDED.active_device,
+      play_count = EXCLUDED.play_count,
+      version = EXCLUDED.version,
+      updated_at = NOW()
+    WHERE play_queue.version = $13
+    RETURNING version, updated_at`
+)
@@ -0,0 +1,23 @@
+package queue
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	uuid "github.com/google/uuid"
+)
+
+type Usecase interface {
+	GetQueue(ctx context.Context, userID uuid.UUID) (*dto.QueueDTO, error)
+	SetQueue(ctx context.Context, userID uuid.UUID, setQueueDTO *dto.SetQueueDTO) (*dto.QueueDTO, error)
+	AddNext(ctx context.Context, userID uuid.UUID, trackID uint64) (*dto.QueueDTO, error)
+	Remove(ctx context.Context, userID uuid.UUID, index uint64) (*dto.QueueDTO, error)
+	SetShuffle(ctx context.Context, userID uuid.UUID, enabled bool) (*dto.QueueDTO, error)
+	SetRepeat(ctx context.Context, userID uuid.UUID, mode string) (*dto.QueueDTO, error)
+	UpdatePlayback(ctx context.Context, userID uuid.UUID, playbackDTO *dto.PlaybackDTO) (*dto.QueueDTO, error)
+	TakeControl(ctx context.Context, userID uuid.UUID, deviceID string) (*dto.QueueDTO, error)
+	Subscribe(userID uuid.UUID) (<-chan *dto.QueueDTO, func())
+	// Dispatch sends the announced state of the queue to the devices
+	// connected to this instance.
+	Dispatch(ctx context.Context, event *dto.QueueEventDTO) error
+}
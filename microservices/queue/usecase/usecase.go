@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	trackDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	uuid "github.com/google/uuid"
+)
+
+const (
+	// radioQueueLength is how many tracks are queued after the radio seed.
+	radioQueueLength = 50
+	// saveAttempts bounds retries when devices change the queue at once.
+	saveAttempts   = 3
+	maxQueueLength = 1000
+)
+
+type queueUsecase struct {
+	queueRepo    queue.Repo
+	trackUsecase track.Usecase
+	hub          queue.Hub
+	logger       logger.Logger
+	shuffle      func(n int, swap func(i, j int))
+}
+
+func NewQueueUsecase(queueRepo queue.Repo, trackUsecase track.Usecase, hub queue.Hub, logger logger.Logger) queue.Usecase {
+	return &queueUsecase{queueRepo, trackUsecase, hub, logger, rand.Shuffle}
+}
+
+func (usecase *queueUsecase) GetQueue(ctx context.Context, userID uuid.UUID) (*dto.QueueDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundQueue, err := usecase.load(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load queue of user %v: %v", userID, err), requestID)
+		return nil, fmt.Errorf("Can't load queue")
+	}
+
+	return dto.NewQueueDTO(foundQueue), nil
+}
+
+func (usecase *queueUsecase) SetQueue(ctx context.Context, userID uuid.UUID, setQueueDTO *dto.SetQueueDTO) (*dto.QueueDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	trackIDs, err := usecase.resolveSource(ctx, setQueueDTO)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't resolve %s %d for queue of user %v: %v", setQueueDTO.Source, setQueueDTO.SourceID, userID, err), requestID)
+		return nil, err
+	}
+
+	if setQueueDTO.StartIndex >= uint64(len(trackIDs)) {
+		return nil, queue.ErrInvalidIndex
+	}
+
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		playQueue.TrackIDs = trackIDs
+		playQueue.OriginalTrackIDs = nil
+		playQueue.Shuffle = false
+		playQueue.CurrentIndex = setQueueDTO.StartIndex
+		playQueue.PositionMs = 0
+		// the new queue starts its track from the beginning, which
+		// startsTrack counts as a play
+		playQueue.PlayCount++
+		playQueue.IsPlaying = true
+		playQueue.SourceType = setQueueDTO.Source
+		playQueue.SourceID = setQueueDTO.SourceID
+		playQueue.ActiveDevice = setQueueDTO.DeviceID
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) AddNext(ctx context.Context, userID uuid.UUID, trackID uint64) (*dto.QueueDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if _, err := usecase.trackUsecase.View(ctx, trackID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't find track %d to queue: %v", trackID, err), requestID)
+		return nil, queue.ErrTrackNotFound
+	}
+
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		if len(playQueue.TrackIDs) >= maxQueueLength {
+			return queue.ErrQueueFull
+		}
+
+		if len(playQueue.TrackIDs) == 0 {
+			playQueue.TrackIDs = []uint64{trackID}
+			playQueue.CurrentIndex = 0
+			playQueue.PositionMs = 0
+			playQueue.SourceType = models.QueueSourceTracks
+			playQueue.SourceID = 0
+			return nil
+		}
+
+		if playQueue.Shuffle {
+			current := playQueue.TrackIDs[playQueue.CurrentIndex]
+			playQueue.OriginalTrackIDs = insertAt(playQueue.OriginalTrackIDs, indexOf(playQueue.OriginalTrackIDs, current)+1, trackID)
+		}
+		playQueue.TrackIDs = insertAt(playQueue.TrackIDs, int(playQueue.CurrentIndex)+1, trackID)
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) Remove(ctx context.Context, userID uuid.UUID, index uint64) (*dto.QueueDTO, error) {
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		if index >= uint64(len(playQueue.TrackIDs)) {
+			return queue.ErrInvalidIndex
+		}
+
+		removed := playQueue.TrackIDs[index]
+		playQueue.TrackIDs = append(playQueue.TrackIDs[:index], playQueue.TrackIDs[index+1:]...)
+		if playQueue.Shuffle {
+			if i := indexOf(playQueue.OriginalTrackIDs, removed); i >= 0 {
+				playQueue.OriginalTrackIDs = append(playQueue.OriginalTrackIDs[:i], playQueue.OriginalTrackIDs[i+1:]...)
+			}
+		}
+
+		switch {
+		case len(playQueue.TrackIDs) == 0:
+			playQueue.CurrentIndex = 0
+			playQueue.PositionMs = 0
+			playQueue.IsPlaying = false
+		case index < playQueue.CurrentIndex:
+			playQueue.CurrentIndex--
+		case index == playQueue.CurrentIndex:
+			// the next track takes the place of the removed one
+			playQueue.PositionMs = 0
+			if playQueue.CurrentIndex >= uint64(len(playQueue.TrackIDs)) {
+				playQueue.CurrentIndex = 0
+				playQueue.IsPlaying = playQueue.IsPlaying && playQueue.RepeatMode == models.RepeatAll
+			}
+		}
+
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) SetShuffle(ctx context.Context, userID uuid.UUID, enabled bool) (*dto.QueueDTO, error) {
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		if playQueue.Shuffle == enabled {
+			return nil
+		}
+		playQueue.Shuffle = enabled
+
+		if len(playQueue.TrackIDs) == 0 {
+			playQueue.OriginalTrackIDs = nil
+			return nil
+		}
+
+		current := playQueue.TrackIDs[playQueue.CurrentIndex]
+		if !enabled {
+			if len(playQueue.OriginalTrackIDs) == 0 {
+				return nil
+			}
+			playQueue.TrackIDs = playQueue.OriginalTrackIDs
+			playQueue.OriginalTrackIDs = nil
+			playQueue.CurrentIndex = uint64(max(indexOf(playQueue.TrackIDs, current), 0))
+			return nil
+		}
+
+		// the current track keeps playing and everything else is shuffled after it
+		playQueue.OriginalTrackIDs = append([]uint64(nil), playQueue.TrackIDs...)
+		rest := make([]uint64, 0, len(playQueue.TrackIDs)-1)
+		rest = append(rest, playQueue.TrackIDs[:playQueue.CurrentIndex]...)
+		rest = append(rest, playQueue.TrackIDs[playQueue.CurrentIndex+1:]...)
+		usecase.shuffle(len(rest), func(i, j int) {
+			rest[i], rest[j] = rest[j], rest[i]
+		})
+
+		playQueue.TrackIDs = append([]uint64{current}, rest...)
+		playQueue.CurrentIndex = 0
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) SetRepeat(ctx context.Context, userID uuid.UUID, mode string) (*dto.QueueDTO, error) {
+	switch mode {
+	case models.RepeatOff, models.RepeatAll, models.RepeatOne:
+	default:
+		return nil, queue.ErrInvalidRepeatMode
+	}
+
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		playQueue.RepeatMode = mode
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) UpdatePlayback(ctx context.Context, userID uuid.UUID, playbackDTO *dto.PlaybackDTO) (*dto.QueueDTO, error) {
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		if playQueue.ActiveDevice != "" && playQueue.ActiveDevice != playbackDTO.DeviceID {
+			return queue.ErrNotActiveDevice
+		}
+		if playbackDTO.CurrentIndex >= uint64(len(playQueue.TrackIDs)) {
+			return queue.ErrInvalidIndex
+		}
+
+		if startsTrack(playQueue, playbackDTO) {
+			playQueue.PlayCount++
+		}
+
+		playQueue.ActiveDevice = playbackDTO.DeviceID
+		playQueue.CurrentIndex = playbackDTO.CurrentIndex
+		playQueue.PositionMs = playbackDTO.PositionMs
+		playQueue.IsPlaying = playbackDTO.IsPlaying
+		return nil
+	})
+}
+
+// startsTrack reports whether the playback plays another track or the
+// current one from its start, resuming a paused track isn't a new play.
+func startsTrack(playQueue *models.PlayQueue, playbackDTO *dto.PlaybackDTO) bool {
+	if !playbackDTO.IsPlaying {
+		return false
+	}
+	return playbackDTO.CurrentIndex != playQueue.CurrentIndex || !playQueue.IsPlaying && playbackDTO.PositionMs == 0
+}
+
+func (usecase *queueUsecase) TakeControl(ctx context.Context, userID uuid.UUID, deviceID string) (*dto.QueueDTO, error) {
+	return usecase.update(ctx, userID, func(playQueue *models.PlayQueue) error {
+		playQueue.ActiveDevice = deviceID
+		return nil
+	})
+}
+
+func (usecase *queueUsecase) Subscribe(userID uuid.UUID) (<-chan *dto.QueueDTO, func()) {
+	return usecase.hub.Subscribe(userID)
+}
+
+func (usecase *queueUsecase) Dispatch(ctx context.Context, event *dto.QueueEventDTO) error {
+	// every instance receives every event, but only the ones
+	// holding a connection of the user have work to do
+	if !usecase.hub.HasSubscribers(event.UserID) {
+		return nil
+	}
+
+	playQueue, err := usecase.load(ctx, event.UserID)
+	if err != nil {
+		usecase.logger.Warnf("Can't load queue of user %v: %v", event.UserID, err)
+		return fmt.Errorf("Can't load queue of user %v", event.UserID)
+	}
+	// a newer version is announced as well, it is sent on its own event
+	if playQueue.Version != event.Version {
+		return nil
+	}
+
+	usecase.hub.Publish(event.UserID, dto.NewQueueDTO(playQueue))
+	return nil
+}
+
+// update applies mutate to the latest stored queue and saves it, starting
+// over when another device saved its change first.
+func (usecase *queueUsecase) update(ctx context.Context, userID uuid.UUID, mutate func(*models.PlayQueue) error) (*dto.QueueDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	for attempt := 0; attempt < saveAttempts; attempt++ {
+		playQueue, err := usecase.load(ctx, userID)
+		if err != nil {
+			usecase.logger.Warn(fmt.Sprintf("Can't load queue of user %v: %v", userID, err), requestID)
+			return nil, fmt.Errorf("Can't load queue")
+		}
+
+		if err := mutate(playQueue); err != nil {
+			return nil, err
+		}
+
+		saved, err := usecase.queueRepo.Save(ctx, playQueue)
+		if err != nil {
+			usecase.logger.Warn(fmt.Sprintf("Can't save queue of user %v: %v", userID, err), requestID)
+			return nil, fmt.Errorf("Can't save queue")
+		}
+
+		// the devices get the new state through Dispatch, on every instance
+		if saved {
+			return dto.NewQueueDTO(playQueue), nil
+		}
+	}
+
+	usecase.logger.Warn(fmt.Sprintf("Queue of user %v keeps changing, giving up", userID), requestID)
+	return nil, queue.ErrQueueConflict
+}
+
+func (usecase *queueUsecase) load(ctx context.Context, userID uuid.UUID) (*models.PlayQueue, error) {
+	playQueue, err := usecase.queueRepo.FindByUser(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.PlayQueue{UserID: userID, RepeatMode: models.RepeatOff}, nil
+	}
+
+	return playQueue, err
+}
+
+func (usecase *queueUsecase) resolveSource(ctx context.Context, setQueueDTO *dto.SetQueueDTO) ([]uint64, error) {
+	var (
+		tracks []*trackDTO.TrackDTO
+		err    error
+	)
+
+	switch setQueueDTO.Source {
+	case models.QueueSourceTracks:
+		if len(setQueueDTO.TrackIDs) == 0 {
+			return nil, queue.ErrEmptySource
+		}
+		if len(setQueueDTO.TrackIDs) > maxQueueLength {
+			return nil, queue.ErrQueueFull
+		}
+		return setQueueDTO.TrackIDs, nil
+	case models.QueueSourceAlbum:
+		tracks, err = usecase.trackUsecase.GetAllByAlbumID(ctx, setQueueDTO.SourceID)
+	case models.QueueSourcePlaylist:
+		tracks, err = usecase.trackUsecase.GetTracksFromPlaylist(ctx, setQueueDTO.SourceID)
+	case models.QueueSourceRadio:
+		seed, seedErr := usecase.trackUsecase.View(ctx, setQueueDTO.SourceID)
+		if seedErr != nil {
+			return nil, queue.ErrTrackNotFound
+		}
+		tracks, err = usecase.trackUsecase.GetRadio(ctx, seed.ID, nil, radioQueueLength)
+		tracks = append([]*trackDTO.TrackDTO{seed}, tracks...)
+	default:
+		return nil, queue.ErrInvalidSource
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("Can't load tracks of %s %d", setQueueDTO.Source, setQueueDTO.SourceID)
+	}
+	if len(tracks) == 0 {
+		return nil, queue.ErrEmptySource
+	}
+	if len(tracks) > maxQueueLength {
+		tracks = tracks[:maxQueueLength]
+	}
+
+	trackIDs := make([]uint64, 0, len(tracks))
+	for _, track := range tracks {
+		trackIDs = append(trackIDs, track.ID)
+	}
+
+	return trackIDs, nil
+}
+
+func indexOf(ids []uint64, id uint64) int {
+	for i, candidate := range ids {
+		if candidate == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func insertAt(ids []uint64, index int, id uint64) []uint64 {
+	ids = append(ids, 0)
+	copy(ids[index+1:], ids[index:])
+	ids[index] = id
+	return ids
+}
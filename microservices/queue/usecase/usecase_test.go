@@ -0,0 +1,430 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	uuid "github.com/google/uuid"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/dto"
+	mockQueue "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/queue/mock"
+	trackDTO "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	mockTrack "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type testDeps struct {
+	repo         *mockQueue.MockRepo
+	trackUsecase *mockTrack.MockUsecase
+	hub          *mockQueue.MockHub
+	usecase      *queueUsecase
+}
+
+func newTestUsecase(t *testing.T) *testDeps {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	deps := &testDeps{
+		repo:         mockQueue.NewMockRepo(ctrl),
+		trackUsecase: mockTrack.NewMockUsecase(ctrl),
+		hub:          mockQueue.NewMockHub(ctrl),
+	}
+	deps.usecase = NewQueueUsecase(deps.repo, deps.trackUsecase, deps.hub, logger.New(&cfg.Service.Logger)).(*queueUsecase)
+
+	return deps
+}
+
+// expectSave stores the queue passed to the repository so tests can
+// inspect what was saved.
+func (deps *testDeps) expectSave(saved **models.PlayQueue) {
+	deps.repo.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, playQueue *models.PlayQueue) (bool, error) {
+			playQueue.Version++
+			*saved = playQueue
+			return true, nil
+		},
+	)
+}
+
+func TestUsecase_GetQueue_Empty(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(nil, sql.ErrNoRows)
+
+	foundQueue, err := deps.usecase.GetQueue(ctx, userID)
+	require.NoError(t, err)
+	require.Empty(t, foundQueue.TrackIDs)
+	require.Equal(t, models.RepeatOff, foundQueue.RepeatMode)
+	require.Equal(t, uint64(0), foundQueue.Version)
+}
+
+func TestUsecase_SetQueue_Album(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	tracks := []*trackDTO.TrackDTO{{ID: 10}, {ID: 11}, {ID: 12}}
+	deps.trackUsecase.EXPECT().GetAllByAlbumID(ctx, uint64(3)).Return(tracks, nil)
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{
+		UserID:     userID,
+		TrackIDs:   []uint64{1},
+		Shuffle:    true,
+		RepeatMode: models.RepeatOne,
+		IsPlaying:  true,
+		PlayCount:  2,
+		Version:    4,
+	}, nil)
+
+	var saved *models.PlayQueue
+	deps.expectSave(&saved)
+
+	updatedQueue, err := deps.usecase.SetQueue(ctx, userID, &dto.SetQueueDTO{
+		Source:     models.QueueSourceAlbum,
+		SourceID:   3,
+		StartIndex: 1,
+		DeviceID:   "laptop",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 11, 12}, saved.TrackIDs)
+	require.Equal(t, uint64(1), updatedQueue.CurrentIndex)
+	require.False(t, updatedQueue.Shuffle)
+	require.Equal(t, models.RepeatOne, updatedQueue.RepeatMode)
+	require.Equal(t, "laptop", updatedQueue.ActiveDevice)
+	require.Equal(t, uint64(5), updatedQueue.Version)
+	require.Equal(t, uint64(3), saved.PlayCount)
+}
+
+func TestUsecase_SetQueue_Radio(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	deps.trackUsecase.EXPECT().View(ctx, uint64(7)).Return(&trackDTO.TrackDTO{ID: 7}, nil)
+	deps.trackUsecase.EXPECT().GetRadio(ctx, uint64(7), nil, uint64(radioQueueLength)).
+		Return([]*trackDTO.TrackDTO{{ID: 8}, {ID: 9}}, nil)
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(nil, sql.ErrNoRows)
+
+	var saved *models.PlayQueue
+	deps.expectSave(&saved)
+
+	_, err := deps.usecase.SetQueue(ctx, userID, &dto.SetQueueDTO{
+		Source:   models.QueueSourceRadio,
+		SourceID: 7,
+		DeviceID: "phone",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 8, 9}, saved.TrackIDs)
+	require.Equal(t, uint64(1), saved.PlayCount)
+}
+
+func TestUsecase_SetQueue_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	_, err := deps.usecase.SetQueue(ctx, userID, &dto.SetQueueDTO{Source: "podcast"})
+	require.ErrorIs(t, err, queue.ErrInvalidSource)
+
+	deps.trackUsecase.EXPECT().GetTracksFromPlaylist(ctx, uint64(2)).Return([]*trackDTO.TrackDTO{}, nil)
+	_, err = deps.usecase.SetQueue(ctx, userID, &dto.SetQueueDTO{Source: models.QueueSourcePlaylist, SourceID: 2})
+	require.ErrorIs(t, err, queue.ErrEmptySource)
+
+	_, err = deps.usecase.SetQueue(ctx, userID, &dto.SetQueueDTO{
+		Source:     models.QueueSourceTracks,
+		TrackIDs:   []uint64{1, 2},
+		StartIndex: 2,
+	})
+	require.ErrorIs(t, err, queue.ErrInvalidIndex)
+}
+
+func TestUsecase_AddNext_Shuffled(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	deps.trackUsecase.EXPECT().View(ctx, uint64(99)).Return(&trackDTO.TrackDTO{ID: 99}, nil)
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{
+		UserID:           userID,
+		TrackIDs:         []uint64{3, 1, 2},
+		OriginalTrackIDs: []uint64{1, 2, 3},
+		CurrentIndex:     1,
+		Shuffle:          true,
+	}, nil)
+
+	var saved *models.PlayQueue
+	deps.expectSave(&saved)
+
+	_, err := deps.usecase.AddNext(ctx, userID, 99)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1, 99, 2}, saved.TrackIDs)
+	require.Equal(t, []uint64{1, 99, 2, 3}, saved.OriginalTrackIDs)
+}
+
+func TestUsecase_AddNext_UnknownTrack(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	deps.trackUsecase.EXPECT().View(ctx, uint64(99)).Return(nil, errors.New("Track wasn't found"))
+
+	_, err := deps.usecase.AddNext(ctx, userID, 99)
+	require.ErrorIs(t, err, queue.ErrTrackNotFound)
+}
+
+func TestUsecase_Remove(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		index         uint64
+		expectedIDs   []uint64
+		expectedIndex uint64
+		isPlaying     bool
+	}{
+		{name: "before current", index: 0, expectedIDs: []uint64{2, 3}, expectedIndex: 0, isPlaying: true},
+		{name: "current", index: 1, expectedIDs: []uint64{1, 3}, expectedIndex: 1, isPlaying: true},
+		{name: "after current", index: 2, expectedIDs: []uint64{1, 2}, expectedIndex: 1, isPlaying: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deps := newTestUsecase(t)
+			deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{
+				UserID:       userID,
+				TrackIDs:     []uint64{1, 2, 3},
+				CurrentIndex: 1,
+				PositionMs:   1000,
+				IsPlaying:    true,
+			}, nil)
+
+			var saved *models.PlayQueue
+			deps.expectSave(&saved)
+
+			_, err := deps.usecase.Remove(ctx, userID, tc.index)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIDs, saved.TrackIDs)
+			require.Equal(t, tc.expectedIndex, saved.CurrentIndex)
+			require.Equal(t, tc.isPlaying, saved.IsPlaying)
+		})
+	}
+
+	t.Run("out of queue", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID, TrackIDs: []uint64{1}}, nil)
+
+		_, err := deps.usecase.Remove(ctx, userID, 1)
+		require.ErrorIs(t, err, queue.ErrInvalidIndex)
+	})
+}
+
+func TestUsecase_SetShuffle(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	// reverse instead of shuffling randomly to get a stable order
+	deps.usecase.shuffle = func(n int, swap func(i, j int)) {
+		for i := 0; i < n/2; i++ {
+			swap(i, n-1-i)
+		}
+	}
+
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{
+		UserID:       userID,
+		TrackIDs:     []uint64{1, 2, 3, 4},
+		CurrentIndex: 1,
+	}, nil)
+
+	var saved *models.PlayQueue
+	deps.expectSave(&saved)
+
+	_, err := deps.usecase.SetShuffle(ctx, userID, true)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4, 3, 1}, saved.TrackIDs)
+	require.Equal(t, []uint64{1, 2, 3, 4}, saved.OriginalTrackIDs)
+	require.Equal(t, uint64(0), saved.CurrentIndex)
+
+	shuffled := *saved
+	shuffled.CurrentIndex = 2
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&shuffled, nil)
+	deps.expectSave(&saved)
+
+	_, err = deps.usecase.SetShuffle(ctx, userID, false)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3, 4}, saved.TrackIDs)
+	require.Nil(t, saved.OriginalTrackIDs)
+	require.Equal(t, uint64(2), saved.CurrentIndex)
+}
+
+func TestUsecase_SetRepeat_InvalidMode(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+
+	_, err := deps.usecase.SetRepeat(context.Background(), uuid.New(), "twice")
+	require.ErrorIs(t, err, queue.ErrInvalidRepeatMode)
+}
+
+func TestUsecase_UpdatePlayback(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	ctx := context.Background()
+	storedQueue := func() *models.PlayQueue {
+		return &models.PlayQueue{UserID: userID, TrackIDs: []uint64{1, 2}, ActiveDevice: "laptop"}
+	}
+
+	t.Run("active device", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(storedQueue(), nil)
+
+		var saved *models.PlayQueue
+		deps.expectSave(&saved)
+
+		_, err := deps.usecase.UpdatePlayback(ctx, userID, &dto.PlaybackDTO{
+			DeviceID:     "laptop",
+			CurrentIndex: 1,
+			PositionMs:   30000,
+			IsPlaying:    true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), saved.CurrentIndex)
+		require.Equal(t, uint64(30000), saved.PositionMs)
+		require.True(t, saved.IsPlaying)
+		require.Equal(t, uint64(1), saved.PlayCount)
+	})
+
+	t.Run("counts started tracks", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		playing := storedQueue()
+		playing.IsPlaying = true
+		playing.PlayCount = 4
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(playing, nil)
+
+		var saved *models.PlayQueue
+		deps.expectSave(&saved)
+
+		_, err := deps.usecase.UpdatePlayback(ctx, userID, &dto.PlaybackDTO{DeviceID: "laptop", PositionMs: 5000, IsPlaying: true})
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), saved.PlayCount)
+
+		paused := storedQueue()
+		paused.PositionMs = 5000
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(paused, nil)
+		deps.expectSave(&saved)
+
+		_, err = deps.usecase.UpdatePlayback(ctx, userID, &dto.PlaybackDTO{DeviceID: "laptop", PositionMs: 5000, IsPlaying: true})
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), saved.PlayCount)
+	})
+
+	t.Run("other device", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(storedQueue(), nil)
+
+		_, err := deps.usecase.UpdatePlayback(ctx, userID, &dto.PlaybackDTO{DeviceID: "phone"})
+		require.ErrorIs(t, err, queue.ErrNotActiveDevice)
+	})
+
+	t.Run("after taking control", func(t *testing.T) {
+		deps := newTestUsecase(t)
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(storedQueue(), nil)
+
+		var saved *models.PlayQueue
+		deps.expectSave(&saved)
+
+		updatedQueue, err := deps.usecase.TakeControl(ctx, userID, "phone")
+		require.NoError(t, err)
+		require.Equal(t, "phone", updatedQueue.ActiveDevice)
+
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(saved, nil)
+		deps.expectSave(&saved)
+
+		_, err = deps.usecase.UpdatePlayback(ctx, userID, &dto.PlaybackDTO{DeviceID: "phone", IsPlaying: true})
+		require.NoError(t, err)
+	})
+}
+
+func TestUsecase_Update_RetriesOnConflict(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	gomock.InOrder(
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID, Version: 1}, nil),
+		deps.repo.EXPECT().Save(ctx, gomock.Any()).Return(false, nil),
+		deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID, Version: 2}, nil),
+		deps.repo.EXPECT().Save(ctx, gomock.Any()).Return(true, nil),
+	)
+
+	updatedQueue, err := deps.usecase.SetRepeat(ctx, userID, models.RepeatAll)
+	require.NoError(t, err)
+	require.Equal(t, models.RepeatAll, updatedQueue.RepeatMode)
+
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID}, nil).Times(saveAttempts)
+	deps.repo.EXPECT().Save(ctx, gomock.Any()).Return(false, nil).Times(saveAttempts)
+
+	_, err = deps.usecase.SetRepeat(ctx, userID, models.RepeatOne)
+	require.ErrorIs(t, err, queue.ErrQueueConflict)
+}
+
+func TestUsecase_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	deps := newTestUsecase(t)
+	userID := uuid.New()
+	ctx := context.Background()
+
+	// nobody is connected to this instance
+	deps.hub.EXPECT().HasSubscribers(userID).Return(false)
+	require.NoError(t, deps.usecase.Dispatch(ctx, &dto.QueueEventDTO{UserID: userID, Version: 3}))
+
+	deps.hub.EXPECT().HasSubscribers(userID).Return(true).Times(2)
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID, TrackIDs: []uint64{7}, Version: 3}, nil)
+	deps.hub.EXPECT().Publish(userID, gomock.Any()).Do(func(_ uuid.UUID, state *dto.QueueDTO) {
+		require.Equal(t, []uint64{7}, state.TrackIDs)
+		require.Equal(t, uint64(3), state.Version)
+	})
+	require.NoError(t, deps.usecase.Dispatch(ctx, &dto.QueueEventDTO{UserID: userID, Version: 3}))
+
+	// the newer version is sent on its own event
+	deps.repo.EXPECT().FindByUser(ctx, userID).Return(&models.PlayQueue{UserID: userID, Version: 4}, nil)
+	require.NoError(t, deps.usecase.Dispatch(ctx, &dto.QueueEventDTO{UserID: userID, Version: 3}))
+}
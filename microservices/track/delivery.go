@@ -14,4 +14,9 @@ type Handlers interface {
 	GetFavoriteTracks(response http.ResponseWriter, request *http.Request)
 	GetTracksFromPlaylist(response http.ResponseWriter, request *http.Request)
 	GetPopular(response http.ResponseWriter, request *http.Request)
+	GetRadio(response http.ResponseWriter, request *http.Request)
+	GetLyrics(response http.ResponseWriter, request *http.Request)
+	SaveLyrics(response http.ResponseWriter, request *http.Request)
+	DeleteLyrics(response http.ResponseWriter, request *http.Request)
+	SearchByLyrics(response http.ResponseWriter, request *http.Request)
 }
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (service *tracksService) FindByID(ctx context.Context, request *trackService.FindByIDRequest) (*trackService.FindByIDResponse, error) {
+	trackID := request.GetId()
+	track, err := service.usecase.View(ctx, trackID)
+	if err != nil {
+		service.logger.Errorf("cannot find track by id: %v", err)
+		return nil, status.Errorf(codes.NotFound, "cannot find track by id: %v", err)
+	}
+
+	return &trackService.FindByIDResponse{Track: service.trackDTOToProto(track)}, nil
+}
+
+func (service *tracksService) FindByIDs(ctx context.Context, request *trackService.FindByIDsRequest) (*trackService.FindByIDsResponse, error) {
+	tracks, err := service.usecase.GetByIDs(ctx, request.GetIds())
+	if err != nil {
+		service.logger.Errorf("cannot find tracks by ids: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot find tracks by ids: %v", err)
+	}
+
+	return &trackService.FindByIDsResponse{Tracks: service.tracksDTOToProto(tracks)}, nil
+}
+
+func (service *tracksService) ListByArtist(ctx context.Context, request *trackService.ListByArtistRequest) (*trackService.ListResponse, error) {
+	tracks, err := service.usecase.GetAllByArtistID(ctx, request.GetArtistID())
+	if err != nil {
+		service.logger.Errorf("cannot list tracks by artist: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list tracks by artist: %v", err)
+	}
+
+	return &trackService.ListResponse{Tracks: service.tracksDTOToProto(tracks)}, nil
+}
+
+func (service *tracksService) ListByAlbum(ctx context.Context, request *trackService.ListByAlbumRequest) (*trackService.ListResponse, error) {
+	tracks, err := service.usecase.GetAllByAlbumID(ctx, request.GetAlbumID())
+	if err != nil {
+		service.logger.Errorf("cannot list tracks by album: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list tracks by album: %v", err)
+	}
+
+	return &trackService.ListResponse{Tracks: service.tracksDTOToProto(tracks)}, nil
+}
+
+func (service *tracksService) ListFavorites(ctx context.Context, request *trackService.ListFavoritesRequest) (*trackService.ListResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	tracks, err := service.usecase.GetFavoriteTracks(ctx, userID)
+	if err != nil {
+		service.logger.Errorf("cannot list favorite tracks: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list favorite tracks: %v", err)
+	}
+
+	return &trackService.ListResponse{Tracks: service.tracksDTOToProto(tracks)}, nil
+}
+
+func (service *tracksService) ListPopular(ctx context.Context, request *trackService.ListPopularRequest) (*trackService.ListResponse, error) {
+	tracks, err := service.usecase.GetPopular(ctx)
+	if err != nil {
+		service.logger.Errorf("cannot list popular tracks: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot list popular tracks: %v", err)
+	}
+
+	return &trackService.ListResponse{Tracks: service.tracksDTOToProto(tracks)}, nil
+}
+
+func (service *tracksService) IsFavorite(ctx context.Context, request *trackService.IsFavoriteRequest) (*trackService.IsFavoriteResponse, error) {
+	userID, err := uuid.Parse(request.GetUserUuid())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user uuid: %v", err)
+	}
+
+	favorite, err := service.usecase.IsFavoriteTrack(ctx, userID, request.GetId())
+	if err != nil {
+		service.logger.Errorf("cannot check favorite track: %v", err)
+		return nil, status.Errorf(codes.Internal, "cannot check favorite track: %v", err)
+	}
+
+	return &trackService.IsFavoriteResponse{Favorite: favorite}, nil
+}
+
+func (service *tracksService) tracksDTOToProto(tracks []*dto.TrackDTO) []*trackService.Track {
+	protoTracks := make([]*trackService.Track, 0, len(tracks))
+	for _, track := range tracks {
+		protoTracks = append(protoTracks, service.trackDTOToProto(track))
+	}
+	return protoTracks
+}
+
+func (service *tracksService) trackDTOToProto(track *dto.TrackDTO) *trackService.Track {
+	return &trackService.Track{
+		Id:          track.ID,
+		Name:        track.Name,
+		Duration:    track.Duration,
+		Filepath:    track.FilePath,
+		Image:       track.Image,
+		ArtistID:    track.ArtistID,
+		ArtistName:  track.ArtistName,
+		AlbumID:     track.AlbumID,
+		AlbumName:   track.AlbumName,
+		ReleaseDate: timestamppb.New(track.ReleaseDate),
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
+	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewTracksService(mockUsecase, logger)
+
+	t.Run("successful find", func(t *testing.T) {
+		releaseDate := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+		track := &dto.TrackDTO{
+			ID:          1,
+			Name:        "Test Track",
+			Duration:    180,
+			FilePath:    "track.mp3",
+			ArtistID:    2,
+			ArtistName:  "Test Artist",
+			AlbumID:     3,
+			AlbumName:   "Test Album",
+			ReleaseDate: releaseDate,
+		}
+
+		mockUsecase.EXPECT().View(gomock.Any(), uint64(1)).Return(track, nil)
+
+		resp, err := service.FindByID(context.Background(), &trackService.FindByIDRequest{Id: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, &trackService.FindByIDResponse{
+			Track: &trackService.Track{
+				Id:          1,
+				Name:        "Test Track",
+				Duration:    180,
+				Filepath:    "track.mp3",
+				ArtistID:    2,
+				ArtistName:  "Test Artist",
+				AlbumID:     3,
+				AlbumName:   "Test Album",
+				ReleaseDate: timestamppb.New(releaseDate),
+			},
+		}, resp)
+	})
+
+	t.Run("not found error", func(t *testing.T) {
+		mockUsecase.EXPECT().View(gomock.Any(), uint64(1)).Return(nil, errors.New("track not found"))
+
+		_, err := service.FindByID(context.Background(), &trackService.FindByIDRequest{Id: 1})
+		assert.Error(t, err)
+		assert.Equal(t, status.Errorf(codes.NotFound, "cannot find track by id: track not found"), err)
+	})
+}
+
+func TestFindByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewTracksService(mockUsecase, logger)
+
+	mockUsecase.EXPECT().GetByIDs(gomock.Any(), []uint64{2, 1}).Return([]*dto.TrackDTO{{ID: 2}, {ID: 1}}, nil)
+
+	resp, err := service.FindByIDs(context.Background(), &trackService.FindByIDsRequest{Ids: []uint64{2, 1}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetTracks(), 2)
+	assert.Equal(t, uint64(2), resp.GetTracks()[0].GetId())
+}
+
+func TestIsFavorite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	mockUsecase := mocks.NewMockUsecase(ctrl)
+	service := NewTracksService(mockUsecase, logger)
+
+	t.Run("favorite track", func(t *testing.T) {
+		userID := uuid.New()
+		mockUsecase.EXPECT().IsFavoriteTrack(gomock.Any(), userID, uint64(1)).Return(true, nil)
+
+		resp, err := service.IsFavorite(context.Background(), &trackService.IsFavoriteRequest{UserUuid: userID.String(), Id: 1})
+		assert.NoError(t, err)
+		assert.True(t, resp.GetFavorite())
+	})
+
+	t.Run("invalid user uuid", func(t *testing.T) {
+		_, err := service.IsFavorite(context.Background(), &trackService.IsFavoriteRequest{UserUuid: "invalid", Id: 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+	"google.golang.org/grpc"
+)
+
+func RegisterTrackService(usecase track.Usecase, logger logger.Logger) func(server *grpc.Server) {
+	return func(server *grpc.Server) {
+		tracksServer := NewTracksService(usecase, logger)
+		trackService.RegisterTrackServiceServer(server, tracksServer)
+	}
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	trackService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/track"
+)
+
+type tracksService struct {
+	usecase track.Usecase
+	logger  logger.Logger
+
+	trackService.UnimplementedTrackServiceServer
+}
+
+func NewTracksService(usecase track.Usecase, logger logger.Logger) *tracksService {
+	return &tracksService{usecase, logger, trackService.UnimplementedTrackServiceServer{}}
+}
@@ -1,7 +1,9 @@
 package http
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -15,6 +17,11 @@ import (
 	"github.com/gorilla/mux"
 )
 
+const (
+	radioDefaultLimit = 20
+	radioMaxLimit     = 50
+)
+
 type trackHandlers struct {
 	usecase track.Usecase
 	logger  logger.Logger
@@ -96,6 +103,10 @@ func (handlers *trackHandlers) ViewTrack(response http.ResponseWriter, request *
 		return
 	}
 
+	if utils.NotModified(response, request, foundTrack) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(foundTrack)
 	if err != nil {
@@ -132,6 +143,10 @@ func (handlers *trackHandlers) GetAll(response http.ResponseWriter, request *htt
 		return
 	}
 
+	if utils.NotModifiedList(response, request, tracks) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
 	if err != nil {
@@ -178,6 +193,10 @@ func (handlers *trackHandlers) GetAllByArtistID(response http.ResponseWriter, re
 		return
 	}
 
+	if utils.NotModifiedList(response, request, tracks) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
 	if err != nil {
@@ -224,6 +243,10 @@ func (handlers *trackHandlers) GetAllByAlbumID(response http.ResponseWriter, req
 		return
 	}
 
+	if utils.NotModifiedList(response, request, tracks) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
 	if err != nil {
@@ -389,6 +412,10 @@ func (handlers *trackHandlers) GetFavoriteTracks(response http.ResponseWriter, r
 		return
 	}
 
+	if utils.NotModifiedList(response, request, tracks) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
 	if err != nil {
@@ -422,6 +449,10 @@ func (handlers *trackHandlers) GetTracksFromPlaylist(response http.ResponseWrite
 		return
 	}
 
+	if utils.NotModifiedList(response, request, playlist) {
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(playlist))
 	if err != nil {
@@ -450,6 +481,76 @@ func (handlers *trackHandlers) GetPopular(response http.ResponseWriter, request
 		return
 	}
 
+	if utils.NotModifiedList(response, request, tracks) {
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode tracks: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode tracks: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+// GetRadio godoc
+// @Summary Get track radio
+// @Description Generates the next batch of a radio queue seeded by the track. Tracks listed in "exclude" are skipped, so the client can keep requesting more.
+// @Param id path uint64 true "Track ID"
+// @Param limit query int false "Number of tracks to return"
+// @Param exclude query string false "Comma separated IDs of already played tracks"
+// @Success 200 {array} dto.TrackDTO "Radio tracks"
+// @Failure 400 {object} utils.ErrorResponse "Invalid track ID or query parameters"
+// @Failure 404 {object} utils.ErrorResponse "Track or radio tracks not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get radio"
+// @Router /api/v1/tracks/{id}/radio [get]
+func (handlers *trackHandlers) GetRadio(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	trackID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	limit, err := utils.ParseLimit(request.URL.Query().Get("limit"), radioDefaultLimit, radioMaxLimit)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Wrong limit: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong limit value")
+		return
+	}
+
+	seenIDs, err := utils.ParseIDList(request.URL.Query().Get("exclude"))
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Wrong exclude list: %v", err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong exclude value")
+		return
+	}
+
+	tracks, err := handlers.usecase.GetRadio(request.Context(), trackID, seenIDs, limit)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get radio: %v", err), requestID)
+		if errors.Is(err, track.ErrTrackNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to get radio: %v", err))
+		return
+	} else if len(tracks) == 0 {
+		utils.JSONError(response, http.StatusNotFound, "No radio tracks were found")
+		return
+	}
+
 	response.Header().Set("Content-Type", "application/json")
 	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(tracks))
 	if err != nil {
@@ -466,3 +567,175 @@ func (handlers *trackHandlers) GetPopular(response http.ResponseWriter, request
 		return
 	}
 }
+
+// GetLyrics godoc
+// @Summary Get track lyrics
+// @Description Returns the lyrics of the track. Time-synced lyrics also carry their lines with the time in milliseconds.
+// @Param id path uint64 true "Track ID"
+// @Success 200 {object} dto.LyricsDTO "Track lyrics"
+// @Failure 400 {object} utils.ErrorResponse "Invalid track ID"
+// @Failure 404 {object} utils.ErrorResponse "Lyrics not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to get lyrics"
+// @Router /api/v1/tracks/{id}/lyrics [get]
+func (handlers *trackHandlers) GetLyrics(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	trackID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	lyrics, err := handlers.usecase.GetLyrics(request.Context(), trackID)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to get lyrics: %v", err), requestID)
+		if errors.Is(err, track.ErrLyricsNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "Failed to get lyrics")
+		return
+	}
+
+	handlers.writeLyrics(response, requestID, lyrics)
+}
+
+// SaveLyrics godoc
+// @Summary Upload track lyrics
+// @Description Creates or replaces the lyrics of the track. The "lrc" format is parsed and validated, its lines must fit into the track duration.
+// @Param id path uint64 true "Track ID"
+// @Param lyrics body dto.LyricsInputDTO true "Lyrics in the 'plain' or 'lrc' format"
+// @Success 200 {object} dto.LyricsDTO "Saved lyrics"
+// @Failure 400 {object} utils.ErrorResponse "Invalid lyrics"
+// @Failure 404 {object} utils.ErrorResponse "Track not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to save lyrics"
+// @Router /api/v1/tracks/{id}/lyrics [put]
+func (handlers *trackHandlers) SaveLyrics(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	trackID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	lyricsInputDTO := &dto.LyricsInputDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, lyricsInputDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	lyrics, err := handlers.usecase.SaveLyrics(request.Context(), trackID, lyricsInputDTO)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to save lyrics: %v", err), requestID)
+		switch {
+		case errors.Is(err, track.ErrInvalidLyrics):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		case errors.Is(err, track.ErrTrackNotFound):
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "Failed to save lyrics")
+		}
+		return
+	}
+
+	handlers.writeLyrics(response, requestID, lyrics)
+}
+
+// DeleteLyrics godoc
+// @Summary Delete track lyrics
+// @Description Removes the lyrics of the track.
+// @Param id path uint64 true "Track ID"
+// @Success 200
+// @Failure 400 {object} utils.ErrorResponse "Invalid track ID"
+// @Failure 404 {object} utils.ErrorResponse "Lyrics not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to delete lyrics"
+// @Router /api/v1/tracks/{id}/lyrics [delete]
+func (handlers *trackHandlers) DeleteLyrics(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	vars := mux.Vars(request)
+	trackID, err := strconv.ParseUint(vars["id"], 10, 64)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Get '%s' wrong id: %v", vars["id"], err), requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong id value")
+		return
+	}
+
+	if err := handlers.usecase.DeleteLyrics(request.Context(), trackID); err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to delete lyrics: %v", err), requestID)
+		if errors.Is(err, track.ErrLyricsNotFound) {
+			utils.JSONError(response, http.StatusNotFound, err.Error())
+			return
+		}
+		utils.JSONError(response, http.StatusInternalServerError, "Failed to delete lyrics")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// SearchByLyrics godoc
+// @Summary Search tracks by lyrics
+// @Description Finds tracks whose lyrics contain the words of the "query" query parameter, best matches first.
+// @Param query query string true "Remembered line of the song"
+// @Success 200 {array} dto.TrackDTO "List of found tracks"
+// @Failure 400 {object} utils.ErrorResponse "Missing query parameter"
+// @Failure 404 {object} utils.ErrorResponse "No tracks found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to search lyrics"
+// @Router /api/v1/tracks/search/lyrics [get]
+func (handlers *trackHandlers) SearchByLyrics(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	query := request.URL.Query().Get("query")
+	if query == "" {
+		handlers.logger.Error("Missing query parameter 'query'", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "Wrong query")
+		return
+	}
+
+	foundTracks, err := handlers.usecase.SearchByLyrics(request.Context(), query)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to search lyrics: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Can't search lyrics")
+		return
+	} else if len(foundTracks) == 0 {
+		utils.JSONError(response, http.StatusNotFound, "No tracks")
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(dto.TrackDTOs(foundTracks))
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode tracks: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Encode fail")
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+func (handlers *trackHandlers) writeLyrics(response http.ResponseWriter, requestID interface{}, lyrics *dto.LyricsDTO) {
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err := easyjson.Marshal(lyrics)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to encode lyrics: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, fmt.Sprintf("Failed to encode lyrics: %v", err))
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
 	mocks "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
@@ -124,6 +127,52 @@ func TestTrackHandlers_ViewTrack(t *testing.T) {
 		assert.Equal(t, track, foundTrack)
 	})
 
+	t.Run("Not modified", func(t *testing.T) {
+		updatedAt := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+		track := dto.TrackDTO{ID: 1, Name: "test", UpdatedAt: updatedAt}
+
+		router := mux.NewRouter()
+		router.HandleFunc("/tracks/{id}", trackHandlers.ViewTrack).Methods("GET")
+
+		usecaseMock.EXPECT().View(gomock.Any(), uint64(1)).Return(&track, nil)
+		request := httptest.NewRequest(http.MethodGet, "/tracks/1", nil)
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		etag := response.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+		assert.Equal(t, updatedAt.Format(http.TimeFormat), response.Header().Get("Last-Modified"))
+
+		usecaseMock.EXPECT().View(gomock.Any(), uint64(1)).Return(&track, nil)
+		request = httptest.NewRequest(http.MethodGet, "/tracks/1", nil)
+		request.Header.Set("If-None-Match", etag)
+		response = httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotModified, response.Code)
+		assert.Empty(t, response.Body.Bytes())
+
+		usecaseMock.EXPECT().View(gomock.Any(), uint64(1)).Return(&track, nil)
+		request = httptest.NewRequest(http.MethodGet, "/tracks/1", nil)
+		request.Header.Set("If-Modified-Since", updatedAt.Format(http.TimeFormat))
+		response = httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusNotModified, response.Code)
+
+		modified := track
+		modified.UpdatedAt = updatedAt.Add(time.Hour)
+		usecaseMock.EXPECT().View(gomock.Any(), uint64(1)).Return(&modified, nil)
+		request = httptest.NewRequest(http.MethodGet, "/tracks/1", nil)
+		request.Header.Set("If-None-Match", etag)
+		response = httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.NotEqual(t, etag, response.Header().Get("ETag"))
+	})
+
 	t.Run("Wrong slug", func(t *testing.T) {
 		router := mux.NewRouter()
 		router.HandleFunc("/tracks/{id}", trackHandlers.ViewTrack).Methods("GET")
@@ -198,6 +247,30 @@ func TestTrackHandlers_GetAllTracks(t *testing.T) {
 		assert.Equal(t, tracks, foundTracks)
 	})
 
+	t.Run("Removed track isn't cached", func(t *testing.T) {
+		updatedAt := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+		tracks := []*dto.TrackDTO{{ID: 1, UpdatedAt: updatedAt}, {ID: 2, UpdatedAt: updatedAt.Add(-time.Hour)}}
+
+		usecaseMock.EXPECT().GetAll(gomock.Any()).Return(tracks, nil)
+		request := httptest.NewRequest(http.MethodGet, "/tracks", nil)
+		response := httptest.NewRecorder()
+		trackHandlers.GetAll(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		etag := response.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+		assert.Empty(t, response.Header().Get("Last-Modified"))
+
+		usecaseMock.EXPECT().GetAll(gomock.Any()).Return(tracks[:1], nil)
+		request = httptest.NewRequest(http.MethodGet, "/tracks", nil)
+		request.Header.Set("If-Modified-Since", updatedAt.Format(http.TimeFormat))
+		response = httptest.NewRecorder()
+		trackHandlers.GetAll(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Code)
+		assert.NotEqual(t, etag, response.Header().Get("ETag"))
+	})
+
 	t.Run("Can't find tracks", func(t *testing.T) {
 		request, err := http.NewRequest(http.MethodGet, "/tracks", nil)
 		assert.NoError(t, err)
@@ -723,3 +796,165 @@ func createTrackRequestWithVars(requestID string, userID uuid.UUID) *http.Reques
 	request = request.WithContext(context.WithValue(request.Context(), utils.RequestIDKey{}, requestID))
 	return request
 }
+
+func TestTrackHandlers_GetRadio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	trackHandlers := NewTrackHandlers(usecaseMock, logger)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/tracks/{id}/radio", trackHandlers.GetRadio).Methods("GET")
+
+	t.Run("Successful radio", func(t *testing.T) {
+		tracks := []*dto.TrackDTO{
+			{Name: "next", ArtistName: "artist2", AlbumName: "album2"},
+		}
+
+		usecaseMock.EXPECT().GetRadio(gomock.Any(), uint64(1), []uint64{2, 3}, uint64(5)).Return(tracks, nil)
+
+		request, err := http.NewRequest(http.MethodGet, "/tracks/1/radio?limit=5&exclude=2,3", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		res := response.Result()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		var foundTracks []*dto.TrackDTO
+		err = json.NewDecoder(res.Body).Decode(&foundTracks)
+		assert.NoError(t, err)
+		assert.Equal(t, tracks, foundTracks)
+	})
+
+	t.Run("Wrong exclude list", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, "/tracks/1/radio?exclude=a,b", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("Seed track not found", func(t *testing.T) {
+		usecaseMock.EXPECT().GetRadio(gomock.Any(), uint64(9), nil, uint64(radioDefaultLimit)).Return(nil, track.ErrTrackNotFound)
+
+		request, err := http.NewRequest(http.MethodGet, "/tracks/9/radio", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("No radio tracks", func(t *testing.T) {
+		usecaseMock.EXPECT().GetRadio(gomock.Any(), uint64(1), nil, uint64(radioDefaultLimit)).Return(nil, nil)
+
+		request, err := http.NewRequest(http.MethodGet, "/tracks/1/radio?limit=0", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func TestTrackHandlers_GetLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	trackHandlers := NewTrackHandlers(usecaseMock, logger)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/tracks/{id}/lyrics", trackHandlers.GetLyrics).Methods("GET")
+
+	t.Run("Successful get", func(t *testing.T) {
+		lyrics := &dto.LyricsDTO{
+			TrackID: 1,
+			Synced:  true,
+			Text:    "hello",
+			Lines:   []*dto.LyricsLineDTO{{TimeMs: 1000, Text: "hello"}},
+		}
+
+		usecaseMock.EXPECT().GetLyrics(gomock.Any(), uint64(1)).Return(lyrics, nil)
+
+		request, err := http.NewRequest(http.MethodGet, "/tracks/1/lyrics", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+
+		res := response.Result()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		defer res.Body.Close()
+		var foundLyrics dto.LyricsDTO
+		err = json.NewDecoder(res.Body).Decode(&foundLyrics)
+		assert.NoError(t, err)
+		assert.Equal(t, lyrics.Lines, foundLyrics.Lines)
+	})
+
+	t.Run("Lyrics not found", func(t *testing.T) {
+		usecaseMock.EXPECT().GetLyrics(gomock.Any(), uint64(2)).Return(nil, track.ErrLyricsNotFound)
+
+		request, err := http.NewRequest(http.MethodGet, "/tracks/2/lyrics", nil)
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
+
+func TestTrackHandlers_SaveLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mocks.NewMockUsecase(ctrl)
+	trackHandlers := NewTrackHandlers(usecaseMock, logger)
+
+	router := mux.NewRouter()
+	router.HandleFunc("/tracks/{id}/lyrics", trackHandlers.SaveLyrics).Methods("PUT")
+
+	t.Run("Successful save", func(t *testing.T) {
+		input := &dto.LyricsInputDTO{Format: dto.LyricsFormatPlain, Content: "hello"}
+		usecaseMock.EXPECT().SaveLyrics(gomock.Any(), uint64(1), input).Return(&dto.LyricsDTO{TrackID: 1, Text: "hello"}, nil)
+
+		request, err := http.NewRequest(http.MethodPut, "/tracks/1/lyrics", strings.NewReader(`{"format":"plain","content":"hello"}`))
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("Invalid lyrics", func(t *testing.T) {
+		usecaseMock.EXPECT().SaveLyrics(gomock.Any(), uint64(1), gomock.Any()).Return(nil, fmt.Errorf("%w: no timed lines", track.ErrInvalidLyrics))
+
+		request, err := http.NewRequest(http.MethodPut, "/tracks/1/lyrics", strings.NewReader(`{"format":"lrc","content":"hello"}`))
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("Malformed body", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodPut, "/tracks/1/lyrics", strings.NewReader(`{`))
+		assert.NoError(t, err)
+
+		response := httptest.NewRecorder()
+		router.ServeHTTP(response, request)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -9,26 +9,54 @@ import (
 	trackUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/usecase"
 	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func BindRoutes(s *httpServer.Server, artistClient artistService.ArtistServiceClient, albumClient albumService.AlbumServiceClient) {
+func BindRoutes(s *httpServer.Server, artistClient artistService.ArtistServiceClient, albumClient albumService.AlbumServiceClient, playlistClient playlistService.PlaylistServiceClient) {
 	s.MUX.Handle("/metrics", promhttp.Handler())
 
 	trackRepo := trackRepo.NewTrackPGRepository(s.PG)
-	trackUsecase := trackUsecase.NewTrackUsecase(trackRepo, artistClient, albumClient, s.Logger)
+	trackUsecase := trackUsecase.NewTrackUsecase(trackRepo, artistClient, albumClient, playlistClient, s.Logger)
 	trackHandleres := NewTrackHandlers(trackUsecase, s.Logger)
 
 	s.MUX.HandleFunc("/api/v1/tracks/search", trackHandleres.SearchTrack).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/tracks/{id:[0-9]+}", trackHandleres.ViewTrack).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/tracks", trackHandleres.GetAll).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/tracks/byArtistId/{artistId:[0-9]+}", trackHandleres.GetAllByArtistID).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/tracks/byAlbumId/{albumId:[0-9]+}", trackHandleres.GetAllByAlbumID).Methods("GET")
-	s.MUX.HandleFunc("/api/v1/tracks/byPlaylistId/{playlistId:[0-9]+}", trackHandleres.GetTracksFromPlaylist).Methods("GET")
+	s.MUX.HandleFunc("/api/v1/tracks/search/lyrics", trackHandleres.SearchByLyrics).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/{id:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.ViewTrack)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.GetAll)),
+	).Methods("GET")
+
+	s.MUX.HandleFunc("/api/v1/tracks/{id:[0-9]+}/radio", trackHandleres.GetRadio).Methods("GET")
+	s.MUX.HandleFunc("/api/v1/tracks/{id:[0-9]+}/lyrics", trackHandleres.GetLyrics).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/byArtistId/{artistId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.GetAllByArtistID)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/byAlbumId/{albumId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.GetAllByAlbumID)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/byPlaylistId/{playlistId:[0-9]+}",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.GetTracksFromPlaylist)),
+	).Methods("GET")
 
 	s.MUX.Handle(
 		"/api/v1/tracks/favorite/byUser/{userID}",
-		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(trackHandleres.GetFavoriteTracks)),
+		middleware.CacheMiddleware(
+			s.CFG.Service.Cache.Private,
+			middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(trackHandleres.GetFavoriteTracks)),
+		),
 	).Methods("GET")
 
 	s.MUX.Handle(
@@ -46,5 +74,24 @@ func BindRoutes(s *httpServer.Server, artistClient artistService.ArtistServiceCl
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(trackHandleres.DeleteFavoriteTrack)),
 	).Methods("DELETE")
 
-	s.MUX.HandleFunc("/api/v1/tracks/popular", trackHandleres.GetPopular).Methods("GET")
+	s.MUX.Handle(
+		"/api/v1/tracks/popular",
+		middleware.CacheMiddleware(s.CFG.Service.Cache.Public, http.HandlerFunc(trackHandleres.GetPopular)),
+	).Methods("GET")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/{id:[0-9]+}/lyrics",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(trackHandleres.SaveLyrics)),
+		),
+	).Methods("PUT")
+
+	s.MUX.Handle(
+		"/api/v1/tracks/{id:[0-9]+}/lyrics",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(trackHandleres.DeleteLyrics)),
+		),
+	).Methods("DELETE")
 }
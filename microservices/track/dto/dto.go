@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/lrc"
 )
 
 //easyjson:json
@@ -18,6 +19,7 @@ type TrackDTO struct {
 	AlbumName   string    `json:"albumName"`
 	AlbumID     uint64    `json:"albumID"`
 	ReleaseDate time.Time `json:"release"`
+	UpdatedAt   time.Time `json:"-"`
 }
 
 func NewTrackDTO(track *models.Track) *TrackDTO {
@@ -28,8 +30,60 @@ func NewTrackDTO(track *models.Track) *TrackDTO {
 		FilePath:    track.FilePath,
 		Image:       track.Image,
 		ReleaseDate: track.ReleaseDate,
+		UpdatedAt:   track.UpdatedAt,
 	}
 }
 
+func (track *TrackDTO) Version() (uint64, time.Time) {
+	return track.ID, track.UpdatedAt
+}
+
 //easyjson:json
 type TrackDTOs []*TrackDTO
+
+const (
+	LyricsFormatPlain = "plain"
+	LyricsFormatLRC   = "lrc"
+)
+
+//easyjson:json
+type LyricsLineDTO struct {
+	TimeMs uint64 `json:"timeMs"`
+	Text   string `json:"text"`
+}
+
+// LyricsDTO always carries the plain text, Lines are only set for
+// time-synced lyrics.
+//
+//easyjson:json
+type LyricsDTO struct {
+	TrackID   uint64           `json:"trackID"`
+	Synced    bool             `json:"synced"`
+	Text      string           `json:"text"`
+	Lines     []*LyricsLineDTO `json:"lines,omitempty"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+//easyjson:json
+type LyricsInputDTO struct {
+	Format  string `json:"format"`
+	Content string `json:"content"`
+}
+
+func NewLyricsDTO(lyrics *models.Lyrics, lines []lrc.Line) *LyricsDTO {
+	lyricsDTO := &LyricsDTO{
+		TrackID:   lyrics.TrackID,
+		Synced:    lyrics.Synced,
+		Text:      lyrics.PlainText,
+		UpdatedAt: lyrics.UpdatedAt,
+	}
+
+	for _, line := range lines {
+		lyricsDTO.Lines = append(lyricsDTO.Lines, &LyricsLineDTO{
+			TimeMs: uint64(line.Time.Milliseconds()),
+			Text:   line.Text,
+		})
+	}
+
+	return lyricsDTO
+}
@@ -0,0 +1,9 @@
+package track
+
+import "errors"
+
+var (
+	ErrTrackNotFound  = errors.New("Track wasn't found")
+	ErrLyricsNotFound = errors.New("Lyrics weren't found")
+	ErrInvalidLyrics  = errors.New("Invalid lyrics")
+)
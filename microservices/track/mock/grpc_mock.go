@@ -0,0 +1,317 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proto/playlist/playlist_grpc.pb.go
+
+// Package mock_track is a generated GoMock package.
+package mock_track
+
+import (
+	context "context"
+	reflect "reflect"
+
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockPlaylistServiceClient is a mock of PlaylistServiceClient interface.
+type MockPlaylistServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaylistServiceClientMockRecorder
+}
+
+// MockPlaylistServiceClientMockRecorder is the mock recorder for MockPlaylistServiceClient.
+type MockPlaylistServiceClientMockRecorder struct {
+	mock *MockPlaylistServiceClient
+}
+
+// NewMockPlaylistServiceClient creates a new mock instance.
+func NewMockPlaylistServiceClient(ctrl *gomock.Controller) *MockPlaylistServiceClient {
+	mock := &MockPlaylistServiceClient{ctrl: ctrl}
+	mock.recorder = &MockPlaylistServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaylistServiceClient) EXPECT() *MockPlaylistServiceClientMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockPlaylistServiceClient) FindByID(ctx context.Context, in *playlistService.FindByIDRequest, opts ...grpc.CallOption) (*playlistService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByID", varargs...)
+	ret0, _ := ret[0].(*playlistService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPlaylistServiceClientMockRecorder) FindByID(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPlaylistServiceClient)(nil).FindByID), varargs...)
+}
+
+// FindByIDs mocks base method.
+func (m *MockPlaylistServiceClient) FindByIDs(ctx context.Context, in *playlistService.FindByIDsRequest, opts ...grpc.CallOption) (*playlistService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindByIDs", varargs...)
+	ret0, _ := ret[0].(*playlistService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockPlaylistServiceClientMockRecorder) FindByIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockPlaylistServiceClient)(nil).FindByIDs), varargs...)
+}
+
+// IsFavorite mocks base method.
+func (m *MockPlaylistServiceClient) IsFavorite(ctx context.Context, in *playlistService.IsFavoriteRequest, opts ...grpc.CallOption) (*playlistService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsFavorite", varargs...)
+	ret0, _ := ret[0].(*playlistService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockPlaylistServiceClientMockRecorder) IsFavorite(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockPlaylistServiceClient)(nil).IsFavorite), varargs...)
+}
+
+// ListByOwner mocks base method.
+func (m *MockPlaylistServiceClient) ListByOwner(ctx context.Context, in *playlistService.ListByOwnerRequest, opts ...grpc.CallOption) (*playlistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByOwner", varargs...)
+	ret0, _ := ret[0].(*playlistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwner indicates an expected call of ListByOwner.
+func (mr *MockPlaylistServiceClientMockRecorder) ListByOwner(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockPlaylistServiceClient)(nil).ListByOwner), varargs...)
+}
+
+// ListFavorites mocks base method.
+func (m *MockPlaylistServiceClient) ListFavorites(ctx context.Context, in *playlistService.ListFavoritesRequest, opts ...grpc.CallOption) (*playlistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFavorites", varargs...)
+	ret0, _ := ret[0].(*playlistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockPlaylistServiceClientMockRecorder) ListFavorites(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockPlaylistServiceClient)(nil).ListFavorites), varargs...)
+}
+
+// ListTrackIDs mocks base method.
+func (m *MockPlaylistServiceClient) ListTrackIDs(ctx context.Context, in *playlistService.ListTrackIDsRequest, opts ...grpc.CallOption) (*playlistService.ListTrackIDsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrackIDs", varargs...)
+	ret0, _ := ret[0].(*playlistService.ListTrackIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrackIDs indicates an expected call of ListTrackIDs.
+func (mr *MockPlaylistServiceClientMockRecorder) ListTrackIDs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrackIDs", reflect.TypeOf((*MockPlaylistServiceClient)(nil).ListTrackIDs), varargs...)
+}
+
+// MockPlaylistServiceServer is a mock of PlaylistServiceServer interface.
+type MockPlaylistServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaylistServiceServerMockRecorder
+}
+
+// MockPlaylistServiceServerMockRecorder is the mock recorder for MockPlaylistServiceServer.
+type MockPlaylistServiceServerMockRecorder struct {
+	mock *MockPlaylistServiceServer
+}
+
+// NewMockPlaylistServiceServer creates a new mock instance.
+func NewMockPlaylistServiceServer(ctrl *gomock.Controller) *MockPlaylistServiceServer {
+	mock := &MockPlaylistServiceServer{ctrl: ctrl}
+	mock.recorder = &MockPlaylistServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaylistServiceServer) EXPECT() *MockPlaylistServiceServerMockRecorder {
+	return m.recorder
+}
+
+// FindByID mocks base method.
+func (m *MockPlaylistServiceServer) FindByID(arg0 context.Context, arg1 *playlistService.FindByIDRequest) (*playlistService.FindByIDResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.FindByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPlaylistServiceServerMockRecorder) FindByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPlaylistServiceServer)(nil).FindByID), arg0, arg1)
+}
+
+// FindByIDs mocks base method.
+func (m *MockPlaylistServiceServer) FindByIDs(arg0 context.Context, arg1 *playlistService.FindByIDsRequest) (*playlistService.FindByIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.FindByIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockPlaylistServiceServerMockRecorder) FindByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockPlaylistServiceServer)(nil).FindByIDs), arg0, arg1)
+}
+
+// IsFavorite mocks base method.
+func (m *MockPlaylistServiceServer) IsFavorite(arg0 context.Context, arg1 *playlistService.IsFavoriteRequest) (*playlistService.IsFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.IsFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFavorite indicates an expected call of IsFavorite.
+func (mr *MockPlaylistServiceServerMockRecorder) IsFavorite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavorite", reflect.TypeOf((*MockPlaylistServiceServer)(nil).IsFavorite), arg0, arg1)
+}
+
+// ListByOwner mocks base method.
+func (m *MockPlaylistServiceServer) ListByOwner(arg0 context.Context, arg1 *playlistService.ListByOwnerRequest) (*playlistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwner", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwner indicates an expected call of ListByOwner.
+func (mr *MockPlaylistServiceServerMockRecorder) ListByOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwner", reflect.TypeOf((*MockPlaylistServiceServer)(nil).ListByOwner), arg0, arg1)
+}
+
+// ListFavorites mocks base method.
+func (m *MockPlaylistServiceServer) ListFavorites(arg0 context.Context, arg1 *playlistService.ListFavoritesRequest) (*playlistService.ListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavorites", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.ListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavorites indicates an expected call of ListFavorites.
+func (mr *MockPlaylistServiceServerMockRecorder) ListFavorites(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavorites", reflect.TypeOf((*MockPlaylistServiceServer)(nil).ListFavorites), arg0, arg1)
+}
+
+// ListTrackIDs mocks base method.
+func (m *MockPlaylistServiceServer) ListTrackIDs(arg0 context.Context, arg1 *playlistService.ListTrackIDsRequest) (*playlistService.ListTrackIDsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrackIDs", arg0, arg1)
+	ret0, _ := ret[0].(*playlistService.ListTrackIDsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrackIDs indicates an expected call of ListTrackIDs.
+func (mr *MockPlaylistServiceServerMockRecorder) ListTrackIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrackIDs", reflect.TypeOf((*MockPlaylistServiceServer)(nil).ListTrackIDs), arg0, arg1)
+}
+
+// mustEmbedUnimplementedPlaylistServiceServer mocks base method.
+func (m *MockPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedPlaylistServiceServer")
+}
+
+// mustEmbedUnimplementedPlaylistServiceServer indicates an expected call of mustEmbedUnimplementedPlaylistServiceServer.
+func (mr *MockPlaylistServiceServerMockRecorder) mustEmbedUnimplementedPlaylistServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPlaylistServiceServer", reflect.TypeOf((*MockPlaylistServiceServer)(nil).mustEmbedUnimplementedPlaylistServiceServer))
+}
+
+// MockUnsafePlaylistServiceServer is a mock of UnsafePlaylistServiceServer interface.
+type MockUnsafePlaylistServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafePlaylistServiceServerMockRecorder
+}
+
+// MockUnsafePlaylistServiceServerMockRecorder is the mock recorder for MockUnsafePlaylistServiceServer.
+type MockUnsafePlaylistServiceServerMockRecorder struct {
+	mock *MockUnsafePlaylistServiceServer
+}
+
+// NewMockUnsafePlaylistServiceServer creates a new mock instance.
+func NewMockUnsafePlaylistServiceServer(ctrl *gomock.Controller) *MockUnsafePlaylistServiceServer {
+	mock := &MockUnsafePlaylistServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafePlaylistServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafePlaylistServiceServer) EXPECT() *MockUnsafePlaylistServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedPlaylistServiceServer mocks base method.
+func (m *MockUnsafePlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedPlaylistServiceServer")
+}
+
+// mustEmbedUnimplementedPlaylistServiceServer indicates an expected call of mustEmbedUnimplementedPlaylistServiceServer.
+func (mr *MockUnsafePlaylistServiceServerMockRecorder) mustEmbedUnimplementedPlaylistServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedPlaylistServiceServer", reflect.TypeOf((*MockUnsafePlaylistServiceServer)(nil).mustEmbedUnimplementedPlaylistServiceServer))
+}
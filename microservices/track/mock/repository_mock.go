@@ -50,6 +50,21 @@ func (mr *MockRepoMockRecorder) AddFavoriteTrack(ctx, userID, trackID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoriteTrack", reflect.TypeOf((*MockRepo)(nil).AddFavoriteTrack), ctx, userID, trackID)
 }
 
+// Count mocks base method.
+func (m *MockRepo) Count(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockRepoMockRecorder) Count(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepo)(nil).Count), ctx)
+}
+
 // Create mocks base method.
 func (m *MockRepo) Create(ctx context.Context, track *models.Track) (*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,36 @@ func (mr *MockRepoMockRecorder) DeleteFavoriteTrack(ctx, userID, trackID interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteTrack", reflect.TypeOf((*MockRepo)(nil).DeleteFavoriteTrack), ctx, userID, trackID)
 }
 
+// DeleteLyrics mocks base method.
+func (m *MockRepo) DeleteLyrics(ctx context.Context, trackID uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLyrics", ctx, trackID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics.
+func (mr *MockRepoMockRecorder) DeleteLyrics(ctx, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockRepo)(nil).DeleteLyrics), ctx, trackID)
+}
+
+// FindByIDs mocks base method.
+func (m *MockRepo) FindByIDs(ctx context.Context, trackIDs []uint64) ([]*models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, trackIDs)
+	ret0, _ := ret[0].([]*models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockRepoMockRecorder) FindByIDs(ctx, trackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockRepo)(nil).FindByIDs), ctx, trackIDs)
+}
+
 // FindById mocks base method.
 func (m *MockRepo) FindById(ctx context.Context, trackID uint64) (*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +139,21 @@ func (mr *MockRepoMockRecorder) FindById(ctx, trackID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRepo)(nil).FindById), ctx, trackID)
 }
 
+// FindByLyrics mocks base method.
+func (m *MockRepo) FindByLyrics(ctx context.Context, query string, limit uint64) ([]*models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByLyrics", ctx, query, limit)
+	ret0, _ := ret[0].([]*models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByLyrics indicates an expected call of FindByLyrics.
+func (mr *MockRepoMockRecorder) FindByLyrics(ctx, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLyrics", reflect.TypeOf((*MockRepo)(nil).FindByLyrics), ctx, query, limit)
+}
+
 // FindByQuery mocks base method.
 func (m *MockRepo) FindByQuery(ctx context.Context, query string) ([]*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +169,21 @@ func (mr *MockRepoMockRecorder) FindByQuery(ctx, query interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByQuery", reflect.TypeOf((*MockRepo)(nil).FindByQuery), ctx, query)
 }
 
+// FindLyrics mocks base method.
+func (m *MockRepo) FindLyrics(ctx context.Context, trackID uint64) (*models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLyrics", ctx, trackID)
+	ret0, _ := ret[0].(*models.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLyrics indicates an expected call of FindLyrics.
+func (mr *MockRepoMockRecorder) FindLyrics(ctx, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLyrics", reflect.TypeOf((*MockRepo)(nil).FindLyrics), ctx, trackID)
+}
+
 // GetAll mocks base method.
 func (m *MockRepo) GetAll(ctx context.Context) ([]*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +214,21 @@ func (mr *MockRepoMockRecorder) GetAllByAlbumID(ctx, albumID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByAlbumID", reflect.TypeOf((*MockRepo)(nil).GetAllByAlbumID), ctx, albumID)
 }
 
+// GetAllByAlbumIDs mocks base method.
+func (m *MockRepo) GetAllByAlbumIDs(ctx context.Context, albumIDs []uint64) ([]*models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByAlbumIDs", ctx, albumIDs)
+	ret0, _ := ret[0].([]*models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByAlbumIDs indicates an expected call of GetAllByAlbumIDs.
+func (mr *MockRepoMockRecorder) GetAllByAlbumIDs(ctx, albumIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByAlbumIDs", reflect.TypeOf((*MockRepo)(nil).GetAllByAlbumIDs), ctx, albumIDs)
+}
+
 // GetAllByArtistID mocks base method.
 func (m *MockRepo) GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +259,21 @@ func (mr *MockRepoMockRecorder) GetFavoriteTracks(ctx, userID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockRepo)(nil).GetFavoriteTracks), ctx, userID)
 }
 
+// GetPage mocks base method.
+func (m *MockRepo) GetPage(ctx context.Context, afterID, limit uint64) ([]*models.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPage", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*models.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPage indicates an expected call of GetPage.
+func (mr *MockRepoMockRecorder) GetPage(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPage", reflect.TypeOf((*MockRepo)(nil).GetPage), ctx, afterID, limit)
+}
+
 // GetPopular mocks base method.
 func (m *MockRepo) GetPopular(ctx context.Context) ([]*models.Track, error) {
 	m.ctrl.T.Helper()
@@ -184,19 +289,19 @@ func (mr *MockRepoMockRecorder) GetPopular(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopular", reflect.TypeOf((*MockRepo)(nil).GetPopular), ctx)
 }
 
-// GetTracksFromPlaylist mocks base method.
-func (m *MockRepo) GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*models.PlaylistTrack, error) {
+// GetRadioCandidates mocks base method.
+func (m *MockRepo) GetRadioCandidates(ctx context.Context, trackID uint64, excludeIDs []uint64, limit uint64) ([]*models.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTracksFromPlaylist", ctx, playlistID)
-	ret0, _ := ret[0].([]*models.PlaylistTrack)
+	ret := m.ctrl.Call(m, "GetRadioCandidates", ctx, trackID, excludeIDs, limit)
+	ret0, _ := ret[0].([]*models.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTracksFromPlaylist indicates an expected call of GetTracksFromPlaylist.
-func (mr *MockRepoMockRecorder) GetTracksFromPlaylist(ctx, playlistID interface{}) *gomock.Call {
+// GetRadioCandidates indicates an expected call of GetRadioCandidates.
+func (mr *MockRepoMockRecorder) GetRadioCandidates(ctx, trackID, excludeIDs, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracksFromPlaylist", reflect.TypeOf((*MockRepo)(nil).GetTracksFromPlaylist), ctx, playlistID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRadioCandidates", reflect.TypeOf((*MockRepo)(nil).GetRadioCandidates), ctx, trackID, excludeIDs, limit)
 }
 
 // IsFavoriteTrack mocks base method.
@@ -213,3 +318,18 @@ func (mr *MockRepoMockRecorder) IsFavoriteTrack(ctx, userID, trackID interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoriteTrack", reflect.TypeOf((*MockRepo)(nil).IsFavoriteTrack), ctx, userID, trackID)
 }
+
+// SaveLyrics mocks base method.
+func (m *MockRepo) SaveLyrics(ctx context.Context, lyrics *models.Lyrics) (*models.Lyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLyrics", ctx, lyrics)
+	ret0, _ := ret[0].(*models.Lyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveLyrics indicates an expected call of SaveLyrics.
+func (mr *MockRepoMockRecorder) SaveLyrics(ctx, lyrics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLyrics", reflect.TypeOf((*MockRepo)(nil).SaveLyrics), ctx, lyrics)
+}
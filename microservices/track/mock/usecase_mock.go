@@ -80,6 +80,20 @@ func (mr *MockUsecaseMockRecorder) DeleteFavoriteTrack(ctx, userID, trackID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavoriteTrack", reflect.TypeOf((*MockUsecase)(nil).DeleteFavoriteTrack), ctx, userID, trackID)
 }
 
+// DeleteLyrics mocks base method.
+func (m *MockUsecase) DeleteLyrics(ctx context.Context, trackID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLyrics", ctx, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLyrics indicates an expected call of DeleteLyrics.
+func (mr *MockUsecaseMockRecorder) DeleteLyrics(ctx, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLyrics", reflect.TypeOf((*MockUsecase)(nil).DeleteLyrics), ctx, trackID)
+}
+
 // GetAll mocks base method.
 func (m *MockUsecase) GetAll(ctx context.Context) ([]*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +139,21 @@ func (mr *MockUsecaseMockRecorder) GetAllByArtistID(ctx, artistID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByArtistID", reflect.TypeOf((*MockUsecase)(nil).GetAllByArtistID), ctx, artistID)
 }
 
+// GetByIDs mocks base method.
+func (m *MockUsecase) GetByIDs(ctx context.Context, trackIDs []uint64) ([]*dto.TrackDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, trackIDs)
+	ret0, _ := ret[0].([]*dto.TrackDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockUsecaseMockRecorder) GetByIDs(ctx, trackIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUsecase)(nil).GetByIDs), ctx, trackIDs)
+}
+
 // GetFavoriteTracks mocks base method.
 func (m *MockUsecase) GetFavoriteTracks(ctx context.Context, userID uuid.UUID) ([]*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +169,21 @@ func (mr *MockUsecaseMockRecorder) GetFavoriteTracks(ctx, userID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockUsecase)(nil).GetFavoriteTracks), ctx, userID)
 }
 
+// GetLyrics mocks base method.
+func (m *MockUsecase) GetLyrics(ctx context.Context, trackID uint64) (*dto.LyricsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLyrics", ctx, trackID)
+	ret0, _ := ret[0].(*dto.LyricsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLyrics indicates an expected call of GetLyrics.
+func (mr *MockUsecaseMockRecorder) GetLyrics(ctx, trackID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLyrics", reflect.TypeOf((*MockUsecase)(nil).GetLyrics), ctx, trackID)
+}
+
 // GetPopular mocks base method.
 func (m *MockUsecase) GetPopular(ctx context.Context) ([]*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +199,21 @@ func (mr *MockUsecaseMockRecorder) GetPopular(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopular", reflect.TypeOf((*MockUsecase)(nil).GetPopular), ctx)
 }
 
+// GetRadio mocks base method.
+func (m *MockUsecase) GetRadio(ctx context.Context, trackID uint64, seenIDs []uint64, limit uint64) ([]*dto.TrackDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRadio", ctx, trackID, seenIDs, limit)
+	ret0, _ := ret[0].([]*dto.TrackDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRadio indicates an expected call of GetRadio.
+func (mr *MockUsecaseMockRecorder) GetRadio(ctx, trackID, seenIDs, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRadio", reflect.TypeOf((*MockUsecase)(nil).GetRadio), ctx, trackID, seenIDs, limit)
+}
+
 // GetTracksFromPlaylist mocks base method.
 func (m *MockUsecase) GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +244,21 @@ func (mr *MockUsecaseMockRecorder) IsFavoriteTrack(ctx, userID, trackID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFavoriteTrack", reflect.TypeOf((*MockUsecase)(nil).IsFavoriteTrack), ctx, userID, trackID)
 }
 
+// SaveLyrics mocks base method.
+func (m *MockUsecase) SaveLyrics(ctx context.Context, trackID uint64, lyricsInputDTO *dto.LyricsInputDTO) (*dto.LyricsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLyrics", ctx, trackID, lyricsInputDTO)
+	ret0, _ := ret[0].(*dto.LyricsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveLyrics indicates an expected call of SaveLyrics.
+func (mr *MockUsecaseMockRecorder) SaveLyrics(ctx, trackID, lyricsInputDTO interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLyrics", reflect.TypeOf((*MockUsecase)(nil).SaveLyrics), ctx, trackID, lyricsInputDTO)
+}
+
 // Search mocks base method.
 func (m *MockUsecase) Search(ctx context.Context, query string) ([]*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +274,21 @@ func (mr *MockUsecaseMockRecorder) Search(ctx, query interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsecase)(nil).Search), ctx, query)
 }
 
+// SearchByLyrics mocks base method.
+func (m *MockUsecase) SearchByLyrics(ctx context.Context, query string) ([]*dto.TrackDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchByLyrics", ctx, query)
+	ret0, _ := ret[0].([]*dto.TrackDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchByLyrics indicates an expected call of SearchByLyrics.
+func (mr *MockUsecaseMockRecorder) SearchByLyrics(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchByLyrics", reflect.TypeOf((*MockUsecase)(nil).SearchByLyrics), ctx, query)
+}
+
 // View mocks base method.
 func (m *MockUsecase) View(ctx context.Context, trackID uint64) (*dto.TrackDTO, error) {
 	m.ctrl.T.Helper()
@@ -10,14 +10,24 @@ import (
 type Repo interface {
 	Create(ctx context.Context, track *models.Track) (*models.Track, error)
 	FindById(ctx context.Context, trackID uint64) (*models.Track, error)
+	FindByIDs(ctx context.Context, trackIDs []uint64) ([]*models.Track, error)
 	GetAll(ctx context.Context) ([]*models.Track, error)
+	// GetPage returns up to limit tracks with ids over afterID, ordered by
+	// id.
+	GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Track, error)
+	Count(ctx context.Context) (uint64, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Track, error)
 	FindByQuery(ctx context.Context, query string) ([]*models.Track, error)
 	GetAllByAlbumID(ctx context.Context, albumID uint64) ([]*models.Track, error)
+	GetAllByAlbumIDs(ctx context.Context, albumIDs []uint64) ([]*models.Track, error)
 	AddFavoriteTrack(ctx context.Context, userID uuid.UUID, trackID uint64) error
 	DeleteFavoriteTrack(ctx context.Context, userID uuid.UUID, trackID uint64) error
 	IsFavoriteTrack(ctx context.Context, userID uuid.UUID, trackID uint64) (bool, error)
 	GetFavoriteTracks(ctx context.Context, userID uuid.UUID) ([]*models.Track, error)
-	GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*models.PlaylistTrack, error)
 	GetPopular(ctx context.Context) ([]*models.Track, error)
+	GetRadioCandidates(ctx context.Context, trackID uint64, excludeIDs []uint64, limit uint64) ([]*models.Track, error)
+	FindLyrics(ctx context.Context, trackID uint64) (*models.Lyrics, error)
+	SaveLyrics(ctx context.Context, lyrics *models.Lyrics) (*models.Lyrics, error)
+	DeleteLyrics(ctx context.Context, trackID uint64) (bool, error)
+	FindByLyrics(ctx context.Context, query string, limit uint64) ([]*models.Track, error)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 )
 
@@ -74,6 +75,44 @@ func (r *TrackRepository) FindById(ctx context.Context, trackID uint64) (*models
 	return track, nil
 }
 
+// FindByIDs returns the tracks in the order of ids, missing ones are skipped.
+func (r *TrackRepository) FindByIDs(ctx context.Context, trackIDs []uint64) ([]*models.Track, error) {
+	ids := make([]int64, 0, len(trackIDs))
+	for _, id := range trackIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var tracks []*models.Track
+	rows, err := r.db.QueryContext(ctx, findByIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		track := &models.Track{}
+		err := rows.Scan(
+			&track.ID,
+			&track.Name,
+			&track.Duration,
+			&track.FilePath,
+			&track.Image,
+			&track.ArtistID,
+			&track.AlbumID,
+			&track.OrderInAlbum,
+			&track.ReleaseDate,
+			&track.CreatedAt,
+			&track.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindByIDs.Query")
+		}
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
 func (r *TrackRepository) FindByQuery(ctx context.Context, query string) ([]*models.Track, error) {
 	tsQuery := utils.MakeSearchQuery(query)
 
@@ -141,6 +180,47 @@ func (r *TrackRepository) GetAll(ctx context.Context) ([]*models.Track, error) {
 	return tracks, nil
 }
 
+func (r *TrackRepository) GetPage(ctx context.Context, afterID uint64, limit uint64) ([]*models.Track, error) {
+	var tracks []*models.Track
+	rows, err := r.db.QueryContext(ctx, getPageQuery, afterID, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPage.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		track := &models.Track{}
+		err := rows.Scan(
+			&track.ID,
+			&track.Name,
+			&track.Duration,
+			&track.FilePath,
+			&track.Image,
+			&track.ArtistID,
+			&track.AlbumID,
+			&track.OrderInAlbum,
+			&track.ReleaseDate,
+			&track.CreatedAt,
+			&track.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetPage.Query")
+		}
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
+func (r *TrackRepository) Count(ctx context.Context) (uint64, error) {
+	var count uint64
+	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, "Count.Query")
+	}
+
+	return count, nil
+}
+
 func (r *TrackRepository) GetAllByArtistID(ctx context.Context, artistID uint64) ([]*models.Track, error) {
 	var tracks []*models.Track
 	rows, err := r.db.QueryContext(ctx, getByArtistIDQuery, artistID)
@@ -205,6 +285,44 @@ func (r *TrackRepository) GetAllByAlbumID(ctx context.Context, albumID uint64) (
 	return tracks, nil
 }
 
+// GetAllByAlbumIDs returns the tracks of all the albums ordered as in albums.
+func (r *TrackRepository) GetAllByAlbumIDs(ctx context.Context, albumIDs []uint64) ([]*models.Track, error) {
+	ids := make([]int64, 0, len(albumIDs))
+	for _, id := range albumIDs {
+		ids = append(ids, int64(id))
+	}
+
+	var tracks []*models.Track
+	rows, err := r.db.QueryContext(ctx, getByAlbumIDsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, errors.Wrap(err, "GetAllByAlbumIDs.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		track := &models.Track{}
+		err := rows.Scan(
+			&track.ID,
+			&track.Name,
+			&track.Duration,
+			&track.FilePath,
+			&track.Image,
+			&track.ArtistID,
+			&track.AlbumID,
+			&track.OrderInAlbum,
+			&track.ReleaseDate,
+			&track.CreatedAt,
+			&track.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetAllByAlbumIDs.Query")
+		}
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
 func (r *TrackRepository) AddFavoriteTrack(ctx context.Context, userID uuid.UUID, trackID uint64) error {
 	_, err := r.db.ExecContext(ctx, addFavoriteTrackQuery, userID, trackID)
 	if err != nil {
@@ -265,38 +383,49 @@ func (r *TrackRepository) GetFavoriteTracks(ctx context.Context, userID uuid.UUI
 	return tracks, nil
 }
 
-func (r *TrackRepository) GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*models.PlaylistTrack, error) {
-	playlist := []*models.PlaylistTrack{}
-	rows, err := r.db.QueryContext(ctx,
-		getTracksFromPlaylistQuery,
-		playlistID,
-	)
+func (r *TrackRepository) GetPopular(ctx context.Context) ([]*models.Track, error) {
+	var tracks []*models.Track
+	rows, err := r.db.QueryContext(ctx, getPopularTracksQuery)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "GetPopular.Query")
 	}
+	defer rows.Close()
 
 	for rows.Next() {
-		track := &models.PlaylistTrack{}
-		if err := rows.Scan(
+		track := &models.Track{}
+		err := rows.Scan(
 			&track.ID,
-			&track.PlaylistID,
-			&track.TrackOrderInPlaylist,
-			&track.TrackID,
+			&track.Name,
+			&track.Duration,
+			&track.FilePath,
+			&track.Image,
+			&track.ArtistID,
+			&track.AlbumID,
+			&track.OrderInAlbum,
+			&track.ReleaseDate,
 			&track.CreatedAt,
-		); err != nil {
-			return nil, err
+			&track.UpdatedAt,
+			//&track.FavoriteCount,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetPopular.Query")
 		}
-		playlist = append(playlist, track)
+		tracks = append(tracks, track)
 	}
 
-	return playlist, nil
+	return tracks, nil
 }
 
-func (r *TrackRepository) GetPopular(ctx context.Context) ([]*models.Track, error) {
+func (r *TrackRepository) GetRadioCandidates(ctx context.Context, trackID uint64, excludeIDs []uint64, limit uint64) ([]*models.Track, error) {
+	exclude := make([]int64, 0, len(excludeIDs))
+	for _, id := range excludeIDs {
+		exclude = append(exclude, int64(id))
+	}
+
 	var tracks []*models.Track
-	rows, err := r.db.QueryContext(ctx, getPopularTracksQuery)
+	rows, err := r.db.QueryContext(ctx, getRadioCandidatesQuery, trackID, pq.Array(exclude), limit)
 	if err != nil {
-		return nil, errors.Wrap(err, "GetPopular.Query")
+		return nil, errors.Wrap(err, "GetRadioCandidates.Query")
 	}
 	defer rows.Close()
 
@@ -314,10 +443,89 @@ func (r *TrackRepository) GetPopular(ctx context.Context) ([]*models.Track, erro
 			&track.ReleaseDate,
 			&track.CreatedAt,
 			&track.UpdatedAt,
-			//&track.FavoriteCount,
 		)
 		if err != nil {
-			return nil, errors.Wrap(err, "GetPopular.Query")
+			return nil, errors.Wrap(err, "GetRadioCandidates.Query")
+		}
+		tracks = append(tracks, track)
+	}
+
+	return tracks, nil
+}
+
+func (r *TrackRepository) FindLyrics(ctx context.Context, trackID uint64) (*models.Lyrics, error) {
+	lyrics := &models.Lyrics{}
+	row := r.db.QueryRowContext(ctx, findLyricsQuery, trackID)
+	if err := row.Scan(
+		&lyrics.TrackID,
+		&lyrics.Synced,
+		&lyrics.Content,
+		&lyrics.PlainText,
+		&lyrics.CreatedAt,
+		&lyrics.UpdatedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "FindLyrics.Query")
+	}
+
+	return lyrics, nil
+}
+
+func (r *TrackRepository) SaveLyrics(ctx context.Context, lyrics *models.Lyrics) (*models.Lyrics, error) {
+	savedLyrics := &models.Lyrics{}
+	row := r.db.QueryRowContext(ctx, saveLyricsQuery, lyrics.TrackID, lyrics.Synced, lyrics.Content, lyrics.PlainText)
+	if err := row.Scan(
+		&savedLyrics.TrackID,
+		&savedLyrics.Synced,
+		&savedLyrics.Content,
+		&savedLyrics.PlainText,
+		&savedLyrics.CreatedAt,
+		&savedLyrics.UpdatedAt,
+	); err != nil {
+		return nil, errors.Wrap(err, "SaveLyrics.Query")
+	}
+
+	return savedLyrics, nil
+}
+
+func (r *TrackRepository) DeleteLyrics(ctx context.Context, trackID uint64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, deleteLyricsQuery, trackID)
+	if err != nil {
+		return false, errors.Wrap(err, "DeleteLyrics.Query")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "DeleteLyrics.RowsAffected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *TrackRepository) FindByLyrics(ctx context.Context, query string, limit uint64) ([]*models.Track, error) {
+	var tracks []*models.Track
+	rows, err := r.db.QueryContext(ctx, findByLyricsQuery, query, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "FindByLyrics.Query")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		track := &models.Track{}
+		err := rows.Scan(
+			&track.ID,
+			&track.Name,
+			&track.Duration,
+			&track.FilePath,
+			&track.Image,
+			&track.ArtistID,
+			&track.AlbumID,
+			&track.OrderInAlbum,
+			&track.ReleaseDate,
+			&track.CreatedAt,
+			&track.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "FindByLyrics.Query")
 		}
 		tracks = append(tracks, track)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -106,6 +107,57 @@ func TestTrackRepositoryFindById(t *testing.T) {
 	require.Equal(t, mockTrack.ID, foundTrack.ID)
 }
 
+func TestTrackRepositoryFindByIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackPGRepository := NewTrackPGRepository(db)
+	releaseDate := time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "name", "duration", "filepath", "image", "artist_id", "album_id", "track_order_in_album", "release", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(3, "song test", 99, "/songs/track_3.mp4", "/imgs/tracks/track_3.jpg", 3, 3, 1, releaseDate, releaseDate, releaseDate).
+		AddRow(1, "ok im cool", 167, "/songs/track_1.mp4", "/imgs/tracks/track_1.jpg", 1, 1, 1, releaseDate, releaseDate, releaseDate)
+
+	mock.ExpectQuery(findByIDsQuery).WithArgs(pq.Array([]int64{3, 2, 1})).WillReturnRows(rows)
+
+	foundTracks, err := trackPGRepository.FindByIDs(context.Background(), []uint64{3, 2, 1})
+	require.NoError(t, err)
+	require.Len(t, foundTracks, 2)
+	require.Equal(t, uint64(3), foundTracks[0].ID)
+	require.Equal(t, uint64(1), foundTracks[1].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositoryGetAllByAlbumIDs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackPGRepository := NewTrackPGRepository(db)
+	releaseDate := time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "name", "duration", "filepath", "image", "artist_id", "album_id", "track_order_in_album", "release", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(1, "ok im cool", 167, "/songs/track_1.mp4", "/imgs/tracks/track_1.jpg", 1, 1, 1, releaseDate, releaseDate, releaseDate).
+		AddRow(2, "song test", 99, "/songs/track_2.mp4", "/imgs/tracks/track_2.jpg", 1, 1, 2, releaseDate, releaseDate, releaseDate).
+		AddRow(5, "another song", 93, "/songs/track_5.mp4", "/imgs/tracks/track_5.jpg", 2, 3, 1, releaseDate, releaseDate, releaseDate)
+
+	mock.ExpectQuery(getByAlbumIDsQuery).WithArgs(pq.Array([]int64{1, 3})).WillReturnRows(rows)
+
+	foundTracks, err := trackPGRepository.GetAllByAlbumIDs(context.Background(), []uint64{1, 3})
+	require.NoError(t, err)
+	require.Len(t, foundTracks, 3)
+	require.Equal(t, uint64(1), foundTracks[1].AlbumID)
+	require.Equal(t, uint64(3), foundTracks[2].AlbumID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestTrackRepositoryFindByQuery(t *testing.T) {
 	t.Parallel()
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
@@ -576,3 +628,222 @@ func TestTrackRepositoryGetPopular(t *testing.T) {
 	require.NotNil(t, foundTracks)
 	require.Equal(t, foundTracks, expectedTracks)
 }
+
+func TestTrackRepositoryGetRadioCandidates(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackRepository := NewTrackPGRepository(db)
+
+	tracks := []models.Track{
+		{
+			ID:           2,
+			Name:         "another song",
+			Duration:     93,
+			FilePath:     "/songs/track_2.mp4",
+			Image:        "/imgs/tracks/track_2.jpg",
+			ArtistID:     2,
+			AlbumID:      2,
+			OrderInAlbum: 1,
+			ReleaseDate:  time.Date(2020, 7, 5, 0, 0, 0, 0, time.UTC),
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		},
+	}
+
+	columns := []string{"id", "name", "duration", "filepath", "image", "artist_id", "album_id", "track_order_in_album", "release_date", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns)
+	for _, track := range tracks {
+		rows.AddRow(
+			track.ID,
+			track.Name,
+			track.Duration,
+			track.FilePath,
+			track.Image,
+			track.ArtistID,
+			track.AlbumID,
+			track.OrderInAlbum,
+			track.ReleaseDate,
+			track.CreatedAt,
+			track.UpdatedAt,
+		)
+	}
+
+	mock.ExpectQuery(getRadioCandidatesQuery).WithArgs(uint64(1), sqlmock.AnyArg(), uint64(10)).WillReturnRows(rows)
+
+	expectedTracks := []*models.Track{&tracks[0]}
+	foundTracks, err := trackRepository.GetRadioCandidates(context.Background(), uint64(1), []uint64{3, 4}, uint64(10))
+	require.NoError(t, err)
+	require.Equal(t, expectedTracks, foundTracks)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositorySaveLyrics(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackRepository := NewTrackPGRepository(db)
+
+	lyrics := &models.Lyrics{
+		TrackID:   1,
+		Synced:    true,
+		Content:   "[00:01.00]hello",
+		PlainText: "hello",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	columns := []string{"track_id", "synced", "content", "plain_text", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).AddRow(
+		lyrics.TrackID,
+		lyrics.Synced,
+		lyrics.Content,
+		lyrics.PlainText,
+		lyrics.CreatedAt,
+		lyrics.UpdatedAt,
+	)
+
+	mock.ExpectQuery(saveLyricsQuery).WithArgs(lyrics.TrackID, lyrics.Synced, lyrics.Content, lyrics.PlainText).WillReturnRows(rows)
+
+	savedLyrics, err := trackRepository.SaveLyrics(context.Background(), lyrics)
+	require.NoError(t, err)
+	require.Equal(t, lyrics, savedLyrics)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositoryFindLyrics(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackRepository := NewTrackPGRepository(db)
+
+	lyrics := &models.Lyrics{
+		TrackID:   1,
+		Content:   "hello",
+		PlainText: "hello",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	columns := []string{"track_id", "synced", "content", "plain_text", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).AddRow(
+		lyrics.TrackID,
+		lyrics.Synced,
+		lyrics.Content,
+		lyrics.PlainText,
+		lyrics.CreatedAt,
+		lyrics.UpdatedAt,
+	)
+
+	mock.ExpectQuery(findLyricsQuery).WithArgs(lyrics.TrackID).WillReturnRows(rows)
+
+	foundLyrics, err := trackRepository.FindLyrics(context.Background(), lyrics.TrackID)
+	require.NoError(t, err)
+	require.Equal(t, lyrics, foundLyrics)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositoryDeleteLyrics(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackRepository := NewTrackPGRepository(db)
+
+	mock.ExpectExec(deleteLyricsQuery).WithArgs(uint64(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(deleteLyricsQuery).WithArgs(uint64(2)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	deleted, err := trackRepository.DeleteLyrics(context.Background(), uint64(1))
+	require.NoError(t, err)
+	require.True(t, deleted)
+
+	deleted, err = trackRepository.DeleteLyrics(context.Background(), uint64(2))
+	require.NoError(t, err)
+	require.False(t, deleted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositoryFindByLyrics(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackRepository := NewTrackPGRepository(db)
+
+	track := models.Track{
+		ID:           1,
+		Name:         "ok im cool",
+		Duration:     167,
+		FilePath:     "/songs/track_1.mp4",
+		Image:        "/imgs/tracks/track_1.jpg",
+		ArtistID:     1,
+		AlbumID:      1,
+		OrderInAlbum: 1,
+		ReleaseDate:  time.Date(2020, 7, 5, 0, 0, 0, 0, time.UTC),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	columns := []string{"id", "name", "duration", "filepath", "image", "artist_id", "album_id", "track_order_in_album", "release_date", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).AddRow(
+		track.ID,
+		track.Name,
+		track.Duration,
+		track.FilePath,
+		track.Image,
+		track.ArtistID,
+		track.AlbumID,
+		track.OrderInAlbum,
+		track.ReleaseDate,
+		track.CreatedAt,
+		track.UpdatedAt,
+	)
+
+	mock.ExpectQuery(findByLyricsQuery).WithArgs("hello world", uint64(20)).WillReturnRows(rows)
+
+	foundTracks, err := trackRepository.FindByLyrics(context.Background(), "hello world", uint64(20))
+	require.NoError(t, err)
+	require.Equal(t, []*models.Track{&track}, foundTracks)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackRepositoryGetPage(t *testing.T) {
+	t.Parallel()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	trackPGRepository := NewTrackPGRepository(db)
+	releaseDate := time.Date(2020, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	columns := []string{"id", "name", "duration", "filepath", "image", "artist_id", "album_id", "track_order_in_album", "release", "created_at", "updated_at"}
+	rows := sqlmock.NewRows(columns).
+		AddRow(3, "song test", 99, "/songs/track_3.mp4", "/imgs/tracks/track_3.jpg", 3, 3, 1, releaseDate, releaseDate, releaseDate).
+		AddRow(4, "another song", 93, "/songs/track_4.mp4", "/imgs/tracks/track_4.jpg", 2, 2, 1, releaseDate, releaseDate, releaseDate)
+	mock.ExpectQuery(getPageQuery).WithArgs(uint64(2), uint64(2)).WillReturnRows(rows)
+
+	foundTracks, err := trackPGRepository.GetPage(context.Background(), 2, 2)
+	require.NoError(t, err)
+	require.Len(t, foundTracks, 2)
+	require.Equal(t, uint64(3), foundTracks[0].ID)
+
+	mock.ExpectQuery(countQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	count, err := trackPGRepository.Count(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,8 +7,14 @@ const (
 
 	findByIDQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE id = $1`
 
+	findByIDsQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE id = ANY($1::INT[]) ORDER BY array_position($1::INT[], id)`
+
 	getAllQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track`
 
+	getPageQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE id > $1 ORDER BY id LIMIT $2`
+
+	countQuery = `SELECT count(*) FROM track`
+
 	findByQuery = `
     SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at
     FROM "track"
@@ -17,7 +23,9 @@ const (
 
 	getByArtistIDQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE artist_id = $1`
 
-	getByAlbumIDQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE artist_id = $1 ORDER BY track_order_in_album ASC`
+	getByAlbumIDQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE album_id = $1 ORDER BY track_order_in_album ASC`
+
+	getByAlbumIDsQuery = `SELECT id, name, duration, filepath, image, artist_id, album_id, track_order_in_album, release_date, created_at, updated_at FROM track WHERE album_id = ANY($1::INT[]) ORDER BY album_id, track_order_in_album ASC`
 
 	addFavoriteTrackQuery = `
     INSERT INTO favorite_track (user_id, track_id) 
@@ -40,8 +48,6 @@ const (
       ON t.id = ft.track_id
     WHERE ft.user_id = $1`
 
-	getTracksFromPlaylistQuery = `SELECT id, playlist_id, track_order_in_playlist, track_id, created_at FROM playlist_track WHERE playlist_id = $1 ORDER BY created_at DESC`
-
 	getPopularTracksQuery = `SELECT 
     t.id, 
     t.name, 
@@ -64,4 +70,60 @@ const (
         COUNT(ft.track_id) DESC
     LIMIT 50;
 `
+
+	getRadioCandidatesQuery = `
+    WITH seed AS (
+        SELECT id, artist_id FROM track WHERE id = $1
+    ),
+    seed_genres AS (
+        SELECT genre_id FROM genre_track WHERE track_id = $1
+    ),
+    seed_artist_genres AS (
+        SELECT ga.genre_id FROM genre_artist ga JOIN seed s ON ga.artist_id = s.artist_id
+    ),
+    seed_fans AS (
+        SELECT user_id FROM favorite_track WHERE track_id = $1
+    ),
+    scored AS (
+        SELECT
+            t.id,
+            3 * (SELECT COUNT(*) FROM genre_track gt
+                WHERE gt.track_id = t.id AND gt.genre_id IN (SELECT genre_id FROM seed_genres))
+            + 2 * (SELECT COUNT(*) FROM favorite_track ft
+                WHERE ft.track_id = t.id AND ft.user_id IN (SELECT user_id FROM seed_fans))
+            + (SELECT COUNT(*) FROM genre_artist ga
+                WHERE ga.artist_id = t.artist_id AND ga.genre_id IN (SELECT genre_id FROM seed_artist_genres)) AS score,
+            (SELECT COUNT(*) FROM favorite_track ft WHERE ft.track_id = t.id) AS popularity
+        FROM track t
+        WHERE t.id <> $1 AND NOT (t.id = ANY($2::INT[]))
+    )
+    SELECT t.id, t.name, t.duration, t.filepath, t.image, t.artist_id, t.album_id, t.track_order_in_album, t.release_date, t.created_at, t.updated_at
+    FROM track t
+      JOIN scored s ON t.id = s.id
+    ORDER BY s.score DESC, s.popularity DESC, t.id
+    LIMIT $3`
+
+	findLyricsQuery = `SELECT track_id, synced, content, plain_text, created_at, updated_at FROM track_lyrics WHERE track_id = $1`
+
+	saveLyricsQuery = `
+    INSERT INTO track_lyrics (track_id, synced, content, plain_text)
+    VALUES ($1, $2, $3, $4)
+    ON CONFLICT (track_id) DO UPDATE SET
+      synced = EXCLUDED.synced,
+      content = EXCLUDED.content,
+      plain_text = EXCLUDED.plain_text,
+      updated_at = NOW()
+    RETURNING track_id, synced, content, plain_text, created_at, updated_at`
+
+	deleteLyricsQuery = `DELETE FROM track_lyrics WHERE track_id = $1`
+
+	findByLyricsQuery = `
+    SELECT t.id, t.name, t.duration, t.filepath, t.image, t.artist_id, t.album_id, t.track_order_in_album, t.release_date, t.created_at, t.updated_at
+    FROM track AS t
+      JOIN track_lyrics AS tl ON t.id = tl.track_id,
+      websearch_to_tsquery('english', $1) AS en,
+      websearch_to_tsquery('russian_hunspell', $1) AS ru
+    WHERE tl.fts @@ en OR tl.fts @@ ru
+    ORDER BY ts_rank(tl.fts, en || ru) DESC, t.id
+    LIMIT $2`
 )
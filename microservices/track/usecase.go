@@ -10,6 +10,7 @@ import (
 
 type Usecase interface {
 	View(ctx context.Context, trackID uint64) (*dto.TrackDTO, error)
+	GetByIDs(ctx context.Context, trackIDs []uint64) ([]*dto.TrackDTO, error)
 	Search(ctx context.Context, query string) ([]*dto.TrackDTO, error)
 	GetAll(ctx context.Context) ([]*dto.TrackDTO, error)
 	GetAllByArtistID(ctx context.Context, artistID uint64) ([]*dto.TrackDTO, error)
@@ -21,4 +22,9 @@ type Usecase interface {
 	ConvertTrackToDTO(ctx context.Context, track *models.Track) (*dto.TrackDTO, error)
 	GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*dto.TrackDTO, error)
 	GetPopular(ctx context.Context) ([]*dto.TrackDTO, error)
+	GetRadio(ctx context.Context, trackID uint64, seenIDs []uint64, limit uint64) ([]*dto.TrackDTO, error)
+	GetLyrics(ctx context.Context, trackID uint64) (*dto.LyricsDTO, error)
+	SaveLyrics(ctx context.Context, trackID uint64, lyricsInputDTO *dto.LyricsInputDTO) (*dto.LyricsDTO, error)
+	DeleteLyrics(ctx context.Context, trackID uint64) error
+	SearchByLyrics(ctx context.Context, query string) ([]*dto.TrackDTO, error)
 }
@@ -2,33 +2,51 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/lrc"
 	uuid "github.com/google/uuid"
 )
 
+// radioCandidatesFactor is how many more candidates than requested are
+// fetched, so there is room to reorder them without repeating an artist.
+const radioCandidatesFactor = 3
+
+const (
+	maxLyricsLength   = 20000
+	lyricsSearchLimit = 20
+)
+
 type trackUsecase struct {
-	trackRepo    track.Repo
-	artistClient artistService.ArtistServiceClient
-	albumClient  albumService.AlbumServiceClient
-	logger       logger.Logger
+	trackRepo      track.Repo
+	artistClient   artistService.ArtistServiceClient
+	albumClient    albumService.AlbumServiceClient
+	playlistClient playlistService.PlaylistServiceClient
+	logger         logger.Logger
 }
 
 func NewTrackUsecase(
 	trackRepo track.Repo,
 	artistClient artistService.ArtistServiceClient,
 	albumClient albumService.AlbumServiceClient,
+	playlistClient playlistService.PlaylistServiceClient,
 	logger logger.Logger,
 ) track.Usecase {
-	return &trackUsecase{trackRepo, artistClient, albumClient, logger}
+	return &trackUsecase{trackRepo, artistClient, albumClient, playlistClient, logger}
 }
 
 func (usecase *trackUsecase) View(ctx context.Context, trackID uint64) (*dto.TrackDTO, error) {
@@ -49,6 +67,28 @@ func (usecase *trackUsecase) View(ctx context.Context, trackID uint64) (*dto.Tra
 	return dtoTrack, nil
 }
 
+func (usecase *trackUsecase) GetByIDs(ctx context.Context, trackIDs []uint64) ([]*dto.TrackDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	tracks, err := usecase.trackRepo.FindByIDs(ctx, trackIDs)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load tracks %v: %v", trackIDs, err), requestID)
+		return nil, fmt.Errorf("Can't load tracks")
+	}
+	usecase.logger.Infof("Found %d of %d tracks", len(tracks), len(trackIDs))
+
+	dtoTracks := []*dto.TrackDTO{}
+	for _, track := range tracks {
+		dtoTrack, err := usecase.ConvertTrackToDTO(ctx, track)
+		if err != nil {
+			usecase.logger.Error(fmt.Sprintf("Can't create DTO for %s track: %v", track.Name, err), requestID)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoTracks = append(dtoTracks, dtoTrack)
+	}
+
+	return dtoTracks, nil
+}
+
 func (usecase *trackUsecase) Search(ctx context.Context, query string) ([]*dto.TrackDTO, error) {
 	requestID := ctx.Value(utils.RequestIDKey{})
 	foundTracks, err := usecase.trackRepo.FindByQuery(ctx, query)
@@ -191,33 +231,13 @@ func (usecase *trackUsecase) GetFavoriteTracks(ctx context.Context, userID uuid.
 }
 
 func (u *trackUsecase) GetTracksFromPlaylist(ctx context.Context, playlistID uint64) ([]*dto.TrackDTO, error) {
-	tracks := []*models.Track{}
-	playlistTracks, err := u.trackRepo.GetTracksFromPlaylist(ctx, playlistID)
+	response, err := u.playlistClient.ListTrackIDs(ctx, &playlistService.ListTrackIDsRequest{Id: playlistID})
 	if err != nil {
 		u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
 		return nil, err
 	}
 
-	for _, playlistTrack := range playlistTracks {
-		track, err := u.trackRepo.FindById(ctx, playlistTrack.TrackID)
-		if err != nil {
-			u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
-			return nil, err
-		}
-		tracks = append(tracks, track)
-	}
-
-	tracksDTO := []*dto.TrackDTO{}
-	for _, track := range tracks {
-		trackDTO, err := u.ConvertTrackToDTO(ctx, track)
-		if err != nil {
-			u.logger.Error(err.Error(), ctx.Value(utils.RequestIDKey{}))
-			return nil, err
-		}
-		tracksDTO = append(tracksDTO, trackDTO)
-	}
-
-	return tracksDTO, nil
+	return u.GetByIDs(ctx, response.GetTrackIDs())
 }
 
 func (usecase *trackUsecase) ConvertTrackToDTO(ctx context.Context, track *models.Track) (*dto.TrackDTO, error) {
@@ -263,3 +283,175 @@ func (usecase *trackUsecase) GetPopular(ctx context.Context) ([]*dto.TrackDTO, e
 
 	return dtoTracks, nil
 }
+
+func (usecase *trackUsecase) GetRadio(ctx context.Context, trackID uint64, seenIDs []uint64, limit uint64) ([]*dto.TrackDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	seed, err := usecase.trackRepo.FindById(ctx, trackID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Track wasn't found: %v", err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, track.ErrTrackNotFound
+		}
+		return nil, fmt.Errorf("Can't load radio")
+	}
+
+	lastArtistID := seed.ArtistID
+	if len(seenIDs) > 0 {
+		if lastTrack, err := usecase.trackRepo.FindById(ctx, seenIDs[len(seenIDs)-1]); err == nil {
+			lastArtistID = lastTrack.ArtistID
+		}
+	}
+
+	candidates, err := usecase.trackRepo.GetRadioCandidates(ctx, trackID, seenIDs, limit*radioCandidatesFactor)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't load radio for track %d: %v", trackID, err), requestID)
+		return nil, fmt.Errorf("Can't load radio")
+	}
+
+	// every related track was already played, so the radio starts over
+	if len(candidates) == 0 && len(seenIDs) > 0 {
+		candidates, err = usecase.trackRepo.GetRadioCandidates(ctx, trackID, nil, limit*radioCandidatesFactor)
+		if err != nil {
+			usecase.logger.Warn(fmt.Sprintf("Can't load radio for track %d: %v", trackID, err), requestID)
+			return nil, fmt.Errorf("Can't load radio")
+		}
+	}
+	usecase.logger.Infof("Found %d radio candidates for track ID %d", len(candidates), trackID)
+
+	var dtoTracks []*dto.TrackDTO
+	for _, track := range diversifyByArtist(candidates, lastArtistID, limit) {
+		dtoTrack, err := usecase.ConvertTrackToDTO(ctx, track)
+		if err != nil {
+			usecase.logger.Error(fmt.Sprintf("Can't create DTO for %s track: %v", track.Name, err), requestID)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoTracks = append(dtoTracks, dtoTrack)
+	}
+
+	return dtoTracks, nil
+}
+
+// diversifyByArtist keeps the ranking of tracks but picks the next best
+// track by another artist whenever the top one would repeat the previous
+// artist. Repeats are allowed only when nothing else is left.
+func diversifyByArtist(tracks []*models.Track, lastArtistID uint64, limit uint64) []*models.Track {
+	pending := append([]*models.Track(nil), tracks...)
+	queue := make([]*models.Track, 0, limit)
+
+	for uint64(len(queue)) < limit && len(pending) > 0 {
+		next := 0
+		for i, track := range pending {
+			if track.ArtistID != lastArtistID {
+				next = i
+				break
+			}
+		}
+
+		track := pending[next]
+		pending = append(pending[:next], pending[next+1:]...)
+		queue = append(queue, track)
+		lastArtistID = track.ArtistID
+	}
+
+	return queue
+}
+
+func (usecase *trackUsecase) GetLyrics(ctx context.Context, trackID uint64) (*dto.LyricsDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundLyrics, err := usecase.trackRepo.FindLyrics(ctx, trackID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, track.ErrLyricsNotFound
+		}
+		usecase.logger.Warn(fmt.Sprintf("Can't load lyrics of track %d: %v", trackID, err), requestID)
+		return nil, fmt.Errorf("Can't load lyrics")
+	}
+
+	var lines []lrc.Line
+	if foundLyrics.Synced {
+		// stored lyrics were validated on save
+		if lines, err = lrc.Parse(foundLyrics.Content); err != nil {
+			usecase.logger.Error(fmt.Sprintf("Stored lyrics of track %d are broken: %v", trackID, err), requestID)
+		}
+	}
+
+	return dto.NewLyricsDTO(foundLyrics, lines), nil
+}
+
+func (usecase *trackUsecase) SaveLyrics(ctx context.Context, trackID uint64, lyricsInputDTO *dto.LyricsInputDTO) (*dto.LyricsDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundTrack, err := usecase.trackRepo.FindById(ctx, trackID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Track %d wasn't found: %v", trackID, err), requestID)
+		return nil, track.ErrTrackNotFound
+	}
+
+	content := strings.TrimSpace(lyricsInputDTO.Content)
+	if content == "" || utf8.RuneCountInString(content) > maxLyricsLength {
+		return nil, fmt.Errorf("%w: content must be from 1 to %d characters", track.ErrInvalidLyrics, maxLyricsLength)
+	}
+
+	lyrics := &models.Lyrics{TrackID: trackID, Content: content}
+	var lines []lrc.Line
+	switch lyricsInputDTO.Format {
+	case dto.LyricsFormatPlain:
+		lyrics.PlainText = content
+	case dto.LyricsFormatLRC:
+		if lines, err = lrc.Parse(content); err != nil {
+			return nil, fmt.Errorf("%w: %v", track.ErrInvalidLyrics, err)
+		}
+		last := lines[len(lines)-1].Time
+		if foundTrack.Duration > 0 && last > time.Duration(foundTrack.Duration)*time.Second {
+			return nil, fmt.Errorf("%w: line at %v is after the end of the track", track.ErrInvalidLyrics, last)
+		}
+		lyrics.Synced = true
+		lyrics.PlainText = lrc.Text(lines)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", track.ErrInvalidLyrics, lyricsInputDTO.Format)
+	}
+
+	savedLyrics, err := usecase.trackRepo.SaveLyrics(ctx, lyrics)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't save lyrics of track %d: %v", trackID, err), requestID)
+		return nil, fmt.Errorf("Can't save lyrics")
+	}
+	usecase.logger.Info(fmt.Sprintf("Lyrics of track %d saved", trackID), requestID)
+
+	return dto.NewLyricsDTO(savedLyrics, lines), nil
+}
+
+func (usecase *trackUsecase) DeleteLyrics(ctx context.Context, trackID uint64) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	deleted, err := usecase.trackRepo.DeleteLyrics(ctx, trackID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't delete lyrics of track %d: %v", trackID, err), requestID)
+		return fmt.Errorf("Can't delete lyrics")
+	}
+
+	if !deleted {
+		return track.ErrLyricsNotFound
+	}
+
+	return nil
+}
+
+func (usecase *trackUsecase) SearchByLyrics(ctx context.Context, query string) ([]*dto.TrackDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundTracks, err := usecase.trackRepo.FindByLyrics(ctx, query, lyricsSearchLimit)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("Can't search lyrics for '%s': %v", query, err), requestID)
+		return nil, fmt.Errorf("Can't search lyrics")
+	}
+
+	dtoTracks := make([]*dto.TrackDTO, 0, len(foundTracks))
+	for _, foundTrack := range foundTracks {
+		dtoTrack, err := usecase.ConvertTrackToDTO(ctx, foundTrack)
+		if err != nil {
+			usecase.logger.Error(fmt.Sprintf("Can't create DTO for %s track: %v", foundTrack.Name, err), requestID)
+			return nil, fmt.Errorf("Can't create DTO")
+		}
+		dtoTracks = append(dtoTracks, dtoTrack)
+	}
+
+	return dtoTracks, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"testing"
@@ -14,10 +15,13 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	mockAlbum "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/album/mock"
 	mockArtist "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/artist/mock"
+	trackPkg "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/dto"
 	mockTrack "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/track/mock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	albumService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/album"
 	artistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/artist"
+	playlistService "github.com/go-park-mail-ru/2024_2_NovaCode/proto/playlist"
 	"github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -42,7 +46,7 @@ func TestUsecase_View_FoundTrack(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	track := &models.Track{
 		ID:          1,
@@ -110,7 +114,7 @@ func TestUsecase_View_NotFoundTrack(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	trackRepoMock.EXPECT().FindById(ctx, uint64(1)).Return(nil, errors.New("Track wasn't found"))
 	dtoTrack, err := trackUsecase.View(ctx, uint64(1))
@@ -137,7 +141,7 @@ func TestUsecase_Search_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -230,7 +234,7 @@ func TestUsecase_Search_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	ctx := context.Background()
 	trackRepoMock.EXPECT().FindByQuery(ctx, "song").Return(nil, errors.New("Can't find tracks"))
@@ -259,7 +263,7 @@ func TestUsecase_GetAll_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -380,7 +384,7 @@ func TestUsecase_GetAll_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	ctx := context.Background()
 	trackRepoMock.EXPECT().GetAll(ctx).Return(nil, errors.New("Can't load tracks"))
@@ -408,7 +412,7 @@ func TestUsecase_GetAllByArtistID_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -505,7 +509,7 @@ func TestUsecase_GetAllByArtistID_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	ctx := context.Background()
 	trackRepoMock.EXPECT().GetAllByArtistID(ctx, uint64(1)).Return(nil, errors.New("Can't load tracks by artist ID 1"))
@@ -536,7 +540,7 @@ func TestUsecase_GetAllByAlbumID_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -615,7 +619,7 @@ func TestUsecase_GetAllByAlbumID_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	ctx := context.Background()
 	trackRepoMock.EXPECT().GetAllByAlbumID(ctx, uint64(1)).Return(nil, errors.New("Can't load tracks by album ID 1"))
@@ -786,7 +790,7 @@ func TestUsecase_GetFavoriteTracks_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -884,7 +888,7 @@ func TestUsecase_GetFavoriteTracks_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	userID := uuid.New()
 	ctx := context.Background()
@@ -916,27 +920,10 @@ func TestUsecase_GetTracksFromPlaylist_FoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	playlistClientMock := mockTrack.NewMockPlaylistServiceClient(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, playlistClientMock, logger)
 
 	now := time.Now()
-	playlistTracks := []*models.PlaylistTrack{
-		{
-			ID:         1,
-			PlaylistID: 1,
-			TrackID:    1,
-		},
-		{
-			ID:         2,
-			PlaylistID: 1,
-			TrackID:    2,
-		},
-		{
-			ID:         3,
-			PlaylistID: 1,
-			TrackID:    3,
-		},
-	}
-
 	tracks := []*models.Track{
 		{
 			ID: uint64(1), Name: "test1", Duration: uint64(1), FilePath: "1", Image: "1",
@@ -973,10 +960,9 @@ func TestUsecase_GetTracksFromPlaylist_FoundTracks(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	trackRepoMock.EXPECT().GetTracksFromPlaylist(ctx, uint64(1)).Return(playlistTracks, nil)
-	trackRepoMock.EXPECT().FindById(ctx, uint64(1)).Return(tracks[0], nil)
-	trackRepoMock.EXPECT().FindById(ctx, uint64(2)).Return(tracks[1], nil)
-	trackRepoMock.EXPECT().FindById(ctx, uint64(3)).Return(tracks[2], nil)
+	playlistClientMock.EXPECT().ListTrackIDs(ctx, &playlistService.ListTrackIDsRequest{Id: 1}).
+		Return(&playlistService.ListTrackIDsResponse{TrackIDs: []uint64{1, 2, 3}}, nil)
+	trackRepoMock.EXPECT().FindByIDs(ctx, []uint64{1, 2, 3}).Return(tracks, nil)
 	artistClientMock.EXPECT().FindByID(ctx, &artistService.FindByIDRequest{Id: tracks[0].ArtistID}).Return(findByIDResponseArtist, nil)
 	artistClientMock.EXPECT().FindByID(ctx, &artistService.FindByIDRequest{Id: tracks[1].ArtistID}).Return(findByIDResponseArtist, nil)
 	artistClientMock.EXPECT().FindByID(ctx, &artistService.FindByIDRequest{Id: tracks[2].ArtistID}).Return(findByIDResponseArtist, nil)
@@ -1016,10 +1002,12 @@ func TestUsecase_GetTracksFromPlaylist_NotFoundTracks(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	playlistClientMock := mockTrack.NewMockPlaylistServiceClient(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, playlistClientMock, logger)
 
 	ctx := context.Background()
-	trackRepoMock.EXPECT().GetTracksFromPlaylist(ctx, uint64(1)).Return(nil, errors.New("Can't load tracks from playlist 1"))
+	playlistClientMock.EXPECT().ListTrackIDs(ctx, &playlistService.ListTrackIDsRequest{Id: 1}).
+		Return(nil, errors.New("Can't load tracks from playlist 1"))
 
 	dtoTracks, err := trackUsecase.GetTracksFromPlaylist(ctx, uint64(1))
 
@@ -1047,7 +1035,7 @@ func TestTrackUsecaseGetPopular(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	now := time.Now()
 	tracks := []*models.Track{
@@ -1120,7 +1108,7 @@ func TestTrackUsecaseGetPopular_Error(t *testing.T) {
 	trackRepoMock := mockTrack.NewMockRepo(ctrl)
 	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
 	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
-	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, logger)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
 
 	ctx := context.Background()
 	trackRepoMock.EXPECT().GetPopular(ctx).Return(nil, errors.New("Can't load tracks"))
@@ -1131,3 +1119,233 @@ func TestTrackUsecaseGetPopular_Error(t *testing.T) {
 	require.Nil(t, dtoTracks)
 	require.EqualError(t, err, "Can't load tracks")
 }
+
+func TestTrackUsecaseGetRadio(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	trackRepoMock := mockTrack.NewMockRepo(ctrl)
+	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
+	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
+
+	seed := &models.Track{ID: uint64(1), Name: "seed", ArtistID: uint64(1), AlbumID: uint64(1)}
+	candidates := []*models.Track{
+		{ID: uint64(2), Name: "same artist 1", ArtistID: uint64(1), AlbumID: uint64(1)},
+		{ID: uint64(3), Name: "same artist 2", ArtistID: uint64(1), AlbumID: uint64(1)},
+		{ID: uint64(4), Name: "other artist", ArtistID: uint64(2), AlbumID: uint64(2)},
+	}
+
+	findByIDResponseArtist := &artistService.FindByIDResponse{
+		Artist: &artistService.Artist{Id: 1, Name: "artist1"},
+	}
+	findByIDResponseAlbum := &albumService.FindByIDResponse{
+		Album: &albumService.Album{Id: 1, Name: "album1", ReleaseDate: timestamppb.New(time.Now())},
+	}
+
+	ctx := context.Background()
+	trackRepoMock.EXPECT().FindById(ctx, seed.ID).Return(seed, nil)
+	trackRepoMock.EXPECT().GetRadioCandidates(ctx, seed.ID, nil, uint64(3*radioCandidatesFactor)).Return(candidates, nil)
+	artistClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(findByIDResponseArtist, nil).Times(3)
+	albumClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(findByIDResponseAlbum, nil).Times(3)
+
+	dtoTracks, err := trackUsecase.GetRadio(ctx, seed.ID, nil, uint64(3))
+
+	require.NoError(t, err)
+	require.Equal(t, 3, len(dtoTracks))
+	require.Equal(t, "other artist", dtoTracks[0].Name)
+	require.Equal(t, "same artist 1", dtoTracks[1].Name)
+	require.Equal(t, "same artist 2", dtoTracks[2].Name)
+}
+
+func TestTrackUsecaseGetRadio_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	trackRepoMock := mockTrack.NewMockRepo(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, nil, nil, nil, logger)
+
+	ctx := context.Background()
+	trackRepoMock.EXPECT().FindById(ctx, uint64(9)).Return(nil, fmt.Errorf("FindById.Query: %w", sql.ErrNoRows))
+
+	_, err := trackUsecase.GetRadio(ctx, uint64(9), nil, uint64(3))
+	require.ErrorIs(t, err, trackPkg.ErrTrackNotFound)
+}
+
+func TestTrackUsecaseGetRadio_StartsOver(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	trackRepoMock := mockTrack.NewMockRepo(ctrl)
+	artistClientMock := mockArtist.NewMockArtistServiceClient(ctrl)
+	albumClientMock := mockAlbum.NewMockAlbumServiceClient(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, artistClientMock, albumClientMock, nil, logger)
+
+	seed := &models.Track{ID: uint64(1), Name: "seed", ArtistID: uint64(1), AlbumID: uint64(1)}
+	played := &models.Track{ID: uint64(2), Name: "played", ArtistID: uint64(2), AlbumID: uint64(2)}
+	seenIDs := []uint64{played.ID}
+
+	findByIDResponseArtist := &artistService.FindByIDResponse{
+		Artist: &artistService.Artist{Id: 2, Name: "artist2"},
+	}
+	findByIDResponseAlbum := &albumService.FindByIDResponse{
+		Album: &albumService.Album{Id: 2, Name: "album2", ReleaseDate: timestamppb.New(time.Now())},
+	}
+
+	ctx := context.Background()
+	trackRepoMock.EXPECT().FindById(ctx, seed.ID).Return(seed, nil)
+	trackRepoMock.EXPECT().FindById(ctx, played.ID).Return(played, nil)
+	trackRepoMock.EXPECT().GetRadioCandidates(ctx, seed.ID, seenIDs, uint64(radioCandidatesFactor)).Return(nil, nil)
+	trackRepoMock.EXPECT().GetRadioCandidates(ctx, seed.ID, nil, uint64(radioCandidatesFactor)).Return([]*models.Track{played}, nil)
+	artistClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(findByIDResponseArtist, nil)
+	albumClientMock.EXPECT().FindByID(ctx, gomock.Any()).Return(findByIDResponseAlbum, nil)
+
+	dtoTracks, err := trackUsecase.GetRadio(ctx, seed.ID, seenIDs, uint64(1))
+
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dtoTracks))
+	require.Equal(t, played.Name, dtoTracks[0].Name)
+}
+
+func TestUsecase_SaveLyrics(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	trackRepoMock := mockTrack.NewMockRepo(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, nil, nil, nil, logger)
+
+	ctx := context.Background()
+	foundTrack := &models.Track{ID: 1, Name: "ok im cool", Duration: 60}
+
+	t.Run("lrc", func(t *testing.T) {
+		trackRepoMock.EXPECT().FindById(ctx, foundTrack.ID).Return(foundTrack, nil)
+		trackRepoMock.EXPECT().SaveLyrics(ctx, &models.Lyrics{
+			TrackID:   1,
+			Synced:    true,
+			Content:   "[00:01.00]hello\n[00:02.50]world",
+			PlainText: "hello\nworld",
+		}).DoAndReturn(func(_ context.Context, lyrics *models.Lyrics) (*models.Lyrics, error) {
+			return lyrics, nil
+		})
+
+		lyrics, err := trackUsecase.SaveLyrics(ctx, foundTrack.ID, &dto.LyricsInputDTO{
+			Format:  dto.LyricsFormatLRC,
+			Content: "  [00:01.00]hello\n[00:02.50]world\n",
+		})
+		require.NoError(t, err)
+		require.True(t, lyrics.Synced)
+		require.Equal(t, "hello\nworld", lyrics.Text)
+		require.Equal(t, []*dto.LyricsLineDTO{{TimeMs: 1000, Text: "hello"}, {TimeMs: 2500, Text: "world"}}, lyrics.Lines)
+	})
+
+	t.Run("plain", func(t *testing.T) {
+		trackRepoMock.EXPECT().FindById(ctx, foundTrack.ID).Return(foundTrack, nil)
+		trackRepoMock.EXPECT().SaveLyrics(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, lyrics *models.Lyrics) (*models.Lyrics, error) {
+			return lyrics, nil
+		})
+
+		lyrics, err := trackUsecase.SaveLyrics(ctx, foundTrack.ID, &dto.LyricsInputDTO{Format: dto.LyricsFormatPlain, Content: "hello"})
+		require.NoError(t, err)
+		require.False(t, lyrics.Synced)
+		require.Empty(t, lyrics.Lines)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		inputs := []*dto.LyricsInputDTO{
+			{Format: dto.LyricsFormatPlain, Content: "   "},
+			{Format: dto.LyricsFormatLRC, Content: "no timestamps here"},
+			{Format: dto.LyricsFormatLRC, Content: "[00:01.00]hello\n[01:30.00]after the end"},
+			{Format: "srt", Content: "hello"},
+		}
+
+		for _, input := range inputs {
+			trackRepoMock.EXPECT().FindById(ctx, foundTrack.ID).Return(foundTrack, nil)
+			_, err := trackUsecase.SaveLyrics(ctx, foundTrack.ID, input)
+			require.ErrorIs(t, err, trackPkg.ErrInvalidLyrics)
+		}
+	})
+
+	t.Run("track not found", func(t *testing.T) {
+		trackRepoMock.EXPECT().FindById(ctx, uint64(2)).Return(nil, sql.ErrNoRows)
+		_, err := trackUsecase.SaveLyrics(ctx, 2, &dto.LyricsInputDTO{Format: dto.LyricsFormatPlain, Content: "hello"})
+		require.ErrorIs(t, err, trackPkg.ErrTrackNotFound)
+	})
+}
+
+func TestUsecase_GetLyrics(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+
+	logger := logger.New(&cfg.Service.Logger)
+	trackRepoMock := mockTrack.NewMockRepo(ctrl)
+	trackUsecase := NewTrackUsecase(trackRepoMock, nil, nil, nil, logger)
+
+	ctx := context.Background()
+
+	trackRepoMock.EXPECT().FindLyrics(ctx, uint64(1)).Return(&models.Lyrics{
+		TrackID:   1,
+		Synced:    true,
+		Content:   "[00:03.00]second\n[00:01.00]first",
+		PlainText: "first\nsecond",
+	}, nil)
+
+	lyrics, err := trackUsecase.GetLyrics(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []*dto.LyricsLineDTO{{TimeMs: 1000, Text: "first"}, {TimeMs: 3000, Text: "second"}}, lyrics.Lines)
+
+	trackRepoMock.EXPECT().FindLyrics(ctx, uint64(2)).Return(nil, fmt.Errorf("FindLyrics.Query: %w", sql.ErrNoRows))
+
+	_, err = trackUsecase.GetLyrics(ctx, 2)
+	require.ErrorIs(t, err, trackPkg.ErrLyricsNotFound)
+}
@@ -12,4 +12,7 @@ type Handlers interface {
 	UploadImage(response http.ResponseWriter, request *http.Request)
 	GetUserByUsername(response http.ResponseWriter, request *http.Request)
 	GetMe(response http.ResponseWriter, request *http.Request)
+	RequestDeletion(response http.ResponseWriter, request *http.Request)
+	CancelDeletion(response http.ResponseWriter, request *http.Request)
+	Delete(response http.ResponseWriter, request *http.Request)
 }
@@ -1,7 +1,7 @@
 package http
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,9 +14,8 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
-	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/content"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/csrf"
-	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 )
 
@@ -326,7 +325,7 @@ func (handlers *userHandlers) Update(response http.ResponseWriter, request *http
 // UploadImage godoc
 // @Tags User
 // @Summary Upload profile imag
-// @Description Upload a profile image for the user. The image file should be in a supported image format.
+// @Description Upload a profile image for the user. JPEG, PNG, GIF and WebP pictures of at least 64x64 pixels are accepted, they are cropped to a square and stored as JPEG and WebP renditions of 64, 300 and 640 pixels.
 // @Accept multipart/form-data
 // @Produce json
 // @Param file formData file true "Profile image file"
@@ -346,7 +345,7 @@ func (handlers *userHandlers) UploadImage(response http.ResponseWriter, request
 		return
 	}
 
-	file, header, err := request.FormFile("file")
+	file, _, err := request.FormFile("file")
 	if err != nil {
 		utils.JSONError(response, http.StatusBadRequest, "failed to get file from request")
 		return
@@ -360,22 +359,19 @@ func (handlers *userHandlers) UploadImage(response http.ResponseWriter, request
 		return
 	}
 
-	contentType, err := content.IsImage(fileBytes)
+	renditions, err := imaging.Process(fileBytes)
 	if err != nil {
-		handlers.logger.Error(fmt.Sprintf("invalid content type: %v", err), requestID)
-		utils.JSONError(response, http.StatusBadRequest, "invalid content type")
+		handlers.logger.Error(fmt.Sprintf("invalid image: %v", err), requestID)
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat), errors.Is(err, imaging.ErrTooSmall), errors.Is(err, imaging.ErrTooLarge):
+			utils.JSONError(response, http.StatusBadRequest, err.Error())
+		default:
+			utils.JSONError(response, http.StatusInternalServerError, "failed to process image")
+		}
 		return
 	}
 
-	upload := s3.Upload{
-		Bucket:      "avatars",
-		File:        bytes.NewReader(fileBytes),
-		Filename:    header.Filename,
-		Size:        header.Size,
-		ContentType: contentType,
-	}
-
-	userDTO, err := handlers.usecase.UploadImage(request.Context(), userID, upload)
+	userDTO, err := handlers.usecase.UploadImage(request.Context(), userID, renditions)
 	if err != nil {
 		handlers.logger.Error(fmt.Sprintf("failed to upload image: %v", err), requestID)
 		utils.JSONError(response, http.StatusInternalServerError, "failed to upload image")
@@ -480,3 +476,124 @@ func (handlers *userHandlers) GetMe(response http.ResponseWriter, request *http.
 		return
 	}
 }
+
+// RequestDeletion godoc
+// @Tags User
+// @Summary Request account deletion
+// @Description Schedules the deletion of the account of the current user after a grace period of 30 days, during which it can be canceled. The password is required to confirm the request. The avatar, playlist covers and data exports are removed from the storage and survey answers are kept without the user.
+// @Accept json
+// @Produce json
+// @Param confirmation body dto.DeleteAccountDTO true "Password of the user"
+// @Success 202 {object} dto.DeletionDTO "Deletion scheduled"
+// @Failure 400 {object} utils.ErrorResponse "Invalid request body"
+// @Failure 403 {object} utils.ErrorResponse "Invalid password"
+// @Failure 500 {object} utils.ErrorResponse "Failed to schedule account deletion"
+// @Router /api/v1/users/me/deletion [post]
+func (handlers *userHandlers) RequestDeletion(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("user id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "user id not found")
+		return
+	}
+
+	deleteDTO := &dto.DeleteAccountDTO{}
+	rawBytes, _ := io.ReadAll(request.Body)
+	if err := easyjson.Unmarshal(rawBytes, deleteDTO); err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if deleteDTO.Password == "" {
+		utils.JSONError(response, http.StatusBadRequest, "password is required")
+		return
+	}
+
+	deletionDTO, err := handlers.usecase.RequestDeletion(request.Context(), userID, deleteDTO.Password)
+	if err != nil {
+		handlers.logger.Warn(fmt.Sprintf("failed to request account deletion: %v", err), requestID)
+		handlers.deletionError(response, err)
+		return
+	}
+
+	response.Header().Set("Content-Type", "application/json")
+	rawBytes, err = easyjson.Marshal(deletionDTO)
+	if err != nil {
+		utils.JSONError(response, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+	_, err = response.Write(rawBytes)
+	if err != nil {
+		handlers.logger.Error(fmt.Sprintf("Failed to write response: %v", err), requestID)
+		utils.JSONError(response, http.StatusInternalServerError, "Write response fail")
+		return
+	}
+}
+
+// CancelDeletion godoc
+// @Tags User
+// @Summary Cancel account deletion
+// @Description Cancels the scheduled deletion of the account of the current user.
+// @Success 204 "Deletion canceled"
+// @Failure 404 {object} utils.ErrorResponse "Account deletion isn't scheduled"
+// @Failure 500 {object} utils.ErrorResponse "Failed to cancel account deletion"
+// @Router /api/v1/users/me/deletion [delete]
+func (handlers *userHandlers) CancelDeletion(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, ok := request.Context().Value(utils.UserIDKey{}).(uuid.UUID)
+	if !ok {
+		handlers.logger.Error("user id not found in context", requestID)
+		utils.JSONError(response, http.StatusBadRequest, "user id not found")
+		return
+	}
+
+	if err := handlers.usecase.CancelDeletion(request.Context(), userID); err != nil {
+		handlers.logger.Warn(fmt.Sprintf("failed to cancel account deletion: %v", err), requestID)
+		handlers.deletionError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// Delete godoc
+// @Tags User
+// @Summary Delete user
+// @Description Deletes the user at once, without a grace period, along with the stored objects of the user. Admin only.
+// @Param user_id path string true "User ID"
+// @Success 204 "User deleted"
+// @Failure 400 {object} utils.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} utils.ErrorResponse "Admin access required"
+// @Failure 404 {object} utils.ErrorResponse "User not found"
+// @Failure 500 {object} utils.ErrorResponse "Failed to delete user"
+// @Router /api/v1/users/{user_id} [delete]
+func (handlers *userHandlers) Delete(response http.ResponseWriter, request *http.Request) {
+	requestID := request.Context().Value(utils.RequestIDKey{})
+	userID, err := uuid.Parse(mux.Vars(request)["user_id"])
+	if err != nil {
+		utils.JSONError(response, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	if err := handlers.usecase.Delete(request.Context(), userID); err != nil {
+		handlers.logger.Warn(fmt.Sprintf("failed to delete user: %v", err), requestID)
+		handlers.deletionError(response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (handlers *userHandlers) deletionError(response http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, user.ErrInvalidPassword):
+		utils.JSONError(response, http.StatusForbidden, err.Error())
+	case errors.Is(err, user.ErrUserNotFound), errors.Is(err, user.ErrDeletionNotFound):
+		utils.JSONError(response, http.StatusNotFound, err.Error())
+	default:
+		utils.JSONError(response, http.StatusInternalServerError, err.Error())
+	}
+}
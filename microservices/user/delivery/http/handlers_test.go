@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"image"
+	"image/png"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -16,6 +19,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/mock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/csrf"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -595,3 +599,49 @@ func TestUserHandlers_GetCSRFToken(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, response.Result().StatusCode)
 	})
 }
+
+func TestUserHandlers_UploadImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := &config.Config{}
+	logger := logger.New(&cfg.Service.Logger)
+	usecaseMock := mock.NewMockUsecase(ctrl)
+	userHandlers := NewUserHandlers(&cfg.Service.Auth, usecaseMock, logger)
+
+	userID := uuid.New()
+
+	newRequest := func(t *testing.T, file []byte) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "avatar")
+		assert.NoError(t, err)
+		_, err = part.Write(file)
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Close())
+
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/users/image", body)
+		request.Header.Set("Content-Type", writer.FormDataContentType())
+		return request.WithContext(context.WithValue(request.Context(), utils.UserIDKey{}, userID))
+	}
+
+	t.Run("successful upload", func(t *testing.T) {
+		picture := image.NewRGBA(image.Rect(0, 0, 120, 100))
+		file := &bytes.Buffer{}
+		assert.NoError(t, png.Encode(file, picture))
+
+		usecaseMock.EXPECT().UploadImage(gomock.Any(), userID, gomock.Len(2*len(imaging.Sizes))).Return(&dto.UserDTO{ID: userID}, nil)
+
+		response := httptest.NewRecorder()
+		userHandlers.UploadImage(response, newRequest(t, file.Bytes()))
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("svg is rejected", func(t *testing.T) {
+		svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`)
+
+		response := httptest.NewRecorder()
+		userHandlers.UploadImage(response, newRequest(t, svg))
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
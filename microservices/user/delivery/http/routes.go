@@ -1,13 +1,16 @@
 package http
 
 import (
+	"context"
 	"net/http"
 
 	s3Repo "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3/repository/s3"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/middleware"
 	httpServer "github.com/go-park-mail-ru/2024_2_NovaCode/internal/server/http"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/delivery/worker"
 	userRepo "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/repository/postgres"
 	userUsecase "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/usecase"
 )
@@ -17,9 +20,14 @@ func BindRoutes(s *httpServer.Server) {
 
 	userPGRepo := userRepo.NewUserPostgresRepository(s.PG, s.Logger)
 	userS3Repo := s3Repo.NewS3Repository(s.S3, s.Logger)
-	userUsecase := userUsecase.NewUserUsecase(&s.CFG.Service.Auth, &s.CFG.Minio, userPGRepo, userS3Repo, s.Logger)
+	recorder := audit.NewPGRecorder(s.PG, s.Logger)
+	userUsecase := userUsecase.NewUserUsecase(&s.CFG.Service.Auth, &s.CFG.Minio, userPGRepo, userS3Repo, recorder, s.Logger)
 	userHandleres := NewUserHandlers(&s.CFG.Service.Auth, userUsecase, s.Logger)
 
+	s.Lifecycle.Go("user deletion worker", func(ctx context.Context) error {
+		return worker.Run(ctx, userUsecase, s.Logger)
+	})
+
 	s.MUX.HandleFunc("/api/v1/health", userHandleres.Health).Methods("GET")
 
 	s.MUX.HandleFunc("/api/v1/auth/register", userHandleres.Register).Methods("POST")
@@ -39,6 +47,27 @@ func BindRoutes(s *httpServer.Server) {
 		middleware.AuthMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(userHandleres.GetMe)),
 	).Methods("GET")
 
+	s.MUX.Handle(
+		"/api/v1/users/me/deletion",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, http.HandlerFunc(userHandleres.RequestDeletion)),
+		),
+	).Methods("POST")
+
+	s.MUX.Handle(
+		"/api/v1/users/me/deletion",
+		middleware.AuthMiddleware(
+			&s.CFG.Service.Auth, s.Logger,
+			middleware.CSRFMiddleware(&s.CFG.Service.Auth.CSRF, s.Logger, http.HandlerFunc(userHandleres.CancelDeletion)),
+		),
+	).Methods("DELETE")
+
+	s.MUX.Handle(
+		"/api/v1/users/{user_id:[0-9a-fA-F-]+}",
+		middleware.AdminMiddleware(&s.CFG.Service.Auth, s.Logger, http.HandlerFunc(userHandleres.Delete)),
+	).Methods("DELETE")
+
 	s.MUX.Handle(
 		"/api/v1/users/{user_id:[0-9a-fA-F-]+}",
 		middleware.AuthMiddleware(
@@ -0,0 +1,29 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+)
+
+// purgeInterval is how often the accounts whose grace period is over are
+// deleted.
+const purgeInterval = 10 * time.Minute
+
+// Run purges due accounts until ctx is cancelled, a purge in progress
+// is finished rather than interrupted.
+func Run(ctx context.Context, usecase user.Usecase, logger logger.Logger) error {
+	for {
+		if err := usecase.PurgeDueDeletions(context.WithoutCancel(ctx)); err != nil {
+			logger.Errorf("failed to delete scheduled users: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(purgeInterval):
+		}
+	}
+}
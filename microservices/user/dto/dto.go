@@ -1,6 +1,9 @@
 package dto
 
 import (
+	"time"
+
+	sharedDTO "github.com/go-park-mail-ru/2024_2_NovaCode/internal/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/google/uuid"
 )
@@ -52,10 +55,11 @@ func NewUserFromUpdateDTO(updateDTO *UpdateDTO) *models.User {
 
 //easyjson:json
 type UserDTO struct {
-	ID       uuid.UUID `json:"id"`
-	Email    string    `json:"email,omitempty"`
-	Username string    `json:"username,omitempty"`
-	Image    string    `json:"image,omitempty"`
+	ID       uuid.UUID             `json:"id"`
+	Email    string                `json:"email,omitempty"`
+	Username string                `json:"username,omitempty"`
+	Image    string                `json:"image,omitempty"`
+	Images   []*sharedDTO.ImageDTO `json:"images,omitempty"`
 }
 
 func NewUserDTO(user *models.User) *UserDTO {
@@ -64,14 +68,16 @@ func NewUserDTO(user *models.User) *UserDTO {
 		user.Email,
 		user.Username,
 		user.Image,
+		sharedDTO.NewImagesDTO(user.Image),
 	}
 }
 
 //easyjson:json
 type PublicUserDTO struct {
-	ID       uuid.UUID `json:"id"`
-	Username string    `json:"username,omitempty"`
-	Image    string    `json:"image,omitempty"`
+	ID       uuid.UUID             `json:"id"`
+	Username string                `json:"username,omitempty"`
+	Image    string                `json:"image,omitempty"`
+	Images   []*sharedDTO.ImageDTO `json:"images,omitempty"`
 }
 
 func NewPublicUserDTO(userDTO *UserDTO) *PublicUserDTO {
@@ -79,6 +85,7 @@ func NewPublicUserDTO(userDTO *UserDTO) *PublicUserDTO {
 		userDTO.ID,
 		userDTO.Username,
 		userDTO.Image,
+		userDTO.Images,
 	}
 }
 
@@ -94,3 +101,13 @@ func NewUserTokenDTO(user *models.User, token string) *UserTokenDTO {
 		token,
 	}
 }
+
+//easyjson:json
+type DeleteAccountDTO struct {
+	Password string `json:"password,omitempty"`
+}
+
+//easyjson:json
+type DeletionDTO struct {
+	ScheduledAt time.Time `json:"scheduledAt"`
+}
@@ -0,0 +1,9 @@
+package user
+
+import "errors"
+
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidPassword  = errors.New("invalid password")
+	ErrDeletionNotFound = errors.New("account deletion isn't scheduled")
+)
@@ -1,10 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/user/repository.go
-//
-// Generated by this command:
-//
-//	mockgen -source=internal/user/repository.go -destination=internal/user/mock/postgres_mock.go -package=mock
-//
+// Source: microservices/user/repository.go
 
 // Package mock is a generated GoMock package.
 package mock
@@ -12,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -22,7 +18,6 @@ import (
 type MockPostgresRepo struct {
 	ctrl     *gomock.Controller
 	recorder *MockPostgresRepoMockRecorder
-	isgomock struct{}
 }
 
 // MockPostgresRepoMockRecorder is the mock recorder for MockPostgresRepo.
@@ -42,6 +37,34 @@ func (m *MockPostgresRepo) EXPECT() *MockPostgresRepoMockRecorder {
 	return m.recorder
 }
 
+// CancelDeletion mocks base method.
+func (m *MockPostgresRepo) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeletion", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDeletion indicates an expected call of CancelDeletion.
+func (mr *MockPostgresRepoMockRecorder) CancelDeletion(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeletion", reflect.TypeOf((*MockPostgresRepo)(nil).CancelDeletion), ctx, userID)
+}
+
+// Delete mocks base method.
+func (m *MockPostgresRepo) Delete(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPostgresRepoMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPostgresRepo)(nil).Delete), ctx, userID)
+}
+
 // FindByEmail mocks base method.
 func (m *MockPostgresRepo) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -52,7 +75,7 @@ func (m *MockPostgresRepo) FindByEmail(ctx context.Context, email string) (*mode
 }
 
 // FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockPostgresRepoMockRecorder) FindByEmail(ctx, email any) *gomock.Call {
+func (mr *MockPostgresRepoMockRecorder) FindByEmail(ctx, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockPostgresRepo)(nil).FindByEmail), ctx, email)
 }
@@ -67,11 +90,26 @@ func (m *MockPostgresRepo) FindByID(ctx context.Context, uuid uuid.UUID) (*model
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockPostgresRepoMockRecorder) FindByID(ctx, uuid any) *gomock.Call {
+func (mr *MockPostgresRepoMockRecorder) FindByID(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPostgresRepo)(nil).FindByID), ctx, uuid)
 }
 
+// FindByIDs mocks base method.
+func (m *MockPostgresRepo) FindByIDs(ctx context.Context, uuids []uuid.UUID) ([]*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, uuids)
+	ret0, _ := ret[0].([]*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockPostgresRepoMockRecorder) FindByIDs(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockPostgresRepo)(nil).FindByIDs), ctx, uuids)
+}
+
 // FindByUsername mocks base method.
 func (m *MockPostgresRepo) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -82,11 +120,41 @@ func (m *MockPostgresRepo) FindByUsername(ctx context.Context, username string)
 }
 
 // FindByUsername indicates an expected call of FindByUsername.
-func (mr *MockPostgresRepoMockRecorder) FindByUsername(ctx, username any) *gomock.Call {
+func (mr *MockPostgresRepoMockRecorder) FindByUsername(ctx, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUsername", reflect.TypeOf((*MockPostgresRepo)(nil).FindByUsername), ctx, username)
 }
 
+// FindDueDeletions mocks base method.
+func (m *MockPostgresRepo) FindDueDeletions(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueDeletions", ctx, limit)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueDeletions indicates an expected call of FindDueDeletions.
+func (mr *MockPostgresRepoMockRecorder) FindDueDeletions(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueDeletions", reflect.TypeOf((*MockPostgresRepo)(nil).FindDueDeletions), ctx, limit)
+}
+
+// FindObjects mocks base method.
+func (m *MockPostgresRepo) FindObjects(ctx context.Context, userID uuid.UUID) (*models.UserObjects, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindObjects", ctx, userID)
+	ret0, _ := ret[0].(*models.UserObjects)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindObjects indicates an expected call of FindObjects.
+func (mr *MockPostgresRepoMockRecorder) FindObjects(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindObjects", reflect.TypeOf((*MockPostgresRepo)(nil).FindObjects), ctx, userID)
+}
+
 // Insert mocks base method.
 func (m *MockPostgresRepo) Insert(ctx context.Context, user *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -97,11 +165,25 @@ func (m *MockPostgresRepo) Insert(ctx context.Context, user *models.User) (*mode
 }
 
 // Insert indicates an expected call of Insert.
-func (mr *MockPostgresRepoMockRecorder) Insert(ctx, user any) *gomock.Call {
+func (mr *MockPostgresRepoMockRecorder) Insert(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPostgresRepo)(nil).Insert), ctx, user)
 }
 
+// ScheduleDeletion mocks base method.
+func (m *MockPostgresRepo) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleDeletion", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleDeletion indicates an expected call of ScheduleDeletion.
+func (mr *MockPostgresRepoMockRecorder) ScheduleDeletion(ctx, userID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDeletion", reflect.TypeOf((*MockPostgresRepo)(nil).ScheduleDeletion), ctx, userID, at)
+}
+
 // Update mocks base method.
 func (m *MockPostgresRepo) Update(ctx context.Context, user *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -112,7 +194,7 @@ func (m *MockPostgresRepo) Update(ctx context.Context, user *models.User) (*mode
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockPostgresRepoMockRecorder) Update(ctx, user any) *gomock.Call {
+func (mr *MockPostgresRepoMockRecorder) Update(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPostgresRepo)(nil).Update), ctx, user)
 }
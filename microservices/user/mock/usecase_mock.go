@@ -10,7 +10,7 @@ import (
 
 	models "github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	dto "github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
-	s3 "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	imaging "github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -38,6 +38,34 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CancelDeletion mocks base method.
+func (m *MockUsecase) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeletion", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDeletion indicates an expected call of CancelDeletion.
+func (mr *MockUsecaseMockRecorder) CancelDeletion(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeletion", reflect.TypeOf((*MockUsecase)(nil).CancelDeletion), ctx, userID)
+}
+
+// Delete mocks base method.
+func (m *MockUsecase) Delete(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUsecaseMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsecase)(nil).Delete), ctx, userID)
+}
+
 // GetByID mocks base method.
 func (m *MockUsecase) GetByID(ctx context.Context, userID uuid.UUID) (*dto.UserDTO, error) {
 	m.ctrl.T.Helper()
@@ -83,6 +111,20 @@ func (mr *MockUsecaseMockRecorder) Login(ctx, user interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUsecase)(nil).Login), ctx, user)
 }
 
+// PurgeDueDeletions mocks base method.
+func (m *MockUsecase) PurgeDueDeletions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDueDeletions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDueDeletions indicates an expected call of PurgeDueDeletions.
+func (mr *MockUsecaseMockRecorder) PurgeDueDeletions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDueDeletions", reflect.TypeOf((*MockUsecase)(nil).PurgeDueDeletions), ctx)
+}
+
 // Register mocks base method.
 func (m *MockUsecase) Register(ctx context.Context, user *models.User) (*dto.UserTokenDTO, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +140,21 @@ func (mr *MockUsecaseMockRecorder) Register(ctx, user interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUsecase)(nil).Register), ctx, user)
 }
 
+// RequestDeletion mocks base method.
+func (m *MockUsecase) RequestDeletion(ctx context.Context, userID uuid.UUID, password string) (*dto.DeletionDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeletion", ctx, userID, password)
+	ret0, _ := ret[0].(*dto.DeletionDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestDeletion indicates an expected call of RequestDeletion.
+func (mr *MockUsecaseMockRecorder) RequestDeletion(ctx, userID, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeletion", reflect.TypeOf((*MockUsecase)(nil).RequestDeletion), ctx, userID, password)
+}
+
 // Update mocks base method.
 func (m *MockUsecase) Update(ctx context.Context, user *models.User) (*dto.UserDTO, error) {
 	m.ctrl.T.Helper()
@@ -114,16 +171,16 @@ func (mr *MockUsecaseMockRecorder) Update(ctx, user interface{}) *gomock.Call {
 }
 
 // UploadImage mocks base method.
-func (m *MockUsecase) UploadImage(ctx context.Context, userID uuid.UUID, file s3.Upload) (*dto.UserDTO, error) {
+func (m *MockUsecase) UploadImage(ctx context.Context, userID uuid.UUID, renditions []*imaging.Rendition) (*dto.UserDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadImage", ctx, userID, file)
+	ret := m.ctrl.Call(m, "UploadImage", ctx, userID, renditions)
 	ret0, _ := ret[0].(*dto.UserDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadImage indicates an expected call of UploadImage.
-func (mr *MockUsecaseMockRecorder) UploadImage(ctx, userID, file interface{}) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) UploadImage(ctx, userID, renditions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUsecase)(nil).UploadImage), ctx, userID, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadImage", reflect.TypeOf((*MockUsecase)(nil).UploadImage), ctx, userID, renditions)
 }
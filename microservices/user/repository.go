@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/google/uuid"
@@ -11,6 +12,12 @@ type PostgresRepo interface {
 	Insert(ctx context.Context, user *models.User) (*models.User, error)
 	Update(ctx context.Context, user *models.User) (*models.User, error)
 	FindByID(ctx context.Context, uuid uuid.UUID) (*models.User, error)
+	FindByIDs(ctx context.Context, uuids []uuid.UUID) ([]*models.User, error)
 	FindByUsername(ctx context.Context, username string) (*models.User, error)
 	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error
+	CancelDeletion(ctx context.Context, userID uuid.UUID) error
+	FindDueDeletions(ctx context.Context, limit int) ([]uuid.UUID, error)
+	FindObjects(ctx context.Context, userID uuid.UUID) (*models.UserObjects, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
 }
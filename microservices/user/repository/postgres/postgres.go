@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const (
@@ -27,6 +29,10 @@ const (
 		SELECT id, username, email, role, password_hash, image, created_at, updated_at
 		FROM "user" WHERE id = $1
 	`
+	findByIDsQuery = `
+		SELECT id, username, email, role, password_hash, image, created_at, updated_at
+		FROM "user" WHERE id = ANY($1::UUID[])
+	`
 	findByUsernameQuery = `
 		SELECT id, username, email, role, password_hash, image, created_at, updated_at
 		FROM "user" WHERE username = $1
@@ -35,6 +41,33 @@ const (
 		SELECT id, username, email, role, password_hash, image, created_at, updated_at
 		FROM "user" WHERE email = $1
 	`
+	scheduleDeletionQuery = `
+		UPDATE "user"
+		SET deletion_scheduled_at = $2
+		WHERE id = $1
+	`
+	cancelDeletionQuery = `
+		UPDATE "user"
+		SET deletion_scheduled_at = NULL
+		WHERE id = $1 AND deletion_scheduled_at IS NOT NULL
+	`
+	findDueDeletionsQuery = `
+		SELECT id
+		FROM "user"
+		WHERE deletion_scheduled_at <= NOW()
+		ORDER BY deletion_scheduled_at
+		LIMIT $1
+	`
+	findObjectsQuery = `
+		SELECT
+			COALESCE(u.image, ''),
+			ARRAY(SELECT p.image FROM playlist p WHERE p.owner_id = u.id AND p.image IS NOT NULL),
+			ARRAY(SELECT e.object_key FROM data_export e WHERE e.user_id = u.id AND e.object_key <> '')
+		FROM "user" u WHERE u.id = $1
+	`
+	deleteUserQuery = `
+		DELETE FROM "user" WHERE id = $1
+	`
 )
 
 type UserPostgresRepo struct {
@@ -115,6 +148,35 @@ func (repo *UserPostgresRepo) FindByID(ctx context.Context, uuid uuid.UUID) (*mo
 	return &user, nil
 }
 
+// FindByIDs returns the found users in no particular order.
+func (repo *UserPostgresRepo) FindByIDs(ctx context.Context, uuids []uuid.UUID) ([]*models.User, error) {
+	rows, err := repo.db.QueryContext(ctx, findByIDsQuery, pq.Array(uuids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users by IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*models.User
+	for rows.Next() {
+		var user models.User
+		if err := rows.Scan(
+			&user.UserID,
+			&user.Username,
+			&user.Email,
+			&user.Role,
+			&user.Password,
+			&user.Image,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, &user)
+	}
+
+	return users, nil
+}
+
 func (repo *UserPostgresRepo) FindByUsername(ctx context.Context, username string) (*models.User, error) {
 	var user models.User
 
@@ -152,3 +214,80 @@ func (repo *UserPostgresRepo) FindByEmail(ctx context.Context, email string) (*m
 
 	return &user, nil
 }
+
+func (repo *UserPostgresRepo) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	result, err := repo.db.ExecContext(ctx, scheduleDeletionQuery, userID, at)
+	if err != nil {
+		return fmt.Errorf("failed to schedule user deletion: %w", err)
+	}
+
+	return checkAffected(result)
+}
+
+func (repo *UserPostgresRepo) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	result, err := repo.db.ExecContext(ctx, cancelDeletionQuery, userID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel user deletion: %w", err)
+	}
+
+	return checkAffected(result)
+}
+
+func (repo *UserPostgresRepo) FindDueDeletions(ctx context.Context, limit int) ([]uuid.UUID, error) {
+	rows, err := repo.db.QueryContext(ctx, findDueDeletionsQuery, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due user deletions: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan due user deletion: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find due user deletions: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+func (repo *UserPostgresRepo) FindObjects(ctx context.Context, userID uuid.UUID) (*models.UserObjects, error) {
+	var objects models.UserObjects
+
+	if err := repo.db.QueryRowContext(ctx, findObjectsQuery, userID).Scan(
+		&objects.Image,
+		pq.Array(&objects.PlaylistImages),
+		pq.Array(&objects.ExportKeys),
+	); err != nil {
+		return nil, fmt.Errorf("failed to find user objects: %w", err)
+	}
+
+	return &objects, nil
+}
+
+func (repo *UserPostgresRepo) Delete(ctx context.Context, userID uuid.UUID) error {
+	result, err := repo.db.ExecContext(ctx, deleteUserQuery, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return checkAffected(result)
+}
+
+// checkAffected reports sql.ErrNoRows when the statement changed no user.
+func checkAffected(result sql.Result) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -158,6 +160,40 @@ func TestFindByID_Regular(t *testing.T) {
 	require.Equal(t, foundUser.UserID, userMock.UserID)
 }
 
+func TestFindByIDs_Regular(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	cfg := &config.Config{
+		Service: config.ServiceConfig{
+			Logger: config.LoggerConfig{
+				Level:  "info",
+				Format: "json",
+			},
+		},
+	}
+	logger := logger.New(&cfg.Service.Logger)
+
+	postgresRepo := NewUserPostgresRepository(db, logger)
+
+	columns := []string{"id", "username", "email", "role", "password", "image", "created_at", "updated_at"}
+	userIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	rows := sqlmock.NewRows(columns).
+		AddRow(userIDs[1], "second", "second@email.com", "regular", "password", "", time.Now(), time.Now()).
+		AddRow(userIDs[0], "first", "first@email.com", "regular", "password", "", time.Now(), time.Now())
+
+	mock.ExpectQuery(findByIDsQuery).WithArgs(pq.Array(userIDs)).WillReturnRows(rows)
+
+	foundUsers, err := postgresRepo.FindByIDs(context.Background(), userIDs)
+	require.NoError(t, err)
+	require.Len(t, foundUsers, 2)
+	require.Equal(t, userIDs[1], foundUsers[0].UserID)
+	require.Equal(t, "first", foundUsers[1].Username)
+}
+
 func TestFindByID_Error(t *testing.T) {
 	t.Parallel()
 
@@ -517,3 +553,52 @@ func TestUpdate_Error(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "failed to update user")
 }
+
+func TestScheduleDeletion(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	postgresRepo := NewUserPostgresRepository(db, logger)
+
+	userID := uuid.New()
+	scheduledAt := time.Now().Add(time.Hour)
+
+	mock.ExpectExec(scheduleDeletionQuery).WithArgs(userID, scheduledAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, postgresRepo.ScheduleDeletion(context.Background(), userID, scheduledAt))
+
+	mock.ExpectExec(cancelDeletionQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 0))
+	require.ErrorIs(t, postgresRepo.CancelDeletion(context.Background(), userID), sql.ErrNoRows)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindObjects(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	postgresRepo := NewUserPostgresRepository(db, logger)
+
+	userID := uuid.New()
+	rows := sqlmock.NewRows([]string{"image", "playlist_images", "export_keys"}).
+		AddRow("avatar.png", "{cover/640.jpg,default.webp}", "{}")
+
+	mock.ExpectQuery(findObjectsQuery).WithArgs(userID).WillReturnRows(rows)
+	objects, err := postgresRepo.FindObjects(context.Background(), userID)
+	require.NoError(t, err)
+	require.Equal(t, &models.UserObjects{
+		Image:          "avatar.png",
+		PlaylistImages: []string{"cover/640.jpg", "default.webp"},
+		ExportKeys:     []string{},
+	}, objects)
+
+	mock.ExpectExec(deleteUserQuery).WithArgs(userID).WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, postgresRepo.Delete(context.Background(), userID))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
-	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/google/uuid"
 )
 
@@ -13,7 +13,11 @@ type Usecase interface {
 	Register(ctx context.Context, user *models.User) (*dto.UserTokenDTO, error)
 	Login(ctx context.Context, user *models.User) (*dto.UserTokenDTO, error)
 	Update(ctx context.Context, user *models.User) (*dto.UserDTO, error)
-	UploadImage(ctx context.Context, userID uuid.UUID, file s3.Upload) (*dto.UserDTO, error)
+	UploadImage(ctx context.Context, userID uuid.UUID, renditions []*imaging.Rendition) (*dto.UserDTO, error)
 	GetByID(ctx context.Context, userID uuid.UUID) (*dto.UserDTO, error)
 	GetByUsername(ctx context.Context, username string) (*dto.UserDTO, error)
+	RequestDeletion(ctx context.Context, userID uuid.UUID, password string) (*dto.DeletionDTO, error)
+	CancelDeletion(ctx context.Context, userID uuid.UUID) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	PurgeDueDeletions(ctx context.Context) error
 }
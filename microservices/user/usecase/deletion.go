@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	"github.com/google/uuid"
+)
+
+const (
+	// deletionGracePeriod is how long a requested deletion can be canceled.
+	deletionGracePeriod = 30 * 24 * time.Hour
+	deletionBatchSize   = 50
+	defaultAvatar       = "default.webp"
+	coversBucket        = "playlists"
+	exportsBucket       = "exports"
+)
+
+type storageObject struct {
+	bucket string
+	key    string
+}
+
+func (usecase *userUsecase) RequestDeletion(ctx context.Context, userID uuid.UUID, password string) (*dto.DeletionDTO, error) {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	foundUser, err := usecase.pgRepo.FindByID(ctx, userID)
+	if err != nil {
+		usecase.logger.Warn(fmt.Sprintf("user not found: %v", err), requestID)
+		return nil, user.ErrUserNotFound
+	}
+
+	if err := foundUser.ComparePasswords(password); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("password confirmation failed for user '%s': %v", userID, err), requestID)
+		return nil, user.ErrInvalidPassword
+	}
+
+	scheduledAt := time.Now().Add(deletionGracePeriod)
+	if err := usecase.pgRepo.ScheduleDeletion(ctx, userID, scheduledAt); err != nil {
+		usecase.logger.Error(fmt.Sprintf("error scheduling user deletion: %v", err), requestID)
+		return nil, fmt.Errorf("failed to schedule account deletion")
+	}
+	usecase.logger.Infof("user '%s' will be deleted at %s", userID, scheduledAt.Format(time.RFC3339))
+	usecase.record(ctx, uuid.Nil, audit.ActionDeletionRequest, userID.String(), nil, map[string]any{"scheduledAt": scheduledAt})
+
+	return &dto.DeletionDTO{ScheduledAt: scheduledAt}, nil
+}
+
+func (usecase *userUsecase) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := usecase.pgRepo.CancelDeletion(ctx, userID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("failed to cancel deletion of user '%s': %v", userID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return user.ErrDeletionNotFound
+		}
+		return fmt.Errorf("failed to cancel account deletion")
+	}
+	usecase.logger.Infof("deletion of user '%s' was canceled", userID)
+	usecase.record(ctx, uuid.Nil, audit.ActionDeletionCancel, userID.String(), nil, nil)
+
+	return nil
+}
+
+func (usecase *userUsecase) Delete(ctx context.Context, userID uuid.UUID) error {
+	requestID := ctx.Value(utils.RequestIDKey{})
+	if err := usecase.purge(ctx, userID); err != nil {
+		usecase.logger.Warn(fmt.Sprintf("failed to delete user '%s': %v", userID, err), requestID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return user.ErrUserNotFound
+		}
+		return fmt.Errorf("failed to delete user")
+	}
+
+	return nil
+}
+
+func (usecase *userUsecase) PurgeDueDeletions(ctx context.Context) error {
+	userIDs, err := usecase.pgRepo.FindDueDeletions(ctx, deletionBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		// a user left behind is picked up again by the next run
+		if err := usecase.purge(ctx, userID); err != nil {
+			usecase.logger.Warnf("failed to delete user '%s': %v", userID, err)
+		}
+	}
+	return nil
+}
+
+// purge removes the objects of the user from the storage and then the user,
+// whose rows in the other tables are removed or anonymized by the database.
+// The user is kept when an object can't be removed, so that nothing is
+// orphaned in the storage.
+func (usecase *userUsecase) purge(ctx context.Context, userID uuid.UUID) error {
+	objects, err := usecase.pgRepo.FindObjects(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range storageObjects(objects) {
+		if err := usecase.s3Repo.Remove(ctx, object.bucket, object.key); err != nil {
+			return fmt.Errorf("failed to remove '%s/%s': %w", object.bucket, object.key, err)
+		}
+	}
+
+	if err := usecase.pgRepo.Delete(ctx, userID); err != nil {
+		return err
+	}
+	usecase.logger.Infof("user '%s' was deleted", userID)
+	usecase.record(ctx, uuid.Nil, audit.ActionUserDelete, userID.String(), nil, nil)
+
+	return nil
+}
+
+// storageObjects lists the stored objects of the user. Playlist covers are
+// only removed when they are renditions of an uploaded image, the other
+// ones may be shared with the catalog.
+func storageObjects(objects *models.UserObjects) []storageObject {
+	var result []storageObject
+
+	avatarKeys := imaging.Keys(objects.Image)
+	if avatarKeys == nil && objects.Image != "" && objects.Image != defaultAvatar {
+		avatarKeys = []string{objects.Image}
+	}
+	for _, key := range avatarKeys {
+		result = append(result, storageObject{avatarsBucket, key})
+	}
+
+	for _, image := range objects.PlaylistImages {
+		for _, key := range imaging.Keys(image) {
+			result = append(result, storageObject{coversBucket, key})
+		}
+	}
+
+	for _, key := range objects.ExportKeys {
+		result = append(result, storageObject{exportsBucket, key})
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_RequestDeletion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, mock.NewMockS3Repo(ctrl), newRecorderMock(ctrl), logger)
+
+	foundUser := &models.User{UserID: uuid.New(), Password: "password"}
+	require.NoError(t, foundUser.HashPassword())
+
+	ctx := context.Background()
+	pgRepoMock.EXPECT().FindByID(ctx, foundUser.UserID).Return(foundUser, nil).Times(2)
+	pgRepoMock.EXPECT().ScheduleDeletion(ctx, foundUser.UserID, gomock.Any()).Return(nil)
+
+	deletionDTO, err := userUsecase.RequestDeletion(ctx, foundUser.UserID, "password")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(deletionGracePeriod), deletionDTO.ScheduledAt, time.Minute)
+
+	_, err = userUsecase.RequestDeletion(ctx, foundUser.UserID, "wrong")
+	require.ErrorIs(t, err, user.ErrInvalidPassword)
+}
+
+func TestUsecase_CancelDeletion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, mock.NewMockS3Repo(ctrl), newRecorderMock(ctrl), logger)
+
+	userID := uuid.New()
+	ctx := context.Background()
+	pgRepoMock.EXPECT().CancelDeletion(ctx, userID).Return(nil)
+	require.NoError(t, userUsecase.CancelDeletion(ctx, userID))
+
+	pgRepoMock.EXPECT().CancelDeletion(ctx, userID).Return(sql.ErrNoRows)
+	require.ErrorIs(t, userUsecase.CancelDeletion(ctx, userID), user.ErrDeletionNotFound)
+}
+
+func TestUsecase_Delete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+	s3RepoMock := mock.NewMockS3Repo(ctrl)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
+
+	userID := uuid.New()
+	avatar := imaging.Largest("0b8f7f5e-6a43-4c55-9a8e-3f0c2b1d4e5f")
+	cover := imaging.Largest("1c9a8a6f-7b54-4d66-8b9f-4a1d3c2e5f60")
+	objects := &models.UserObjects{
+		Image:          avatar,
+		PlaylistImages: []string{cover, "default.webp"},
+		ExportKeys:     []string{userID.String() + "/3.zip"},
+	}
+
+	ctx := context.Background()
+	pgRepoMock.EXPECT().FindObjects(ctx, userID).Return(objects, nil)
+	for _, key := range imaging.Keys(avatar) {
+		s3RepoMock.EXPECT().Remove(ctx, "avatars", key).Return(nil)
+	}
+	for _, key := range imaging.Keys(cover) {
+		s3RepoMock.EXPECT().Remove(ctx, "playlists", key).Return(nil)
+	}
+	s3RepoMock.EXPECT().Remove(ctx, "exports", userID.String()+"/3.zip").Return(nil)
+	pgRepoMock.EXPECT().Delete(ctx, userID).Return(nil)
+
+	require.NoError(t, userUsecase.Delete(ctx, userID))
+
+	pgRepoMock.EXPECT().FindObjects(ctx, userID).Return(nil, fmt.Errorf("failed to find user objects: %w", sql.ErrNoRows))
+	require.ErrorIs(t, userUsecase.Delete(ctx, userID), user.ErrUserNotFound)
+}
+
+func TestUsecase_PurgeDueDeletions_KeepsUserOnStorageError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+	s3RepoMock := mock.NewMockS3Repo(ctrl)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
+
+	failing, legacy := uuid.New(), uuid.New()
+	ctx := context.Background()
+	pgRepoMock.EXPECT().FindDueDeletions(ctx, deletionBatchSize).Return([]uuid.UUID{failing, legacy}, nil)
+
+	pgRepoMock.EXPECT().FindObjects(ctx, failing).Return(&models.UserObjects{ExportKeys: []string{"a.zip"}}, nil)
+	s3RepoMock.EXPECT().Remove(ctx, "exports", "a.zip").Return(fmt.Errorf("unavailable"))
+
+	pgRepoMock.EXPECT().FindObjects(ctx, legacy).Return(&models.UserObjects{Image: "avatar.png"}, nil)
+	s3RepoMock.EXPECT().Remove(ctx, "avatars", "avatar.png").Return(nil)
+	pgRepoMock.EXPECT().Delete(ctx, legacy).Return(nil)
+
+	require.NoError(t, userUsecase.PurgeDueDeletions(ctx))
+}
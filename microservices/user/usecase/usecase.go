@@ -1,24 +1,30 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/utils"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/dto"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/google/uuid"
 )
 
+const avatarsBucket = "avatars"
+
 type userUsecase struct {
-	cfg    *userUsecaseConfig
-	pgRepo user.PostgresRepo
-	s3Repo s3.S3Repo
-	logger logger.Logger
+	cfg      *userUsecaseConfig
+	pgRepo   user.PostgresRepo
+	s3Repo   s3.S3Repo
+	recorder audit.Recorder
+	logger   logger.Logger
 }
 
 type userUsecaseConfig struct {
@@ -26,7 +32,14 @@ type userUsecaseConfig struct {
 	Minio *config.MinioConfig
 }
 
-func NewUserUsecase(authCfg *config.AuthConfig, minioCfg *config.MinioConfig, pgRepo user.PostgresRepo, s3Repo s3.S3Repo, logger logger.Logger) user.Usecase {
+func NewUserUsecase(
+	authCfg *config.AuthConfig,
+	minioCfg *config.MinioConfig,
+	pgRepo user.PostgresRepo,
+	s3Repo s3.S3Repo,
+	recorder audit.Recorder,
+	logger logger.Logger,
+) user.Usecase {
 	return &userUsecase{
 		&userUsecaseConfig{
 			authCfg,
@@ -34,6 +47,7 @@ func NewUserUsecase(authCfg *config.AuthConfig, minioCfg *config.MinioConfig, pg
 		},
 		pgRepo,
 		s3Repo,
+		recorder,
 		logger,
 	}
 }
@@ -68,6 +82,7 @@ func (usecase *userUsecase) Register(ctx context.Context, user *models.User) (*d
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 	usecase.logger.Infof("user '%s' successfully registered", insertedUser.Username)
+	usecase.record(ctx, insertedUser.UserID, audit.ActionUserRegister, insertedUser.UserID.String(), nil, auditFields(insertedUser))
 
 	token, err := utils.GenerateJWT(&usecase.cfg.Auth.Jwt, insertedUser)
 	if err != nil {
@@ -84,12 +99,14 @@ func (usecase *userUsecase) Login(ctx context.Context, user *models.User) (*dto.
 	foundUser, err := usecase.pgRepo.FindByUsername(ctx, user.Username)
 	if err != nil {
 		usecase.logger.Warn(fmt.Sprintf("user not found: %v", err), requestID)
+		usecase.record(ctx, uuid.Nil, audit.ActionUserLoginFailed, user.Username, nil, nil)
 		return nil, fmt.Errorf("invalid username or password")
 	}
 	usecase.logger.Infof("user found: %s", foundUser.Username)
 
 	if err := foundUser.ComparePasswords(user.Password); err != nil {
 		usecase.logger.Warn(fmt.Sprintf("password comparison failed for user '%s': %v", user.Username, err), requestID)
+		usecase.record(ctx, uuid.Nil, audit.ActionUserLoginFailed, foundUser.UserID.String(), nil, nil)
 		return nil, fmt.Errorf("invalid username or password")
 	}
 
@@ -99,6 +116,8 @@ func (usecase *userUsecase) Login(ctx context.Context, user *models.User) (*dto.
 		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
+	usecase.record(ctx, foundUser.UserID, audit.ActionUserLogin, foundUser.UserID.String(), nil, nil)
+
 	userTokenDTO := dto.NewUserTokenDTO(foundUser, token)
 	return userTokenDTO, nil
 }
@@ -110,6 +129,7 @@ func (usecase *userUsecase) Update(ctx context.Context, user *models.User) (*dto
 		usecase.logger.Warn(fmt.Sprintf("user not found: %v", err), requestID)
 		return nil, fmt.Errorf("failed to find user")
 	}
+	before := auditFields(currentUser)
 
 	if user.Username != "" {
 		currentUser.Username = user.Username
@@ -131,11 +151,18 @@ func (usecase *userUsecase) Update(ctx context.Context, user *models.User) (*dto
 	}
 	usecase.logger.Infof("user '%s' successfully updated", updatedUser.UserID)
 
+	after := auditFields(updatedUser)
+	if user.Password != "" {
+		// the password itself is never recorded, only the fact it was changed
+		after["password"] = "changed"
+	}
+	usecase.record(ctx, uuid.Nil, audit.ActionUserUpdate, updatedUser.UserID.String(), before, after)
+
 	userDTO := dto.NewUserDTO(updatedUser)
 	return userDTO, nil
 }
 
-func (usecase *userUsecase) UploadImage(ctx context.Context, userID uuid.UUID, file s3.Upload) (*dto.UserDTO, error) {
+func (usecase *userUsecase) UploadImage(ctx context.Context, userID uuid.UUID, renditions []*imaging.Rendition) (*dto.UserDTO, error) {
 	requestID := ctx.Value(utils.RequestIDKey{})
 	user, err := usecase.pgRepo.FindByID(ctx, userID)
 	if err != nil {
@@ -143,26 +170,50 @@ func (usecase *userUsecase) UploadImage(ctx context.Context, userID uuid.UUID, f
 		return nil, fmt.Errorf("user not found")
 	}
 
-	uploadInfo, err := usecase.s3Repo.Put(ctx, file)
-	if err != nil {
-		usecase.logger.Warn(fmt.Sprintf("failed to save user image: %v", err), requestID)
-		return nil, fmt.Errorf("failed to save user image")
+	previousImage := user.Image
+	base := uuid.New().String()
+	keys := make([]string, 0, len(renditions))
+	for _, rendition := range renditions {
+		upload := s3.Upload{
+			Bucket:      avatarsBucket,
+			Key:         rendition.Key(base),
+			File:        bytes.NewReader(rendition.Data),
+			Size:        int64(len(rendition.Data)),
+			ContentType: rendition.Format.ContentType(),
+		}
+		if _, err := usecase.s3Repo.Put(ctx, upload); err != nil {
+			usecase.logger.Warn(fmt.Sprintf("failed to save user image: %v", err), requestID)
+			usecase.removeImages(ctx, keys)
+			return nil, fmt.Errorf("failed to save user image")
+		}
+		keys = append(keys, upload.Key)
 	}
 
-	imageURL := uploadInfo.Key
-
 	updatedUserDTO, err := usecase.Update(ctx, &models.User{
 		UserID: user.UserID,
-		Image:  imageURL,
+		Image:  imaging.Largest(base),
 	})
 	if err != nil {
 		usecase.logger.Warn(fmt.Sprintf("failed to update user model: %v", err), requestID)
+		usecase.removeImages(ctx, keys)
 		return nil, fmt.Errorf("failed to update user model")
 	}
 
+	usecase.removeImages(ctx, imaging.Keys(previousImage))
+
 	return updatedUserDTO, nil
 }
 
+// removeImages removes the avatar renditions, a failure only leaves an
+// unreferenced object in the bucket.
+func (usecase *userUsecase) removeImages(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		if err := usecase.s3Repo.Remove(ctx, avatarsBucket, key); err != nil {
+			usecase.logger.Warn(fmt.Sprintf("failed to remove user image '%s': %v", key, err), ctx.Value(utils.RequestIDKey{}))
+		}
+	}
+}
+
 func (usecase *userUsecase) GetByID(ctx context.Context, userID uuid.UUID) (*dto.UserDTO, error) {
 	requestID := ctx.Value(utils.RequestIDKey{})
 	user, err := usecase.pgRepo.FindByID(ctx, userID)
@@ -186,3 +237,26 @@ func (usecase *userUsecase) GetByUsername(ctx context.Context, username string)
 	userDTO := dto.NewUserDTO(user)
 	return userDTO, nil
 }
+
+// record appends an audit event about the user, actorID is taken from ctx
+// when it is uuid.Nil.
+func (usecase *userUsecase) record(ctx context.Context, actorID uuid.UUID, action, userID string, before, after any) {
+	event := &models.AuditEvent{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: audit.TargetUser,
+		TargetID:   userID,
+	}
+	event.Before, event.After = audit.Diff(before, after)
+	usecase.recorder.Record(ctx, event)
+}
+
+// auditFields are the fields of the user recorded by the audit log.
+func auditFields(user *models.User) map[string]any {
+	return map[string]any{
+		"username": user.Username,
+		"email":    user.Email,
+		"role":     user.Role,
+		"image":    user.Image,
+	}
+}
@@ -4,17 +4,29 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit"
+	auditMock "github.com/go-park-mail-ru/2024_2_NovaCode/internal/audit/mock"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/internal/models"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/microservices/user/mock"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/db/s3"
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/imaging"
 	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/logger"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/require"
 )
 
+func newRecorderMock(ctrl *gomock.Controller) *auditMock.MockRecorder {
+	recorderMock := auditMock.NewMockRecorder(ctrl)
+	recorderMock.EXPECT().Record(gomock.Any(), gomock.Any()).AnyTimes()
+	return recorderMock
+}
+
 func TestUsecase_Register(t *testing.T) {
 	t.Parallel()
 
@@ -38,7 +50,7 @@ func TestUsecase_Register(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	user := &models.User{
 		Username: "test_user",
@@ -81,7 +93,7 @@ func TestUsecase_Register_UsernameAlreadyExists(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	user := &models.User{
 		Username: "test_user",
@@ -126,7 +138,7 @@ func TestUsecase_Register_EmailAlreadyExists(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	user := &models.User{
 		Username: "test_user",
@@ -173,7 +185,7 @@ func TestUsecase_Register_InsertError(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	user := &models.User{
 		Username: "test_user",
@@ -217,7 +229,7 @@ func TestUsecase_Login(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	password := "password"
 
@@ -268,7 +280,7 @@ func TestUsecase_Login_InvalidPassword(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	password := "password"
 	wrongPassword := "wrong_password"
@@ -318,7 +330,8 @@ func TestUsecase_Login_UserNotFound(t *testing.T) {
 	logger := logger.New(&cfg.Service.Logger)
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, logger)
+	recorderMock := auditMock.NewMockRecorder(ctrl)
+	userUsecase := NewUserUsecase(&cfg.Service.Auth, &cfg.Minio, pgRepoMock, s3RepoMock, recorderMock, logger)
 
 	user := &models.User{
 		Username: "test_user",
@@ -328,6 +341,11 @@ func TestUsecase_Login_UserNotFound(t *testing.T) {
 	ctx := context.Background()
 
 	pgRepoMock.EXPECT().FindByUsername(ctx, gomock.Eq(user.Username)).Return(nil, sql.ErrNoRows)
+	recorderMock.EXPECT().Record(ctx, &models.AuditEvent{
+		Action:     audit.ActionUserLoginFailed,
+		TargetType: audit.TargetUser,
+		TargetID:   user.Username,
+	})
 
 	userToken, err := userUsecase.Login(ctx, user)
 	require.Error(t, err)
@@ -344,7 +362,7 @@ func TestUsecase_Update(t *testing.T) {
 	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
 	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
 	s3RepoMock := mock.NewMockS3Repo(ctrl)
-	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, logger)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
 
 	user := &models.User{
 		UserID:   uuid.New(),
@@ -370,3 +388,109 @@ func TestUsecase_Update(t *testing.T) {
 	require.NotNil(t, updatedUserDTO)
 	require.Equal(t, user.Username, updatedUserDTO.Username)
 }
+
+func TestUsecase_UploadImage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+	s3RepoMock := mock.NewMockS3Repo(ctrl)
+	userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
+
+	previousImage := imaging.Largest("0b8f7f5e-6a43-4c55-9a8e-3f0c2b1d4e5f")
+	user := &models.User{
+		UserID:   uuid.New(),
+		Username: "test_user",
+		Image:    previousImage,
+	}
+	renditions := []*imaging.Rendition{
+		{Size: 64, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+		{Size: 64, Format: imaging.FormatWebP, Data: []byte("webp")},
+	}
+
+	ctx := context.Background()
+
+	var keys []string
+	pgRepoMock.EXPECT().FindByID(ctx, user.UserID).Return(user, nil).Times(2)
+	s3RepoMock.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+		require.Equal(t, "avatars", upload.Bucket)
+		keys = append(keys, upload.Key)
+		return &minio.UploadInfo{Key: upload.Key}, nil
+	}).Times(2)
+	pgRepoMock.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, updated *models.User) (*models.User, error) {
+		return updated, nil
+	})
+	for _, key := range imaging.Keys(previousImage) {
+		s3RepoMock.EXPECT().Remove(ctx, "avatars", key).Return(nil)
+	}
+
+	userDTO, err := userUsecase.UploadImage(ctx, user.UserID, renditions)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Regexp(t, `/64\.jpg$`, keys[0])
+	require.Regexp(t, `/64\.webp$`, keys[1])
+	require.Equal(t, imaging.Largest(strings.TrimSuffix(keys[0], "/64.jpg")), userDTO.Image)
+	require.Len(t, userDTO.Images, len(imaging.Sizes))
+}
+
+func TestUsecase_UploadImageFailure(t *testing.T) {
+	t.Parallel()
+
+	logger := logger.New(&config.LoggerConfig{Level: "info", Format: "json"})
+	user := &models.User{
+		UserID:   uuid.New(),
+		Username: "test_user",
+		Image:    imaging.Largest("0b8f7f5e-6a43-4c55-9a8e-3f0c2b1d4e5f"),
+	}
+	renditions := []*imaging.Rendition{
+		{Size: 64, Format: imaging.FormatJPEG, Data: []byte("jpeg")},
+		{Size: 64, Format: imaging.FormatWebP, Data: []byte("webp")},
+	}
+	ctx := context.Background()
+
+	t.Run("Upload fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
+
+		var uploaded string
+		pgRepoMock.EXPECT().FindByID(ctx, user.UserID).Return(user, nil)
+		gomock.InOrder(
+			s3RepoMock.EXPECT().Put(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, upload s3.Upload) (*minio.UploadInfo, error) {
+				uploaded = upload.Key
+				return &minio.UploadInfo{Key: upload.Key}, nil
+			}),
+			s3RepoMock.EXPECT().Put(ctx, gomock.Any()).Return(nil, fmt.Errorf("bucket is unavailable")),
+		)
+		s3RepoMock.EXPECT().Remove(ctx, "avatars", gomock.Any()).DoAndReturn(func(_ context.Context, _, key string) error {
+			require.Equal(t, uploaded, key)
+			return nil
+		})
+
+		_, err := userUsecase.UploadImage(ctx, user.UserID, renditions)
+		require.Error(t, err)
+	})
+
+	t.Run("Update fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		pgRepoMock := mock.NewMockPostgresRepo(ctrl)
+		s3RepoMock := mock.NewMockS3Repo(ctrl)
+		userUsecase := NewUserUsecase(nil, nil, pgRepoMock, s3RepoMock, newRecorderMock(ctrl), logger)
+
+		pgRepoMock.EXPECT().FindByID(ctx, user.UserID).Return(user, nil).Times(2)
+		s3RepoMock.EXPECT().Put(ctx, gomock.Any()).Return(&minio.UploadInfo{}, nil).Times(2)
+		pgRepoMock.EXPECT().Update(ctx, gomock.Any()).Return(nil, fmt.Errorf("connection reset"))
+		s3RepoMock.EXPECT().Remove(ctx, "avatars", gomock.Any()).Return(nil).Times(2)
+
+		_, err := userUsecase.UploadImage(ctx, user.UserID, renditions)
+		require.Error(t, err)
+	})
+}
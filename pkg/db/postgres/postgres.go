@@ -6,21 +6,18 @@ import (
 	"time"
 
 	"github.com/go-park-mail-ru/2024_2_NovaCode/config"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type Client *sql.DB
 
-func New(cfg *config.PostgresConfig) (Client, error) {
-	connStr := fmt.Sprintf("host=%s port=%s user=%s dbname=%s sslmode=disable password=%s",
-		cfg.Host,
-		cfg.Port,
-		cfg.User,
-		cfg.DBName,
-		cfg.Password,
-	)
+const (
+	listenerMinReconnectInterval = 10 * time.Second
+	listenerMaxReconnectInterval = time.Minute
+)
 
-	db, err := sql.Open(cfg.Driver, connStr)
+func New(cfg *config.PostgresConfig) (Client, error) {
+	db, err := sql.Open(cfg.Driver, connString(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -36,3 +33,25 @@ func New(cfg *config.PostgresConfig) (Client, error) {
 
 	return db, nil
 }
+
+// NewListener opens a dedicated connection subscribed to the channel,
+// it reconnects by itself when the connection is lost.
+func NewListener(cfg *config.PostgresConfig, channel string) (*pq.Listener, error) {
+	listener := pq.NewListener(connString(cfg), listenerMinReconnectInterval, listenerMaxReconnectInterval, nil)
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("cannot listen to %s channel: %v", channel, err)
+	}
+
+	return listener, nil
+}
+
+func connString(cfg *config.PostgresConfig) string {
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s sslmode=disable password=%s",
+		cfg.Host,
+		cfg.Port,
+		cfg.User,
+		cfg.DBName,
+		cfg.Password,
+	)
+}
@@ -25,7 +25,12 @@ func (repo *S3Repo) Put(ctx context.Context, upload s3.Upload) (*minio.UploadInf
 		UserMetadata: map[string]string{"x-amz-acl": "public-read"},
 	}
 
-	uploadInfo, err := repo.client.PutObject(ctx, upload.Bucket, repo.generateFilename(upload.Filename), upload.File, upload.Size, options)
+	key := upload.Key
+	if key == "" {
+		key = repo.generateFilename(upload.Filename)
+	}
+
+	uploadInfo, err := repo.client.PutObject(ctx, upload.Bucket, key, upload.File, upload.Size, options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to put object: %v", err)
 	}
@@ -3,7 +3,10 @@ package s3
 import "io"
 
 type Upload struct {
-	Bucket      string
+	Bucket string
+	// Key is the object name. When empty, a unique name is generated from
+	// Filename.
+	Key         string
 	File        io.Reader
 	Filename    string
 	Size        int64
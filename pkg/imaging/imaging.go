@@ -0,0 +1,254 @@
+// Package imaging turns uploaded pictures into the fixed-size renditions
+// served for avatars and covers.
+//
+// Uploads are decoded and re-encoded, so metadata such as EXIF GPS tags never
+// reaches the storage, and markup based formats like SVG are rejected.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"regexp"
+
+	"github.com/go-park-mail-ru/2024_2_NovaCode/pkg/webp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooSmall          = errors.New("image is too small")
+	ErrTooLarge          = errors.New("image is too large")
+)
+
+// Format is the encoding of a rendition, named after its file extension.
+type Format string
+
+const (
+	FormatJPEG Format = "jpg"
+	FormatWebP Format = "webp"
+)
+
+func (f Format) ContentType() string {
+	if f == FormatWebP {
+		return "image/webp"
+	}
+	return "image/jpeg"
+}
+
+// Sizes are the side lengths, in pixels, of the square renditions from the
+// smallest to the largest.
+var Sizes = []int{64, 300, 640}
+
+const (
+	MaxFileSize = 15 << 20
+	MinSide     = 64
+	MaxSide     = 8000
+	MaxPixels   = 24_000_000
+
+	jpegQuality = 85
+	webpQuality = 80
+)
+
+// Rendition is one encoded size of an image.
+type Rendition struct {
+	Size   int
+	Format Format
+	Data   []byte
+}
+
+// Key returns the storage key of the rendition for the image stored under base.
+func (r *Rendition) Key(base string) string {
+	return Key(base, r.Size, r.Format)
+}
+
+// Key returns the storage key of the rendition of the given size and format
+// for the image stored under base.
+func Key(base string, size int, format Format) string {
+	return fmt.Sprintf("%s/%d.%s", base, size, format)
+}
+
+// Process validates an uploaded picture and returns its renditions for every
+// size in Sizes, both in JPEG and WebP. The picture is center-cropped to a
+// square and transparent areas are flattened onto white.
+func Process(data []byte) ([]*Rendition, error) {
+	src, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	bounds := src.Bounds()
+	if bounds.Dx() < MinSide || bounds.Dy() < MinSide {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooSmall, bounds.Dx(), bounds.Dy())
+	}
+
+	return encode(src, squareCrop(bounds))
+}
+
+// Mosaic composes a cover from the pictures of up to four covers: four or
+// more are laid out in a 2x2 grid, while fewer leave the first one filling
+// the whole cover. The renditions are produced like the ones of Process.
+func Mosaic(covers [][]byte) ([]*Rendition, error) {
+	if len(covers) == 0 {
+		return nil, fmt.Errorf("%w: no covers", ErrUnsupportedFormat)
+	}
+	if len(covers) < 4 {
+		covers = covers[:1]
+	} else {
+		covers = covers[:4]
+	}
+
+	side := Sizes[len(Sizes)-1]
+	half := side / 2
+	canvas := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(canvas, canvas.Bounds(), image.White, image.Point{}, draw.Src)
+	for i, cover := range covers {
+		src, err := decode(cover)
+		if err != nil {
+			return nil, err
+		}
+
+		tile := canvas.Bounds()
+		if len(covers) == 4 {
+			tile = image.Rect(0, 0, half, half).Add(image.Pt(i%2*half, i/2*half))
+		}
+		xdraw.CatmullRom.Scale(canvas, tile, src, squareCrop(src.Bounds()), xdraw.Over, nil)
+	}
+
+	return encode(canvas, canvas.Bounds())
+}
+
+// decode validates the file and decodes the picture upright.
+func decode(data []byte) (image.Image, error) {
+	if len(data) > MaxFileSize {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTooLarge, len(data))
+	}
+	if isMarkup(data) {
+		return nil, fmt.Errorf("%w: markup images are not allowed", ErrUnsupportedFormat)
+	}
+
+	// The dimensions are checked before decoding, so a small file declaring a
+	// huge picture can't exhaust the memory.
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if config.Width > MaxSide || config.Height > MaxSide || config.Width*config.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooLarge, config.Width, config.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, err)
+	}
+	if format == "jpeg" {
+		src = orient(src, orientation(data))
+	}
+	return src, nil
+}
+
+// squareCrop returns the largest square centered in bounds.
+func squareCrop(bounds image.Rectangle) image.Rectangle {
+	side := min(bounds.Dx(), bounds.Dy())
+	return image.Rect(0, 0, side, side).Add(bounds.Min).Add(image.Pt((bounds.Dx()-side)/2, (bounds.Dy()-side)/2))
+}
+
+// encode scales the crop of src to every size in Sizes and encodes each of
+// them both in JPEG and WebP.
+func encode(src image.Image, crop image.Rectangle) ([]*Rendition, error) {
+	renditions := make([]*Rendition, 0, 2*len(Sizes))
+	var larger *image.RGBA
+	for i := len(Sizes) - 1; i >= 0; i-- {
+		// Smaller sizes are scaled down from the previous rendition, which is
+		// much cheaper than resampling a large original again.
+		size := Sizes[i]
+		var scaled *image.RGBA
+		if larger != nil {
+			scaled = scale(larger, larger.Bounds(), size)
+		} else {
+			scaled = scale(src, crop, size)
+		}
+		larger = scaled
+
+		var jpegBuf, webpBuf bytes.Buffer
+		if err := jpeg.Encode(&jpegBuf, scaled, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode jpeg rendition: %w", err)
+		}
+		if err := webp.Encode(&webpBuf, scaled, &webp.Options{Quality: webpQuality}); err != nil {
+			return nil, fmt.Errorf("failed to encode webp rendition: %w", err)
+		}
+		renditions = append(renditions,
+			&Rendition{Size: size, Format: FormatJPEG, Data: jpegBuf.Bytes()},
+			&Rendition{Size: size, Format: FormatWebP, Data: webpBuf.Bytes()},
+		)
+	}
+
+	return renditions, nil
+}
+
+// scale resamples the rect of src into a new size x size image on a white
+// background.
+func scale(src image.Image, rect image.Rectangle, size int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), src, rect, xdraw.Over, nil)
+	return dst
+}
+
+// isMarkup reports whether data looks like an XML or HTML document, which
+// covers SVG. Every supported raster format starts with a binary signature.
+func isMarkup(data []byte) bool {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.TrimLeft(data, " \t\r\n")
+	return len(data) > 0 && data[0] == '<'
+}
+
+// Variant lists the storage keys of the renditions of one size.
+type Variant struct {
+	Size int
+	JPEG string
+	WebP string
+}
+
+var keyPattern = regexp.MustCompile(`^(.*[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})/\d+\.(?:jpg|webp)$`)
+
+// Variants returns the renditions of the image given by the key of any of
+// them, or nil when the image was not produced by Process, like the images
+// uploaded before the renditions were introduced.
+func Variants(image string) []Variant {
+	match := keyPattern.FindStringSubmatch(image)
+	if match == nil {
+		return nil
+	}
+
+	variants := make([]Variant, 0, len(Sizes))
+	for _, size := range Sizes {
+		variants = append(variants, Variant{
+			Size: size,
+			JPEG: Key(match[1], size, FormatJPEG),
+			WebP: Key(match[1], size, FormatWebP),
+		})
+	}
+	return variants
+}
+
+// Keys returns the storage keys of all renditions of the image given by the
+// key of any of them.
+func Keys(image string) []string {
+	var keys []string
+	for _, variant := range Variants(image) {
+		keys = append(keys, variant.JPEG, variant.WebP)
+	}
+	return keys
+}
+
+// Largest returns the key of the largest JPEG rendition stored under base, the
+// one kept in the models as the image of an entity.
+func Largest(base string) string {
+	return Key(base, Sizes[len(Sizes)-1], FormatJPEG)
+}
@@ -0,0 +1,205 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	_ "golang.org/x/image/webp"
+)
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	m := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			m.SetNRGBA(x, y, color.NRGBA{uint8(x), uint8(y), 100, 200})
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, m))
+	return buf.Bytes()
+}
+
+// withEXIF inserts an APP1 segment with the given orientation after the SOI
+// marker of a JPEG file.
+func withEXIF(data []byte, orientation uint16) []byte {
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1}
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:], orientationTag)
+	binary.BigEndian.PutUint16(entry[2:], 3)
+	binary.BigEndian.PutUint32(entry[4:], 1)
+	binary.BigEndian.PutUint16(entry[8:], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	header := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(header[2:], uint16(len(segment)+2))
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, header...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+
+	renditions, err := Process(encodePNG(t, 800, 500))
+	require.NoError(t, err)
+	require.Len(t, renditions, 2*len(Sizes))
+
+	for _, rendition := range renditions {
+		decoded, format, err := image.Decode(bytes.NewReader(rendition.Data))
+		require.NoError(t, err)
+		require.Equal(t, image.Rect(0, 0, rendition.Size, rendition.Size), decoded.Bounds())
+
+		switch rendition.Format {
+		case FormatJPEG:
+			require.Equal(t, "jpeg", format)
+		case FormatWebP:
+			require.Equal(t, "webp", format)
+		}
+	}
+}
+
+func TestProcessStripsMetadata(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 100, 100)), nil))
+	data := withEXIF(buf.Bytes(), 6)
+	require.Equal(t, 6, orientation(data))
+
+	renditions, err := Process(data)
+	require.NoError(t, err)
+	for _, rendition := range renditions {
+		require.NotContains(t, string(rendition.Data), "Exif")
+	}
+}
+
+func TestProcessRejects(t *testing.T) {
+	t.Parallel()
+
+	svg := []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"></svg>`)
+	_, err := Process(svg)
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = Process([]byte("\n  <svg></svg>"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = Process([]byte("definitely not an image"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = Process(encodePNG(t, 32, 300))
+	require.ErrorIs(t, err, ErrTooSmall)
+
+	// A tiny file whose header declares a huge picture.
+	huge := encodePNG(t, 64, 64)
+	binary.BigEndian.PutUint32(huge[16:], 20000)
+	binary.BigEndian.PutUint32(huge[20:], 20000)
+	binary.BigEndian.PutUint32(huge[29:], crc32.ChecksumIEEE(huge[12:29]))
+	_, err = Process(huge)
+	require.ErrorIs(t, err, ErrTooLarge)
+}
+
+func TestOrient(t *testing.T) {
+	t.Parallel()
+
+	// A 2x1 image with a red left and a blue right pixel.
+	red, blue := color.RGBA{255, 0, 0, 255}, color.RGBA{0, 0, 255, 255}
+	m := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	m.SetRGBA(0, 0, red)
+	m.SetRGBA(1, 0, blue)
+
+	rotated := orient(m, 6)
+	require.Equal(t, image.Rect(0, 0, 1, 2), rotated.Bounds())
+	require.Equal(t, red, rotated.At(0, 0))
+	require.Equal(t, blue, rotated.At(0, 1))
+
+	rotated = orient(m, 8)
+	require.Equal(t, blue, rotated.At(0, 0))
+	require.Equal(t, red, rotated.At(0, 1))
+
+	mirrored := orient(m, 2)
+	require.Equal(t, blue, mirrored.At(0, 0))
+
+	require.Same(t, m, orient(m, 1))
+}
+
+func TestVariants(t *testing.T) {
+	t.Parallel()
+
+	base := "covers/5f0e1cda-3b4e-4b7a-9d51-0c8f1c3e2a10"
+	variants := Variants(Largest(base))
+	require.Equal(t, []Variant{
+		{Size: 64, JPEG: base + "/64.jpg", WebP: base + "/64.webp"},
+		{Size: 300, JPEG: base + "/300.jpg", WebP: base + "/300.webp"},
+		{Size: 640, JPEG: base + "/640.jpg", WebP: base + "/640.webp"},
+	}, variants)
+	require.Len(t, Keys(base+"/64.webp"), 2*len(Sizes))
+
+	require.Nil(t, Variants("/imgs/artists/artist_1.jpg"))
+	require.Nil(t, Variants("3c1d1b5e-bb0c-4a3f-a4b2-5f7c1f0b1d2e-avatar.png"))
+	require.Nil(t, Keys(""))
+}
+
+func encodeFill(t *testing.T, fill color.RGBA) []byte {
+	m := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			m.SetRGBA(x, y, fill)
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, m))
+	return buf.Bytes()
+}
+
+func TestMosaic(t *testing.T) {
+	t.Parallel()
+
+	red, green := color.RGBA{255, 0, 0, 255}, color.RGBA{0, 255, 0, 255}
+	blue, black := color.RGBA{0, 0, 255, 255}, color.RGBA{0, 0, 0, 255}
+	covers := [][]byte{encodeFill(t, red), encodeFill(t, green), encodeFill(t, blue), encodeFill(t, black), encodeFill(t, red)}
+
+	renditions, err := Mosaic(covers)
+	require.NoError(t, err)
+	require.Len(t, renditions, 2*len(Sizes))
+
+	require.Equal(t, FormatJPEG, renditions[0].Format)
+	decoded, err := jpeg.Decode(bytes.NewReader(renditions[0].Data))
+	require.NoError(t, err)
+	side := Sizes[len(Sizes)-1]
+	require.Equal(t, image.Rect(0, 0, side, side), decoded.Bounds())
+
+	near := func(want color.RGBA, x, y int) {
+		r, g, b, _ := decoded.At(x, y).RGBA()
+		require.InDelta(t, want.R, r>>8, 24, "at %d,%d", x, y)
+		require.InDelta(t, want.G, g>>8, 24, "at %d,%d", x, y)
+		require.InDelta(t, want.B, b>>8, 24, "at %d,%d", x, y)
+	}
+	near(red, side/4, side/4)
+	near(green, 3*side/4, side/4)
+	near(blue, side/4, 3*side/4)
+	near(black, 3*side/4, 3*side/4)
+
+	renditions, err = Mosaic(covers[:2])
+	require.NoError(t, err)
+	decoded, err = jpeg.Decode(bytes.NewReader(renditions[0].Data))
+	require.NoError(t, err)
+	near(red, 3*side/4, 3*side/4)
+
+	_, err = Mosaic(nil)
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	_, err = Mosaic([][]byte{[]byte("<svg></svg>")})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
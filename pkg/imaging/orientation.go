@@ -0,0 +1,122 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+)
+
+// orientationTag is the EXIF tag holding how the camera was held.
+const orientationTag = 0x0112
+
+// orientation returns the EXIF orientation of a JPEG file, from 1 to 8, or 1
+// when the file has none.
+func orientation(data []byte) int {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return 1
+	}
+
+	// Walk the marker segments up to the start of the scan.
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xda || marker == 0xd9 {
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		if marker == 0xe1 {
+			if o := exifOrientation(data[i+4 : i+2+length]); o != 0 {
+				return o
+			}
+		}
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// exifOrientation returns the orientation stored in the first IFD of an APP1
+// segment, or 0 when there is none.
+func exifOrientation(segment []byte) int {
+	if len(segment) < 14 || string(segment[:6]) != "Exif\x00\x00" {
+		return 0
+	}
+
+	tiff := segment[6:]
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 0
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < entries; n++ {
+		entry := ifd + 2 + 12*n
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == orientationTag {
+			if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+				return o
+			}
+			return 0
+		}
+	}
+
+	return 0
+}
+
+// orient returns m transformed to display upright for the EXIF orientation o.
+func orient(m image.Image, o int) image.Image {
+	if o <= 1 || o > 8 {
+		return m
+	}
+
+	bounds := m.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	if o >= 5 {
+		dst = image.NewRGBA(image.Rect(0, 0, h, w))
+	}
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch o {
+			case 2: // Mirrored horizontally.
+				dx, dy = w-1-x, y
+			case 3: // Rotated by 180 degrees.
+				dx, dy = w-1-x, h-1-y
+			case 4: // Mirrored vertically.
+				dx, dy = x, h-1-y
+			case 5: // Transposed.
+				dx, dy = y, x
+			case 6: // Rotated by 90 degrees clockwise to display.
+				dx, dy = h-1-y, x
+			case 7: // Transversed.
+				dx, dy = h-1-y, w-1-x
+			case 8: // Rotated by 90 degrees counterclockwise to display.
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, m.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,99 @@
+package libraryexport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// columns lists the header names services use for each field, lowercase.
+var columns = map[string][]string{
+	"title":    {"track name", "title", "track", "track title", "song", "song name", "name"},
+	"artist":   {"artist name(s)", "artist", "artists", "artist name", "creator"},
+	"album":    {"album name", "album", "album title"},
+	"isrc":     {"isrc"},
+	"playlist": {"playlist", "playlist name"},
+}
+
+func parseCSV(data []byte) ([]*Entry, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = delimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	index := make(map[string]int)
+	for field, names := range columns {
+		index[field] = -1
+		for i, column := range header {
+			if containsName(names, strings.ToLower(strings.TrimSpace(column))) {
+				index[field] = i
+				break
+			}
+		}
+	}
+	if index["title"] < 0 {
+		return nil, ErrUnsupportedFormat
+	}
+
+	var entries []*Entry
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+
+		value := func(field string) string {
+			if i := index[field]; i >= 0 && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		entry := newEntry(value("playlist"), value("title"), value("artist"), value("album"), value("isrc"))
+		if entry.Title == "" {
+			continue
+		}
+		if len(entries) == MaxEntries {
+			return nil, ErrTooManyEntries
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// delimiter picks the separator of the header line, since spreadsheets
+// localized for Europe export with semicolons.
+func delimiter(data []byte) rune {
+	line := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		line = data[:i]
+	}
+
+	best, count := ',', bytes.Count(line, []byte{','})
+	for _, candidate := range []rune{';', '\t'} {
+		if n := bytes.Count(line, []byte{byte(candidate)}); n > count {
+			best, count = candidate, n
+		}
+	}
+	return best
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
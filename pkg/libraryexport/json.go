@@ -0,0 +1,114 @@
+package libraryexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonLibrary covers the YourLibrary.json and Playlist1.json files of the
+// Spotify data export, which keep the liked songs in "tracks" and the
+// playlists in "playlists".
+type jsonLibrary struct {
+	Tracks    []jsonTrack    `json:"tracks"`
+	Playlists []jsonPlaylist `json:"playlists"`
+}
+
+type jsonPlaylist struct {
+	Name   string      `json:"name"`
+	Items  []jsonTrack `json:"items"`
+	Tracks []jsonTrack `json:"tracks"`
+}
+
+// jsonTrack accepts the field names of the known layouts. Track is either
+// the title or, in Spotify playlists, the nested track.
+type jsonTrack struct {
+	Title      string          `json:"title"`
+	Name       string          `json:"name"`
+	TrackName  string          `json:"trackName"`
+	Track      json.RawMessage `json:"track"`
+	Artist     string          `json:"artist"`
+	ArtistName string          `json:"artistName"`
+	Album      string          `json:"album"`
+	AlbumName  string          `json:"albumName"`
+	ISRC       string          `json:"isrc"`
+	Playlist   string          `json:"playlist"`
+}
+
+func parseJSON(data []byte) ([]*Entry, error) {
+	var library jsonLibrary
+	if content := bytes.TrimLeft(data, " \t\r\n"); len(content) > 0 && content[0] == '[' {
+		if err := json.Unmarshal(data, &library.Tracks); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+		}
+	} else if err := json.Unmarshal(data, &library); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if library.Tracks == nil && library.Playlists == nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	var entries []*Entry
+	add := func(playlist string, tracks []jsonTrack) error {
+		for _, track := range tracks {
+			entry, ok := track.entry(playlist)
+			if !ok {
+				continue
+			}
+			if len(entries) == MaxEntries {
+				return ErrTooManyEntries
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	}
+
+	if err := add("", library.Tracks); err != nil {
+		return nil, err
+	}
+	for _, playlist := range library.Playlists {
+		if err := add(playlist.Name, playlist.Items); err != nil {
+			return nil, err
+		}
+		if err := add(playlist.Name, playlist.Tracks); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+func (t *jsonTrack) entry(playlist string) (*Entry, bool) {
+	track := *t
+	if len(track.Track) > 0 {
+		var title string
+		if err := json.Unmarshal(track.Track, &title); err == nil {
+			track.Title = firstNonEmpty(track.Title, title)
+		} else {
+			var nested jsonTrack
+			if err := json.Unmarshal(track.Track, &nested); err != nil {
+				return nil, false
+			}
+			nested.Playlist = firstNonEmpty(nested.Playlist, track.Playlist)
+			return nested.entry(playlist)
+		}
+	}
+
+	entry := newEntry(
+		firstNonEmpty(track.Playlist, playlist),
+		firstNonEmpty(track.Title, track.TrackName, track.Name),
+		firstNonEmpty(track.Artist, track.ArtistName),
+		firstNonEmpty(track.Album, track.AlbumName),
+		track.ISRC,
+	)
+	return entry, entry.Title != ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+// Package libraryexport reads the libraries exported from other streaming
+// services: CSV files like the ones of Exportify or Soundiiz and the JSON
+// files of the Spotify data export.
+package libraryexport
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported library format")
+	ErrInvalidFile       = errors.New("invalid library file")
+	ErrTooManyEntries    = errors.New("library has too many entries")
+)
+
+// MaxEntries limits the number of tracks read from a file.
+const MaxEntries = 10000
+
+// Entry is a track of the library. Playlist is empty for the liked songs.
+type Entry struct {
+	Playlist string
+	Title    string
+	Artist   string
+	Album    string
+	ISRC     string
+}
+
+// Parse reads a library file, guessing its format by the name and falling
+// back to the content. Entries without a title are left out.
+func Parse(filename string, data []byte) ([]*Entry, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".csv", ".tsv":
+		return parseCSV(data)
+	case ".json":
+		return parseJSON(data)
+	}
+
+	content := bytes.TrimLeft(data, " \t\r\n")
+	switch {
+	case len(content) == 0:
+		return nil, ErrInvalidFile
+	case content[0] == '{' || content[0] == '[':
+		return parseJSON(data)
+	}
+	return parseCSV(data)
+}
+
+func newEntry(playlist, title, artist, album, isrc string) *Entry {
+	return &Entry{
+		Playlist: strings.TrimSpace(playlist),
+		Title:    strings.TrimSpace(title),
+		Artist:   strings.TrimSpace(artist),
+		Album:    strings.TrimSpace(album),
+		ISRC:     normalizeISRC(isrc),
+	}
+}
+
+// normalizeISRC drops the dashes some services put in codes and anything
+// that doesn't look like a code.
+func normalizeISRC(isrc string) string {
+	isrc = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(isrc), "-", ""))
+	if len(isrc) != 12 {
+		return ""
+	}
+	for i, r := range isrc {
+		switch {
+		case i < 2 && (r < 'A' || r > 'Z'):
+			return ""
+		case i >= 2 && i < 5 && !(r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'):
+			return ""
+		case i >= 5 && (r < '0' || r > '9'):
+			return ""
+		}
+	}
+	return isrc
+}
@@ -0,0 +1,68 @@
+package libraryexport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	t.Parallel()
+
+	exportify := "\ufeffTrack URI,Track Name,Artist Name(s),Album Name,ISRC\n" +
+		"spotify:track:1,Группа крови,Кино,Группа крови,RU-A12-88-00001\n" +
+		"spotify:track:2,\"Song, With Comma\",\"A, B\",Album,bad\n" +
+		"spotify:track:3,,Nobody,,\n"
+
+	entries, err := Parse("liked.csv", []byte(exportify))
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{
+		{Title: "Группа крови", Artist: "Кино", Album: "Группа крови", ISRC: "RUA128800001"},
+		{Title: "Song, With Comma", Artist: "A, B", Album: "Album"},
+	}, entries)
+
+	semicolons := "Playlist;Title;Artist\nRoad trip;Intro;EKKSTACY\n"
+	entries, err = Parse("export", []byte(semicolons))
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{{Playlist: "Road trip", Title: "Intro", Artist: "EKKSTACY"}}, entries)
+
+	_, err = Parse("export.csv", []byte("Foo,Bar\n1,2\n"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+
+	yourLibrary := `{"tracks": [{"artist": "Кино", "album": "Группа крови", "track": "Группа крови", "uri": "spotify:track:1"}]}`
+	entries, err := Parse("YourLibrary.json", []byte(yourLibrary))
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{{Title: "Группа крови", Artist: "Кино", Album: "Группа крови"}}, entries)
+
+	playlists := `{"playlists": [{"name": "Road trip", "items": [
+		{"track": {"trackName": "Intro", "artistName": "EKKSTACY", "albumName": "misery"}},
+		{"track": null, "episode": {"episodeName": "Podcast"}}
+	]}]}`
+	entries, err = Parse("Playlist1.json", []byte(playlists))
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{{Playlist: "Road trip", Title: "Intro", Artist: "EKKSTACY", Album: "misery"}}, entries)
+
+	generic := `[{"title": "Intro", "artist": "EKKSTACY", "isrc": "USUM71703861", "playlist": "Mix"}]`
+	entries, err = Parse("library", []byte(generic))
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{{Playlist: "Mix", Title: "Intro", Artist: "EKKSTACY", ISRC: "USUM71703861"}}, entries)
+
+	_, err = Parse("library.json", []byte(`{"albums": []}`))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	_, err = Parse("library.json", []byte(`{"tracks": `))
+	require.ErrorIs(t, err, ErrInvalidFile)
+}
+
+func TestParseTooManyEntries(t *testing.T) {
+	t.Parallel()
+
+	file := "title\n" + strings.Repeat("song\n", MaxEntries+1)
+	_, err := Parse("library.csv", []byte(file))
+	require.ErrorIs(t, err, ErrTooManyEntries)
+}
@@ -0,0 +1,121 @@
+// Package lrc parses lyrics in the LRC format, where every line is prefixed
+// with one or more [mm:ss.xx] timestamps.
+package lrc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoTimedLines = errors.New("lrc: no timed lines")
+
+	timestampRe = regexp.MustCompile(`^\[(\d{1,3}):(\d{2})(?:[.:](\d{1,3}))?\]`)
+	tagRe       = regexp.MustCompile(`(?i)^\[([a-z]+):(.*)\]$`)
+)
+
+type Line struct {
+	Time time.Duration
+	Text string
+}
+
+// Parse returns the timed lines of an LRC document ordered by time. The
+// offset tag is applied, other tags (artist, title and so on) are skipped.
+func Parse(document string) ([]Line, error) {
+	var (
+		lines  []Line
+		offset time.Duration
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(document))
+	for number := 1; scanner.Scan(); number++ {
+		raw := strings.TrimSpace(scanner.Text())
+		if raw == "" {
+			continue
+		}
+
+		var times []time.Duration
+		for {
+			match := timestampRe.FindStringSubmatch(raw)
+			if match == nil {
+				break
+			}
+
+			timestamp, err := parseTimestamp(match[1], match[2], match[3])
+			if err != nil {
+				return nil, fmt.Errorf("lrc: line %d: %w", number, err)
+			}
+			times = append(times, timestamp)
+			raw = raw[len(match[0]):]
+		}
+
+		if len(times) == 0 {
+			tag := tagRe.FindStringSubmatch(raw)
+			if tag == nil {
+				return nil, fmt.Errorf("lrc: line %d: missing timestamp", number)
+			}
+			// ID tags are usually lowercase, some editors write [AR:] or [Length:]
+			if name := strings.ToLower(tag[1]); name == "offset" {
+				ms, err := strconv.Atoi(strings.TrimSpace(tag[2]))
+				if err != nil {
+					return nil, fmt.Errorf("lrc: line %d: invalid offset %q", number, tag[2])
+				}
+				offset = time.Duration(ms) * time.Millisecond
+			}
+			continue
+		}
+
+		text := strings.TrimSpace(raw)
+		for _, timestamp := range times {
+			lines = append(lines, Line{Time: timestamp, Text: text})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("lrc: %w", err)
+	}
+	if len(lines) == 0 {
+		return nil, ErrNoTimedLines
+	}
+
+	// a positive offset shows lyrics earlier
+	for i := range lines {
+		lines[i].Time = max(lines[i].Time-offset, 0)
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].Time < lines[j].Time
+	})
+
+	return lines, nil
+}
+
+// Text joins the lines into plain lyrics without timestamps.
+func Text(lines []Line) string {
+	texts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		texts = append(texts, line.Text)
+	}
+	return strings.Join(texts, "\n")
+}
+
+func parseTimestamp(minutes, seconds, fraction string) (time.Duration, error) {
+	m, _ := strconv.Atoi(minutes)
+	s, _ := strconv.Atoi(seconds)
+	if s >= 60 {
+		return 0, fmt.Errorf("invalid seconds %q", seconds)
+	}
+
+	var ms int
+	if fraction != "" {
+		// .5, .50 and .500 all mean half a second
+		ms, _ = strconv.Atoi((fraction + "00")[:3])
+	}
+
+	return time.Duration(m)*time.Minute + time.Duration(s)*time.Second + time.Duration(ms)*time.Millisecond, nil
+}
@@ -0,0 +1,65 @@
+package lrc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	document := `[ar:quinn]
+[ti:Attempted Lover]
+
+[00:12.5]first line
+[00:05.00][01:02.345]chorus
+[00:20]
+`
+
+	lines, err := Parse(document)
+	require.NoError(t, err)
+	require.Equal(t, []Line{
+		{Time: 5 * time.Second, Text: "chorus"},
+		{Time: 12*time.Second + 500*time.Millisecond, Text: "first line"},
+		{Time: 20 * time.Second, Text: ""},
+		{Time: time.Minute + 2*time.Second + 345*time.Millisecond, Text: "chorus"},
+	}, lines)
+	require.Equal(t, "chorus\nfirst line\n\nchorus", Text(lines))
+}
+
+func TestParseOffset(t *testing.T) {
+	t.Parallel()
+
+	lines, err := Parse("[offset:+500]\n[00:00.20]intro\n[00:10.00]verse")
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), lines[0].Time)
+	require.Equal(t, 9*time.Second+500*time.Millisecond, lines[1].Time)
+}
+
+func TestParseUppercaseTags(t *testing.T) {
+	t.Parallel()
+
+	lines, err := Parse("[AR:quinn]\n[Length:03:12]\n[OFFSET:+1000]\n[00:02.00]intro")
+	require.NoError(t, err)
+	require.Equal(t, []Line{{Time: time.Second, Text: "intro"}}, lines)
+}
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"no timestamps":   "just some words",
+		"only tags":       "[ar:quinn]\n[ti:song]",
+		"invalid seconds": "[00:75.00]line",
+		"invalid offset":  "[offset:soon]\n[00:01.00]line",
+	}
+
+	for name, document := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(document)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package matching
+
+// DurationTolerance is the largest difference in seconds between durations of
+// the same recording reported by different services.
+const DurationTolerance = 5
+
+// Track is a catalog track as seen by the matching. Duration is in seconds,
+// 0 when unknown.
+type Track struct {
+	ID       uint64
+	Title    string
+	Artist   string
+	Album    string
+	Duration uint64
+}
+
+type indexedTrack struct {
+	*Track
+	artists []string
+}
+
+// Index finds catalog tracks by normalized title, artist and duration.
+type Index struct {
+	byTitle map[string][]indexedTrack
+}
+
+func NewIndex(tracks []*Track) *Index {
+	index := &Index{byTitle: make(map[string][]indexedTrack)}
+	for _, track := range tracks {
+		title := Normalize(track.Title)
+		index.byTitle[title] = append(index.byTitle[title], indexedTrack{track, Artists(track.Artist)})
+	}
+	return index
+}
+
+// Match returns the catalog track with the same normalized title, sharing a
+// credited artist and of a close duration. An unknown artist or duration
+// matches any, but a track crediting the artist is preferred, then the
+// closest duration.
+func (i *Index) Match(title, artist string, duration uint64) (*Track, bool) {
+	artists := Artists(artist)
+
+	var (
+		best      *Track
+		bestScore int
+	)
+	for _, candidate := range i.byTitle[Normalize(title)] {
+		// A shared artist outweighs any duration difference.
+		score := 2 * (DurationTolerance + 1)
+		if len(artists) > 0 {
+			if !shareArtist(artists, candidate.artists) {
+				continue
+			}
+			score *= 2
+		}
+
+		if duration > 0 && candidate.Duration > 0 {
+			diff := int(duration) - int(candidate.Duration)
+			if diff < 0 {
+				diff = -diff
+			}
+			if diff > DurationTolerance {
+				continue
+			}
+			score -= diff
+		}
+
+		if best == nil || score > bestScore || score == bestScore && candidate.ID < best.ID {
+			best, bestScore = candidate.Track, score
+		}
+	}
+
+	return best, best != nil
+}
+
+func shareArtist(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Package matching compares track metadata coming from outside of the
+// catalog, like imported playlist files, with the catalog entries.
+package matching
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// decorations are the bracketed parts of titles that differ between
+	// services, like "(feat. X)" or "[Remastered 2011]".
+	decorations = regexp.MustCompile(`\s*[(\[][^)\]]*[)\]]`)
+	// suffixes are the unbracketed versions of decorations.
+	suffixes = regexp.MustCompile(`\s+(?:-\s+)?(?:feat\.?|ft\.?|featuring|prod\.?)\s.*$|\s+-\s+.*(?:remaster|version|edit|mix|live).*$`)
+	// separators split the credited artists of a track.
+	separators = regexp.MustCompile(`\s*(?:,|&|\bx\b|\band\b|\bи\b|\bfeat\.?|\bft\.?|\bfeaturing\b|\bvs\.?|\bwith\b)\s*`)
+)
+
+// Normalize reduces a title or a name to the form compared by the matching:
+// lowercase letters and digits separated by single spaces, without diacritics
+// and decorations like "(feat. X)" or " - Remastered".
+func Normalize(s string) string {
+	s = strings.ToLower(s)
+	s = decorations.ReplaceAllString(s, "")
+	s = suffixes.ReplaceAllString(s, "")
+	return simplify(s)
+}
+
+// Artists returns the normalized names of the artists credited in s, like
+// "A feat. B" or "A & B".
+func Artists(s string) []string {
+	s = strings.ToLower(s)
+	s = decorations.ReplaceAllString(s, "")
+
+	var artists []string
+	for _, name := range separators.Split(s, -1) {
+		if name = simplify(name); name != "" {
+			artists = append(artists, name)
+		}
+	}
+	return artists
+}
+
+// folds are the letters without a decomposition that unaccent still folds,
+// the catalog names are normalized by it on the database side.
+var folds = strings.NewReplacer("ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "ł", "l", "đ", "d", "ð", "d", "ı", "i")
+
+// stripMarks removes the diacritics, except for the breve of "й" which is a
+// letter of its own rather than a decorated "и".
+func stripMarks(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFC.String(s) {
+		if r == 'й' {
+			b.WriteRune(r)
+			continue
+		}
+		for _, d := range norm.NFD.String(string(r)) {
+			if !unicode.Is(unicode.Mn, d) {
+				b.WriteRune(d)
+			}
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// simplify removes diacritics and replaces punctuation with spaces.
+func simplify(s string) string {
+	s = strings.ReplaceAll(s, "ё", "е")
+	s = folds.Replace(stripMarks(s))
+
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\'' || r == '’' || r == '`':
+			return -1
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return r
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
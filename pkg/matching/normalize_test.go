@@ -0,0 +1,67 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"I Just Want To Hide My Face":         "i just want to hide my face",
+		"Don't Stop Me Now - Remastered 2011": "dont stop me now",
+		"Song (feat. Someone) [Live]":         "song",
+		"Song feat. Someone":                  "song",
+		"Beyoncé":                             "beyonce",
+		"Ёлка  и   Мой Друг!":                 "елка и мой друг",
+		"Мой Йорш":                            "мой йорш",
+		"Mădălina - Live Version":             "madalina",
+		"Øyvind & Straße":                     "oyvind strasse",
+		"  ":                                  "",
+	}
+	for input, expected := range cases {
+		require.Equal(t, expected, Normalize(input), input)
+	}
+}
+
+func TestArtists(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []string{"ekkstacy"}, Artists("EKKSTACY"))
+	require.Equal(t, []string{"a", "b", "c"}, Artists("A feat. B & C"))
+	require.Equal(t, []string{"макс корж", "элджей"}, Artists("Макс Корж, Элджей"))
+	require.Empty(t, Artists(""))
+}
+
+func TestIndexMatch(t *testing.T) {
+	t.Parallel()
+
+	index := NewIndex([]*Track{
+		{ID: 1, Title: "i just want to hide my face", Artist: "EKKSTACY", Duration: 132},
+		{ID: 2, Title: "Intro", Artist: "EKKSTACY", Duration: 60},
+		{ID: 3, Title: "Intro", Artist: "The xx", Duration: 127},
+		{ID: 4, Title: "Intro", Artist: "Someone Else", Duration: 125},
+	})
+
+	track, ok := index.Match("I Just Want To Hide My Face", "Ekkstacy", 133)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), track.ID)
+
+	track, ok = index.Match("Intro", "The XX feat. Nobody", 0)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), track.ID)
+
+	// Without an artist the closest duration wins.
+	track, ok = index.Match("Intro (Remastered)", "", 126)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), track.ID)
+
+	_, ok = index.Match("Intro", "EKKSTACY", 200)
+	require.False(t, ok)
+	_, ok = index.Match("Intro", "Unknown", 60)
+	require.False(t, ok)
+	_, ok = index.Match("Outro", "", 0)
+	require.False(t, ok)
+}
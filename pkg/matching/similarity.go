@@ -0,0 +1,75 @@
+package matching
+
+import "strings"
+
+const (
+	titleWeight  = 0.6
+	artistWeight = 0.3
+	albumWeight  = 0.1
+)
+
+// Similarity compares two titles or names the way pg_trgm does, by the share
+// of the trigrams of their words they have in common. Both are normalized and
+// transliterated to Latin letters first, so the result is between 0 and 1
+// regardless of the alphabet they are written in.
+func Similarity(a, b string) float64 {
+	x := trigrams(ToLatin(Normalize(a)))
+	y := trigrams(ToLatin(Normalize(b)))
+	if len(x) == 0 || len(y) == 0 {
+		return 0
+	}
+
+	common := 0
+	for trigram := range x {
+		if y[trigram] {
+			common++
+		}
+	}
+	return float64(common) / float64(len(x)+len(y)-common)
+}
+
+// trigrams returns the trigrams of the words of s, each padded with two
+// spaces in front and one at the end.
+func trigrams(s string) map[string]bool {
+	result := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		runes := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			result[string(runes[i:i+3])] = true
+		}
+	}
+	return result
+}
+
+// Score rates how likely the catalog track is the given one, between 0 and 1.
+// The title weighs most, then the artist and the album; the missing ones are
+// left out of the rating.
+func Score(track *Track, title, artist, album string) float64 {
+	score := titleWeight * Similarity(track.Title, title)
+	weight := titleWeight
+
+	if artist != "" {
+		weight += artistWeight
+		score += artistWeight * artistSimilarity(track.Artist, artist)
+	}
+	if album != "" && track.Album != "" {
+		weight += albumWeight
+		score += albumWeight * Similarity(track.Album, album)
+	}
+
+	return score / weight
+}
+
+// artistSimilarity compares the credited artists one by one, so "A feat. B"
+// fully matches a track credited to A alone.
+func artistSimilarity(a, b string) float64 {
+	best := Similarity(a, b)
+	for _, x := range Artists(a) {
+		for _, y := range Artists(b) {
+			if similarity := Similarity(x, y); similarity > best {
+				best = similarity
+			}
+		}
+	}
+	return best
+}
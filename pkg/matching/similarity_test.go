@@ -0,0 +1,42 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransliteration(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "kino", ToLatin("кино"))
+	require.Equal(t, "zhizn i smert", ToLatin("жизнь и смерть"))
+	require.Equal(t, "кино", ToCyrillic("kino"))
+	require.Equal(t, "мой друг", ToCyrillic("moy drug"))
+	require.Equal(t, "ялта", ToCyrillic("yalta"))
+	require.Equal(t, []string{"группа крови", "gruppa krovi"}, Variants("Группа крови"))
+	require.Equal(t, []string{"gruppa krovi", "группа крови"}, Variants("Gruppa Krovi"))
+	require.Empty(t, Variants(" "))
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 1.0, Similarity("Кино", "Kino"))
+	require.Equal(t, 1.0, Similarity("Song (Remastered)", "song"))
+	require.Greater(t, Similarity("Gruppa krovi", "Группа Крови"), 0.99)
+	require.Greater(t, Similarity("Zvezda po imeni Solntse", "Звезда по имени Солнце"), 0.6)
+	require.Less(t, Similarity("Intro", "Outro"), 0.5)
+	require.Zero(t, Similarity("", "song"))
+}
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+
+	track := &Track{ID: 1, Title: "Группа крови", Artist: "Кино", Album: "Группа крови"}
+
+	require.InDelta(t, 1.0, Score(track, "Gruppa Krovi", "Kino", ""), 1e-9)
+	require.InDelta(t, 1.0, Score(track, "Gruppa Krovi", "Kino feat. Someone", "Gruppa Krovi"), 1e-9)
+	require.Less(t, Score(track, "Gruppa Krovi", "Someone Else", ""), 0.8)
+	require.Less(t, Score(track, "Completely different", "Kino", ""), 0.45)
+}
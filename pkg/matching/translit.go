@@ -0,0 +1,104 @@
+package matching
+
+import "strings"
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// latinToCyrillic is tried longest sequence first.
+var latinToCyrillic = []struct {
+	latin    string
+	cyrillic string
+}{
+	{"shch", "щ"},
+	{"zh", "ж"}, {"kh", "х"}, {"ts", "ц"}, {"ch", "ч"}, {"sh", "ш"},
+	{"yu", "ю"}, {"ya", "я"}, {"yo", "ё"}, {"ye", "е"}, {"ck", "к"},
+	{"a", "а"}, {"b", "б"}, {"c", "к"}, {"d", "д"}, {"e", "е"}, {"f", "ф"},
+	{"g", "г"}, {"h", "х"}, {"i", "и"}, {"j", "дж"}, {"k", "к"}, {"l", "л"},
+	{"m", "м"}, {"n", "н"}, {"o", "о"}, {"p", "п"}, {"q", "к"}, {"r", "р"},
+	{"s", "с"}, {"t", "т"}, {"u", "у"}, {"v", "в"}, {"w", "в"}, {"x", "кс"},
+	{"z", "з"},
+}
+
+// ToLatin transliterates the Cyrillic letters of a lowercase string, so
+// "кино" and "kino" compare equal.
+func ToLatin(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			b.WriteString(latin)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ToCyrillic transliterates the Latin letters of a lowercase string. It is
+// a guess, since the same Latin spelling is used for different Cyrillic
+// ones, good enough to look up the catalog.
+func ToCyrillic(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		if runes[i] == 'y' {
+			// "y" is "й" after a vowel and "ы" otherwise, unless it starts
+			// one of the iotated vowels handled below.
+			if i+1 >= len(runes) || !strings.ContainsRune("aeou", runes[i+1]) {
+				if i > 0 && strings.ContainsRune("aeiouаеиоуыэюя", runes[i-1]) {
+					b.WriteRune('й')
+				} else {
+					b.WriteRune('ы')
+				}
+				i++
+				continue
+			}
+		}
+
+		matched := false
+		for _, pair := range latinToCyrillic {
+			if strings.HasPrefix(string(runes[i:]), pair.latin) {
+				b.WriteString(pair.cyrillic)
+				i += len([]rune(pair.latin))
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteRune(runes[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// Variants returns the normalized forms of s to look it up in the catalog:
+// as written, in Latin and in Cyrillic letters.
+func Variants(s string) []string {
+	s = Normalize(s)
+	if s == "" {
+		return nil
+	}
+
+	variants := []string{s}
+	for _, variant := range []string{ToLatin(s), ToCyrillic(s)} {
+		if variant != s && !containsString(variants, variant) {
+			variants = append(variants, variant)
+		}
+	}
+	return variants
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package playlistfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type jsonPlaylist struct {
+	Title  string      `json:"title"`
+	Tracks []jsonTrack `json:"tracks"`
+}
+
+type jsonTrack struct {
+	Title    string `json:"title"`
+	Artist   string `json:"artist,omitempty"`
+	Album    string `json:"album,omitempty"`
+	Duration uint64 `json:"duration,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+func encodeJSON(w io.Writer, playlist *Playlist) error {
+	file := jsonPlaylist{Title: playlist.Title, Tracks: []jsonTrack{}}
+	for _, entry := range playlist.Entries {
+		file.Tracks = append(file.Tracks, jsonTrack{
+			Title:    entry.Title,
+			Artist:   entry.Artist,
+			Album:    entry.Album,
+			Duration: entry.Duration,
+			URL:      entry.URL,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(file)
+}
+
+func decodeJSON(data []byte) (*Playlist, error) {
+	var file jsonPlaylist
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	playlist := &Playlist{Title: strings.TrimSpace(file.Title)}
+	for _, track := range file.Tracks {
+		playlist.Entries = append(playlist.Entries, &Entry{
+			Title:    strings.TrimSpace(track.Title),
+			Artist:   strings.TrimSpace(track.Artist),
+			Album:    strings.TrimSpace(track.Album),
+			Duration: track.Duration,
+			URL:      strings.TrimSpace(track.URL),
+		})
+	}
+
+	return playlist, nil
+}
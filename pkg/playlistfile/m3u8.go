@@ -0,0 +1,123 @@
+package playlistfile
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	m3uHeader   = "#EXTM3U"
+	m3uPlaylist = "#PLAYLIST:"
+	m3uInfo     = "#EXTINF:"
+	m3uAlbum    = "#EXTALB:"
+)
+
+func encodeM3U8(w io.Writer, playlist *Playlist) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, m3uHeader)
+	if playlist.Title != "" {
+		fmt.Fprintf(bw, "%s%s\n", m3uPlaylist, singleLine(playlist.Title))
+	}
+
+	for _, entry := range playlist.Entries {
+		title := singleLine(entry.Title)
+		if entry.Artist != "" {
+			title = singleLine(entry.Artist) + " - " + title
+		}
+		duration := int64(entry.Duration)
+		if duration == 0 {
+			duration = -1
+		}
+		fmt.Fprintf(bw, "%s%d,%s\n", m3uInfo, duration, title)
+		if entry.Album != "" {
+			fmt.Fprintf(bw, "%s%s\n", m3uAlbum, singleLine(entry.Album))
+		}
+		fmt.Fprintln(bw, singleLine(entry.URL))
+	}
+
+	return bw.Flush()
+}
+
+func decodeM3U8(data []byte) (*Playlist, error) {
+	playlist := &Playlist{}
+	var pending *Entry
+
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line == m3uHeader:
+		case strings.HasPrefix(line, m3uPlaylist):
+			playlist.Title = strings.TrimSpace(strings.TrimPrefix(line, m3uPlaylist))
+		case strings.HasPrefix(line, m3uInfo):
+			pending = parseInfo(strings.TrimPrefix(line, m3uInfo))
+		case strings.HasPrefix(line, m3uAlbum):
+			if pending == nil {
+				pending = &Entry{}
+			}
+			pending.Album = strings.TrimSpace(strings.TrimPrefix(line, m3uAlbum))
+		case strings.HasPrefix(line, "#"):
+			// Other directives and comments.
+		default:
+			entry := pending
+			if entry == nil {
+				entry = &Entry{}
+			}
+			entry.URL = line
+			if entry.Title == "" {
+				entry.Title = titleFromLocation(line)
+			}
+			playlist.Entries = append(playlist.Entries, entry)
+			pending = nil
+		}
+
+		if len(playlist.Entries) > MaxEntries {
+			return nil, ErrTooManyEntries
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	return playlist, nil
+}
+
+// parseInfo parses the "duration attributes,Artist - Title" value of an
+// #EXTINF directive.
+func parseInfo(info string) *Entry {
+	entry := &Entry{}
+	meta, title, _ := strings.Cut(info, ",")
+	if fields := strings.Fields(meta); len(fields) > 0 {
+		if duration, err := strconv.ParseFloat(fields[0], 64); err == nil && duration > 0 {
+			entry.Duration = uint64(duration + 0.5)
+		}
+	}
+
+	title = strings.TrimSpace(title)
+	if artist, rest, ok := strings.Cut(title, " - "); ok {
+		entry.Artist = strings.TrimSpace(artist)
+		title = strings.TrimSpace(rest)
+	}
+	entry.Title = title
+	return entry
+}
+
+// titleFromLocation names an entry without metadata after its file.
+func titleFromLocation(location string) string {
+	if u, err := url.Parse(location); err == nil && u.Path != "" {
+		location = u.Path
+	}
+	location = strings.ReplaceAll(location, "\\", "/")
+	name := path.Base(location)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
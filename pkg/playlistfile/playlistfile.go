@@ -0,0 +1,125 @@
+// Package playlistfile reads and writes playlists in the M3U8, XSPF and JSON
+// file formats.
+package playlistfile
+
+import (
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported playlist format")
+	ErrInvalidFile       = errors.New("invalid playlist file")
+	ErrTooManyEntries    = errors.New("playlist has too many entries")
+)
+
+// MaxEntries limits the number of entries read from a file.
+const MaxEntries = 1000
+
+// Format is a playlist file format, named after its file extension.
+type Format string
+
+const (
+	FormatM3U8 Format = "m3u8"
+	FormatXSPF Format = "xspf"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat returns the format of the given name or file extension.
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimPrefix(name, "."))) {
+	case FormatM3U8, "m3u":
+		return FormatM3U8, nil
+	case FormatXSPF:
+		return FormatXSPF, nil
+	case FormatJSON:
+		return FormatJSON, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// DetectFormat guesses the format of a file by its name, falling back to its
+// content.
+func DetectFormat(filename string, data []byte) (Format, error) {
+	if format, err := ParseFormat(path.Ext(filename)); err == nil {
+		return format, nil
+	}
+
+	content := strings.TrimLeft(strings.TrimPrefix(string(data), "\ufeff"), " \t\r\n")
+	switch {
+	case strings.HasPrefix(content, "#EXTM3U"):
+		return FormatM3U8, nil
+	case strings.HasPrefix(content, "<"):
+		return FormatXSPF, nil
+	case strings.HasPrefix(content, "{"):
+		return FormatJSON, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatM3U8:
+		return "application/vnd.apple.mpegurl"
+	case FormatXSPF:
+		return "application/xspf+xml"
+	}
+	return "application/json"
+}
+
+// Entry is a track of a playlist file. Duration is in seconds, 0 when
+// unknown.
+type Entry struct {
+	Title    string
+	Artist   string
+	Album    string
+	Duration uint64
+	URL      string
+}
+
+// Playlist is the content of a playlist file.
+type Playlist struct {
+	Title   string
+	Entries []*Entry
+}
+
+// Encode writes the playlist in the given format.
+func Encode(w io.Writer, format Format, playlist *Playlist) error {
+	switch format {
+	case FormatM3U8:
+		return encodeM3U8(w, playlist)
+	case FormatXSPF:
+		return encodeXSPF(w, playlist)
+	case FormatJSON:
+		return encodeJSON(w, playlist)
+	}
+	return ErrUnsupportedFormat
+}
+
+// Decode reads a playlist in the given format.
+func Decode(data []byte, format Format) (*Playlist, error) {
+	var (
+		playlist *Playlist
+		err      error
+	)
+	switch format {
+	case FormatM3U8:
+		playlist, err = decodeM3U8(data)
+	case FormatXSPF:
+		playlist, err = decodeXSPF(data)
+	case FormatJSON:
+		playlist, err = decodeJSON(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(playlist.Entries) > MaxEntries {
+		return nil, ErrTooManyEntries
+	}
+
+	return playlist, nil
+}
@@ -0,0 +1,95 @@
+package playlistfile
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testPlaylist() *Playlist {
+	return &Playlist{
+		Title: "Road trip",
+		Entries: []*Entry{
+			{Title: "i just want to hide my face", Artist: "EKKSTACY", Album: "misery", Duration: 132, URL: "https://nova-music.ru/storage/tracks/ekkstacy_misery_1.mp3"},
+			{Title: "Track & <Friends>", Artist: "A, B", Duration: 0, URL: "https://nova-music.ru/storage/tracks/2.mp3"},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []Format{FormatM3U8, FormatXSPF, FormatJSON} {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, format, testPlaylist()))
+
+		detected, err := DetectFormat("", buf.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, format, detected)
+
+		decoded, err := Decode(buf.Bytes(), format)
+		require.NoError(t, err, format)
+		require.Equal(t, testPlaylist(), decoded, format)
+	}
+}
+
+func TestDecodeM3U8(t *testing.T) {
+	t.Parallel()
+
+	data := "\xef\xbb\xbf#EXTM3U\r\n" +
+		"#EXTINF:212.6 tvg-id=\"x\",Queen - Don't Stop Me Now\r\n" +
+		"C:\\Music\\Queen\\dont_stop.mp3\r\n" +
+		"# a comment\n" +
+		"/music/Unknown Artist/track 07.flac\n"
+
+	playlist, err := Decode([]byte(data), FormatM3U8)
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{
+		{Title: "Don't Stop Me Now", Artist: "Queen", Duration: 213, URL: "C:\\Music\\Queen\\dont_stop.mp3"},
+		{Title: "track 07", URL: "/music/Unknown Artist/track 07.flac"},
+	}, playlist.Entries)
+}
+
+func TestDecodeXSPFWithoutNamespace(t *testing.T) {
+	t.Parallel()
+
+	data := `<playlist version="1"><trackList>
+<track><title>Song</title><creator>Artist</creator><duration>180400</duration></track>
+<track><location>file:///music/other.ogg</location></track>
+</trackList></playlist>`
+
+	playlist, err := Decode([]byte(data), FormatXSPF)
+	require.NoError(t, err)
+	require.Equal(t, []*Entry{
+		{Title: "Song", Artist: "Artist", Duration: 180},
+		{Title: "other", URL: "file:///music/other.ogg"},
+	}, playlist.Entries)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := Decode([]byte("{not json"), FormatJSON)
+	require.ErrorIs(t, err, ErrInvalidFile)
+
+	_, err = Decode([]byte("<playlist><trackList>"), FormatXSPF)
+	require.ErrorIs(t, err, ErrInvalidFile)
+
+	_, err = Decode(nil, Format("pls"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+
+	var buf bytes.Buffer
+	buf.WriteString("#EXTM3U\n")
+	for i := 0; i <= MaxEntries; i++ {
+		buf.WriteString("track.mp3\n")
+	}
+	_, err = Decode(buf.Bytes(), FormatM3U8)
+	require.ErrorIs(t, err, ErrTooManyEntries)
+
+	format, err := DetectFormat("list.M3U", nil)
+	require.NoError(t, err)
+	require.Equal(t, FormatM3U8, format)
+	_, err = DetectFormat("list.txt", []byte("hello"))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
@@ -0,0 +1,84 @@
+package playlistfile
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+// xspfPlaylist matches the elements regardless of their namespace, since
+// some players omit it.
+type xspfPlaylist struct {
+	XMLName xml.Name    `xml:"playlist"`
+	Xmlns   string      `xml:"xmlns,attr,omitempty"`
+	Version string      `xml:"version,attr"`
+	Title   string      `xml:"title,omitempty"`
+	Tracks  []xspfTrack `xml:"trackList>track"`
+}
+
+type xspfTrack struct {
+	Location string `xml:"location,omitempty"`
+	Title    string `xml:"title,omitempty"`
+	Creator  string `xml:"creator,omitempty"`
+	Album    string `xml:"album,omitempty"`
+	// Duration is in milliseconds.
+	Duration uint64 `xml:"duration,omitempty"`
+}
+
+func encodeXSPF(w io.Writer, playlist *Playlist) error {
+	file := xspfPlaylist{Xmlns: xspfNamespace, Version: "1", Title: playlist.Title}
+	for _, entry := range playlist.Entries {
+		file.Tracks = append(file.Tracks, xspfTrack{
+			Location: entry.URL,
+			Title:    entry.Title,
+			Creator:  entry.Artist,
+			Album:    entry.Album,
+			Duration: entry.Duration * 1000,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(file); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func decodeXSPF(data []byte) (*Playlist, error) {
+	// Entities are not expanded by encoding/xml, so a file can't blow up
+	// while decoding.
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var file xspfPlaylist
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if len(file.Tracks) > MaxEntries {
+		return nil, ErrTooManyEntries
+	}
+
+	playlist := &Playlist{Title: strings.TrimSpace(file.Title)}
+	for _, track := range file.Tracks {
+		entry := &Entry{
+			Title:    strings.TrimSpace(track.Title),
+			Artist:   strings.TrimSpace(track.Creator),
+			Album:    strings.TrimSpace(track.Album),
+			Duration: (track.Duration + 500) / 1000,
+			URL:      strings.TrimSpace(track.Location),
+		}
+		if entry.Title == "" && entry.URL != "" {
+			entry.Title = titleFromLocation(entry.URL)
+		}
+		playlist.Entries = append(playlist.Entries, entry)
+	}
+
+	return playlist, nil
+}
@@ -0,0 +1,222 @@
+package webp
+
+// maxLevel is the largest quantized level a DCT_CAT6 token can hold.
+const maxLevel = 2048
+
+// nonZero tracks which of the bottom or right blocks of a macroblock have
+// non-zero coefficients, the context of the token probabilities specified in
+// section 13.3.
+type nonZero struct {
+	y  [4]uint8
+	uv [2][2]uint8
+	y2 uint8
+}
+
+// writeModes writes the first partition: the frame header of sections 9.2 to
+// 9.11 followed by the per-macroblock prediction modes of section 11.
+func writeModes(mbs []*macroblock, q int, skipProb uint8) []byte {
+	e := newBoolEncoder()
+
+	// Color space and clamping type.
+	e.writeBit(uniformProb, false)
+	e.writeBit(uniformProb, false)
+	// No segmentation.
+	e.writeBit(uniformProb, false)
+	// Normal loop filter of a level following the quantizer, no sharpness and
+	// no mode or reference frame adjustments.
+	e.writeBit(uniformProb, false)
+	e.writeUint(uniformProb, uint32(q/2), 6)
+	e.writeUint(uniformProb, 0, 3)
+	e.writeBit(uniformProb, false)
+	// A single token partition.
+	e.writeUint(uniformProb, 0, 2)
+	// The quantizer index with no per-plane deltas.
+	e.writeUint(uniformProb, uint32(q), 7)
+	for i := 0; i < 5; i++ {
+		e.writeOptionalInt(uniformProb, 0, 4)
+	}
+	// Refresh entropy probabilities.
+	e.writeBit(uniformProb, false)
+	// No token probability updates.
+	for i := range tokenProbUpdateProb {
+		for j := range tokenProbUpdateProb[i] {
+			for k := range tokenProbUpdateProb[i][j] {
+				for l := range tokenProbUpdateProb[i][j][k] {
+					e.writeBit(tokenProbUpdateProb[i][j][k][l], false)
+				}
+			}
+		}
+	}
+	// Macroblocks without coefficients are flagged as skipped.
+	e.writeBit(uniformProb, true)
+	e.writeUint(uniformProb, uint32(skipProb), 8)
+
+	for _, mb := range mbs {
+		e.writeBit(skipProb, mb.skip)
+
+		// The key frame luma mode tree, always choosing a 16x16 mode.
+		e.writeBit(145, true)
+		switch mb.predY {
+		case predDC:
+			e.writeBit(156, false)
+			e.writeBit(163, false)
+		case predVE:
+			e.writeBit(156, false)
+			e.writeBit(163, true)
+		case predHE:
+			e.writeBit(156, true)
+			e.writeBit(128, false)
+		case predTM:
+			e.writeBit(156, true)
+			e.writeBit(128, true)
+		}
+
+		// The key frame chroma mode tree.
+		switch mb.predC {
+		case predDC:
+			e.writeBit(142, false)
+		case predVE:
+			e.writeBit(142, true)
+			e.writeBit(114, false)
+		case predHE:
+			e.writeBit(142, true)
+			e.writeBit(114, true)
+			e.writeBit(183, false)
+		case predTM:
+			e.writeBit(142, true)
+			e.writeBit(114, true)
+			e.writeBit(183, true)
+		}
+	}
+
+	return e.finish()
+}
+
+// writeTokens writes the partition of DCT/WHT coefficients.
+func writeTokens(mbw int, mbs []*macroblock) []byte {
+	e := newBoolEncoder()
+	up := make([]nonZero, mbw)
+	var left nonZero
+
+	for i, mb := range mbs {
+		mbx := i % mbw
+		if mbx == 0 {
+			left = nonZero{}
+		}
+		if mb.skip {
+			left, up[mbx] = nonZero{}, nonZero{}
+			continue
+		}
+
+		nz := writeBlock(e, planeY2, left.y2+up[mbx].y2, &mb.coeff[24], 0)
+		left.y2, up[mbx].y2 = nz, nz
+
+		for y := 0; y < 4; y++ {
+			for x := 0; x < 4; x++ {
+				nz := writeBlock(e, planeY1WithY2, left.y[y]+up[mbx].y[x], &mb.coeff[4*y+x], 1)
+				left.y[y], up[mbx].y[x] = nz, nz
+			}
+		}
+
+		for c := 0; c < 2; c++ {
+			for y := 0; y < 2; y++ {
+				for x := 0; x < 2; x++ {
+					nz := writeBlock(e, planeUV, left.uv[c][y]+up[mbx].uv[c][x], &mb.coeff[16+4*c+2*y+x], 0)
+					left.uv[c][y], up[mbx].uv[c][x] = nz, nz
+				}
+			}
+		}
+	}
+
+	return e.finish()
+}
+
+// writeBlock writes the coefficients of a block starting at position first
+// with the token tree of section 13.2 and returns 1 if any of them is
+// non-zero.
+func writeBlock(e *boolEncoder, plane int, context uint8, coeff *[16]int32, first int) uint8 {
+	last := -1
+	for n := first; n < 16; n++ {
+		if coeff[zigzag[n]] != 0 {
+			last = n
+		}
+	}
+
+	prob := &defaultTokenProb[plane]
+	p := &prob[bands[first]][context]
+	if last < 0 {
+		e.writeBit(p[0], false)
+		return 0
+	}
+	e.writeBit(p[0], true)
+
+	for n := first; n < 16; {
+		v := coeff[zigzag[n]]
+		negative := v < 0
+		if negative {
+			v = -v
+		}
+		n++
+
+		if v == 0 {
+			e.writeBit(p[1], false)
+			p = &prob[bands[n]][0]
+			continue
+		}
+		e.writeBit(p[1], true)
+
+		if v == 1 {
+			e.writeBit(p[2], false)
+			p = &prob[bands[n]][1]
+		} else {
+			e.writeBit(p[2], true)
+			switch {
+			case v <= 4:
+				e.writeBit(p[3], false)
+				if v == 2 {
+					e.writeBit(p[4], false)
+				} else {
+					e.writeBit(p[4], true)
+					e.writeBit(p[5], v == 4)
+				}
+			case v <= 10:
+				e.writeBit(p[3], true)
+				e.writeBit(p[6], false)
+				if v <= 6 {
+					e.writeBit(p[7], false)
+					e.writeBit(159, v == 6)
+				} else {
+					e.writeBit(p[7], true)
+					e.writeBit(165, (v-7)&2 != 0)
+					e.writeBit(145, (v-7)&1 != 0)
+				}
+			default:
+				e.writeBit(p[3], true)
+				e.writeBit(p[6], true)
+				cat := 3
+				for cat > 0 && v < 3+(8<<cat) {
+					cat--
+				}
+				e.writeBit(p[8], cat >= 2)
+				e.writeBit(p[9+cat/2], cat&1 != 0)
+				extra := uint32(v - 3 - 8<<cat)
+				tab := cat3456[cat]
+				for i, bitProb := range tab {
+					e.writeBit(bitProb, extra&(1<<(len(tab)-1-i)) != 0)
+				}
+			}
+			p = &prob[bands[n]][2]
+		}
+
+		e.writeBit(uniformProb, negative)
+		if n == 16 {
+			break
+		}
+		e.writeBit(p[0], n <= last)
+		if n > last {
+			break
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,97 @@
+package webp
+
+// uniformProb represents a 50% probability that the next bit is 0.
+const uniformProb = 128
+
+// boolEncoder is the boolean entropy encoder specified in section 7.3.
+type boolEncoder struct {
+	buf      []byte
+	rng      uint32
+	bottom   uint32
+	bitCount int
+}
+
+func newBoolEncoder() *boolEncoder {
+	return &boolEncoder{rng: 255, bitCount: 24}
+}
+
+// writeBit writes bit with the probability prob/256 of it being false.
+func (e *boolEncoder) writeBit(prob uint8, bit bool) {
+	split := 1 + ((e.rng-1)*uint32(prob))>>8
+	if bit {
+		e.bottom += split
+		e.rng -= split
+	} else {
+		e.rng = split
+	}
+
+	for e.rng < 128 {
+		e.rng <<= 1
+		if e.bottom&(1<<31) != 0 {
+			e.carry()
+		}
+		e.bottom <<= 1
+		e.bitCount--
+		if e.bitCount == 0 {
+			e.buf = append(e.buf, byte(e.bottom>>24))
+			e.bottom &= 1<<24 - 1
+			e.bitCount = 8
+		}
+	}
+}
+
+// writeUint writes the n least significant bits of v, most significant first.
+func (e *boolEncoder) writeUint(prob uint8, v uint32, n int) {
+	for n > 0 {
+		n--
+		e.writeBit(prob, v&(1<<n) != 0)
+	}
+}
+
+// writeOptionalInt writes a signed n-bit value preceded by a presence flag.
+func (e *boolEncoder) writeOptionalInt(prob uint8, v int32, n int) {
+	if v == 0 {
+		e.writeBit(prob, false)
+		return
+	}
+
+	e.writeBit(prob, true)
+	if v < 0 {
+		e.writeUint(prob, uint32(-v), n)
+		e.writeBit(prob, true)
+	} else {
+		e.writeUint(prob, uint32(v), n)
+		e.writeBit(prob, false)
+	}
+}
+
+// carry propagates an overflow of bottom into the already written bytes.
+func (e *boolEncoder) carry() {
+	i := len(e.buf) - 1
+	for i >= 0 && e.buf[i] == 0xff {
+		e.buf[i] = 0
+		i--
+	}
+	if i >= 0 {
+		e.buf[i]++
+	}
+}
+
+// finish flushes the pending bits and returns the encoded partition.
+func (e *boolEncoder) finish() []byte {
+	c := e.bitCount
+	v := e.bottom
+	if v&(1<<(32-c)) != 0 {
+		e.carry()
+	}
+	v <<= uint(c & 7)
+	for c >>= 3; c > 0; c-- {
+		v <<= 8
+	}
+	for i := 0; i < 4; i++ {
+		e.buf = append(e.buf, byte(v>>24))
+		v <<= 8
+	}
+
+	return e.buf
+}
@@ -0,0 +1,315 @@
+// Package webp implements a lossy WebP encoder.
+//
+// Images are coded as a single VP8 key frame (RFC 6386) with whole-block
+// intra prediction and the default token probabilities, which keeps the
+// encoder small while producing files any WebP decoder can read.
+package webp
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+)
+
+// DefaultQuality is the default quality encoding parameter.
+const DefaultQuality = 75
+
+// maxDimension is the largest width or height a VP8 frame can describe.
+const maxDimension = 1<<14 - 1
+
+// Options are the encoding parameters. Quality ranges from 1 to 100 inclusive,
+// higher is better.
+type Options struct {
+	Quality int
+}
+
+// frame holds the source and the reconstructed planes of an image padded to
+// a whole number of macroblocks.
+type frame struct {
+	width, height    int
+	mbw, mbh         int
+	yStride, cStride int
+	srcY, srcCb      []uint8
+	srcCr            []uint8
+	y, cb, cr        []uint8
+}
+
+// macroblock is the coded representation of a 16x16 luma and 2x8x8 chroma
+// region.
+type macroblock struct {
+	predY, predC uint8
+	skip         bool
+	// coeff holds the quantized levels of 16 luma, 4 Cb, 4 Cr and 1 Y2 blocks.
+	coeff [25][16]int32
+}
+
+// quantizer holds the DC and AC quantization steps of a plane.
+type quantizer [2]int32
+
+// Encode writes the image m to w in the lossy WebP format. Transparency is
+// not preserved.
+func Encode(w io.Writer, m image.Image, o *Options) error {
+	bounds := m.Bounds()
+	if bounds.Dx() < 1 || bounds.Dy() < 1 {
+		return errors.New("webp: empty image")
+	}
+	if bounds.Dx() > maxDimension || bounds.Dy() > maxDimension {
+		return errors.New("webp: image is too large to encode")
+	}
+
+	quality := DefaultQuality
+	if o != nil {
+		quality = o.Quality
+	}
+	if quality < 1 {
+		quality = 1
+	} else if quality > 100 {
+		quality = 100
+	}
+
+	data := encodeFrame(newFrame(m), (100-quality)*127/99)
+
+	// The RIFF container is specified in the WebP container specification.
+	chunkLen := len(data) + len(data)&1
+	header := make([]byte, 20)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(4+8+chunkLen))
+	copy(header[8:16], "WEBPVP8 ")
+	binary.LittleEndian.PutUint32(header[16:20], uint32(len(data)))
+	if len(data)&1 == 1 {
+		data = append(data, 0)
+	}
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// newFrame converts m to BT.601 limited range YCbCr with 4:2:0 subsampling,
+// the color space of VP8, replicating the edge pixels into the padding.
+func newFrame(m image.Image) *frame {
+	bounds := m.Bounds()
+	f := &frame{
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		mbw:    (bounds.Dx() + 15) >> 4,
+		mbh:    (bounds.Dy() + 15) >> 4,
+	}
+	f.yStride = 16 * f.mbw
+	f.cStride = 8 * f.mbw
+	f.srcY = make([]uint8, f.yStride*16*f.mbh)
+	f.srcCb = make([]uint8, f.cStride*8*f.mbh)
+	f.srcCr = make([]uint8, f.cStride*8*f.mbh)
+	f.y = make([]uint8, len(f.srcY))
+	f.cb = make([]uint8, len(f.srcCb))
+	f.cr = make([]uint8, len(f.srcCr))
+
+	rgb := func(x, y int) (int32, int32, int32) {
+		x = min(x, f.width-1)
+		y = min(y, f.height-1)
+		r, g, b, _ := color.NRGBAModel.Convert(m.At(bounds.Min.X+x, bounds.Min.Y+y)).RGBA()
+		return int32(r >> 8), int32(g >> 8), int32(b >> 8)
+	}
+
+	for y := 0; y < 16*f.mbh; y++ {
+		for x := 0; x < f.yStride; x++ {
+			r, g, b := rgb(x, y)
+			f.srcY[y*f.yStride+x] = uint8((16839*r + 33059*g + 6420*b + 16<<16 + 1<<15) >> 16)
+		}
+	}
+
+	for y := 0; y < 8*f.mbh; y++ {
+		for x := 0; x < f.cStride; x++ {
+			var r, g, b int32
+			for _, d := range [4][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+				pr, pg, pb := rgb(2*x+d[0], 2*y+d[1])
+				r, g, b = r+pr, g+pg, b+pb
+			}
+			f.srcCb[y*f.cStride+x] = uint8((-9719*r - 19081*g + 28800*b + 128<<18 + 1<<17) >> 18)
+			f.srcCr[y*f.cStride+x] = uint8((28800*r - 24116*g - 4684*b + 128<<18 + 1<<17) >> 18)
+		}
+	}
+
+	return f
+}
+
+// encoder holds the state of a frame being encoded.
+type encoder struct {
+	f          *frame
+	ws         workspace
+	y1, y2, uv quantizer
+}
+
+// encodeFrame codes the frame with the quantizer index q and returns the VP8
+// bitstream.
+func encodeFrame(f *frame, q int) []byte {
+	e := &encoder{
+		f:  f,
+		y1: quantizer{dequantTableDC[q], dequantTableAC[q]},
+		y2: quantizer{dequantTableDC[q] * 2, max(dequantTableAC[q]*155/100, 8)},
+		uv: quantizer{dequantTableDC[min(q, 117)], dequantTableAC[q]},
+	}
+
+	mbs := make([]*macroblock, 0, f.mbw*f.mbh)
+	skipped := 0
+	for mby := 0; mby < f.mbh; mby++ {
+		for mbx := 0; mbx < f.mbw; mbx++ {
+			mb := e.encodeMacroblock(mbx, mby)
+			if mb.skip {
+				skipped++
+			}
+			mbs = append(mbs, mb)
+		}
+	}
+
+	skipProb := uint8(min(max(255-skipped*255/len(mbs), 1), 254))
+	first := writeModes(mbs, q, skipProb)
+	tokens := writeTokens(f.mbw, mbs)
+
+	// The frame tag and the key frame header are specified in section 9.1.
+	header := make([]byte, 10)
+	tag := uint32(1<<4 | len(first)<<5)
+	header[0], header[1], header[2] = byte(tag), byte(tag>>8), byte(tag>>16)
+	header[3], header[4], header[5] = 0x9d, 0x01, 0x2a
+	binary.LittleEndian.PutUint16(header[6:8], uint16(f.width))
+	binary.LittleEndian.PutUint16(header[8:10], uint16(f.height))
+
+	data := make([]byte, 0, len(header)+len(first)+len(tokens))
+	data = append(data, header...)
+	data = append(data, first...)
+	return append(data, tokens...)
+}
+
+// encodeMacroblock picks the prediction modes of the macroblock, quantizes its
+// residuals and reconstructs it into the frame.
+func (e *encoder) encodeMacroblock(mbx, mby int) *macroblock {
+	f, ws := e.f, &e.ws
+	mb := &macroblock{}
+	ws.prepare(f, mbx, mby)
+
+	yOffset := 16*mby*f.yStride + 16*mbx
+	cOffset := 8*mby*f.cStride + 8*mbx
+
+	mb.predY = e.bestMode(func(mode uint8) uint32 {
+		ws.predict(wsYY, wsYX, 16, mode, mbx, mby)
+		return ws.sse(wsYY, wsYX, 16, f.srcY, f.yStride, yOffset)
+	})
+	mb.predC = e.bestMode(func(mode uint8) uint32 {
+		ws.predict(wsBY, wsBX, 8, mode, mbx, mby)
+		ws.predict(wsRY, wsRX, 8, mode, mbx, mby)
+		return ws.sse(wsBY, wsBX, 8, f.srcCb, f.cStride, cOffset) + ws.sse(wsRY, wsRX, 8, f.srcCr, f.cStride, cOffset)
+	})
+
+	var coeff, dequantized [16]int32
+
+	// Luma is coded as 16 blocks of AC coefficients and the Y2 block holding
+	// the transformed DC coefficients of the 16 blocks.
+	var dc [16]int32
+	for n := 0; n < 16; n++ {
+		src := block(f.srcY, f.yStride, yOffset+4*(n/4)*f.yStride+4*(n%4))
+		pred := ws.block(wsYY+4*(n/4), wsYX+4*(n%4))
+		forwardDCT(&src, &pred, coeff[:])
+		dc[n] = coeff[0]
+		for i := 1; i < 16; i++ {
+			mb.coeff[n][i] = quantize(coeff[i], e.y1[1])
+		}
+	}
+	forwardWHT(&dc, coeff[:])
+	for i := 0; i < 16; i++ {
+		mb.coeff[24][i] = quantize(coeff[i], e.y2[btoi(i > 0)])
+		dequantized[i] = mb.coeff[24][i] * e.y2[btoi(i > 0)]
+	}
+
+	dcs := inverseWHT(dequantized[:])
+	for n := 0; n < 16; n++ {
+		dequantized[0] = dcs[n]
+		for i := 1; i < 16; i++ {
+			dequantized[i] = mb.coeff[n][i] * e.y1[1]
+		}
+		ws.inverseDCT(wsYY+4*(n/4), wsYX+4*(n%4), dequantized[:])
+	}
+
+	// Chroma is coded as 4 blocks per plane.
+	planes := [2]struct {
+		src  []uint8
+		y, x int
+	}{{f.srcCb, wsBY, wsBX}, {f.srcCr, wsRY, wsRX}}
+	for c, plane := range planes {
+		for n := 0; n < 4; n++ {
+			by, bx := plane.y+4*(n/2), plane.x+4*(n%2)
+			src := block(plane.src, f.cStride, cOffset+4*(n/2)*f.cStride+4*(n%2))
+			pred := ws.block(by, bx)
+			forwardDCT(&src, &pred, coeff[:])
+			levels := &mb.coeff[16+4*c+n]
+			for i := 0; i < 16; i++ {
+				levels[i] = quantize(coeff[i], e.uv[btoi(i > 0)])
+				dequantized[i] = levels[i] * e.uv[btoi(i > 0)]
+			}
+			ws.inverseDCT(by, bx, dequantized[:])
+		}
+	}
+
+	mb.skip = true
+	for n := range mb.coeff {
+		for _, level := range mb.coeff[n] {
+			if level != 0 {
+				mb.skip = false
+			}
+		}
+	}
+
+	for y := 0; y < 16; y++ {
+		copy(f.y[yOffset+y*f.yStride:], ws[wsYY+y][wsYX:wsYX+16])
+	}
+	for y := 0; y < 8; y++ {
+		copy(f.cb[cOffset+y*f.cStride:], ws[wsBY+y][wsBX:wsBX+8])
+		copy(f.cr[cOffset+y*f.cStride:], ws[wsRY+y][wsRX:wsRX+8])
+	}
+
+	return mb
+}
+
+// bestMode returns the prediction mode with the smallest error and leaves its
+// prediction in the workspace. predict fills the workspace with the given
+// mode and returns the error.
+func (e *encoder) bestMode(predict func(mode uint8) uint32) uint8 {
+	best, bestCost := uint8(predDC), ^uint32(0)
+	for _, mode := range [4]uint8{predDC, predTM, predVE, predHE} {
+		if cost := predict(mode); cost < bestCost {
+			best, bestCost = mode, cost
+		}
+	}
+	predict(best)
+	return best
+}
+
+// block copies the 4x4 block of the plane starting at offset.
+func block(plane []uint8, stride, offset int) (b [4][4]uint8) {
+	for j := 0; j < 4; j++ {
+		copy(b[j][:], plane[offset+j*stride:])
+	}
+	return b
+}
+
+// block copies the 4x4 block of the workspace at (y, x).
+func (w *workspace) block(y, x int) (b [4][4]uint8) {
+	for j := 0; j < 4; j++ {
+		copy(b[j][:], w[y+j][x:x+4])
+	}
+	return b
+}
+
+// quantize returns the level of the coefficient for the quantization step q,
+// rounding towards zero slightly more than to nearest to favour zero levels.
+func quantize(coeff, q int32) int32 {
+	sign := int32(1)
+	if coeff < 0 {
+		sign, coeff = -1, -coeff
+	}
+	level := (coeff + q*3/8) / q
+	return sign * min(level, maxLevel)
+}
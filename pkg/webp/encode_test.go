@@ -0,0 +1,128 @@
+package webp
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+// gradient draws a picture with smooth areas, sharp edges and noise.
+func gradient(width, height int) *image.RGBA {
+	m := image.NewRGBA(image.Rect(0, 0, width, height))
+	seed := uint32(1)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			seed = seed*1664525 + 1013904223
+			noise := uint8(seed >> 29)
+			c := color.RGBA{uint8(x * 255 / width), uint8(y * 255 / height), 128 + noise, 255}
+			if (x/16+y/16)%5 == 0 {
+				c = color.RGBA{250, 20, 30, 255}
+			}
+			m.SetRGBA(x, y, c)
+		}
+	}
+	return m
+}
+
+// psnr compares the luma of the decoded image with the one of the source
+// frame.
+func psnr(t *testing.T, f *frame, m image.Image) float64 {
+	decoded, ok := m.(*image.YCbCr)
+	require.True(t, ok)
+
+	var sum float64
+	for y := 0; y < f.height; y++ {
+		for x := 0; x < f.width; x++ {
+			d := float64(f.srcY[y*f.yStride+x]) - float64(decoded.Y[decoded.YOffset(x, y)])
+			sum += d * d
+		}
+	}
+	mse := sum / float64(f.width*f.height)
+	if mse == 0 {
+		return math.Inf(1)
+	}
+	return 10 * math.Log10(255*255/mse)
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	sizes := [][2]int{{64, 64}, {300, 300}, {17, 33}, {1, 1}}
+	for _, size := range sizes {
+		src := gradient(size[0], size[1])
+
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, src, &Options{Quality: 80}))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, src.Bounds(), decoded.Bounds())
+		require.Greater(t, psnr(t, newFrame(src), decoded), 30.0, "size %v", size)
+	}
+}
+
+func TestEncodeQuality(t *testing.T) {
+	t.Parallel()
+
+	src := gradient(128, 128)
+	f := newFrame(src)
+
+	var low, high bytes.Buffer
+	require.NoError(t, Encode(&low, src, &Options{Quality: 10}))
+	require.NoError(t, Encode(&high, src, &Options{Quality: 100}))
+	require.Less(t, low.Len(), high.Len())
+
+	decoded, err := webp.Decode(&high)
+	require.NoError(t, err)
+	require.Greater(t, psnr(t, f, decoded), 45.0)
+}
+
+func TestEncodeUniform(t *testing.T) {
+	t.Parallel()
+
+	src := image.NewRGBA(image.Rect(0, 0, 48, 48))
+	fill := color.RGBA{10, 200, 30, 255}
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 48; x++ {
+			src.SetRGBA(x, y, fill)
+		}
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, src, nil))
+
+	decoded, err := webp.Decode(&buf)
+	require.NoError(t, err)
+	require.Greater(t, psnr(t, newFrame(src), decoded), 40.0)
+}
+
+// FuzzEncode checks that pictures of any size, including the ones that don't
+// fill whole macroblocks, are encoded into files the reference decoder reads
+// back with the same bounds and a close luma.
+func FuzzEncode(f *testing.F) {
+	for _, seed := range [][3]int{{1, 1, 80}, {1, 300, 80}, {300, 1, 80}, {15, 17, 1}, {33, 47, 100}, {641, 639, 75}} {
+		f.Add(uint16(seed[0]), uint16(seed[1]), uint8(seed[2]))
+	}
+
+	f.Fuzz(func(t *testing.T, width, height uint16, quality uint8) {
+		// Bounded so a single run stays fast, large pictures are covered by
+		// the renditions of the imaging package.
+		w, h := int(width)%700+1, int(height)%700+1
+		src := gradient(w, h)
+
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, src, &Options{Quality: int(quality)}))
+
+		decoded, err := webp.Decode(&buf)
+		require.NoError(t, err)
+		require.Equal(t, src.Bounds(), decoded.Bounds())
+		if quality >= 50 {
+			require.Greater(t, psnr(t, newFrame(src), decoded), 25.0, "size %dx%d quality %d", w, h, quality)
+		}
+	})
+}
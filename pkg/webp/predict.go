@@ -0,0 +1,131 @@
+package webp
+
+// This file implements the whole-block intra predictors specified in chapter
+// 12. Macroblocks are predicted and reconstructed in a workspace laid out
+// exactly like the one of golang.org/x/image/vp8, so the encoder sees the same
+// pixels a decoder does:
+//
+//	row 0:      the bottom row of the macroblock above, left corner at column 7
+//	rows 1-16:  the left column at column 7, luma at columns 8-23
+//	row 17:     the bottom chroma rows above, Cb at 7-15 and Cr at 23-31
+//	rows 18-25: the left chroma columns at 7 and 23, Cb at 8-15, Cr at 24-31
+
+const (
+	wsYX = 8
+	wsYY = 1
+	wsBX = 8
+	wsBY = 18
+	wsRX = 24
+	wsRY = 18
+)
+
+// The prediction modes, in the order of the decoder.
+const (
+	predDC = iota
+	predTM
+	predVE
+	predHE
+)
+
+type workspace [1 + 16 + 1 + 8][32]uint8
+
+// prepare fills the borders of the workspace from the reconstructed frame.
+func (w *workspace) prepare(f *frame, mbx, mby int) {
+	if mbx == 0 {
+		for y := 0; y < 17; y++ {
+			w[y][7] = 0x81
+		}
+		for y := 17; y < 26; y++ {
+			w[y][7] = 0x81
+			w[y][23] = 0x81
+		}
+	} else {
+		for y := 0; y < 17; y++ {
+			w[y][7] = w[y][7+16]
+		}
+		for y := 17; y < 26; y++ {
+			w[y][7] = w[y][15]
+			w[y][23] = w[y][31]
+		}
+	}
+
+	if mby == 0 {
+		for x := 7; x < 24; x++ {
+			w[0][x] = 0x7f
+		}
+		for x := 7; x < 16; x++ {
+			w[17][x] = 0x7f
+		}
+		for x := 23; x < 32; x++ {
+			w[17][x] = 0x7f
+		}
+		return
+	}
+
+	copy(w[0][8:24], f.y[(16*mby-1)*f.yStride+16*mbx:])
+	copy(w[17][8:16], f.cb[(8*mby-1)*f.cStride+8*mbx:])
+	copy(w[17][24:32], f.cr[(8*mby-1)*f.cStride+8*mbx:])
+}
+
+// predict fills the size x size block at (y, x) with the prediction of the
+// given mode. DC prediction falls back to the available borders for the
+// macroblocks of the top row and the left column.
+func (w *workspace) predict(y, x, size int, mode uint8, mbx, mby int) {
+	switch mode {
+	case predDC:
+		sum, n := uint32(0), uint32(0)
+		if mby > 0 {
+			for i := 0; i < size; i++ {
+				sum += uint32(w[y-1][x+i])
+			}
+			n += uint32(size)
+		}
+		if mbx > 0 {
+			for j := 0; j < size; j++ {
+				sum += uint32(w[y+j][x-1])
+			}
+			n += uint32(size)
+		}
+		avg := uint8(0x80)
+		if n > 0 {
+			avg = uint8((sum + n/2) / n)
+		}
+		for j := 0; j < size; j++ {
+			for i := 0; i < size; i++ {
+				w[y+j][x+i] = avg
+			}
+		}
+	case predTM:
+		delta0 := -int32(w[y-1][x-1])
+		for j := 0; j < size; j++ {
+			delta1 := delta0 + int32(w[y+j][x-1])
+			for i := 0; i < size; i++ {
+				w[y+j][x+i] = clip8(delta1 + int32(w[y-1][x+i]))
+			}
+		}
+	case predVE:
+		for j := 0; j < size; j++ {
+			copy(w[y+j][x:x+size], w[y-1][x:x+size])
+		}
+	case predHE:
+		for j := 0; j < size; j++ {
+			for i := 0; i < size; i++ {
+				w[y+j][x+i] = w[y+j][x-1]
+			}
+		}
+	}
+}
+
+// sse returns the squared error between the predicted block at (y, x) and the
+// source block of the plane.
+func (w *workspace) sse(y, x, size int, plane []uint8, stride, offset int) uint32 {
+	var sum uint32
+	for j := 0; j < size; j++ {
+		row := plane[offset+j*stride:]
+		for i := 0; i < size; i++ {
+			d := int32(w[y+j][x+i]) - int32(row[i])
+			sum += uint32(d * d)
+		}
+	}
+	return sum
+}
@@ -0,0 +1,137 @@
+package webp
+
+// This file implements the forward transforms used by the encoder and the
+// inverse ones used to reconstruct macroblocks exactly as a decoder does, as
+// specified in sections 14.3 and 14.4. Coefficients of a 4x4 block are stored
+// in raster order: vertical frequency * 4 + horizontal frequency.
+
+func clip8(v int32) uint8 {
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+func btoi(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// forwardDCT transforms the difference between the 4x4 src and pred blocks.
+func forwardDCT(src, pred *[4][4]uint8, out []int32) {
+	var tmp [16]int32
+	for i := 0; i < 4; i++ {
+		d0 := int32(src[i][0]) - int32(pred[i][0])
+		d1 := int32(src[i][1]) - int32(pred[i][1])
+		d2 := int32(src[i][2]) - int32(pred[i][2])
+		d3 := int32(src[i][3]) - int32(pred[i][3])
+		a0 := d0 + d3
+		a1 := d1 + d2
+		a2 := d1 - d2
+		a3 := d0 - d3
+		tmp[0+i*4] = (a0 + a1) * 8
+		tmp[1+i*4] = (a2*2217 + a3*5352 + 1812) >> 9
+		tmp[2+i*4] = (a0 - a1) * 8
+		tmp[3+i*4] = (a3*2217 - a2*5352 + 937) >> 9
+	}
+	for i := 0; i < 4; i++ {
+		a0 := tmp[0+i] + tmp[12+i]
+		a1 := tmp[4+i] + tmp[8+i]
+		a2 := tmp[4+i] - tmp[8+i]
+		a3 := tmp[0+i] - tmp[12+i]
+		out[0+i] = (a0 + a1 + 7) >> 4
+		out[4+i] = ((a2*2217 + a3*5352 + 12000) >> 16) + btoi(a3 != 0)
+		out[8+i] = (a0 - a1 + 7) >> 4
+		out[12+i] = (a3*2217 - a2*5352 + 51000) >> 16
+	}
+}
+
+// forwardWHT transforms the DC coefficients of the 16 luma blocks of a
+// macroblock, given in raster order.
+func forwardWHT(in *[16]int32, out []int32) {
+	var tmp [16]int32
+	for i := 0; i < 4; i++ {
+		a0 := in[i*4+0] + in[i*4+2]
+		a1 := in[i*4+1] + in[i*4+3]
+		a2 := in[i*4+1] - in[i*4+3]
+		a3 := in[i*4+0] - in[i*4+2]
+		tmp[0+i*4] = a0 + a1
+		tmp[1+i*4] = a3 + a2
+		tmp[2+i*4] = a3 - a2
+		tmp[3+i*4] = a0 - a1
+	}
+	for i := 0; i < 4; i++ {
+		a0 := tmp[0+i] + tmp[8+i]
+		a1 := tmp[4+i] + tmp[12+i]
+		a2 := tmp[4+i] - tmp[12+i]
+		a3 := tmp[0+i] - tmp[8+i]
+		out[0+i] = (a0 + a1) >> 1
+		out[4+i] = (a3 + a2) >> 1
+		out[8+i] = (a3 - a2) >> 1
+		out[12+i] = (a0 - a1) >> 1
+	}
+}
+
+// inverseDCT adds the inverse transform of coeff to the 4x4 block at (y, x)
+// of the workspace.
+func (w *workspace) inverseDCT(y, x int, coeff []int32) {
+	const (
+		c1 = 85627 // 65536 * cos(pi/8) * sqrt(2).
+		c2 = 35468 // 65536 * sin(pi/8) * sqrt(2).
+	)
+	var m [4][4]int32
+	for i := 0; i < 4; i++ {
+		a := coeff[i+0] + coeff[i+8]
+		b := coeff[i+0] - coeff[i+8]
+		c := (coeff[i+4]*c2)>>16 - (coeff[i+12]*c1)>>16
+		d := (coeff[i+4]*c1)>>16 + (coeff[i+12]*c2)>>16
+		m[i][0] = a + d
+		m[i][1] = b + c
+		m[i][2] = b - c
+		m[i][3] = a - d
+	}
+	for j := 0; j < 4; j++ {
+		dc := m[0][j] + 4
+		a := dc + m[2][j]
+		b := dc - m[2][j]
+		c := (m[1][j]*c2)>>16 - (m[3][j]*c1)>>16
+		d := (m[1][j]*c1)>>16 + (m[3][j]*c2)>>16
+		w[y+j][x+0] = clip8(int32(w[y+j][x+0]) + (a+d)>>3)
+		w[y+j][x+1] = clip8(int32(w[y+j][x+1]) + (b+c)>>3)
+		w[y+j][x+2] = clip8(int32(w[y+j][x+2]) + (b-c)>>3)
+		w[y+j][x+3] = clip8(int32(w[y+j][x+3]) + (a-d)>>3)
+	}
+}
+
+// inverseWHT restores the DC coefficients of the 16 luma blocks, returned in
+// raster order.
+func inverseWHT(coeff []int32) [16]int32 {
+	var m, out [16]int32
+	for i := 0; i < 4; i++ {
+		a0 := coeff[0+i] + coeff[12+i]
+		a1 := coeff[4+i] + coeff[8+i]
+		a2 := coeff[4+i] - coeff[8+i]
+		a3 := coeff[0+i] - coeff[12+i]
+		m[0+i] = a0 + a1
+		m[8+i] = a0 - a1
+		m[4+i] = a3 + a2
+		m[12+i] = a3 - a2
+	}
+	for i := 0; i < 4; i++ {
+		dc := m[0+i*4] + 3
+		a0 := dc + m[3+i*4]
+		a1 := m[1+i*4] + m[2+i*4]
+		a2 := m[1+i*4] - m[2+i*4]
+		a3 := dc - m[3+i*4]
+		out[i*4+0] = (a0 + a1) >> 3
+		out[i*4+1] = (a3 + a2) >> 3
+		out[i*4+2] = (a0 - a1) >> 3
+		out[i*4+3] = (a3 - a2) >> 3
+	}
+	return out
+}
@@ -22,7 +22,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AlbumService_FindByID_FullMethodName = "/albumService.AlbumService/FindByID"
+	AlbumService_FindByID_FullMethodName      = "/albumService.AlbumService/FindByID"
+	AlbumService_ListByArtist_FullMethodName  = "/albumService.AlbumService/ListByArtist"
+	AlbumService_ListRecent_FullMethodName    = "/albumService.AlbumService/ListRecent"
+	AlbumService_ListFavorites_FullMethodName = "/albumService.AlbumService/ListFavorites"
+	AlbumService_IsFavorite_FullMethodName    = "/albumService.AlbumService/IsFavorite"
 )
 
 // AlbumServiceClient is the client API for AlbumService service.
@@ -30,6 +34,10 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AlbumServiceClient interface {
 	FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error)
+	ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListRecent(ctx context.Context, in *ListRecentRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error)
 }
 
 type albumServiceClient struct {
@@ -50,11 +58,55 @@ func (c *albumServiceClient) FindByID(ctx context.Context, in *FindByIDRequest,
 	return out, nil
 }
 
+func (c *albumServiceClient) ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, AlbumService_ListByArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ListRecent(ctx context.Context, in *ListRecentRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, AlbumService_ListRecent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, AlbumService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFavoriteResponse)
+	err := c.cc.Invoke(ctx, AlbumService_IsFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlbumServiceServer is the server API for AlbumService service.
 // All implementations must embed UnimplementedAlbumServiceServer
 // for forward compatibility.
 type AlbumServiceServer interface {
 	FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error)
+	ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error)
+	ListRecent(context.Context, *ListRecentRequest) (*ListResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error)
+	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error)
 	mustEmbedUnimplementedAlbumServiceServer()
 }
 
@@ -68,6 +120,18 @@ type UnimplementedAlbumServiceServer struct{}
 func (UnimplementedAlbumServiceServer) FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByID not implemented")
 }
+func (UnimplementedAlbumServiceServer) ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByArtist not implemented")
+}
+func (UnimplementedAlbumServiceServer) ListRecent(context.Context, *ListRecentRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecent not implemented")
+}
+func (UnimplementedAlbumServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedAlbumServiceServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
+}
 func (UnimplementedAlbumServiceServer) mustEmbedUnimplementedAlbumServiceServer() {}
 func (UnimplementedAlbumServiceServer) testEmbeddedByValue()                      {}
 
@@ -107,6 +171,78 @@ func _AlbumService_FindByID_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlbumService_ListByArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListByArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_ListByArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListByArtist(ctx, req.(*ListByArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ListRecent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListRecent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_ListRecent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListRecent(ctx, req.(*ListRecentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_IsFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).IsFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_IsFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).IsFavorite(ctx, req.(*IsFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AlbumService_ServiceDesc is the grpc.ServiceDesc for AlbumService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -118,6 +254,22 @@ var AlbumService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindByID",
 			Handler:    _AlbumService_FindByID_Handler,
 		},
+		{
+			MethodName: "ListByArtist",
+			Handler:    _AlbumService_ListByArtist_Handler,
+		},
+		{
+			MethodName: "ListRecent",
+			Handler:    _AlbumService_ListRecent_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _AlbumService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "IsFavorite",
+			Handler:    _AlbumService_IsFavorite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/album/album.proto",
@@ -22,7 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArtistService_FindByID_FullMethodName = "/artistService.ArtistService/FindByID"
+	ArtistService_FindByID_FullMethodName      = "/artistService.ArtistService/FindByID"
+	ArtistService_ListPopular_FullMethodName   = "/artistService.ArtistService/ListPopular"
+	ArtistService_ListFavorites_FullMethodName = "/artistService.ArtistService/ListFavorites"
+	ArtistService_IsFavorite_FullMethodName    = "/artistService.ArtistService/IsFavorite"
 )
 
 // ArtistServiceClient is the client API for ArtistService service.
@@ -30,6 +33,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ArtistServiceClient interface {
 	FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error)
+	ListPopular(ctx context.Context, in *ListPopularRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error)
 }
 
 type artistServiceClient struct {
@@ -50,11 +56,44 @@ func (c *artistServiceClient) FindByID(ctx context.Context, in *FindByIDRequest,
 	return out, nil
 }
 
+func (c *artistServiceClient) ListPopular(ctx context.Context, in *ListPopularRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, ArtistService_ListPopular_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, ArtistService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFavoriteResponse)
+	err := c.cc.Invoke(ctx, ArtistService_IsFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtistServiceServer is the server API for ArtistService service.
 // All implementations must embed UnimplementedArtistServiceServer
 // for forward compatibility.
 type ArtistServiceServer interface {
 	FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error)
+	ListPopular(context.Context, *ListPopularRequest) (*ListResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error)
+	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error)
 	mustEmbedUnimplementedArtistServiceServer()
 }
 
@@ -68,6 +107,15 @@ type UnimplementedArtistServiceServer struct{}
 func (UnimplementedArtistServiceServer) FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindByID not implemented")
 }
+func (UnimplementedArtistServiceServer) ListPopular(context.Context, *ListPopularRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPopular not implemented")
+}
+func (UnimplementedArtistServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedArtistServiceServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
+}
 func (UnimplementedArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {}
 func (UnimplementedArtistServiceServer) testEmbeddedByValue()                       {}
 
@@ -107,6 +155,60 @@ func _ArtistService_FindByID_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtistService_ListPopular_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopularRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListPopular(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_ListPopular_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListPopular(ctx, req.(*ListPopularRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_IsFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).IsFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_IsFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).IsFavorite(ctx, req.(*IsFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArtistService_ServiceDesc is the grpc.ServiceDesc for ArtistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -118,6 +220,18 @@ var ArtistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindByID",
 			Handler:    _ArtistService_FindByID_Handler,
 		},
+		{
+			MethodName: "ListPopular",
+			Handler:    _ArtistService_ListPopular_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _ArtistService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "IsFavorite",
+			Handler:    _ArtistService_IsFavorite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/artist/artist.proto",
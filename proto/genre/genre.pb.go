@@ -0,0 +1,525 @@
+// protoc --go_out=proto/genre/. --go-grpc_out=proto/genre/.
+// proto/genre/genre.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.28.3
+// source: proto/genre/genre.proto
+
+package genreService
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Genre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RusName string `protobuf:"bytes,3,opt,name=rus_name,json=rusName,proto3" json:"rus_name,omitempty"`
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	mi := &file_proto_genre_genre_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Genre) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Genre) GetRusName() string {
+	if x != nil {
+		return x.RusName
+	}
+	return ""
+}
+
+type FindByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindByIDRequest) Reset() {
+	*x = FindByIDRequest{}
+	mi := &file_proto_genre_genre_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIDRequest) ProtoMessage() {}
+
+func (x *FindByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIDRequest.ProtoReflect.Descriptor instead.
+func (*FindByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type FindByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genre *Genre `protobuf:"bytes,1,opt,name=genre,proto3" json:"genre,omitempty"`
+}
+
+func (x *FindByIDResponse) Reset() {
+	*x = FindByIDResponse{}
+	mi := &file_proto_genre_genre_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIDResponse) ProtoMessage() {}
+
+func (x *FindByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIDResponse.ProtoReflect.Descriptor instead.
+func (*FindByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FindByIDResponse) GetGenre() *Genre {
+	if x != nil {
+		return x.Genre
+	}
+	return nil
+}
+
+// genres not found are left out of the response
+type FindByIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *FindByIDsRequest) Reset() {
+	*x = FindByIDsRequest{}
+	mi := &file_proto_genre_genre_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIDsRequest) ProtoMessage() {}
+
+func (x *FindByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIDsRequest.ProtoReflect.Descriptor instead.
+func (*FindByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindByIDsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type FindByIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genres []*Genre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+}
+
+func (x *FindByIDsResponse) Reset() {
+	*x = FindByIDsResponse{}
+	mi := &file_proto_genre_genre_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindByIDsResponse) ProtoMessage() {}
+
+func (x *FindByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindByIDsResponse.ProtoReflect.Descriptor instead.
+func (*FindByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindByIDsResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+type ListByArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistID uint64 `protobuf:"varint,1,opt,name=artistID,proto3" json:"artistID,omitempty"`
+}
+
+func (x *ListByArtistRequest) Reset() {
+	*x = ListByArtistRequest{}
+	mi := &file_proto_genre_genre_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListByArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByArtistRequest) ProtoMessage() {}
+
+func (x *ListByArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByArtistRequest.ProtoReflect.Descriptor instead.
+func (*ListByArtistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListByArtistRequest) GetArtistID() uint64 {
+	if x != nil {
+		return x.ArtistID
+	}
+	return 0
+}
+
+type ListByTrackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackID uint64 `protobuf:"varint,1,opt,name=trackID,proto3" json:"trackID,omitempty"`
+}
+
+func (x *ListByTrackRequest) Reset() {
+	*x = ListByTrackRequest{}
+	mi := &file_proto_genre_genre_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListByTrackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListByTrackRequest) ProtoMessage() {}
+
+func (x *ListByTrackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListByTrackRequest.ProtoReflect.Descriptor instead.
+func (*ListByTrackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListByTrackRequest) GetTrackID() uint64 {
+	if x != nil {
+		return x.TrackID
+	}
+	return 0
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genres []*Genre `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_proto_genre_genre_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_genre_genre_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_genre_genre_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListResponse) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+var File_proto_genre_genre_proto protoreflect.FileDescriptor
+
+var file_proto_genre_genre_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x2f, 0x67, 0x65,
+	0x6e, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x46, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x21, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3d, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x05, 0x67, 0x65, 0x6e, 0x72,
+	0x65, 0x22, 0x24, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x42,
+	0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x22, 0x3b, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x72,
+	0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x32, 0xc3, 0x02, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x46, 0x69,
+	0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1d, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49,
+	0x44, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x12, 0x20, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x10, 0x5a, 0x0e, 0x2e, 0x3b, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_genre_genre_proto_rawDescOnce sync.Once
+	file_proto_genre_genre_proto_rawDescData = file_proto_genre_genre_proto_rawDesc
+)
+
+func file_proto_genre_genre_proto_rawDescGZIP() []byte {
+	file_proto_genre_genre_proto_rawDescOnce.Do(func() {
+		file_proto_genre_genre_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_genre_genre_proto_rawDescData)
+	})
+	return file_proto_genre_genre_proto_rawDescData
+}
+
+var file_proto_genre_genre_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_genre_genre_proto_goTypes = []any{
+	(*Genre)(nil),               // 0: genreService.Genre
+	(*FindByIDRequest)(nil),     // 1: genreService.FindByIDRequest
+	(*FindByIDResponse)(nil),    // 2: genreService.FindByIDResponse
+	(*FindByIDsRequest)(nil),    // 3: genreService.FindByIDsRequest
+	(*FindByIDsResponse)(nil),   // 4: genreService.FindByIDsResponse
+	(*ListByArtistRequest)(nil), // 5: genreService.ListByArtistRequest
+	(*ListByTrackRequest)(nil),  // 6: genreService.ListByTrackRequest
+	(*ListResponse)(nil),        // 7: genreService.ListResponse
+}
+var file_proto_genre_genre_proto_depIdxs = []int32{
+	0, // 0: genreService.FindByIDResponse.genre:type_name -> genreService.Genre
+	0, // 1: genreService.FindByIDsResponse.genres:type_name -> genreService.Genre
+	0, // 2: genreService.ListResponse.genres:type_name -> genreService.Genre
+	1, // 3: genreService.GenreService.FindByID:input_type -> genreService.FindByIDRequest
+	3, // 4: genreService.GenreService.FindByIDs:input_type -> genreService.FindByIDsRequest
+	5, // 5: genreService.GenreService.ListByArtist:input_type -> genreService.ListByArtistRequest
+	6, // 6: genreService.GenreService.ListByTrack:input_type -> genreService.ListByTrackRequest
+	2, // 7: genreService.GenreService.FindByID:output_type -> genreService.FindByIDResponse
+	4, // 8: genreService.GenreService.FindByIDs:output_type -> genreService.FindByIDsResponse
+	7, // 9: genreService.GenreService.ListByArtist:output_type -> genreService.ListResponse
+	7, // 10: genreService.GenreService.ListByTrack:output_type -> genreService.ListResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_genre_genre_proto_init() }
+func file_proto_genre_genre_proto_init() {
+	if File_proto_genre_genre_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_genre_genre_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_genre_genre_proto_goTypes,
+		DependencyIndexes: file_proto_genre_genre_proto_depIdxs,
+		MessageInfos:      file_proto_genre_genre_proto_msgTypes,
+	}.Build()
+	File_proto_genre_genre_proto = out.File
+	file_proto_genre_genre_proto_rawDesc = nil
+	file_proto_genre_genre_proto_goTypes = nil
+	file_proto_genre_genre_proto_depIdxs = nil
+}
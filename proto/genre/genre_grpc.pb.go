@@ -0,0 +1,238 @@
+// protoc --go_out=proto/genre/. --go-grpc_out=proto/genre/.
+// proto/genre/genre.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: proto/genre/genre.proto
+
+package genreService
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GenreService_FindByID_FullMethodName     = "/genreService.GenreService/FindByID"
+	GenreService_FindByIDs_FullMethodName    = "/genreService.GenreService/FindByIDs"
+	GenreService_ListByArtist_FullMethodName = "/genreService.GenreService/ListByArtist"
+	GenreService_ListByTrack_FullMethodName  = "/genreService.GenreService/ListByTrack"
+)
+
+// GenreServiceClient is the client API for GenreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GenreServiceClient interface {
+	FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error)
+	FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error)
+	ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListByTrack(ctx context.Context, in *ListByTrackRequest, opts ...grpc.CallOption) (*ListResponse, error)
+}
+
+type genreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGenreServiceClient(cc grpc.ClientConnInterface) GenreServiceClient {
+	return &genreServiceClient{cc}
+}
+
+func (c *genreServiceClient) FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDResponse)
+	err := c.cc.Invoke(ctx, GenreService_FindByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDsResponse)
+	err := c.cc.Invoke(ctx, GenreService_FindByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, GenreService_ListByArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *genreServiceClient) ListByTrack(ctx context.Context, in *ListByTrackRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, GenreService_ListByTrack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GenreServiceServer is the server API for GenreService service.
+// All implementations must embed UnimplementedGenreServiceServer
+// for forward compatibility.
+type GenreServiceServer interface {
+	FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error)
+	FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error)
+	ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error)
+	ListByTrack(context.Context, *ListByTrackRequest) (*ListResponse, error)
+	mustEmbedUnimplementedGenreServiceServer()
+}
+
+// UnimplementedGenreServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGenreServiceServer struct{}
+
+func (UnimplementedGenreServiceServer) FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByID not implemented")
+}
+func (UnimplementedGenreServiceServer) FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByIDs not implemented")
+}
+func (UnimplementedGenreServiceServer) ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByArtist not implemented")
+}
+func (UnimplementedGenreServiceServer) ListByTrack(context.Context, *ListByTrackRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByTrack not implemented")
+}
+func (UnimplementedGenreServiceServer) mustEmbedUnimplementedGenreServiceServer() {}
+func (UnimplementedGenreServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeGenreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GenreServiceServer will
+// result in compilation errors.
+type UnsafeGenreServiceServer interface {
+	mustEmbedUnimplementedGenreServiceServer()
+}
+
+func RegisterGenreServiceServer(s grpc.ServiceRegistrar, srv GenreServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGenreServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GenreService_ServiceDesc, srv)
+}
+
+func _GenreService_FindByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).FindByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_FindByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).FindByID(ctx, req.(*FindByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_FindByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).FindByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_FindByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).FindByIDs(ctx, req.(*FindByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_ListByArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).ListByArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_ListByArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).ListByArtist(ctx, req.(*ListByArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GenreService_ListByTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenreServiceServer).ListByTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GenreService_ListByTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenreServiceServer).ListByTrack(ctx, req.(*ListByTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GenreService_ServiceDesc is the grpc.ServiceDesc for GenreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GenreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "genreService.GenreService",
+	HandlerType: (*GenreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindByID",
+			Handler:    _GenreService_FindByID_Handler,
+		},
+		{
+			MethodName: "FindByIDs",
+			Handler:    _GenreService_FindByIDs_Handler,
+		},
+		{
+			MethodName: "ListByArtist",
+			Handler:    _GenreService_ListByArtist_Handler,
+		},
+		{
+			MethodName: "ListByTrack",
+			Handler:    _GenreService_ListByTrack_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/genre/genre.proto",
+}
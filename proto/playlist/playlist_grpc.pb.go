@@ -0,0 +1,314 @@
+// protoc --go_out=proto/playlist/. --go-grpc_out=proto/playlist/.
+// proto/playlist/playlist.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: proto/playlist/playlist.proto
+
+package playlistService
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PlaylistService_FindByID_FullMethodName      = "/playlistService.PlaylistService/FindByID"
+	PlaylistService_FindByIDs_FullMethodName     = "/playlistService.PlaylistService/FindByIDs"
+	PlaylistService_ListByOwner_FullMethodName   = "/playlistService.PlaylistService/ListByOwner"
+	PlaylistService_ListFavorites_FullMethodName = "/playlistService.PlaylistService/ListFavorites"
+	PlaylistService_ListTrackIDs_FullMethodName  = "/playlistService.PlaylistService/ListTrackIDs"
+	PlaylistService_IsFavorite_FullMethodName    = "/playlistService.PlaylistService/IsFavorite"
+)
+
+// PlaylistServiceClient is the client API for PlaylistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlaylistServiceClient interface {
+	FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error)
+	FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error)
+	ListByOwner(ctx context.Context, in *ListByOwnerRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListTrackIDs(ctx context.Context, in *ListTrackIDsRequest, opts ...grpc.CallOption) (*ListTrackIDsResponse, error)
+	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error)
+}
+
+type playlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaylistServiceClient(cc grpc.ClientConnInterface) PlaylistServiceClient {
+	return &playlistServiceClient{cc}
+}
+
+func (c *playlistServiceClient) FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_FindByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_FindByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListByOwner(ctx context.Context, in *ListByOwnerRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListByOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) ListTrackIDs(ctx context.Context, in *ListTrackIDsRequest, opts ...grpc.CallOption) (*ListTrackIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrackIDsResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_ListTrackIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistServiceClient) IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFavoriteResponse)
+	err := c.cc.Invoke(ctx, PlaylistService_IsFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlaylistServiceServer is the server API for PlaylistService service.
+// All implementations must embed UnimplementedPlaylistServiceServer
+// for forward compatibility.
+type PlaylistServiceServer interface {
+	FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error)
+	FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error)
+	ListByOwner(context.Context, *ListByOwnerRequest) (*ListResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error)
+	ListTrackIDs(context.Context, *ListTrackIDsRequest) (*ListTrackIDsResponse, error)
+	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error)
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+// UnimplementedPlaylistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPlaylistServiceServer struct{}
+
+func (UnimplementedPlaylistServiceServer) FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByID not implemented")
+}
+func (UnimplementedPlaylistServiceServer) FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByIDs not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListByOwner(context.Context, *ListByOwnerRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByOwner not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedPlaylistServiceServer) ListTrackIDs(context.Context, *ListTrackIDsRequest) (*ListTrackIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrackIDs not implemented")
+}
+func (UnimplementedPlaylistServiceServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
+}
+func (UnimplementedPlaylistServiceServer) mustEmbedUnimplementedPlaylistServiceServer() {}
+func (UnimplementedPlaylistServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafePlaylistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaylistServiceServer will
+// result in compilation errors.
+type UnsafePlaylistServiceServer interface {
+	mustEmbedUnimplementedPlaylistServiceServer()
+}
+
+func RegisterPlaylistServiceServer(s grpc.ServiceRegistrar, srv PlaylistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPlaylistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PlaylistService_ServiceDesc, srv)
+}
+
+func _PlaylistService_FindByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).FindByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_FindByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).FindByID(ctx, req.(*FindByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_FindByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).FindByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_FindByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).FindByIDs(ctx, req.(*FindByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListByOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListByOwner(ctx, req.(*ListByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_ListTrackIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrackIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).ListTrackIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_ListTrackIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).ListTrackIDs(ctx, req.(*ListTrackIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistService_IsFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistServiceServer).IsFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistService_IsFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistServiceServer).IsFavorite(ctx, req.(*IsFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PlaylistService_ServiceDesc is the grpc.ServiceDesc for PlaylistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaylistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "playlistService.PlaylistService",
+	HandlerType: (*PlaylistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindByID",
+			Handler:    _PlaylistService_FindByID_Handler,
+		},
+		{
+			MethodName: "FindByIDs",
+			Handler:    _PlaylistService_FindByIDs_Handler,
+		},
+		{
+			MethodName: "ListByOwner",
+			Handler:    _PlaylistService_ListByOwner_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _PlaylistService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "ListTrackIDs",
+			Handler:    _PlaylistService_ListTrackIDs_Handler,
+		},
+		{
+			MethodName: "IsFavorite",
+			Handler:    _PlaylistService_IsFavorite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/playlist/playlist.proto",
+}
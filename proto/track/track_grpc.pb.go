@@ -0,0 +1,352 @@
+// protoc --go_out=proto/track/. --go-grpc_out=proto/track/.
+// proto/track/track.proto
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: proto/track/track.proto
+
+package trackService
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TrackService_FindByID_FullMethodName      = "/trackService.TrackService/FindByID"
+	TrackService_FindByIDs_FullMethodName     = "/trackService.TrackService/FindByIDs"
+	TrackService_ListByArtist_FullMethodName  = "/trackService.TrackService/ListByArtist"
+	TrackService_ListByAlbum_FullMethodName   = "/trackService.TrackService/ListByAlbum"
+	TrackService_ListFavorites_FullMethodName = "/trackService.TrackService/ListFavorites"
+	TrackService_ListPopular_FullMethodName   = "/trackService.TrackService/ListPopular"
+	TrackService_IsFavorite_FullMethodName    = "/trackService.TrackService/IsFavorite"
+)
+
+// TrackServiceClient is the client API for TrackService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrackServiceClient interface {
+	FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error)
+	FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error)
+	ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListByAlbum(ctx context.Context, in *ListByAlbumRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	ListPopular(ctx context.Context, in *ListPopularRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error)
+}
+
+type trackServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrackServiceClient(cc grpc.ClientConnInterface) TrackServiceClient {
+	return &trackServiceClient{cc}
+}
+
+func (c *trackServiceClient) FindByID(ctx context.Context, in *FindByIDRequest, opts ...grpc.CallOption) (*FindByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDResponse)
+	err := c.cc.Invoke(ctx, TrackService_FindByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) FindByIDs(ctx context.Context, in *FindByIDsRequest, opts ...grpc.CallOption) (*FindByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindByIDsResponse)
+	err := c.cc.Invoke(ctx, TrackService_FindByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListByArtist(ctx context.Context, in *ListByArtistRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, TrackService_ListByArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListByAlbum(ctx context.Context, in *ListByAlbumRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, TrackService_ListByAlbum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, TrackService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ListPopular(ctx context.Context, in *ListPopularRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, TrackService_ListPopular_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) IsFavorite(ctx context.Context, in *IsFavoriteRequest, opts ...grpc.CallOption) (*IsFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsFavoriteResponse)
+	err := c.cc.Invoke(ctx, TrackService_IsFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackServiceServer is the server API for TrackService service.
+// All implementations must embed UnimplementedTrackServiceServer
+// for forward compatibility.
+type TrackServiceServer interface {
+	FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error)
+	FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error)
+	ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error)
+	ListByAlbum(context.Context, *ListByAlbumRequest) (*ListResponse, error)
+	ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error)
+	ListPopular(context.Context, *ListPopularRequest) (*ListResponse, error)
+	IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error)
+	mustEmbedUnimplementedTrackServiceServer()
+}
+
+// UnimplementedTrackServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrackServiceServer struct{}
+
+func (UnimplementedTrackServiceServer) FindByID(context.Context, *FindByIDRequest) (*FindByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByID not implemented")
+}
+func (UnimplementedTrackServiceServer) FindByIDs(context.Context, *FindByIDsRequest) (*FindByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindByIDs not implemented")
+}
+func (UnimplementedTrackServiceServer) ListByArtist(context.Context, *ListByArtistRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByArtist not implemented")
+}
+func (UnimplementedTrackServiceServer) ListByAlbum(context.Context, *ListByAlbumRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListByAlbum not implemented")
+}
+func (UnimplementedTrackServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedTrackServiceServer) ListPopular(context.Context, *ListPopularRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPopular not implemented")
+}
+func (UnimplementedTrackServiceServer) IsFavorite(context.Context, *IsFavoriteRequest) (*IsFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFavorite not implemented")
+}
+func (UnimplementedTrackServiceServer) mustEmbedUnimplementedTrackServiceServer() {}
+func (UnimplementedTrackServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTrackServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackServiceServer will
+// result in compilation errors.
+type UnsafeTrackServiceServer interface {
+	mustEmbedUnimplementedTrackServiceServer()
+}
+
+func RegisterTrackServiceServer(s grpc.ServiceRegistrar, srv TrackServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTrackServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrackService_ServiceDesc, srv)
+}
+
+func _TrackService_FindByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).FindByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_FindByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).FindByID(ctx, req.(*FindByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_FindByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).FindByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_FindByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).FindByIDs(ctx, req.(*FindByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListByArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListByArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListByArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListByArtist(ctx, req.(*ListByArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListByAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListByAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListByAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListByAlbum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListByAlbum(ctx, req.(*ListByAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListFavorites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListFavorites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ListPopular_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopularRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ListPopular(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_ListPopular_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ListPopular(ctx, req.(*ListPopularRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_IsFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).IsFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrackService_IsFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).IsFavorite(ctx, req.(*IsFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrackService_ServiceDesc is the grpc.ServiceDesc for TrackService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrackService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "trackService.TrackService",
+	HandlerType: (*TrackServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindByID",
+			Handler:    _TrackService_FindByID_Handler,
+		},
+		{
+			MethodName: "FindByIDs",
+			Handler:    _TrackService_FindByIDs_Handler,
+		},
+		{
+			MethodName: "ListByArtist",
+			Handler:    _TrackService_ListByArtist_Handler,
+		},
+		{
+			MethodName: "ListByAlbum",
+			Handler:    _TrackService_ListByAlbum_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _TrackService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "ListPopular",
+			Handler:    _TrackService_ListPopular_Handler,
+		},
+		{
+			MethodName: "IsFavorite",
+			Handler:    _TrackService_IsFavorite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/track/track.proto",
+}